@@ -60,6 +60,23 @@ type DateTimeNode struct {
 	Value    time.Time
 }
 
+// NumericNode represents a number compared with an operator, e.g. a size
+type NumericNode struct {
+	*Base
+	Key      string
+	Operator *OperatorNode
+	Value    float64
+}
+
+// ProximityNode represents two terms which occur within Distance words of each other
+type ProximityNode struct {
+	*Base
+	Key      string
+	Left     string
+	Right    string
+	Distance int
+}
+
 // OperatorNode represents an operator value like
 // AND, OR, NOT, =, <= ... and so on
 type OperatorNode struct {
@@ -81,6 +98,10 @@ func NodeKey(n Node) string {
 		return node.Key
 	case *DateTimeNode:
 		return node.Key
+	case *NumericNode:
+		return node.Key
+	case *ProximityNode:
+		return node.Key
 	case *BooleanNode:
 		return node.Key
 	case *GroupNode:
@@ -97,6 +118,10 @@ func NodeValue(n Node) interface{} {
 		return node.Value
 	case *DateTimeNode:
 		return node.Value
+	case *NumericNode:
+		return node.Value
+	case *ProximityNode:
+		return []string{node.Left, node.Right}
 	case *BooleanNode:
 		return node.Value
 	case *GroupNode:
@@ -0,0 +1,78 @@
+// Package favorite provides a favorites manager persisting the favorites in a go-micro store.
+package favorite
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	user "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/opencloud-eu/reva/v2/pkg/storage/favorite"
+	"github.com/opencloud-eu/reva/v2/pkg/storagespace"
+	microstore "go-micro.dev/v4/store"
+)
+
+// Manager keeps the favorites of the users in a store. The ocdav service
+// records the favorites when they are set with a PROPPATCH, the webdav service
+// looks them up to answer filter-files reports. Both have to share the store.
+type Manager struct {
+	store microstore.Store
+}
+
+var _ favorite.Manager = (*Manager)(nil)
+
+// NewManager returns a favorites manager using the given store
+func NewManager(st microstore.Store) *Manager {
+	return &Manager{store: st}
+}
+
+// ListFavorites returns the ids of the resources marked as favorite by the user
+func (m *Manager) ListFavorites(_ context.Context, userID *user.UserId) ([]*provider.ResourceId, error) {
+	prefix := userPrefix(userID)
+	keys, err := m.store.List(microstore.ListPrefix(prefix))
+	if err != nil {
+		return nil, err
+	}
+
+	favorites := make([]*provider.ResourceId, 0, len(keys))
+	for _, key := range keys {
+		id, err := storagespace.ParseID(strings.TrimPrefix(key, prefix))
+		if err != nil {
+			// not written by us, ignore it
+			continue
+		}
+		favorites = append(favorites, &id)
+	}
+	return favorites, nil
+}
+
+// SetFavorite marks the resource as favorite of the user
+func (m *Manager) SetFavorite(_ context.Context, userID *user.UserId, resourceInfo *provider.ResourceInfo) error {
+	return m.store.Write(&microstore.Record{
+		Key: key(userID, resourceInfo.GetId()),
+	})
+}
+
+// UnsetFavorite removes the favorite mark of the user from the resource
+func (m *Manager) UnsetFavorite(_ context.Context, userID *user.UserId, resourceInfo *provider.ResourceInfo) error {
+	err := m.store.Delete(key(userID, resourceInfo.GetId()))
+	if errors.Is(err, microstore.ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+// userPrefix returns the prefix of the keys of a user. The user id is hashed, the
+// prefixes have the same length then and the prefix of one user can't be the
+// beginning of the prefix of another one, like 'alice.' of 'alice.smith.'.
+func userPrefix(userID *user.UserId) string {
+	h := sha256.Sum256([]byte(userID.GetOpaqueId()))
+	return hex.EncodeToString(h[:]) + "."
+}
+
+func key(userID *user.UserId, id *provider.ResourceId) string {
+	return userPrefix(userID) + storagespace.FormatResourceID(id)
+}
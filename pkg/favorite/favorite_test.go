@@ -0,0 +1,64 @@
+package favorite
+
+import (
+	"context"
+	"testing"
+
+	user "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/stretchr/testify/require"
+	microstore "go-micro.dev/v4/store"
+)
+
+func TestManager(t *testing.T) {
+	ctx := context.Background()
+	m := NewManager(microstore.NewMemoryStore())
+	einstein := &user.UserId{OpaqueId: "einstein"}
+	marie := &user.UserId{OpaqueId: "marie"}
+	file := &provider.ResourceInfo{Id: &provider.ResourceId{StorageId: "storage", SpaceId: "space", OpaqueId: "file"}}
+	folder := &provider.ResourceInfo{Id: &provider.ResourceId{StorageId: "storage", SpaceId: "space", OpaqueId: "folder"}}
+
+	favorites, err := m.ListFavorites(ctx, einstein)
+	require.NoError(t, err)
+	require.Empty(t, favorites)
+
+	require.NoError(t, m.SetFavorite(ctx, einstein, file))
+	require.NoError(t, m.SetFavorite(ctx, einstein, folder))
+	require.NoError(t, m.SetFavorite(ctx, einstein, folder))
+	require.NoError(t, m.SetFavorite(ctx, marie, file))
+
+	favorites, err = m.ListFavorites(ctx, einstein)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []*provider.ResourceId{file.Id, folder.Id}, favorites)
+
+	require.NoError(t, m.UnsetFavorite(ctx, einstein, file))
+	require.NoError(t, m.UnsetFavorite(ctx, einstein, file))
+
+	favorites, err = m.ListFavorites(ctx, einstein)
+	require.NoError(t, err)
+	require.Len(t, favorites, 1)
+	require.Equal(t, "folder", favorites[0].GetOpaqueId())
+
+	favorites, err = m.ListFavorites(ctx, marie)
+	require.NoError(t, err)
+	require.Len(t, favorites, 1)
+	require.Equal(t, "file", favorites[0].GetOpaqueId())
+}
+
+func TestManagerOverlappingUserIDs(t *testing.T) {
+	ctx := context.Background()
+	m := NewManager(microstore.NewMemoryStore())
+	alice := &user.UserId{OpaqueId: "alice"}
+	aliceSmith := &user.UserId{OpaqueId: "alice.smith"}
+	file := &provider.ResourceInfo{Id: &provider.ResourceId{StorageId: "storage", SpaceId: "space", OpaqueId: "file"}}
+
+	require.NoError(t, m.SetFavorite(ctx, aliceSmith, file))
+
+	favorites, err := m.ListFavorites(ctx, alice)
+	require.NoError(t, err)
+	require.Empty(t, favorites)
+
+	favorites, err = m.ListFavorites(ctx, aliceSmith)
+	require.NoError(t, err)
+	require.Len(t, favorites, 1)
+}
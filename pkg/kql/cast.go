@@ -2,7 +2,10 @@ package kql
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/jinzhu/now"
 	"github.com/opencloud-eu/opencloud/pkg/ast"
@@ -70,6 +73,53 @@ func toString(in interface{}) (string, error) {
 	}
 }
 
+// _numberUnits are the factors of the byte units a number can have
+var _numberUnits = map[string]float64{
+	"":    1,
+	"KB":  1e3,
+	"MB":  1e6,
+	"GB":  1e9,
+	"TB":  1e12,
+	"PB":  1e15,
+	"EB":  1e18,
+	"KIB": 1 << 10,
+	"MIB": 1 << 20,
+	"GIB": 1 << 30,
+	"TIB": 1 << 40,
+	"PIB": 1 << 50,
+	"EIB": 1 << 60,
+}
+
+// ParseNumber parses a number with an optional byte unit like 10, 1.5MB or 2GiB, the units are case-insensitive
+func ParseNumber(s string) (float64, error) {
+	s = strings.TrimSpace(s)
+	number, unit := s, ""
+	if i := strings.IndexFunc(s, func(r rune) bool { return r != '.' && !unicode.IsDigit(r) }); i >= 0 {
+		number, unit = s[:i], s[i:]
+	}
+
+	factor, ok := _numberUnits[strings.ToUpper(unit)]
+	if !ok {
+		return 0, fmt.Errorf("unknown unit '%s' in '%s'", unit, s)
+	}
+
+	v, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, fmt.Errorf("'%s' is not a number", s)
+	}
+
+	return v * factor, nil
+}
+
+func toNumber(in interface{}) (float64, error) {
+	s, err := toString(in)
+	if err != nil {
+		return 0, err
+	}
+
+	return ParseNumber(s)
+}
+
 func toTime(in interface{}) (time.Time, error) {
 	ts, err := toString(in)
 	if err != nil {
@@ -43,12 +43,12 @@ var g = &grammar{
 					pos: position{line: 19, col: 6, offset: 351},
 					exprs: []any{
 						&actionExpr{
-							pos: position{line: 233, col: 5, offset: 4804},
+							pos: position{line: 266, col: 5, offset: 5545},
 							run: (*parser).callonNodes3,
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 233, col: 5, offset: 4804},
+								pos: position{line: 266, col: 5, offset: 5545},
 								expr: &charClassMatcher{
-									pos:        position{line: 233, col: 5, offset: 4804},
+									pos:        position{line: 266, col: 5, offset: 5545},
 									val:        "[ \\t]",
 									chars:      []rune{' ', '\t'},
 									ignoreCase: false,
@@ -75,21 +75,21 @@ var g = &grammar{
 						name: "GroupNode",
 					},
 					&actionExpr{
-						pos: position{line: 46, col: 5, offset: 1042},
+						pos: position{line: 47, col: 5, offset: 1071},
 						run: (*parser).callonNode3,
 						expr: &seqExpr{
-							pos: position{line: 46, col: 5, offset: 1042},
+							pos: position{line: 47, col: 5, offset: 1071},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 46, col: 5, offset: 1042},
+									pos:   position{line: 47, col: 5, offset: 1071},
 									label: "k",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 46, col: 7, offset: 1044},
+										pos: position{line: 47, col: 7, offset: 1073},
 										expr: &actionExpr{
-											pos: position{line: 218, col: 5, offset: 4634},
+											pos: position{line: 246, col: 5, offset: 5275},
 											run: (*parser).callonNode7,
 											expr: &charClassMatcher{
-												pos:        position{line: 218, col: 5, offset: 4634},
+												pos:        position{line: 246, col: 5, offset: 5275},
 												val:        "[A-Za-z]",
 												ranges:     []rune{'A', 'Z', 'a', 'z'},
 												ignoreCase: false,
@@ -99,23 +99,23 @@ var g = &grammar{
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 46, col: 14, offset: 1051},
+									pos: position{line: 47, col: 14, offset: 1080},
 									alternatives: []any{
 										&actionExpr{
-											pos: position{line: 120, col: 5, offset: 2911},
+											pos: position{line: 148, col: 5, offset: 3552},
 											run: (*parser).callonNode10,
 											expr: &litMatcher{
-												pos:        position{line: 120, col: 5, offset: 2911},
+												pos:        position{line: 148, col: 5, offset: 3552},
 												val:        ":",
 												ignoreCase: false,
 												want:       "\":\"",
 											},
 										},
 										&actionExpr{
-											pos: position{line: 125, col: 5, offset: 2997},
+											pos: position{line: 153, col: 5, offset: 3638},
 											run: (*parser).callonNode12,
 											expr: &litMatcher{
-												pos:        position{line: 125, col: 5, offset: 2997},
+												pos:        position{line: 153, col: 5, offset: 3638},
 												val:        "=",
 												ignoreCase: false,
 												want:       "\"=\"",
@@ -124,19 +124,19 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 46, col: 53, offset: 1090},
+									pos:   position{line: 47, col: 53, offset: 1119},
 									label: "v",
 									expr: &choiceExpr{
-										pos: position{line: 46, col: 56, offset: 1093},
+										pos: position{line: 47, col: 56, offset: 1122},
 										alternatives: []any{
 											&litMatcher{
-												pos:        position{line: 46, col: 56, offset: 1093},
+												pos:        position{line: 47, col: 56, offset: 1122},
 												val:        "true",
 												ignoreCase: false,
 												want:       "\"true\"",
 											},
 											&litMatcher{
-												pos:        position{line: 46, col: 65, offset: 1102},
+												pos:        position{line: 47, col: 65, offset: 1131},
 												val:        "false",
 												ignoreCase: false,
 												want:       "\"false\"",
@@ -148,21 +148,21 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 51, col: 5, offset: 1203},
+						pos: position{line: 52, col: 5, offset: 1232},
 						run: (*parser).callonNode18,
 						expr: &seqExpr{
-							pos: position{line: 51, col: 5, offset: 1203},
+							pos: position{line: 52, col: 5, offset: 1232},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 51, col: 5, offset: 1203},
+									pos:   position{line: 52, col: 5, offset: 1232},
 									label: "k",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 51, col: 7, offset: 1205},
+										pos: position{line: 52, col: 7, offset: 1234},
 										expr: &actionExpr{
-											pos: position{line: 218, col: 5, offset: 4634},
+											pos: position{line: 246, col: 5, offset: 5275},
 											run: (*parser).callonNode22,
 											expr: &charClassMatcher{
-												pos:        position{line: 218, col: 5, offset: 4634},
+												pos:        position{line: 246, col: 5, offset: 5275},
 												val:        "[A-Za-z]",
 												ranges:     []rune{'A', 'Z', 'a', 'z'},
 												ignoreCase: false,
@@ -172,66 +172,66 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 51, col: 13, offset: 1211},
+									pos:   position{line: 52, col: 13, offset: 1240},
 									label: "o",
 									expr: &choiceExpr{
-										pos: position{line: 52, col: 9, offset: 1223},
+										pos: position{line: 53, col: 9, offset: 1252},
 										alternatives: []any{
 											&actionExpr{
-												pos: position{line: 145, col: 5, offset: 3358},
+												pos: position{line: 173, col: 5, offset: 3999},
 												run: (*parser).callonNode26,
 												expr: &litMatcher{
-													pos:        position{line: 145, col: 5, offset: 3358},
+													pos:        position{line: 173, col: 5, offset: 3999},
 													val:        ">=",
 													ignoreCase: false,
 													want:       "\">=\"",
 												},
 											},
 											&actionExpr{
-												pos: position{line: 135, col: 5, offset: 3174},
+												pos: position{line: 163, col: 5, offset: 3815},
 												run: (*parser).callonNode28,
 												expr: &litMatcher{
-													pos:        position{line: 135, col: 5, offset: 3174},
+													pos:        position{line: 163, col: 5, offset: 3815},
 													val:        "<=",
 													ignoreCase: false,
 													want:       "\"<=\"",
 												},
 											},
 											&actionExpr{
-												pos: position{line: 140, col: 5, offset: 3263},
+												pos: position{line: 168, col: 5, offset: 3904},
 												run: (*parser).callonNode30,
 												expr: &litMatcher{
-													pos:        position{line: 140, col: 5, offset: 3263},
+													pos:        position{line: 168, col: 5, offset: 3904},
 													val:        ">",
 													ignoreCase: false,
 													want:       "\">\"",
 												},
 											},
 											&actionExpr{
-												pos: position{line: 130, col: 5, offset: 3082},
+												pos: position{line: 158, col: 5, offset: 3723},
 												run: (*parser).callonNode32,
 												expr: &litMatcher{
-													pos:        position{line: 130, col: 5, offset: 3082},
+													pos:        position{line: 158, col: 5, offset: 3723},
 													val:        "<",
 													ignoreCase: false,
 													want:       "\"<\"",
 												},
 											},
 											&actionExpr{
-												pos: position{line: 125, col: 5, offset: 2997},
+												pos: position{line: 153, col: 5, offset: 3638},
 												run: (*parser).callonNode34,
 												expr: &litMatcher{
-													pos:        position{line: 125, col: 5, offset: 2997},
+													pos:        position{line: 153, col: 5, offset: 3638},
 													val:        "=",
 													ignoreCase: false,
 													want:       "\"=\"",
 												},
 											},
 											&actionExpr{
-												pos: position{line: 120, col: 5, offset: 2911},
+												pos: position{line: 148, col: 5, offset: 3552},
 												run: (*parser).callonNode36,
 												expr: &litMatcher{
-													pos:        position{line: 120, col: 5, offset: 2911},
+													pos:        position{line: 148, col: 5, offset: 3552},
 													val:        ":",
 													ignoreCase: false,
 													want:       "\":\"",
@@ -241,43 +241,43 @@ var g = &grammar{
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 58, col: 7, offset: 1403},
+									pos: position{line: 59, col: 7, offset: 1432},
 									expr: &litMatcher{
-										pos:        position{line: 58, col: 7, offset: 1403},
+										pos:        position{line: 59, col: 7, offset: 1432},
 										val:        "\"",
 										ignoreCase: false,
 										want:       "\"\\\"\"",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 58, col: 12, offset: 1408},
+									pos:   position{line: 59, col: 12, offset: 1437},
 									label: "v",
 									expr: &choiceExpr{
-										pos: position{line: 59, col: 9, offset: 1420},
+										pos: position{line: 60, col: 9, offset: 1449},
 										alternatives: []any{
 											&actionExpr{
-												pos: position{line: 195, col: 5, offset: 4197},
+												pos: position{line: 223, col: 5, offset: 4838},
 												run: (*parser).callonNode42,
 												expr: &seqExpr{
-													pos: position{line: 195, col: 5, offset: 4197},
+													pos: position{line: 223, col: 5, offset: 4838},
 													exprs: []any{
 														&actionExpr{
-															pos: position{line: 185, col: 5, offset: 3960},
+															pos: position{line: 213, col: 5, offset: 4601},
 															run: (*parser).callonNode44,
 															expr: &seqExpr{
-																pos: position{line: 185, col: 5, offset: 3960},
+																pos: position{line: 213, col: 5, offset: 4601},
 																exprs: []any{
 																	&actionExpr{
-																		pos: position{line: 155, col: 5, offset: 3560},
+																		pos: position{line: 183, col: 5, offset: 4201},
 																		run: (*parser).callonNode46,
 																		expr: &seqExpr{
-																			pos: position{line: 155, col: 5, offset: 3560},
+																			pos: position{line: 183, col: 5, offset: 4201},
 																			exprs: []any{
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
+																					pos: position{line: 256, col: 5, offset: 5394},
 																					run: (*parser).callonNode48,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 256, col: 5, offset: 5394},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -285,10 +285,10 @@ var g = &grammar{
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
+																					pos: position{line: 256, col: 5, offset: 5394},
 																					run: (*parser).callonNode50,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 256, col: 5, offset: 5394},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -296,10 +296,10 @@ var g = &grammar{
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
+																					pos: position{line: 256, col: 5, offset: 5394},
 																					run: (*parser).callonNode52,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 256, col: 5, offset: 5394},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -307,10 +307,10 @@ var g = &grammar{
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
+																					pos: position{line: 256, col: 5, offset: 5394},
 																					run: (*parser).callonNode54,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 256, col: 5, offset: 5394},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -321,22 +321,22 @@ var g = &grammar{
 																		},
 																	},
 																	&litMatcher{
-																		pos:        position{line: 185, col: 14, offset: 3969},
+																		pos:        position{line: 213, col: 14, offset: 4610},
 																		val:        "-",
 																		ignoreCase: false,
 																		want:       "\"-\"",
 																	},
 																	&actionExpr{
-																		pos: position{line: 160, col: 5, offset: 3637},
+																		pos: position{line: 188, col: 5, offset: 4278},
 																		run: (*parser).callonNode57,
 																		expr: &seqExpr{
-																			pos: position{line: 160, col: 5, offset: 3637},
+																			pos: position{line: 188, col: 5, offset: 4278},
 																			exprs: []any{
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
+																					pos: position{line: 256, col: 5, offset: 5394},
 																					run: (*parser).callonNode59,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 256, col: 5, offset: 5394},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -344,10 +344,10 @@ var g = &grammar{
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
+																					pos: position{line: 256, col: 5, offset: 5394},
 																					run: (*parser).callonNode61,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 256, col: 5, offset: 5394},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -358,22 +358,22 @@ var g = &grammar{
 																		},
 																	},
 																	&litMatcher{
-																		pos:        position{line: 185, col: 28, offset: 3983},
+																		pos:        position{line: 213, col: 28, offset: 4624},
 																		val:        "-",
 																		ignoreCase: false,
 																		want:       "\"-\"",
 																	},
 																	&actionExpr{
-																		pos: position{line: 165, col: 5, offset: 3700},
+																		pos: position{line: 193, col: 5, offset: 4341},
 																		run: (*parser).callonNode64,
 																		expr: &seqExpr{
-																			pos: position{line: 165, col: 5, offset: 3700},
+																			pos: position{line: 193, col: 5, offset: 4341},
 																			exprs: []any{
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
+																					pos: position{line: 256, col: 5, offset: 5394},
 																					run: (*parser).callonNode66,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 256, col: 5, offset: 5394},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -381,10 +381,10 @@ var g = &grammar{
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
+																					pos: position{line: 256, col: 5, offset: 5394},
 																					run: (*parser).callonNode68,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 256, col: 5, offset: 5394},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -398,28 +398,28 @@ var g = &grammar{
 															},
 														},
 														&litMatcher{
-															pos:        position{line: 195, col: 14, offset: 4206},
+															pos:        position{line: 223, col: 14, offset: 4847},
 															val:        "T",
 															ignoreCase: false,
 															want:       "\"T\"",
 														},
 														&actionExpr{
-															pos: position{line: 190, col: 5, offset: 4047},
+															pos: position{line: 218, col: 5, offset: 4688},
 															run: (*parser).callonNode71,
 															expr: &seqExpr{
-																pos: position{line: 190, col: 5, offset: 4047},
+																pos: position{line: 218, col: 5, offset: 4688},
 																exprs: []any{
 																	&actionExpr{
-																		pos: position{line: 170, col: 5, offset: 3764},
+																		pos: position{line: 198, col: 5, offset: 4405},
 																		run: (*parser).callonNode73,
 																		expr: &seqExpr{
-																			pos: position{line: 170, col: 5, offset: 3764},
+																			pos: position{line: 198, col: 5, offset: 4405},
 																			exprs: []any{
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
+																					pos: position{line: 256, col: 5, offset: 5394},
 																					run: (*parser).callonNode75,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 256, col: 5, offset: 5394},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -427,10 +427,10 @@ var g = &grammar{
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
+																					pos: position{line: 256, col: 5, offset: 5394},
 																					run: (*parser).callonNode77,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 256, col: 5, offset: 5394},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -441,22 +441,22 @@ var g = &grammar{
 																		},
 																	},
 																	&litMatcher{
-																		pos:        position{line: 190, col: 14, offset: 4056},
+																		pos:        position{line: 218, col: 14, offset: 4697},
 																		val:        ":",
 																		ignoreCase: false,
 																		want:       "\":\"",
 																	},
 																	&actionExpr{
-																		pos: position{line: 175, col: 5, offset: 3830},
+																		pos: position{line: 203, col: 5, offset: 4471},
 																		run: (*parser).callonNode80,
 																		expr: &seqExpr{
-																			pos: position{line: 175, col: 5, offset: 3830},
+																			pos: position{line: 203, col: 5, offset: 4471},
 																			exprs: []any{
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
+																					pos: position{line: 256, col: 5, offset: 5394},
 																					run: (*parser).callonNode82,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 256, col: 5, offset: 5394},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -464,10 +464,10 @@ var g = &grammar{
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
+																					pos: position{line: 256, col: 5, offset: 5394},
 																					run: (*parser).callonNode84,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 256, col: 5, offset: 5394},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -478,22 +478,22 @@ var g = &grammar{
 																		},
 																	},
 																	&litMatcher{
-																		pos:        position{line: 190, col: 29, offset: 4071},
+																		pos:        position{line: 218, col: 29, offset: 4712},
 																		val:        ":",
 																		ignoreCase: false,
 																		want:       "\":\"",
 																	},
 																	&actionExpr{
-																		pos: position{line: 180, col: 5, offset: 3896},
+																		pos: position{line: 208, col: 5, offset: 4537},
 																		run: (*parser).callonNode87,
 																		expr: &seqExpr{
-																			pos: position{line: 180, col: 5, offset: 3896},
+																			pos: position{line: 208, col: 5, offset: 4537},
 																			exprs: []any{
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
+																					pos: position{line: 256, col: 5, offset: 5394},
 																					run: (*parser).callonNode89,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 256, col: 5, offset: 5394},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -501,10 +501,10 @@ var g = &grammar{
 																					},
 																				},
 																				&actionExpr{
-																					pos: position{line: 228, col: 5, offset: 4753},
+																					pos: position{line: 256, col: 5, offset: 5394},
 																					run: (*parser).callonNode91,
 																					expr: &charClassMatcher{
-																						pos:        position{line: 228, col: 5, offset: 4753},
+																						pos:        position{line: 256, col: 5, offset: 5394},
 																						val:        "[0-9]",
 																						ranges:     []rune{'0', '9'},
 																						ignoreCase: false,
@@ -515,23 +515,23 @@ var g = &grammar{
 																		},
 																	},
 																	&zeroOrOneExpr{
-																		pos: position{line: 190, col: 44, offset: 4086},
+																		pos: position{line: 218, col: 44, offset: 4727},
 																		expr: &seqExpr{
-																			pos: position{line: 190, col: 45, offset: 4087},
+																			pos: position{line: 218, col: 45, offset: 4728},
 																			exprs: []any{
 																				&litMatcher{
-																					pos:        position{line: 190, col: 45, offset: 4087},
+																					pos:        position{line: 218, col: 45, offset: 4728},
 																					val:        ".",
 																					ignoreCase: false,
 																					want:       "\".\"",
 																				},
 																				&oneOrMoreExpr{
-																					pos: position{line: 190, col: 49, offset: 4091},
+																					pos: position{line: 218, col: 49, offset: 4732},
 																					expr: &actionExpr{
-																						pos: position{line: 228, col: 5, offset: 4753},
+																						pos: position{line: 256, col: 5, offset: 5394},
 																						run: (*parser).callonNode97,
 																						expr: &charClassMatcher{
-																							pos:        position{line: 228, col: 5, offset: 4753},
+																							pos:        position{line: 256, col: 5, offset: 5394},
 																							val:        "[0-9]",
 																							ranges:     []rune{'0', '9'},
 																							ignoreCase: false,
@@ -543,35 +543,35 @@ var g = &grammar{
 																		},
 																	},
 																	&choiceExpr{
-																		pos: position{line: 190, col: 59, offset: 4101},
+																		pos: position{line: 218, col: 59, offset: 4742},
 																		alternatives: []any{
 																			&litMatcher{
-																				pos:        position{line: 190, col: 59, offset: 4101},
+																				pos:        position{line: 218, col: 59, offset: 4742},
 																				val:        "Z",
 																				ignoreCase: false,
 																				want:       "\"Z\"",
 																			},
 																			&seqExpr{
-																				pos: position{line: 190, col: 65, offset: 4107},
+																				pos: position{line: 218, col: 65, offset: 4748},
 																				exprs: []any{
 																					&charClassMatcher{
-																						pos:        position{line: 190, col: 66, offset: 4108},
+																						pos:        position{line: 218, col: 66, offset: 4749},
 																						val:        "[+-]",
 																						chars:      []rune{'+', '-'},
 																						ignoreCase: false,
 																						inverted:   false,
 																					},
 																					&actionExpr{
-																						pos: position{line: 170, col: 5, offset: 3764},
+																						pos: position{line: 198, col: 5, offset: 4405},
 																						run: (*parser).callonNode103,
 																						expr: &seqExpr{
-																							pos: position{line: 170, col: 5, offset: 3764},
+																							pos: position{line: 198, col: 5, offset: 4405},
 																							exprs: []any{
 																								&actionExpr{
-																									pos: position{line: 228, col: 5, offset: 4753},
+																									pos: position{line: 256, col: 5, offset: 5394},
 																									run: (*parser).callonNode105,
 																									expr: &charClassMatcher{
-																										pos:        position{line: 228, col: 5, offset: 4753},
+																										pos:        position{line: 256, col: 5, offset: 5394},
 																										val:        "[0-9]",
 																										ranges:     []rune{'0', '9'},
 																										ignoreCase: false,
@@ -579,10 +579,10 @@ var g = &grammar{
 																									},
 																								},
 																								&actionExpr{
-																									pos: position{line: 228, col: 5, offset: 4753},
+																									pos: position{line: 256, col: 5, offset: 5394},
 																									run: (*parser).callonNode107,
 																									expr: &charClassMatcher{
-																										pos:        position{line: 228, col: 5, offset: 4753},
+																										pos:        position{line: 256, col: 5, offset: 5394},
 																										val:        "[0-9]",
 																										ranges:     []rune{'0', '9'},
 																										ignoreCase: false,
@@ -593,22 +593,22 @@ var g = &grammar{
 																						},
 																					},
 																					&litMatcher{
-																						pos:        position{line: 190, col: 86, offset: 4128},
+																						pos:        position{line: 218, col: 86, offset: 4769},
 																						val:        ":",
 																						ignoreCase: false,
 																						want:       "\":\"",
 																					},
 																					&actionExpr{
-																						pos: position{line: 175, col: 5, offset: 3830},
+																						pos: position{line: 203, col: 5, offset: 4471},
 																						run: (*parser).callonNode110,
 																						expr: &seqExpr{
-																							pos: position{line: 175, col: 5, offset: 3830},
+																							pos: position{line: 203, col: 5, offset: 4471},
 																							exprs: []any{
 																								&actionExpr{
-																									pos: position{line: 228, col: 5, offset: 4753},
+																									pos: position{line: 256, col: 5, offset: 5394},
 																									run: (*parser).callonNode112,
 																									expr: &charClassMatcher{
-																										pos:        position{line: 228, col: 5, offset: 4753},
+																										pos:        position{line: 256, col: 5, offset: 5394},
 																										val:        "[0-9]",
 																										ranges:     []rune{'0', '9'},
 																										ignoreCase: false,
@@ -616,10 +616,10 @@ var g = &grammar{
 																									},
 																								},
 																								&actionExpr{
-																									pos: position{line: 228, col: 5, offset: 4753},
+																									pos: position{line: 256, col: 5, offset: 5394},
 																									run: (*parser).callonNode114,
 																									expr: &charClassMatcher{
-																										pos:        position{line: 228, col: 5, offset: 4753},
+																										pos:        position{line: 256, col: 5, offset: 5394},
 																										val:        "[0-9]",
 																										ranges:     []rune{'0', '9'},
 																										ignoreCase: false,
@@ -640,22 +640,22 @@ var g = &grammar{
 												},
 											},
 											&actionExpr{
-												pos: position{line: 185, col: 5, offset: 3960},
+												pos: position{line: 213, col: 5, offset: 4601},
 												run: (*parser).callonNode116,
 												expr: &seqExpr{
-													pos: position{line: 185, col: 5, offset: 3960},
+													pos: position{line: 213, col: 5, offset: 4601},
 													exprs: []any{
 														&actionExpr{
-															pos: position{line: 155, col: 5, offset: 3560},
+															pos: position{line: 183, col: 5, offset: 4201},
 															run: (*parser).callonNode118,
 															expr: &seqExpr{
-																pos: position{line: 155, col: 5, offset: 3560},
+																pos: position{line: 183, col: 5, offset: 4201},
 																exprs: []any{
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
+																		pos: position{line: 256, col: 5, offset: 5394},
 																		run: (*parser).callonNode120,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 256, col: 5, offset: 5394},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -663,10 +663,10 @@ var g = &grammar{
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
+																		pos: position{line: 256, col: 5, offset: 5394},
 																		run: (*parser).callonNode122,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 256, col: 5, offset: 5394},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -674,10 +674,10 @@ var g = &grammar{
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
+																		pos: position{line: 256, col: 5, offset: 5394},
 																		run: (*parser).callonNode124,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 256, col: 5, offset: 5394},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -685,10 +685,10 @@ var g = &grammar{
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
+																		pos: position{line: 256, col: 5, offset: 5394},
 																		run: (*parser).callonNode126,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 256, col: 5, offset: 5394},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -699,22 +699,22 @@ var g = &grammar{
 															},
 														},
 														&litMatcher{
-															pos:        position{line: 185, col: 14, offset: 3969},
+															pos:        position{line: 213, col: 14, offset: 4610},
 															val:        "-",
 															ignoreCase: false,
 															want:       "\"-\"",
 														},
 														&actionExpr{
-															pos: position{line: 160, col: 5, offset: 3637},
+															pos: position{line: 188, col: 5, offset: 4278},
 															run: (*parser).callonNode129,
 															expr: &seqExpr{
-																pos: position{line: 160, col: 5, offset: 3637},
+																pos: position{line: 188, col: 5, offset: 4278},
 																exprs: []any{
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
+																		pos: position{line: 256, col: 5, offset: 5394},
 																		run: (*parser).callonNode131,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 256, col: 5, offset: 5394},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -722,10 +722,10 @@ var g = &grammar{
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
+																		pos: position{line: 256, col: 5, offset: 5394},
 																		run: (*parser).callonNode133,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 256, col: 5, offset: 5394},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -736,22 +736,22 @@ var g = &grammar{
 															},
 														},
 														&litMatcher{
-															pos:        position{line: 185, col: 28, offset: 3983},
+															pos:        position{line: 213, col: 28, offset: 4624},
 															val:        "-",
 															ignoreCase: false,
 															want:       "\"-\"",
 														},
 														&actionExpr{
-															pos: position{line: 165, col: 5, offset: 3700},
+															pos: position{line: 193, col: 5, offset: 4341},
 															run: (*parser).callonNode136,
 															expr: &seqExpr{
-																pos: position{line: 165, col: 5, offset: 3700},
+																pos: position{line: 193, col: 5, offset: 4341},
 																exprs: []any{
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
+																		pos: position{line: 256, col: 5, offset: 5394},
 																		run: (*parser).callonNode138,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 256, col: 5, offset: 5394},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -759,10 +759,10 @@ var g = &grammar{
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
+																		pos: position{line: 256, col: 5, offset: 5394},
 																		run: (*parser).callonNode140,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 256, col: 5, offset: 5394},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -776,22 +776,22 @@ var g = &grammar{
 												},
 											},
 											&actionExpr{
-												pos: position{line: 190, col: 5, offset: 4047},
+												pos: position{line: 218, col: 5, offset: 4688},
 												run: (*parser).callonNode142,
 												expr: &seqExpr{
-													pos: position{line: 190, col: 5, offset: 4047},
+													pos: position{line: 218, col: 5, offset: 4688},
 													exprs: []any{
 														&actionExpr{
-															pos: position{line: 170, col: 5, offset: 3764},
+															pos: position{line: 198, col: 5, offset: 4405},
 															run: (*parser).callonNode144,
 															expr: &seqExpr{
-																pos: position{line: 170, col: 5, offset: 3764},
+																pos: position{line: 198, col: 5, offset: 4405},
 																exprs: []any{
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
+																		pos: position{line: 256, col: 5, offset: 5394},
 																		run: (*parser).callonNode146,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 256, col: 5, offset: 5394},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -799,10 +799,10 @@ var g = &grammar{
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
+																		pos: position{line: 256, col: 5, offset: 5394},
 																		run: (*parser).callonNode148,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 256, col: 5, offset: 5394},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -813,22 +813,22 @@ var g = &grammar{
 															},
 														},
 														&litMatcher{
-															pos:        position{line: 190, col: 14, offset: 4056},
+															pos:        position{line: 218, col: 14, offset: 4697},
 															val:        ":",
 															ignoreCase: false,
 															want:       "\":\"",
 														},
 														&actionExpr{
-															pos: position{line: 175, col: 5, offset: 3830},
+															pos: position{line: 203, col: 5, offset: 4471},
 															run: (*parser).callonNode151,
 															expr: &seqExpr{
-																pos: position{line: 175, col: 5, offset: 3830},
+																pos: position{line: 203, col: 5, offset: 4471},
 																exprs: []any{
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
+																		pos: position{line: 256, col: 5, offset: 5394},
 																		run: (*parser).callonNode153,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 256, col: 5, offset: 5394},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -836,10 +836,10 @@ var g = &grammar{
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
+																		pos: position{line: 256, col: 5, offset: 5394},
 																		run: (*parser).callonNode155,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 256, col: 5, offset: 5394},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -850,22 +850,22 @@ var g = &grammar{
 															},
 														},
 														&litMatcher{
-															pos:        position{line: 190, col: 29, offset: 4071},
+															pos:        position{line: 218, col: 29, offset: 4712},
 															val:        ":",
 															ignoreCase: false,
 															want:       "\":\"",
 														},
 														&actionExpr{
-															pos: position{line: 180, col: 5, offset: 3896},
+															pos: position{line: 208, col: 5, offset: 4537},
 															run: (*parser).callonNode158,
 															expr: &seqExpr{
-																pos: position{line: 180, col: 5, offset: 3896},
+																pos: position{line: 208, col: 5, offset: 4537},
 																exprs: []any{
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
+																		pos: position{line: 256, col: 5, offset: 5394},
 																		run: (*parser).callonNode160,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 256, col: 5, offset: 5394},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -873,10 +873,10 @@ var g = &grammar{
 																		},
 																	},
 																	&actionExpr{
-																		pos: position{line: 228, col: 5, offset: 4753},
+																		pos: position{line: 256, col: 5, offset: 5394},
 																		run: (*parser).callonNode162,
 																		expr: &charClassMatcher{
-																			pos:        position{line: 228, col: 5, offset: 4753},
+																			pos:        position{line: 256, col: 5, offset: 5394},
 																			val:        "[0-9]",
 																			ranges:     []rune{'0', '9'},
 																			ignoreCase: false,
@@ -887,23 +887,23 @@ var g = &grammar{
 															},
 														},
 														&zeroOrOneExpr{
-															pos: position{line: 190, col: 44, offset: 4086},
+															pos: position{line: 218, col: 44, offset: 4727},
 															expr: &seqExpr{
-																pos: position{line: 190, col: 45, offset: 4087},
+																pos: position{line: 218, col: 45, offset: 4728},
 																exprs: []any{
 																	&litMatcher{
-																		pos:        position{line: 190, col: 45, offset: 4087},
+																		pos:        position{line: 218, col: 45, offset: 4728},
 																		val:        ".",
 																		ignoreCase: false,
 																		want:       "\".\"",
 																	},
 																	&oneOrMoreExpr{
-																		pos: position{line: 190, col: 49, offset: 4091},
+																		pos: position{line: 218, col: 49, offset: 4732},
 																		expr: &actionExpr{
-																			pos: position{line: 228, col: 5, offset: 4753},
+																			pos: position{line: 256, col: 5, offset: 5394},
 																			run: (*parser).callonNode168,
 																			expr: &charClassMatcher{
-																				pos:        position{line: 228, col: 5, offset: 4753},
+																				pos:        position{line: 256, col: 5, offset: 5394},
 																				val:        "[0-9]",
 																				ranges:     []rune{'0', '9'},
 																				ignoreCase: false,
@@ -915,35 +915,35 @@ var g = &grammar{
 															},
 														},
 														&choiceExpr{
-															pos: position{line: 190, col: 59, offset: 4101},
+															pos: position{line: 218, col: 59, offset: 4742},
 															alternatives: []any{
 																&litMatcher{
-																	pos:        position{line: 190, col: 59, offset: 4101},
+																	pos:        position{line: 218, col: 59, offset: 4742},
 																	val:        "Z",
 																	ignoreCase: false,
 																	want:       "\"Z\"",
 																},
 																&seqExpr{
-																	pos: position{line: 190, col: 65, offset: 4107},
+																	pos: position{line: 218, col: 65, offset: 4748},
 																	exprs: []any{
 																		&charClassMatcher{
-																			pos:        position{line: 190, col: 66, offset: 4108},
+																			pos:        position{line: 218, col: 66, offset: 4749},
 																			val:        "[+-]",
 																			chars:      []rune{'+', '-'},
 																			ignoreCase: false,
 																			inverted:   false,
 																		},
 																		&actionExpr{
-																			pos: position{line: 170, col: 5, offset: 3764},
+																			pos: position{line: 198, col: 5, offset: 4405},
 																			run: (*parser).callonNode174,
 																			expr: &seqExpr{
-																				pos: position{line: 170, col: 5, offset: 3764},
+																				pos: position{line: 198, col: 5, offset: 4405},
 																				exprs: []any{
 																					&actionExpr{
-																						pos: position{line: 228, col: 5, offset: 4753},
+																						pos: position{line: 256, col: 5, offset: 5394},
 																						run: (*parser).callonNode176,
 																						expr: &charClassMatcher{
-																							pos:        position{line: 228, col: 5, offset: 4753},
+																							pos:        position{line: 256, col: 5, offset: 5394},
 																							val:        "[0-9]",
 																							ranges:     []rune{'0', '9'},
 																							ignoreCase: false,
@@ -951,10 +951,10 @@ var g = &grammar{
 																						},
 																					},
 																					&actionExpr{
-																						pos: position{line: 228, col: 5, offset: 4753},
+																						pos: position{line: 256, col: 5, offset: 5394},
 																						run: (*parser).callonNode178,
 																						expr: &charClassMatcher{
-																							pos:        position{line: 228, col: 5, offset: 4753},
+																							pos:        position{line: 256, col: 5, offset: 5394},
 																							val:        "[0-9]",
 																							ranges:     []rune{'0', '9'},
 																							ignoreCase: false,
@@ -965,22 +965,22 @@ var g = &grammar{
 																			},
 																		},
 																		&litMatcher{
-																			pos:        position{line: 190, col: 86, offset: 4128},
+																			pos:        position{line: 218, col: 86, offset: 4769},
 																			val:        ":",
 																			ignoreCase: false,
 																			want:       "\":\"",
 																		},
 																		&actionExpr{
-																			pos: position{line: 175, col: 5, offset: 3830},
+																			pos: position{line: 203, col: 5, offset: 4471},
 																			run: (*parser).callonNode181,
 																			expr: &seqExpr{
-																				pos: position{line: 175, col: 5, offset: 3830},
+																				pos: position{line: 203, col: 5, offset: 4471},
 																				exprs: []any{
 																					&actionExpr{
-																						pos: position{line: 228, col: 5, offset: 4753},
+																						pos: position{line: 256, col: 5, offset: 5394},
 																						run: (*parser).callonNode183,
 																						expr: &charClassMatcher{
-																							pos:        position{line: 228, col: 5, offset: 4753},
+																							pos:        position{line: 256, col: 5, offset: 5394},
 																							val:        "[0-9]",
 																							ranges:     []rune{'0', '9'},
 																							ignoreCase: false,
@@ -988,10 +988,10 @@ var g = &grammar{
 																						},
 																					},
 																					&actionExpr{
-																						pos: position{line: 228, col: 5, offset: 4753},
+																						pos: position{line: 256, col: 5, offset: 5394},
 																						run: (*parser).callonNode185,
 																						expr: &charClassMatcher{
-																							pos:        position{line: 228, col: 5, offset: 4753},
+																							pos:        position{line: 256, col: 5, offset: 5394},
 																							val:        "[0-9]",
 																							ranges:     []rune{'0', '9'},
 																							ignoreCase: false,
@@ -1012,9 +1012,9 @@ var g = &grammar{
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 62, col: 7, offset: 1473},
+									pos: position{line: 63, col: 7, offset: 1502},
 									expr: &litMatcher{
-										pos:        position{line: 62, col: 7, offset: 1473},
+										pos:        position{line: 63, col: 7, offset: 1502},
 										val:        "\"",
 										ignoreCase: false,
 										want:       "\"\\\"\"",
@@ -1024,21 +1024,21 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 65, col: 5, offset: 1549},
+						pos: position{line: 66, col: 5, offset: 1578},
 						run: (*parser).callonNode189,
 						expr: &seqExpr{
-							pos: position{line: 65, col: 5, offset: 1549},
+							pos: position{line: 66, col: 5, offset: 1578},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 65, col: 5, offset: 1549},
+									pos:   position{line: 66, col: 5, offset: 1578},
 									label: "k",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 65, col: 7, offset: 1551},
+										pos: position{line: 66, col: 7, offset: 1580},
 										expr: &actionExpr{
-											pos: position{line: 218, col: 5, offset: 4634},
+											pos: position{line: 246, col: 5, offset: 5275},
 											run: (*parser).callonNode193,
 											expr: &charClassMatcher{
-												pos:        position{line: 218, col: 5, offset: 4634},
+												pos:        position{line: 246, col: 5, offset: 5275},
 												val:        "[A-Za-z]",
 												ranges:     []rune{'A', 'Z', 'a', 'z'},
 												ignoreCase: false,
@@ -1048,23 +1048,23 @@ var g = &grammar{
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 66, col: 9, offset: 1567},
+									pos: position{line: 67, col: 9, offset: 1596},
 									alternatives: []any{
 										&actionExpr{
-											pos: position{line: 125, col: 5, offset: 2997},
+											pos: position{line: 153, col: 5, offset: 3638},
 											run: (*parser).callonNode196,
 											expr: &litMatcher{
-												pos:        position{line: 125, col: 5, offset: 2997},
+												pos:        position{line: 153, col: 5, offset: 3638},
 												val:        "=",
 												ignoreCase: false,
 												want:       "\"=\"",
 											},
 										},
 										&actionExpr{
-											pos: position{line: 120, col: 5, offset: 2911},
+											pos: position{line: 148, col: 5, offset: 3552},
 											run: (*parser).callonNode198,
 											expr: &litMatcher{
-												pos:        position{line: 120, col: 5, offset: 2911},
+												pos:        position{line: 148, col: 5, offset: 3552},
 												val:        ":",
 												ignoreCase: false,
 												want:       "\":\"",
@@ -1073,79 +1073,79 @@ var g = &grammar{
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 68, col: 7, offset: 1619},
+									pos: position{line: 69, col: 7, offset: 1648},
 									expr: &litMatcher{
-										pos:        position{line: 68, col: 7, offset: 1619},
+										pos:        position{line: 69, col: 7, offset: 1648},
 										val:        "\"",
 										ignoreCase: false,
 										want:       "\"\\\"\"",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 68, col: 12, offset: 1624},
+									pos:   position{line: 69, col: 12, offset: 1653},
 									label: "v",
 									expr: &choiceExpr{
-										pos: position{line: 200, col: 5, offset: 4285},
+										pos: position{line: 228, col: 5, offset: 4926},
 										alternatives: []any{
 											&litMatcher{
-												pos:        position{line: 200, col: 5, offset: 4285},
+												pos:        position{line: 228, col: 5, offset: 4926},
 												val:        "today",
 												ignoreCase: false,
 												want:       "\"today\"",
 											},
 											&litMatcher{
-												pos:        position{line: 201, col: 5, offset: 4299},
+												pos:        position{line: 229, col: 5, offset: 4940},
 												val:        "yesterday",
 												ignoreCase: false,
 												want:       "\"yesterday\"",
 											},
 											&litMatcher{
-												pos:        position{line: 202, col: 5, offset: 4317},
+												pos:        position{line: 230, col: 5, offset: 4958},
 												val:        "this week",
 												ignoreCase: false,
 												want:       "\"this week\"",
 											},
 											&litMatcher{
-												pos:        position{line: 203, col: 5, offset: 4335},
+												pos:        position{line: 231, col: 5, offset: 4976},
 												val:        "last week",
 												ignoreCase: false,
 												want:       "\"last week\"",
 											},
 											&litMatcher{
-												pos:        position{line: 204, col: 5, offset: 4353},
+												pos:        position{line: 232, col: 5, offset: 4994},
 												val:        "last 7 days",
 												ignoreCase: false,
 												want:       "\"last 7 days\"",
 											},
 											&litMatcher{
-												pos:        position{line: 205, col: 5, offset: 4373},
+												pos:        position{line: 233, col: 5, offset: 5014},
 												val:        "this month",
 												ignoreCase: false,
 												want:       "\"this month\"",
 											},
 											&litMatcher{
-												pos:        position{line: 206, col: 5, offset: 4392},
+												pos:        position{line: 234, col: 5, offset: 5033},
 												val:        "last month",
 												ignoreCase: false,
 												want:       "\"last month\"",
 											},
 											&litMatcher{
-												pos:        position{line: 207, col: 5, offset: 4411},
+												pos:        position{line: 235, col: 5, offset: 5052},
 												val:        "last 30 days",
 												ignoreCase: false,
 												want:       "\"last 30 days\"",
 											},
 											&litMatcher{
-												pos:        position{line: 208, col: 5, offset: 4432},
+												pos:        position{line: 236, col: 5, offset: 5073},
 												val:        "this year",
 												ignoreCase: false,
 												want:       "\"this year\"",
 											},
 											&actionExpr{
-												pos: position{line: 209, col: 5, offset: 4450},
+												pos: position{line: 237, col: 5, offset: 5091},
 												run: (*parser).callonNode213,
 												expr: &litMatcher{
-													pos:        position{line: 209, col: 5, offset: 4450},
+													pos:        position{line: 237, col: 5, offset: 5091},
 													val:        "last year",
 													ignoreCase: false,
 													want:       "\"last year\"",
@@ -1155,9 +1155,9 @@ var g = &grammar{
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 68, col: 38, offset: 1650},
+									pos: position{line: 69, col: 38, offset: 1679},
 									expr: &litMatcher{
-										pos:        position{line: 68, col: 38, offset: 1650},
+										pos:        position{line: 69, col: 38, offset: 1679},
 										val:        "\"",
 										ignoreCase: false,
 										want:       "\"\\\"\"",
@@ -1167,21 +1167,205 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 73, col: 5, offset: 1769},
+						pos: position{line: 74, col: 5, offset: 1793},
 						run: (*parser).callonNode217,
 						expr: &seqExpr{
-							pos: position{line: 73, col: 5, offset: 1769},
+							pos: position{line: 74, col: 5, offset: 1793},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 73, col: 5, offset: 1769},
+									pos:   position{line: 74, col: 5, offset: 1793},
 									label: "k",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 73, col: 7, offset: 1771},
+										pos: position{line: 74, col: 7, offset: 1795},
 										expr: &actionExpr{
-											pos: position{line: 218, col: 5, offset: 4634},
+											pos: position{line: 246, col: 5, offset: 5275},
 											run: (*parser).callonNode221,
 											expr: &charClassMatcher{
-												pos:        position{line: 218, col: 5, offset: 4634},
+												pos:        position{line: 246, col: 5, offset: 5275},
+												val:        "[A-Za-z]",
+												ranges:     []rune{'A', 'Z', 'a', 'z'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 74, col: 13, offset: 1801},
+									label: "o",
+									expr: &choiceExpr{
+										pos: position{line: 75, col: 9, offset: 1813},
+										alternatives: []any{
+											&actionExpr{
+												pos: position{line: 173, col: 5, offset: 3999},
+												run: (*parser).callonNode225,
+												expr: &litMatcher{
+													pos:        position{line: 173, col: 5, offset: 3999},
+													val:        ">=",
+													ignoreCase: false,
+													want:       "\">=\"",
+												},
+											},
+											&actionExpr{
+												pos: position{line: 163, col: 5, offset: 3815},
+												run: (*parser).callonNode227,
+												expr: &litMatcher{
+													pos:        position{line: 163, col: 5, offset: 3815},
+													val:        "<=",
+													ignoreCase: false,
+													want:       "\"<=\"",
+												},
+											},
+											&actionExpr{
+												pos: position{line: 168, col: 5, offset: 3904},
+												run: (*parser).callonNode229,
+												expr: &litMatcher{
+													pos:        position{line: 168, col: 5, offset: 3904},
+													val:        ">",
+													ignoreCase: false,
+													want:       "\">\"",
+												},
+											},
+											&actionExpr{
+												pos: position{line: 158, col: 5, offset: 3723},
+												run: (*parser).callonNode231,
+												expr: &litMatcher{
+													pos:        position{line: 158, col: 5, offset: 3723},
+													val:        "<",
+													ignoreCase: false,
+													want:       "\"<\"",
+												},
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 79, col: 7, offset: 1937},
+									label: "v",
+									expr: &actionExpr{
+										pos: position{line: 261, col: 5, offset: 5450},
+										run: (*parser).callonNode234,
+										expr: &seqExpr{
+											pos: position{line: 261, col: 5, offset: 5450},
+											exprs: []any{
+												&oneOrMoreExpr{
+													pos: position{line: 261, col: 5, offset: 5450},
+													expr: &actionExpr{
+														pos: position{line: 256, col: 5, offset: 5394},
+														run: (*parser).callonNode237,
+														expr: &charClassMatcher{
+															pos:        position{line: 256, col: 5, offset: 5394},
+															val:        "[0-9]",
+															ranges:     []rune{'0', '9'},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
+												&zeroOrOneExpr{
+													pos: position{line: 261, col: 12, offset: 5457},
+													expr: &seqExpr{
+														pos: position{line: 261, col: 13, offset: 5458},
+														exprs: []any{
+															&litMatcher{
+																pos:        position{line: 261, col: 13, offset: 5458},
+																val:        ".",
+																ignoreCase: false,
+																want:       "\".\"",
+															},
+															&oneOrMoreExpr{
+																pos: position{line: 261, col: 17, offset: 5462},
+																expr: &actionExpr{
+																	pos: position{line: 256, col: 5, offset: 5394},
+																	run: (*parser).callonNode243,
+																	expr: &charClassMatcher{
+																		pos:        position{line: 256, col: 5, offset: 5394},
+																		val:        "[0-9]",
+																		ranges:     []rune{'0', '9'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																},
+															},
+														},
+													},
+												},
+												&zeroOrOneExpr{
+													pos: position{line: 261, col: 26, offset: 5471},
+													expr: &seqExpr{
+														pos: position{line: 261, col: 27, offset: 5472},
+														exprs: []any{
+															&charClassMatcher{
+																pos:        position{line: 261, col: 27, offset: 5472},
+																val:        "[KkMmGgTtPpEe]",
+																chars:      []rune{'K', 'k', 'M', 'm', 'G', 'g', 'T', 't', 'P', 'p', 'E', 'e'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+															&zeroOrOneExpr{
+																pos: position{line: 261, col: 42, offset: 5487},
+																expr: &charClassMatcher{
+																	pos:        position{line: 261, col: 42, offset: 5487},
+																	val:        "[Ii]",
+																	chars:      []rune{'I', 'i'},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
+															&charClassMatcher{
+																pos:        position{line: 261, col: 48, offset: 5493},
+																val:        "[Bb]",
+																chars:      []rune{'B', 'b'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								&andExpr{
+									pos: position{line: 79, col: 16, offset: 1946},
+									expr: &choiceExpr{
+										pos: position{line: 79, col: 18, offset: 1948},
+										alternatives: []any{
+											&charClassMatcher{
+												pos:        position{line: 79, col: 18, offset: 1948},
+												val:        "[ ()]",
+												chars:      []rune{' ', '(', ')'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+											&notExpr{
+												pos: position{line: 79, col: 26, offset: 1956},
+												expr: &anyMatcher{
+													line: 79, col: 27, offset: 1957,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 84, col: 5, offset: 2060},
+						run: (*parser).callonNode256,
+						expr: &seqExpr{
+							pos: position{line: 84, col: 5, offset: 2060},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 84, col: 5, offset: 2060},
+									label: "k",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 84, col: 7, offset: 2062},
+										expr: &actionExpr{
+											pos: position{line: 246, col: 5, offset: 5275},
+											run: (*parser).callonNode260,
+											expr: &charClassMatcher{
+												pos:        position{line: 246, col: 5, offset: 5275},
 												val:        "[A-Za-z]",
 												ranges:     []rune{'A', 'Z', 'a', 'z'},
 												ignoreCase: false,
@@ -1191,23 +1375,23 @@ var g = &grammar{
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 73, col: 14, offset: 1778},
+									pos: position{line: 84, col: 14, offset: 2069},
 									alternatives: []any{
 										&actionExpr{
-											pos: position{line: 120, col: 5, offset: 2911},
-											run: (*parser).callonNode224,
+											pos: position{line: 148, col: 5, offset: 3552},
+											run: (*parser).callonNode263,
 											expr: &litMatcher{
-												pos:        position{line: 120, col: 5, offset: 2911},
+												pos:        position{line: 148, col: 5, offset: 3552},
 												val:        ":",
 												ignoreCase: false,
 												want:       "\":\"",
 											},
 										},
 										&actionExpr{
-											pos: position{line: 125, col: 5, offset: 2997},
-											run: (*parser).callonNode226,
+											pos: position{line: 153, col: 5, offset: 3638},
+											run: (*parser).callonNode265,
 											expr: &litMatcher{
-												pos:        position{line: 125, col: 5, offset: 2997},
+												pos:        position{line: 153, col: 5, offset: 3638},
 												val:        "=",
 												ignoreCase: false,
 												want:       "\"=\"",
@@ -1216,30 +1400,30 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 73, col: 53, offset: 1817},
+									pos:   position{line: 84, col: 53, offset: 2108},
 									label: "v",
 									expr: &choiceExpr{
-										pos: position{line: 73, col: 56, offset: 1820},
+										pos: position{line: 84, col: 56, offset: 2111},
 										alternatives: []any{
 											&actionExpr{
-												pos: position{line: 223, col: 5, offset: 4693},
-												run: (*parser).callonNode230,
+												pos: position{line: 251, col: 5, offset: 5334},
+												run: (*parser).callonNode269,
 												expr: &seqExpr{
-													pos: position{line: 223, col: 5, offset: 4693},
+													pos: position{line: 251, col: 5, offset: 5334},
 													exprs: []any{
 														&litMatcher{
-															pos:        position{line: 223, col: 5, offset: 4693},
+															pos:        position{line: 251, col: 5, offset: 5334},
 															val:        "\"",
 															ignoreCase: false,
 															want:       "\"\\\"\"",
 														},
 														&labeledExpr{
-															pos:   position{line: 223, col: 9, offset: 4697},
+															pos:   position{line: 251, col: 9, offset: 5338},
 															label: "v",
 															expr: &zeroOrMoreExpr{
-																pos: position{line: 223, col: 11, offset: 4699},
+																pos: position{line: 251, col: 11, offset: 5340},
 																expr: &charClassMatcher{
-																	pos:        position{line: 223, col: 11, offset: 4699},
+																	pos:        position{line: 251, col: 11, offset: 5340},
 																	val:        "[^\"]",
 																	chars:      []rune{'"'},
 																	ignoreCase: false,
@@ -1248,7 +1432,7 @@ var g = &grammar{
 															},
 														},
 														&litMatcher{
-															pos:        position{line: 223, col: 17, offset: 4705},
+															pos:        position{line: 251, col: 17, offset: 5346},
 															val:        "\"",
 															ignoreCase: false,
 															want:       "\"\\\"\"",
@@ -1257,9 +1441,9 @@ var g = &grammar{
 												},
 											},
 											&oneOrMoreExpr{
-												pos: position{line: 73, col: 65, offset: 1829},
+												pos: position{line: 84, col: 65, offset: 2120},
 												expr: &charClassMatcher{
-													pos:        position{line: 73, col: 65, offset: 1829},
+													pos:        position{line: 84, col: 65, offset: 2120},
 													val:        "[^ ()]",
 													chars:      []rune{' ', '(', ')'},
 													ignoreCase: false,
@@ -1273,19 +1457,19 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 105, col: 5, offset: 2621},
-						run: (*parser).callonNode239,
+						pos: position{line: 133, col: 5, offset: 3262},
+						run: (*parser).callonNode278,
 						expr: &choiceExpr{
-							pos: position{line: 105, col: 6, offset: 2622},
+							pos: position{line: 133, col: 6, offset: 3263},
 							alternatives: []any{
 								&litMatcher{
-									pos:        position{line: 105, col: 6, offset: 2622},
+									pos:        position{line: 133, col: 6, offset: 3263},
 									val:        "AND",
 									ignoreCase: false,
 									want:       "\"AND\"",
 								},
 								&litMatcher{
-									pos:        position{line: 105, col: 14, offset: 2630},
+									pos:        position{line: 133, col: 14, offset: 3271},
 									val:        "+",
 									ignoreCase: false,
 									want:       "\"+\"",
@@ -1294,19 +1478,19 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 110, col: 5, offset: 2722},
-						run: (*parser).callonNode243,
+						pos: position{line: 138, col: 5, offset: 3363},
+						run: (*parser).callonNode282,
 						expr: &choiceExpr{
-							pos: position{line: 110, col: 6, offset: 2723},
+							pos: position{line: 138, col: 6, offset: 3364},
 							alternatives: []any{
 								&litMatcher{
-									pos:        position{line: 110, col: 6, offset: 2723},
+									pos:        position{line: 138, col: 6, offset: 3364},
 									val:        "NOT",
 									ignoreCase: false,
 									want:       "\"NOT\"",
 								},
 								&litMatcher{
-									pos:        position{line: 110, col: 14, offset: 2731},
+									pos:        position{line: 138, col: 14, offset: 3372},
 									val:        "-",
 									ignoreCase: false,
 									want:       "\"-\"",
@@ -1315,28 +1499,273 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 115, col: 5, offset: 2822},
-						run: (*parser).callonNode247,
+						pos: position{line: 143, col: 5, offset: 3463},
+						run: (*parser).callonNode286,
 						expr: &litMatcher{
-							pos:        position{line: 115, col: 6, offset: 2823},
+							pos:        position{line: 143, col: 6, offset: 3464},
 							val:        "OR",
 							ignoreCase: false,
 							want:       "\"OR\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 86, col: 6, offset: 2109},
-						run: (*parser).callonNode249,
+						pos: position{line: 98, col: 5, offset: 2422},
+						run: (*parser).callonNode288,
+						expr: &seqExpr{
+							pos: position{line: 98, col: 5, offset: 2422},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 98, col: 5, offset: 2422},
+									label: "l",
+									expr: &choiceExpr{
+										pos: position{line: 103, col: 5, offset: 2584},
+										alternatives: []any{
+											&actionExpr{
+												pos: position{line: 251, col: 5, offset: 5334},
+												run: (*parser).callonNode292,
+												expr: &seqExpr{
+													pos: position{line: 251, col: 5, offset: 5334},
+													exprs: []any{
+														&litMatcher{
+															pos:        position{line: 251, col: 5, offset: 5334},
+															val:        "\"",
+															ignoreCase: false,
+															want:       "\"\\\"\"",
+														},
+														&labeledExpr{
+															pos:   position{line: 251, col: 9, offset: 5338},
+															label: "v",
+															expr: &zeroOrMoreExpr{
+																pos: position{line: 251, col: 11, offset: 5340},
+																expr: &charClassMatcher{
+																	pos:        position{line: 251, col: 11, offset: 5340},
+																	val:        "[^\"]",
+																	chars:      []rune{'"'},
+																	ignoreCase: false,
+																	inverted:   true,
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 251, col: 17, offset: 5346},
+															val:        "\"",
+															ignoreCase: false,
+															want:       "\"\\\"\"",
+														},
+													},
+												},
+											},
+											&actionExpr{
+												pos: position{line: 104, col: 5, offset: 2597},
+												run: (*parser).callonNode299,
+												expr: &oneOrMoreExpr{
+													pos: position{line: 104, col: 5, offset: 2597},
+													expr: &charClassMatcher{
+														pos:        position{line: 104, col: 5, offset: 2597},
+														val:        "[^ :()\"]",
+														chars:      []rune{' ', ':', '(', ')', '"'},
+														ignoreCase: false,
+														inverted:   true,
+													},
+												},
+											},
+										},
+									},
+								},
+								&oneOrMoreExpr{
+									pos: position{line: 98, col: 21, offset: 2438},
+									expr: &charClassMatcher{
+										pos:        position{line: 98, col: 21, offset: 2438},
+										val:        "[ \\t]",
+										chars:      []rune{' ', '\t'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 98, col: 28, offset: 2445},
+									val:        "NEAR",
+									ignoreCase: false,
+									want:       "\"NEAR\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 98, col: 35, offset: 2452},
+									label: "d",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 98, col: 37, offset: 2454},
+										expr: &actionExpr{
+											pos: position{line: 109, col: 5, offset: 2668},
+											run: (*parser).callonNode307,
+											expr: &seqExpr{
+												pos: position{line: 109, col: 5, offset: 2668},
+												exprs: []any{
+													&litMatcher{
+														pos:        position{line: 109, col: 5, offset: 2668},
+														val:        "(",
+														ignoreCase: false,
+														want:       "\"(\"",
+													},
+													&zeroOrOneExpr{
+														pos: position{line: 109, col: 9, offset: 2672},
+														expr: &seqExpr{
+															pos: position{line: 109, col: 10, offset: 2673},
+															exprs: []any{
+																&litMatcher{
+																	pos:        position{line: 109, col: 10, offset: 2673},
+																	val:        "n",
+																	ignoreCase: false,
+																	want:       "\"n\"",
+																},
+																&actionExpr{
+																	pos: position{line: 266, col: 5, offset: 5545},
+																	run: (*parser).callonNode313,
+																	expr: &zeroOrMoreExpr{
+																		pos: position{line: 266, col: 5, offset: 5545},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 266, col: 5, offset: 5545},
+																			val:        "[ \\t]",
+																			chars:      []rune{' ', '\t'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+																&litMatcher{
+																	pos:        position{line: 109, col: 16, offset: 2679},
+																	val:        "=",
+																	ignoreCase: false,
+																	want:       "\"=\"",
+																},
+																&actionExpr{
+																	pos: position{line: 266, col: 5, offset: 5545},
+																	run: (*parser).callonNode317,
+																	expr: &zeroOrMoreExpr{
+																		pos: position{line: 266, col: 5, offset: 5545},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 266, col: 5, offset: 5545},
+																			val:        "[ \\t]",
+																			chars:      []rune{' ', '\t'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+													},
+													&labeledExpr{
+														pos:   position{line: 109, col: 24, offset: 2687},
+														label: "v",
+														expr: &oneOrMoreExpr{
+															pos: position{line: 109, col: 26, offset: 2689},
+															expr: &actionExpr{
+																pos: position{line: 256, col: 5, offset: 5394},
+																run: (*parser).callonNode322,
+																expr: &charClassMatcher{
+																	pos:        position{line: 256, col: 5, offset: 5394},
+																	val:        "[0-9]",
+																	ranges:     []rune{'0', '9'},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
+														},
+													},
+													&litMatcher{
+														pos:        position{line: 109, col: 33, offset: 2696},
+														val:        ")",
+														ignoreCase: false,
+														want:       "\")\"",
+													},
+												},
+											},
+										},
+									},
+								},
+								&oneOrMoreExpr{
+									pos: position{line: 98, col: 56, offset: 2473},
+									expr: &charClassMatcher{
+										pos:        position{line: 98, col: 56, offset: 2473},
+										val:        "[ \\t]",
+										chars:      []rune{' ', '\t'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 98, col: 63, offset: 2480},
+									label: "r",
+									expr: &choiceExpr{
+										pos: position{line: 103, col: 5, offset: 2584},
+										alternatives: []any{
+											&actionExpr{
+												pos: position{line: 251, col: 5, offset: 5334},
+												run: (*parser).callonNode329,
+												expr: &seqExpr{
+													pos: position{line: 251, col: 5, offset: 5334},
+													exprs: []any{
+														&litMatcher{
+															pos:        position{line: 251, col: 5, offset: 5334},
+															val:        "\"",
+															ignoreCase: false,
+															want:       "\"\\\"\"",
+														},
+														&labeledExpr{
+															pos:   position{line: 251, col: 9, offset: 5338},
+															label: "v",
+															expr: &zeroOrMoreExpr{
+																pos: position{line: 251, col: 11, offset: 5340},
+																expr: &charClassMatcher{
+																	pos:        position{line: 251, col: 11, offset: 5340},
+																	val:        "[^\"]",
+																	chars:      []rune{'"'},
+																	ignoreCase: false,
+																	inverted:   true,
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 251, col: 17, offset: 5346},
+															val:        "\"",
+															ignoreCase: false,
+															want:       "\"\\\"\"",
+														},
+													},
+												},
+											},
+											&actionExpr{
+												pos: position{line: 104, col: 5, offset: 2597},
+												run: (*parser).callonNode336,
+												expr: &oneOrMoreExpr{
+													pos: position{line: 104, col: 5, offset: 2597},
+													expr: &charClassMatcher{
+														pos:        position{line: 104, col: 5, offset: 2597},
+														val:        "[^ :()\"]",
+														chars:      []rune{' ', ':', '(', ')', '"'},
+														ignoreCase: false,
+														inverted:   true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 114, col: 6, offset: 2750},
+						run: (*parser).callonNode339,
 						expr: &seqExpr{
-							pos: position{line: 86, col: 6, offset: 2109},
+							pos: position{line: 114, col: 6, offset: 2750},
 							exprs: []any{
 								&zeroOrOneExpr{
-									pos: position{line: 86, col: 6, offset: 2109},
+									pos: position{line: 114, col: 6, offset: 2750},
 									expr: &actionExpr{
-										pos: position{line: 120, col: 5, offset: 2911},
-										run: (*parser).callonNode252,
+										pos: position{line: 148, col: 5, offset: 3552},
+										run: (*parser).callonNode342,
 										expr: &litMatcher{
-											pos:        position{line: 120, col: 5, offset: 2911},
+											pos:        position{line: 148, col: 5, offset: 3552},
 											val:        ":",
 											ignoreCase: false,
 											want:       "\":\"",
@@ -1344,12 +1773,12 @@ var g = &grammar{
 									},
 								},
 								&actionExpr{
-									pos: position{line: 233, col: 5, offset: 4804},
-									run: (*parser).callonNode254,
+									pos: position{line: 266, col: 5, offset: 5545},
+									run: (*parser).callonNode344,
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 233, col: 5, offset: 4804},
+										pos: position{line: 266, col: 5, offset: 5545},
 										expr: &charClassMatcher{
-											pos:        position{line: 233, col: 5, offset: 4804},
+											pos:        position{line: 266, col: 5, offset: 5545},
 											val:        "[ \\t]",
 											chars:      []rune{' ', '\t'},
 											ignoreCase: false,
@@ -1358,27 +1787,27 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 86, col: 27, offset: 2130},
+									pos:   position{line: 114, col: 27, offset: 2771},
 									label: "v",
 									expr: &actionExpr{
-										pos: position{line: 223, col: 5, offset: 4693},
-										run: (*parser).callonNode258,
+										pos: position{line: 251, col: 5, offset: 5334},
+										run: (*parser).callonNode348,
 										expr: &seqExpr{
-											pos: position{line: 223, col: 5, offset: 4693},
+											pos: position{line: 251, col: 5, offset: 5334},
 											exprs: []any{
 												&litMatcher{
-													pos:        position{line: 223, col: 5, offset: 4693},
+													pos:        position{line: 251, col: 5, offset: 5334},
 													val:        "\"",
 													ignoreCase: false,
 													want:       "\"\\\"\"",
 												},
 												&labeledExpr{
-													pos:   position{line: 223, col: 9, offset: 4697},
+													pos:   position{line: 251, col: 9, offset: 5338},
 													label: "v",
 													expr: &zeroOrMoreExpr{
-														pos: position{line: 223, col: 11, offset: 4699},
+														pos: position{line: 251, col: 11, offset: 5340},
 														expr: &charClassMatcher{
-															pos:        position{line: 223, col: 11, offset: 4699},
+															pos:        position{line: 251, col: 11, offset: 5340},
 															val:        "[^\"]",
 															chars:      []rune{'"'},
 															ignoreCase: false,
@@ -1387,7 +1816,7 @@ var g = &grammar{
 													},
 												},
 												&litMatcher{
-													pos:        position{line: 223, col: 17, offset: 4705},
+													pos:        position{line: 251, col: 17, offset: 5346},
 													val:        "\"",
 													ignoreCase: false,
 													want:       "\"\\\"\"",
@@ -1397,12 +1826,12 @@ var g = &grammar{
 									},
 								},
 								&actionExpr{
-									pos: position{line: 233, col: 5, offset: 4804},
-									run: (*parser).callonNode265,
+									pos: position{line: 266, col: 5, offset: 5545},
+									run: (*parser).callonNode355,
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 233, col: 5, offset: 4804},
+										pos: position{line: 266, col: 5, offset: 5545},
 										expr: &charClassMatcher{
-											pos:        position{line: 233, col: 5, offset: 4804},
+											pos:        position{line: 266, col: 5, offset: 5545},
 											val:        "[ \\t]",
 											chars:      []rune{' ', '\t'},
 											ignoreCase: false,
@@ -1411,12 +1840,12 @@ var g = &grammar{
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 86, col: 38, offset: 2141},
+									pos: position{line: 114, col: 38, offset: 2782},
 									expr: &actionExpr{
-										pos: position{line: 120, col: 5, offset: 2911},
-										run: (*parser).callonNode269,
+										pos: position{line: 148, col: 5, offset: 3552},
+										run: (*parser).callonNode359,
 										expr: &litMatcher{
-											pos:        position{line: 120, col: 5, offset: 2911},
+											pos:        position{line: 148, col: 5, offset: 3552},
 											val:        ":",
 											ignoreCase: false,
 											want:       "\":\"",
@@ -1427,18 +1856,18 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 91, col: 6, offset: 2239},
-						run: (*parser).callonNode271,
+						pos: position{line: 119, col: 6, offset: 2880},
+						run: (*parser).callonNode361,
 						expr: &seqExpr{
-							pos: position{line: 91, col: 6, offset: 2239},
+							pos: position{line: 119, col: 6, offset: 2880},
 							exprs: []any{
 								&zeroOrOneExpr{
-									pos: position{line: 91, col: 6, offset: 2239},
+									pos: position{line: 119, col: 6, offset: 2880},
 									expr: &actionExpr{
-										pos: position{line: 120, col: 5, offset: 2911},
-										run: (*parser).callonNode274,
+										pos: position{line: 148, col: 5, offset: 3552},
+										run: (*parser).callonNode364,
 										expr: &litMatcher{
-											pos:        position{line: 120, col: 5, offset: 2911},
+											pos:        position{line: 148, col: 5, offset: 3552},
 											val:        ":",
 											ignoreCase: false,
 											want:       "\":\"",
@@ -1446,12 +1875,12 @@ var g = &grammar{
 									},
 								},
 								&actionExpr{
-									pos: position{line: 233, col: 5, offset: 4804},
-									run: (*parser).callonNode276,
+									pos: position{line: 266, col: 5, offset: 5545},
+									run: (*parser).callonNode366,
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 233, col: 5, offset: 4804},
+										pos: position{line: 266, col: 5, offset: 5545},
 										expr: &charClassMatcher{
-											pos:        position{line: 233, col: 5, offset: 4804},
+											pos:        position{line: 266, col: 5, offset: 5545},
 											val:        "[ \\t]",
 											chars:      []rune{' ', '\t'},
 											ignoreCase: false,
@@ -1460,12 +1889,12 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 91, col: 27, offset: 2260},
+									pos:   position{line: 119, col: 27, offset: 2901},
 									label: "v",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 91, col: 29, offset: 2262},
+										pos: position{line: 119, col: 29, offset: 2903},
 										expr: &charClassMatcher{
-											pos:        position{line: 91, col: 29, offset: 2262},
+											pos:        position{line: 119, col: 29, offset: 2903},
 											val:        "[^ :()]",
 											chars:      []rune{' ', ':', '(', ')'},
 											ignoreCase: false,
@@ -1474,12 +1903,12 @@ var g = &grammar{
 									},
 								},
 								&actionExpr{
-									pos: position{line: 233, col: 5, offset: 4804},
-									run: (*parser).callonNode282,
+									pos: position{line: 266, col: 5, offset: 5545},
+									run: (*parser).callonNode372,
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 233, col: 5, offset: 4804},
+										pos: position{line: 266, col: 5, offset: 5545},
 										expr: &charClassMatcher{
-											pos:        position{line: 233, col: 5, offset: 4804},
+											pos:        position{line: 266, col: 5, offset: 5545},
 											val:        "[ \\t]",
 											chars:      []rune{' ', '\t'},
 											ignoreCase: false,
@@ -1488,12 +1917,12 @@ var g = &grammar{
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 91, col: 40, offset: 2273},
+									pos: position{line: 119, col: 40, offset: 2914},
 									expr: &actionExpr{
-										pos: position{line: 120, col: 5, offset: 2911},
-										run: (*parser).callonNode286,
+										pos: position{line: 148, col: 5, offset: 3552},
+										run: (*parser).callonNode376,
 										expr: &litMatcher{
-											pos:        position{line: 120, col: 5, offset: 2911},
+											pos:        position{line: 148, col: 5, offset: 3552},
 											val:        ":",
 											ignoreCase: false,
 											want:       "\":\"",
@@ -1523,10 +1952,10 @@ var g = &grammar{
 								expr: &oneOrMoreExpr{
 									pos: position{line: 32, col: 8, offset: 615},
 									expr: &actionExpr{
-										pos: position{line: 218, col: 5, offset: 4634},
+										pos: position{line: 246, col: 5, offset: 5275},
 										run: (*parser).callonGroupNode6,
 										expr: &charClassMatcher{
-											pos:        position{line: 218, col: 5, offset: 4634},
+											pos:        position{line: 246, col: 5, offset: 5275},
 											val:        "[A-Za-z]",
 											ranges:     []rune{'A', 'Z', 'a', 'z'},
 											ignoreCase: false,
@@ -1542,20 +1971,20 @@ var g = &grammar{
 								pos: position{line: 32, col: 17, offset: 624},
 								alternatives: []any{
 									&actionExpr{
-										pos: position{line: 120, col: 5, offset: 2911},
+										pos: position{line: 148, col: 5, offset: 3552},
 										run: (*parser).callonGroupNode10,
 										expr: &litMatcher{
-											pos:        position{line: 120, col: 5, offset: 2911},
+											pos:        position{line: 148, col: 5, offset: 3552},
 											val:        ":",
 											ignoreCase: false,
 											want:       "\":\"",
 										},
 									},
 									&actionExpr{
-										pos: position{line: 125, col: 5, offset: 2997},
+										pos: position{line: 153, col: 5, offset: 3638},
 										run: (*parser).callonGroupNode12,
 										expr: &litMatcher{
-											pos:        position{line: 125, col: 5, offset: 2997},
+											pos:        position{line: 153, col: 5, offset: 3638},
 											val:        "=",
 											ignoreCase: false,
 											want:       "\"=\"",
@@ -2460,202 +2889,400 @@ func (p *parser) callonNode221() (any, error) {
 	return p.cur.onNode221()
 }
 
-func (c *current) onNode224() (any, error) {
+func (c *current) onNode225() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode225() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode225()
+}
+
+func (c *current) onNode227() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode227() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode227()
+}
+
+func (c *current) onNode229() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode229() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode229()
+}
+
+func (c *current) onNode231() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode224() (any, error) {
+func (p *parser) callonNode231() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode231()
+}
+
+func (c *current) onNode237() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode237() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode237()
+}
+
+func (c *current) onNode243() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode243() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode243()
+}
+
+func (c *current) onNode234() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode234() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode234()
+}
+
+func (c *current) onNode217(k, o, v any) (any, error) {
+	return buildNumericNode(k, o, v, c.text, c.pos)
+
+}
+
+func (p *parser) callonNode217() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode217(stack["k"], stack["o"], stack["v"])
+}
+
+func (c *current) onNode260() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode260() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode224()
+	return p.cur.onNode260()
 }
 
-func (c *current) onNode226() (any, error) {
+func (c *current) onNode263() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode226() (any, error) {
+func (p *parser) callonNode263() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode226()
+	return p.cur.onNode263()
 }
 
-func (c *current) onNode230(v any) (any, error) {
+func (c *current) onNode265() (any, error) {
+	return buildOperatorNode(c.text, c.pos)
+
+}
+
+func (p *parser) callonNode265() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode265()
+}
+
+func (c *current) onNode269(v any) (any, error) {
 	return v, nil
 
 }
 
-func (p *parser) callonNode230() (any, error) {
+func (p *parser) callonNode269() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode230(stack["v"])
+	return p.cur.onNode269(stack["v"])
 }
 
-func (c *current) onNode217(k, v any) (any, error) {
+func (c *current) onNode256(k, v any) (any, error) {
 	return buildStringNode(k, v, c.text, c.pos)
 
 }
 
-func (p *parser) callonNode217() (any, error) {
+func (p *parser) callonNode256() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode217(stack["k"], stack["v"])
+	return p.cur.onNode256(stack["k"], stack["v"])
 }
 
-func (c *current) onNode239() (any, error) {
+func (c *current) onNode278() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode239() (any, error) {
+func (p *parser) callonNode278() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode239()
+	return p.cur.onNode278()
 }
 
-func (c *current) onNode243() (any, error) {
+func (c *current) onNode282() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode243() (any, error) {
+func (p *parser) callonNode282() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode243()
+	return p.cur.onNode282()
 }
 
-func (c *current) onNode247() (any, error) {
+func (c *current) onNode286() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode247() (any, error) {
+func (p *parser) callonNode286() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode286()
+}
+
+func (c *current) onNode292(v any) (any, error) {
+	return v, nil
+
+}
+
+func (p *parser) callonNode292() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode292(stack["v"])
+}
+
+func (c *current) onNode299() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode299() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode299()
+}
+
+func (c *current) onNode313() (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonNode313() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode247()
+	return p.cur.onNode313()
 }
 
-func (c *current) onNode252() (any, error) {
+func (c *current) onNode317() (any, error) {
+	return nil, nil
+
+}
+
+func (p *parser) callonNode317() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode317()
+}
+
+func (c *current) onNode322() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode322() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode322()
+}
+
+func (c *current) onNode307(v any) (any, error) {
+	return v, nil
+
+}
+
+func (p *parser) callonNode307() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode307(stack["v"])
+}
+
+func (c *current) onNode329(v any) (any, error) {
+	return v, nil
+
+}
+
+func (p *parser) callonNode329() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode329(stack["v"])
+}
+
+func (c *current) onNode336() (any, error) {
+	return c.text, nil
+
+}
+
+func (p *parser) callonNode336() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode336()
+}
+
+func (c *current) onNode288(l, d, r any) (any, error) {
+	return buildProximityNode(l, d, r, c.text, c.pos)
+
+}
+
+func (p *parser) callonNode288() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNode288(stack["l"], stack["d"], stack["r"])
+}
+
+func (c *current) onNode342() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode252() (any, error) {
+func (p *parser) callonNode342() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode252()
+	return p.cur.onNode342()
 }
 
-func (c *current) onNode254() (any, error) {
+func (c *current) onNode344() (any, error) {
 	return nil, nil
 
 }
 
-func (p *parser) callonNode254() (any, error) {
+func (p *parser) callonNode344() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode254()
+	return p.cur.onNode344()
 }
 
-func (c *current) onNode258(v any) (any, error) {
+func (c *current) onNode348(v any) (any, error) {
 	return v, nil
 
 }
 
-func (p *parser) callonNode258() (any, error) {
+func (p *parser) callonNode348() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode258(stack["v"])
+	return p.cur.onNode348(stack["v"])
 }
 
-func (c *current) onNode265(v any) (any, error) {
+func (c *current) onNode355(v any) (any, error) {
 	return nil, nil
 
 }
 
-func (p *parser) callonNode265() (any, error) {
+func (p *parser) callonNode355() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode265(stack["v"])
+	return p.cur.onNode355(stack["v"])
 }
 
-func (c *current) onNode269() (any, error) {
+func (c *current) onNode359() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode269() (any, error) {
+func (p *parser) callonNode359() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode269()
+	return p.cur.onNode359()
 }
 
-func (c *current) onNode249(v any) (any, error) {
+func (c *current) onNode339(v any) (any, error) {
 	return buildStringNode("", v, c.text, c.pos)
 
 }
 
-func (p *parser) callonNode249() (any, error) {
+func (p *parser) callonNode339() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode249(stack["v"])
+	return p.cur.onNode339(stack["v"])
 }
 
-func (c *current) onNode274() (any, error) {
+func (c *current) onNode364() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode274() (any, error) {
+func (p *parser) callonNode364() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode274()
+	return p.cur.onNode364()
 }
 
-func (c *current) onNode276() (any, error) {
+func (c *current) onNode366() (any, error) {
 	return nil, nil
 
 }
 
-func (p *parser) callonNode276() (any, error) {
+func (p *parser) callonNode366() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode276()
+	return p.cur.onNode366()
 }
 
-func (c *current) onNode282(v any) (any, error) {
+func (c *current) onNode372(v any) (any, error) {
 	return nil, nil
 
 }
 
-func (p *parser) callonNode282() (any, error) {
+func (p *parser) callonNode372() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode282(stack["v"])
+	return p.cur.onNode372(stack["v"])
 }
 
-func (c *current) onNode286() (any, error) {
+func (c *current) onNode376() (any, error) {
 	return buildOperatorNode(c.text, c.pos)
 
 }
 
-func (p *parser) callonNode286() (any, error) {
+func (p *parser) callonNode376() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode286()
+	return p.cur.onNode376()
 }
 
-func (c *current) onNode271(v any) (any, error) {
+func (c *current) onNode361(v any) (any, error) {
 	return buildStringNode("", v, c.text, c.pos)
 
 }
 
-func (p *parser) callonNode271() (any, error) {
+func (p *parser) callonNode361() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNode271(stack["v"])
+	return p.cur.onNode361(stack["v"])
 }
 
 func (c *current) onGroupNode6() (any, error) {
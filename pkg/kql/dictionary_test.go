@@ -1319,6 +1319,74 @@ func TestParse_Stress(t *testing.T) {
 				},
 			},
 		},
+		// NEAR Operator
+		{
+			name: `cat NEAR dog`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.ProximityNode{Left: "cat", Right: "dog", Distance: kql.DefaultProximityDistance},
+				},
+			},
+		},
+		{
+			name: `"black cat" NEAR(n=2) dog fox`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.ProximityNode{Left: "black cat", Right: "dog", Distance: 2},
+					&ast.OperatorNode{Value: kql.BoolAND},
+					&ast.StringNode{Value: "fox"},
+				},
+			},
+		},
+		{
+			name: `cat NEAR(3) dog`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.ProximityNode{Left: "cat", Right: "dog", Distance: 3},
+				},
+			},
+		},
+		{
+			name: `cat NEARBY`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.StringNode{Value: "cat"},
+					&ast.OperatorNode{Value: kql.BoolAND},
+					&ast.StringNode{Value: "NEARBY"},
+				},
+			},
+		},
+		// Numeric Values
+		{
+			name: `size>10MB size<=1.5GiB Size>=1024 size<10kb`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.NumericNode{Key: "size", Operator: &ast.OperatorNode{Value: ">"}, Value: 10e6},
+					&ast.OperatorNode{Value: kql.BoolOR},
+					&ast.NumericNode{Key: "size", Operator: &ast.OperatorNode{Value: "<="}, Value: 1.5 * (1 << 30)},
+					&ast.OperatorNode{Value: kql.BoolOR},
+					&ast.NumericNode{Key: "Size", Operator: &ast.OperatorNode{Value: ">="}, Value: 1024},
+					&ast.OperatorNode{Value: kql.BoolOR},
+					&ast.NumericNode{Key: "size", Operator: &ast.OperatorNode{Value: "<"}, Value: 10e3},
+				},
+			},
+		},
+		{
+			name: `(size>1MB AND size<2MB) size:10`,
+			ast: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.GroupNode{
+						Nodes: []ast.Node{
+							&ast.NumericNode{Key: "size", Operator: &ast.OperatorNode{Value: ">"}, Value: 1e6},
+							&ast.OperatorNode{Value: kql.BoolAND},
+							&ast.NumericNode{Key: "size", Operator: &ast.OperatorNode{Value: "<"}, Value: 2e6},
+						},
+					},
+					&ast.OperatorNode{Value: kql.BoolAND},
+					&ast.StringNode{Key: "size", Value: "10"},
+				},
+			},
+		},
 		{
 			name: "ids",
 			query: join([]string{
@@ -1,6 +1,7 @@
 package kql
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/opencloud-eu/opencloud/pkg/ast"
@@ -101,6 +102,73 @@ func buildDateTimeNode(k, o, v interface{}, text []byte, pos position) (*ast.Dat
 		Value:    value,
 	}, nil
 }
+
+func buildNumericNode(k, o, v interface{}, text []byte, pos position) (*ast.NumericNode, error) {
+	b, err := base(text, pos)
+	if err != nil {
+		return nil, err
+	}
+
+	operator, err := toNode[*ast.OperatorNode](o)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := toString(k)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := toNumber(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ast.NumericNode{
+		Base:     b,
+		Key:      key,
+		Operator: operator,
+		Value:    value,
+	}, nil
+}
+
+func buildProximityNode(l, d, r interface{}, text []byte, pos position) (*ast.ProximityNode, error) {
+	b, err := base(text, pos)
+	if err != nil {
+		return nil, err
+	}
+
+	left, err := toString(l)
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := toString(r)
+	if err != nil {
+		return nil, err
+	}
+
+	distance := DefaultProximityDistance
+	if d != nil {
+		ds, err := toString(d)
+		if err != nil {
+			return nil, err
+		}
+
+		distance, err = strconv.Atoi(ds)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &ast.ProximityNode{
+		Base:     b,
+		Left:     left,
+		Right:    right,
+		Distance: distance,
+	}, nil
+}
+
 func buildNaturalLanguageDateTimeNodes(k, v interface{}, text []byte, pos position) ([]ast.Node, error) {
 	b, err := base(text, pos)
 	if err != nil {
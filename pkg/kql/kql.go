@@ -18,6 +18,9 @@ const (
 	BoolNOT = "NOT"
 )
 
+// DefaultProximityDistance is the number of words allowed between the terms of a NEAR operator without distance
+const DefaultProximityDistance = 8
+
 // Builder implements kql Builder interface
 type Builder struct{}
 
@@ -262,6 +262,42 @@ func (m *Entity) UnmarshalJSON(b []byte) error {
 
 var _ json.Unmarshaler = (*Entity)(nil)
 
+// FragmentJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of Fragment. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var FragmentJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *Fragment) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := FragmentJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*Fragment)(nil)
+
+// FragmentJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of Fragment. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var FragmentJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *Fragment) UnmarshalJSON(b []byte) error {
+	return FragmentJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*Fragment)(nil)
+
 // MatchJSONMarshaler describes the default jsonpb.Marshaler used by all
 // instances of Match. This struct is safe to replace or modify but
 // should not be done so concurrently.
@@ -39,6 +39,89 @@ func (_m *SearchProviderService) EXPECT() *SearchProviderService_Expecter {
 	return &SearchProviderService_Expecter{mock: &_m.Mock}
 }
 
+// CompactIndex provides a mock function for the type SearchProviderService
+func (_mock *SearchProviderService) CompactIndex(ctx context.Context, in *v0.CompactIndexRequest, opts ...client.CallOption) (*v0.CompactIndexResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompactIndex")
+	}
+
+	var r0 *v0.CompactIndexResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v0.CompactIndexRequest, ...client.CallOption) (*v0.CompactIndexResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v0.CompactIndexRequest, ...client.CallOption) *v0.CompactIndexResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v0.CompactIndexResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v0.CompactIndexRequest, ...client.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SearchProviderService_CompactIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompactIndex'
+type SearchProviderService_CompactIndex_Call struct {
+	*mock.Call
+}
+
+// CompactIndex is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v0.CompactIndexRequest
+//   - opts ...client.CallOption
+func (_e *SearchProviderService_Expecter) CompactIndex(ctx interface{}, in interface{}, opts ...interface{}) *SearchProviderService_CompactIndex_Call {
+	return &SearchProviderService_CompactIndex_Call{Call: _e.mock.On("CompactIndex",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SearchProviderService_CompactIndex_Call) Run(run func(ctx context.Context, in *v0.CompactIndexRequest, opts ...client.CallOption)) *SearchProviderService_CompactIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v0.CompactIndexRequest
+		if args[1] != nil {
+			arg1 = args[1].(*v0.CompactIndexRequest)
+		}
+		var arg2 []client.CallOption
+		var variadicArgs []client.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]client.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *SearchProviderService_CompactIndex_Call) Return(compactIndexResponse *v0.CompactIndexResponse, err error) *SearchProviderService_CompactIndex_Call {
+	_c.Call.Return(compactIndexResponse, err)
+	return _c
+}
+
+func (_c *SearchProviderService_CompactIndex_Call) RunAndReturn(run func(ctx context.Context, in *v0.CompactIndexRequest, opts ...client.CallOption) (*v0.CompactIndexResponse, error)) *SearchProviderService_CompactIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IndexSpace provides a mock function for the type SearchProviderService
 func (_mock *SearchProviderService) IndexSpace(ctx context.Context, in *v0.IndexSpaceRequest, opts ...client.CallOption) (*v0.IndexSpaceResponse, error) {
 	var tmpRet mock.Arguments
@@ -122,6 +205,172 @@ func (_c *SearchProviderService_IndexSpace_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// IndexStats provides a mock function for the type SearchProviderService
+func (_mock *SearchProviderService) IndexStats(ctx context.Context, in *v0.IndexStatsRequest, opts ...client.CallOption) (*v0.IndexStatsResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for IndexStats")
+	}
+
+	var r0 *v0.IndexStatsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v0.IndexStatsRequest, ...client.CallOption) (*v0.IndexStatsResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v0.IndexStatsRequest, ...client.CallOption) *v0.IndexStatsResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v0.IndexStatsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v0.IndexStatsRequest, ...client.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SearchProviderService_IndexStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IndexStats'
+type SearchProviderService_IndexStats_Call struct {
+	*mock.Call
+}
+
+// IndexStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v0.IndexStatsRequest
+//   - opts ...client.CallOption
+func (_e *SearchProviderService_Expecter) IndexStats(ctx interface{}, in interface{}, opts ...interface{}) *SearchProviderService_IndexStats_Call {
+	return &SearchProviderService_IndexStats_Call{Call: _e.mock.On("IndexStats",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SearchProviderService_IndexStats_Call) Run(run func(ctx context.Context, in *v0.IndexStatsRequest, opts ...client.CallOption)) *SearchProviderService_IndexStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v0.IndexStatsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*v0.IndexStatsRequest)
+		}
+		var arg2 []client.CallOption
+		var variadicArgs []client.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]client.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *SearchProviderService_IndexStats_Call) Return(indexStatsResponse *v0.IndexStatsResponse, err error) *SearchProviderService_IndexStats_Call {
+	_c.Call.Return(indexStatsResponse, err)
+	return _c
+}
+
+func (_c *SearchProviderService_IndexStats_Call) RunAndReturn(run func(ctx context.Context, in *v0.IndexStatsRequest, opts ...client.CallOption) (*v0.IndexStatsResponse, error)) *SearchProviderService_IndexStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RebuildIndex provides a mock function for the type SearchProviderService
+func (_mock *SearchProviderService) RebuildIndex(ctx context.Context, in *v0.RebuildIndexRequest, opts ...client.CallOption) (*v0.RebuildIndexResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for RebuildIndex")
+	}
+
+	var r0 *v0.RebuildIndexResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v0.RebuildIndexRequest, ...client.CallOption) (*v0.RebuildIndexResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v0.RebuildIndexRequest, ...client.CallOption) *v0.RebuildIndexResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v0.RebuildIndexResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v0.RebuildIndexRequest, ...client.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SearchProviderService_RebuildIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RebuildIndex'
+type SearchProviderService_RebuildIndex_Call struct {
+	*mock.Call
+}
+
+// RebuildIndex is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v0.RebuildIndexRequest
+//   - opts ...client.CallOption
+func (_e *SearchProviderService_Expecter) RebuildIndex(ctx interface{}, in interface{}, opts ...interface{}) *SearchProviderService_RebuildIndex_Call {
+	return &SearchProviderService_RebuildIndex_Call{Call: _e.mock.On("RebuildIndex",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SearchProviderService_RebuildIndex_Call) Run(run func(ctx context.Context, in *v0.RebuildIndexRequest, opts ...client.CallOption)) *SearchProviderService_RebuildIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v0.RebuildIndexRequest
+		if args[1] != nil {
+			arg1 = args[1].(*v0.RebuildIndexRequest)
+		}
+		var arg2 []client.CallOption
+		var variadicArgs []client.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]client.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *SearchProviderService_RebuildIndex_Call) Return(rebuildIndexResponse *v0.RebuildIndexResponse, err error) *SearchProviderService_RebuildIndex_Call {
+	_c.Call.Return(rebuildIndexResponse, err)
+	return _c
+}
+
+func (_c *SearchProviderService_RebuildIndex_Call) RunAndReturn(run func(ctx context.Context, in *v0.RebuildIndexRequest, opts ...client.CallOption) (*v0.RebuildIndexResponse, error)) *SearchProviderService_RebuildIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Search provides a mock function for the type SearchProviderService
 func (_mock *SearchProviderService) Search(ctx context.Context, in *v0.SearchRequest, opts ...client.CallOption) (*v0.SearchResponse, error) {
 	var tmpRet mock.Arguments
@@ -204,3 +453,86 @@ func (_c *SearchProviderService_Search_Call) RunAndReturn(run func(ctx context.C
 	_c.Call.Return(run)
 	return _c
 }
+
+// VerifyIndex provides a mock function for the type SearchProviderService
+func (_mock *SearchProviderService) VerifyIndex(ctx context.Context, in *v0.VerifyIndexRequest, opts ...client.CallOption) (*v0.VerifyIndexResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyIndex")
+	}
+
+	var r0 *v0.VerifyIndexResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v0.VerifyIndexRequest, ...client.CallOption) (*v0.VerifyIndexResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v0.VerifyIndexRequest, ...client.CallOption) *v0.VerifyIndexResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v0.VerifyIndexResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v0.VerifyIndexRequest, ...client.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SearchProviderService_VerifyIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyIndex'
+type SearchProviderService_VerifyIndex_Call struct {
+	*mock.Call
+}
+
+// VerifyIndex is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v0.VerifyIndexRequest
+//   - opts ...client.CallOption
+func (_e *SearchProviderService_Expecter) VerifyIndex(ctx interface{}, in interface{}, opts ...interface{}) *SearchProviderService_VerifyIndex_Call {
+	return &SearchProviderService_VerifyIndex_Call{Call: _e.mock.On("VerifyIndex",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *SearchProviderService_VerifyIndex_Call) Run(run func(ctx context.Context, in *v0.VerifyIndexRequest, opts ...client.CallOption)) *SearchProviderService_VerifyIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v0.VerifyIndexRequest
+		if args[1] != nil {
+			arg1 = args[1].(*v0.VerifyIndexRequest)
+		}
+		var arg2 []client.CallOption
+		var variadicArgs []client.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]client.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *SearchProviderService_VerifyIndex_Call) Return(verifyIndexResponse *v0.VerifyIndexResponse, err error) *SearchProviderService_VerifyIndex_Call {
+	_c.Call.Return(verifyIndexResponse, err)
+	return _c
+}
+
+func (_c *SearchProviderService_VerifyIndex_Call) RunAndReturn(run func(ctx context.Context, in *v0.VerifyIndexRequest, opts ...client.CallOption) (*v0.VerifyIndexResponse, error)) *SearchProviderService_VerifyIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -47,6 +47,30 @@ func NewSearchProviderEndpoints() []*api.Endpoint {
 			Method:  []string{"POST"},
 			Handler: "rpc",
 		},
+		{
+			Name:    "SearchProvider.VerifyIndex",
+			Path:    []string{"/api/v0/search/index/verify"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "SearchProvider.RebuildIndex",
+			Path:    []string{"/api/v0/search/index/rebuild"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "SearchProvider.CompactIndex",
+			Path:    []string{"/api/v0/search/index/compact"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "SearchProvider.IndexStats",
+			Path:    []string{"/api/v0/search/index/stats"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
 	}
 }
 
@@ -55,6 +79,10 @@ func NewSearchProviderEndpoints() []*api.Endpoint {
 type SearchProviderService interface {
 	Search(ctx context.Context, in *SearchRequest, opts ...client.CallOption) (*SearchResponse, error)
 	IndexSpace(ctx context.Context, in *IndexSpaceRequest, opts ...client.CallOption) (*IndexSpaceResponse, error)
+	VerifyIndex(ctx context.Context, in *VerifyIndexRequest, opts ...client.CallOption) (*VerifyIndexResponse, error)
+	RebuildIndex(ctx context.Context, in *RebuildIndexRequest, opts ...client.CallOption) (*RebuildIndexResponse, error)
+	CompactIndex(ctx context.Context, in *CompactIndexRequest, opts ...client.CallOption) (*CompactIndexResponse, error)
+	IndexStats(ctx context.Context, in *IndexStatsRequest, opts ...client.CallOption) (*IndexStatsResponse, error)
 }
 
 type searchProviderService struct {
@@ -89,17 +117,65 @@ func (c *searchProviderService) IndexSpace(ctx context.Context, in *IndexSpaceRe
 	return out, nil
 }
 
+func (c *searchProviderService) VerifyIndex(ctx context.Context, in *VerifyIndexRequest, opts ...client.CallOption) (*VerifyIndexResponse, error) {
+	req := c.c.NewRequest(c.name, "SearchProvider.VerifyIndex", in)
+	out := new(VerifyIndexResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchProviderService) RebuildIndex(ctx context.Context, in *RebuildIndexRequest, opts ...client.CallOption) (*RebuildIndexResponse, error) {
+	req := c.c.NewRequest(c.name, "SearchProvider.RebuildIndex", in)
+	out := new(RebuildIndexResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchProviderService) CompactIndex(ctx context.Context, in *CompactIndexRequest, opts ...client.CallOption) (*CompactIndexResponse, error) {
+	req := c.c.NewRequest(c.name, "SearchProvider.CompactIndex", in)
+	out := new(CompactIndexResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchProviderService) IndexStats(ctx context.Context, in *IndexStatsRequest, opts ...client.CallOption) (*IndexStatsResponse, error) {
+	req := c.c.NewRequest(c.name, "SearchProvider.IndexStats", in)
+	out := new(IndexStatsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for SearchProvider service
 
 type SearchProviderHandler interface {
 	Search(context.Context, *SearchRequest, *SearchResponse) error
 	IndexSpace(context.Context, *IndexSpaceRequest, *IndexSpaceResponse) error
+	VerifyIndex(context.Context, *VerifyIndexRequest, *VerifyIndexResponse) error
+	RebuildIndex(context.Context, *RebuildIndexRequest, *RebuildIndexResponse) error
+	CompactIndex(context.Context, *CompactIndexRequest, *CompactIndexResponse) error
+	IndexStats(context.Context, *IndexStatsRequest, *IndexStatsResponse) error
 }
 
 func RegisterSearchProviderHandler(s server.Server, hdlr SearchProviderHandler, opts ...server.HandlerOption) error {
 	type searchProvider interface {
 		Search(ctx context.Context, in *SearchRequest, out *SearchResponse) error
 		IndexSpace(ctx context.Context, in *IndexSpaceRequest, out *IndexSpaceResponse) error
+		VerifyIndex(ctx context.Context, in *VerifyIndexRequest, out *VerifyIndexResponse) error
+		RebuildIndex(ctx context.Context, in *RebuildIndexRequest, out *RebuildIndexResponse) error
+		CompactIndex(ctx context.Context, in *CompactIndexRequest, out *CompactIndexResponse) error
+		IndexStats(ctx context.Context, in *IndexStatsRequest, out *IndexStatsResponse) error
 	}
 	type SearchProvider struct {
 		searchProvider
@@ -117,6 +193,30 @@ func RegisterSearchProviderHandler(s server.Server, hdlr SearchProviderHandler,
 		Method:  []string{"POST"},
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "SearchProvider.VerifyIndex",
+		Path:    []string{"/api/v0/search/index/verify"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "SearchProvider.RebuildIndex",
+		Path:    []string{"/api/v0/search/index/rebuild"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "SearchProvider.CompactIndex",
+		Path:    []string{"/api/v0/search/index/compact"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "SearchProvider.IndexStats",
+		Path:    []string{"/api/v0/search/index/stats"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
 	return s.Handle(s.NewHandler(&SearchProvider{h}, opts...))
 }
 
@@ -132,6 +232,22 @@ func (h *searchProviderHandler) IndexSpace(ctx context.Context, in *IndexSpaceRe
 	return h.SearchProviderHandler.IndexSpace(ctx, in, out)
 }
 
+func (h *searchProviderHandler) VerifyIndex(ctx context.Context, in *VerifyIndexRequest, out *VerifyIndexResponse) error {
+	return h.SearchProviderHandler.VerifyIndex(ctx, in, out)
+}
+
+func (h *searchProviderHandler) RebuildIndex(ctx context.Context, in *RebuildIndexRequest, out *RebuildIndexResponse) error {
+	return h.SearchProviderHandler.RebuildIndex(ctx, in, out)
+}
+
+func (h *searchProviderHandler) CompactIndex(ctx context.Context, in *CompactIndexRequest, out *CompactIndexResponse) error {
+	return h.SearchProviderHandler.CompactIndex(ctx, in, out)
+}
+
+func (h *searchProviderHandler) IndexStats(ctx context.Context, in *IndexStatsRequest, out *IndexStatsResponse) error {
+	return h.SearchProviderHandler.IndexStats(ctx, in, out)
+}
+
 // Api Endpoints for IndexProvider service
 
 func NewIndexProviderEndpoints() []*api.Endpoint {
@@ -75,6 +75,110 @@ func (h *webSearchProviderHandler) IndexSpace(w http.ResponseWriter, r *http.Req
 	render.JSON(w, r, resp)
 }
 
+func (h *webSearchProviderHandler) VerifyIndex(w http.ResponseWriter, r *http.Request) {
+	req := &VerifyIndexRequest{}
+	resp := &VerifyIndexResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.VerifyIndex(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webSearchProviderHandler) RebuildIndex(w http.ResponseWriter, r *http.Request) {
+	req := &RebuildIndexRequest{}
+	resp := &RebuildIndexResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.RebuildIndex(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webSearchProviderHandler) CompactIndex(w http.ResponseWriter, r *http.Request) {
+	req := &CompactIndexRequest{}
+	resp := &CompactIndexResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.CompactIndex(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webSearchProviderHandler) IndexStats(w http.ResponseWriter, r *http.Request) {
+	req := &IndexStatsRequest{}
+	resp := &IndexStatsResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.IndexStats(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
 func RegisterSearchProviderWeb(r chi.Router, i SearchProviderHandler, middlewares ...func(http.Handler) http.Handler) {
 	handler := &webSearchProviderHandler{
 		r: r,
@@ -83,6 +187,10 @@ func RegisterSearchProviderWeb(r chi.Router, i SearchProviderHandler, middleware
 
 	r.MethodFunc("POST", "/api/v0/search/search", handler.Search)
 	r.MethodFunc("POST", "/api/v0/search/index-space", handler.IndexSpace)
+	r.MethodFunc("POST", "/api/v0/search/index/verify", handler.VerifyIndex)
+	r.MethodFunc("POST", "/api/v0/search/index/rebuild", handler.RebuildIndex)
+	r.MethodFunc("POST", "/api/v0/search/index/compact", handler.CompactIndex)
+	r.MethodFunc("POST", "/api/v0/search/index/stats", handler.IndexStats)
 }
 
 type webIndexProviderHandler struct {
@@ -344,3 +452,363 @@ func (m *IndexSpaceResponse) UnmarshalJSON(b []byte) error {
 }
 
 var _ json.Unmarshaler = (*IndexSpaceResponse)(nil)
+
+// VerifyIndexRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of VerifyIndexRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var VerifyIndexRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *VerifyIndexRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := VerifyIndexRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*VerifyIndexRequest)(nil)
+
+// VerifyIndexRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of VerifyIndexRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var VerifyIndexRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *VerifyIndexRequest) UnmarshalJSON(b []byte) error {
+	return VerifyIndexRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*VerifyIndexRequest)(nil)
+
+// VerifyIndexResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of VerifyIndexResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var VerifyIndexResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *VerifyIndexResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := VerifyIndexResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*VerifyIndexResponse)(nil)
+
+// VerifyIndexResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of VerifyIndexResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var VerifyIndexResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *VerifyIndexResponse) UnmarshalJSON(b []byte) error {
+	return VerifyIndexResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*VerifyIndexResponse)(nil)
+
+// SpaceDriftJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of SpaceDrift. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SpaceDriftJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *SpaceDrift) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := SpaceDriftJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*SpaceDrift)(nil)
+
+// SpaceDriftJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of SpaceDrift. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SpaceDriftJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *SpaceDrift) UnmarshalJSON(b []byte) error {
+	return SpaceDriftJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*SpaceDrift)(nil)
+
+// RebuildIndexRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of RebuildIndexRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RebuildIndexRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *RebuildIndexRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := RebuildIndexRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*RebuildIndexRequest)(nil)
+
+// RebuildIndexRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of RebuildIndexRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RebuildIndexRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *RebuildIndexRequest) UnmarshalJSON(b []byte) error {
+	return RebuildIndexRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*RebuildIndexRequest)(nil)
+
+// RebuildIndexResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of RebuildIndexResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RebuildIndexResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *RebuildIndexResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := RebuildIndexResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*RebuildIndexResponse)(nil)
+
+// RebuildIndexResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of RebuildIndexResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RebuildIndexResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *RebuildIndexResponse) UnmarshalJSON(b []byte) error {
+	return RebuildIndexResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*RebuildIndexResponse)(nil)
+
+// CompactIndexRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of CompactIndexRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CompactIndexRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *CompactIndexRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := CompactIndexRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*CompactIndexRequest)(nil)
+
+// CompactIndexRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of CompactIndexRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CompactIndexRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *CompactIndexRequest) UnmarshalJSON(b []byte) error {
+	return CompactIndexRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*CompactIndexRequest)(nil)
+
+// CompactIndexResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of CompactIndexResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CompactIndexResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *CompactIndexResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := CompactIndexResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*CompactIndexResponse)(nil)
+
+// CompactIndexResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of CompactIndexResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CompactIndexResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *CompactIndexResponse) UnmarshalJSON(b []byte) error {
+	return CompactIndexResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*CompactIndexResponse)(nil)
+
+// IndexStatsRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of IndexStatsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var IndexStatsRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *IndexStatsRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := IndexStatsRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*IndexStatsRequest)(nil)
+
+// IndexStatsRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of IndexStatsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var IndexStatsRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *IndexStatsRequest) UnmarshalJSON(b []byte) error {
+	return IndexStatsRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*IndexStatsRequest)(nil)
+
+// IndexStatsResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of IndexStatsResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var IndexStatsResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *IndexStatsResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := IndexStatsResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*IndexStatsResponse)(nil)
+
+// IndexStatsResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of IndexStatsResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var IndexStatsResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *IndexStatsResponse) UnmarshalJSON(b []byte) error {
+	return IndexStatsResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*IndexStatsResponse)(nil)
+
+// SpaceStatsJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of SpaceStats. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SpaceStatsJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *SpaceStats) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := SpaceStatsJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*SpaceStats)(nil)
+
+// SpaceStatsJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of SpaceStats. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SpaceStatsJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *SpaceStats) UnmarshalJSON(b []byte) error {
+	return SpaceStatsJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*SpaceStats)(nil)
@@ -412,6 +412,18 @@ func NewRoleServiceEndpoints() []*api.Endpoint {
 			Method:  []string{"POST"},
 			Handler: "rpc",
 		},
+		{
+			Name:    "RoleService.SaveRole",
+			Path:    []string{"/api/v0/settings/roles-save"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
+		{
+			Name:    "RoleService.DeleteRole",
+			Path:    []string{"/api/v0/settings/roles-delete"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
 	}
 }
 
@@ -423,6 +435,8 @@ type RoleService interface {
 	ListRoleAssignmentsFiltered(ctx context.Context, in *ListRoleAssignmentsFilteredRequest, opts ...client.CallOption) (*ListRoleAssignmentsResponse, error)
 	AssignRoleToUser(ctx context.Context, in *AssignRoleToUserRequest, opts ...client.CallOption) (*AssignRoleToUserResponse, error)
 	RemoveRoleFromUser(ctx context.Context, in *RemoveRoleFromUserRequest, opts ...client.CallOption) (*emptypb.Empty, error)
+	SaveRole(ctx context.Context, in *SaveRoleRequest, opts ...client.CallOption) (*SaveRoleResponse, error)
+	DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...client.CallOption) (*emptypb.Empty, error)
 }
 
 type roleService struct {
@@ -487,6 +501,26 @@ func (c *roleService) RemoveRoleFromUser(ctx context.Context, in *RemoveRoleFrom
 	return out, nil
 }
 
+func (c *roleService) SaveRole(ctx context.Context, in *SaveRoleRequest, opts ...client.CallOption) (*SaveRoleResponse, error) {
+	req := c.c.NewRequest(c.name, "RoleService.SaveRole", in)
+	out := new(SaveRoleResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleService) DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...client.CallOption) (*emptypb.Empty, error) {
+	req := c.c.NewRequest(c.name, "RoleService.DeleteRole", in)
+	out := new(emptypb.Empty)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for RoleService service
 
 type RoleServiceHandler interface {
@@ -495,6 +529,8 @@ type RoleServiceHandler interface {
 	ListRoleAssignmentsFiltered(context.Context, *ListRoleAssignmentsFilteredRequest, *ListRoleAssignmentsResponse) error
 	AssignRoleToUser(context.Context, *AssignRoleToUserRequest, *AssignRoleToUserResponse) error
 	RemoveRoleFromUser(context.Context, *RemoveRoleFromUserRequest, *emptypb.Empty) error
+	SaveRole(context.Context, *SaveRoleRequest, *SaveRoleResponse) error
+	DeleteRole(context.Context, *DeleteRoleRequest, *emptypb.Empty) error
 }
 
 func RegisterRoleServiceHandler(s server.Server, hdlr RoleServiceHandler, opts ...server.HandlerOption) error {
@@ -504,6 +540,8 @@ func RegisterRoleServiceHandler(s server.Server, hdlr RoleServiceHandler, opts .
 		ListRoleAssignmentsFiltered(ctx context.Context, in *ListRoleAssignmentsFilteredRequest, out *ListRoleAssignmentsResponse) error
 		AssignRoleToUser(ctx context.Context, in *AssignRoleToUserRequest, out *AssignRoleToUserResponse) error
 		RemoveRoleFromUser(ctx context.Context, in *RemoveRoleFromUserRequest, out *emptypb.Empty) error
+		SaveRole(ctx context.Context, in *SaveRoleRequest, out *SaveRoleResponse) error
+		DeleteRole(ctx context.Context, in *DeleteRoleRequest, out *emptypb.Empty) error
 	}
 	type RoleService struct {
 		roleService
@@ -539,6 +577,18 @@ func RegisterRoleServiceHandler(s server.Server, hdlr RoleServiceHandler, opts .
 		Method:  []string{"POST"},
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "RoleService.SaveRole",
+		Path:    []string{"/api/v0/settings/roles-save"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "RoleService.DeleteRole",
+		Path:    []string{"/api/v0/settings/roles-delete"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
 	return s.Handle(s.NewHandler(&RoleService{h}, opts...))
 }
 
@@ -566,6 +616,14 @@ func (h *roleServiceHandler) RemoveRoleFromUser(ctx context.Context, in *RemoveR
 	return h.RoleServiceHandler.RemoveRoleFromUser(ctx, in, out)
 }
 
+func (h *roleServiceHandler) SaveRole(ctx context.Context, in *SaveRoleRequest, out *SaveRoleResponse) error {
+	return h.RoleServiceHandler.SaveRole(ctx, in, out)
+}
+
+func (h *roleServiceHandler) DeleteRole(ctx context.Context, in *DeleteRoleRequest, out *emptypb.Empty) error {
+	return h.RoleServiceHandler.DeleteRole(ctx, in, out)
+}
+
 // Api Endpoints for PermissionService service
 
 func NewPermissionServiceEndpoints() []*api.Endpoint {
@@ -588,6 +646,12 @@ func NewPermissionServiceEndpoints() []*api.Endpoint {
 			Method:  []string{"POST"},
 			Handler: "rpc",
 		},
+		{
+			Name:    "PermissionService.ListAvailablePermissions",
+			Path:    []string{"/api/v0/settings/permissions-list-available"},
+			Method:  []string{"POST"},
+			Handler: "rpc",
+		},
 	}
 }
 
@@ -597,6 +661,7 @@ type PermissionService interface {
 	ListPermissions(ctx context.Context, in *ListPermissionsRequest, opts ...client.CallOption) (*ListPermissionsResponse, error)
 	ListPermissionsByResource(ctx context.Context, in *ListPermissionsByResourceRequest, opts ...client.CallOption) (*ListPermissionsByResourceResponse, error)
 	GetPermissionByID(ctx context.Context, in *GetPermissionByIDRequest, opts ...client.CallOption) (*GetPermissionByIDResponse, error)
+	ListAvailablePermissions(ctx context.Context, in *ListAvailablePermissionsRequest, opts ...client.CallOption) (*ListAvailablePermissionsResponse, error)
 }
 
 type permissionService struct {
@@ -641,12 +706,23 @@ func (c *permissionService) GetPermissionByID(ctx context.Context, in *GetPermis
 	return out, nil
 }
 
+func (c *permissionService) ListAvailablePermissions(ctx context.Context, in *ListAvailablePermissionsRequest, opts ...client.CallOption) (*ListAvailablePermissionsResponse, error) {
+	req := c.c.NewRequest(c.name, "PermissionService.ListAvailablePermissions", in)
+	out := new(ListAvailablePermissionsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for PermissionService service
 
 type PermissionServiceHandler interface {
 	ListPermissions(context.Context, *ListPermissionsRequest, *ListPermissionsResponse) error
 	ListPermissionsByResource(context.Context, *ListPermissionsByResourceRequest, *ListPermissionsByResourceResponse) error
 	GetPermissionByID(context.Context, *GetPermissionByIDRequest, *GetPermissionByIDResponse) error
+	ListAvailablePermissions(context.Context, *ListAvailablePermissionsRequest, *ListAvailablePermissionsResponse) error
 }
 
 func RegisterPermissionServiceHandler(s server.Server, hdlr PermissionServiceHandler, opts ...server.HandlerOption) error {
@@ -654,6 +730,7 @@ func RegisterPermissionServiceHandler(s server.Server, hdlr PermissionServiceHan
 		ListPermissions(ctx context.Context, in *ListPermissionsRequest, out *ListPermissionsResponse) error
 		ListPermissionsByResource(ctx context.Context, in *ListPermissionsByResourceRequest, out *ListPermissionsByResourceResponse) error
 		GetPermissionByID(ctx context.Context, in *GetPermissionByIDRequest, out *GetPermissionByIDResponse) error
+		ListAvailablePermissions(ctx context.Context, in *ListAvailablePermissionsRequest, out *ListAvailablePermissionsResponse) error
 	}
 	type PermissionService struct {
 		permissionService
@@ -677,6 +754,12 @@ func RegisterPermissionServiceHandler(s server.Server, hdlr PermissionServiceHan
 		Method:  []string{"POST"},
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "PermissionService.ListAvailablePermissions",
+		Path:    []string{"/api/v0/settings/permissions-list-available"},
+		Method:  []string{"POST"},
+		Handler: "rpc",
+	}))
 	return s.Handle(s.NewHandler(&PermissionService{h}, opts...))
 }
 
@@ -695,3 +778,7 @@ func (h *permissionServiceHandler) ListPermissionsByResource(ctx context.Context
 func (h *permissionServiceHandler) GetPermissionByID(ctx context.Context, in *GetPermissionByIDRequest, out *GetPermissionByIDResponse) error {
 	return h.PermissionServiceHandler.GetPermissionByID(ctx, in, out)
 }
+
+func (h *permissionServiceHandler) ListAvailablePermissions(ctx context.Context, in *ListAvailablePermissionsRequest, out *ListAvailablePermissionsResponse) error {
+	return h.PermissionServiceHandler.ListAvailablePermissions(ctx, in, out)
+}
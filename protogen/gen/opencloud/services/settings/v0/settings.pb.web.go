@@ -432,6 +432,58 @@ func (h *webRoleServiceHandler) RemoveRoleFromUser(w http.ResponseWriter, r *htt
 	render.NoContent(w, r)
 }
 
+func (h *webRoleServiceHandler) SaveRole(w http.ResponseWriter, r *http.Request) {
+	req := &SaveRoleRequest{}
+	resp := &SaveRoleResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.SaveRole(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webRoleServiceHandler) DeleteRole(w http.ResponseWriter, r *http.Request) {
+	req := &DeleteRoleRequest{}
+	resp := &ptypesempty.Empty{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.DeleteRole(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusNoContent)
+	render.NoContent(w, r)
+}
+
 func RegisterRoleServiceWeb(r chi.Router, i RoleServiceHandler, middlewares ...func(http.Handler) http.Handler) {
 	handler := &webRoleServiceHandler{
 		r: r,
@@ -443,6 +495,8 @@ func RegisterRoleServiceWeb(r chi.Router, i RoleServiceHandler, middlewares ...f
 	r.MethodFunc("POST", "/api/v0/settings/assignments-list-filtered", handler.ListRoleAssignmentsFiltered)
 	r.MethodFunc("POST", "/api/v0/settings/assignments-add", handler.AssignRoleToUser)
 	r.MethodFunc("POST", "/api/v0/settings/assignments-remove", handler.RemoveRoleFromUser)
+	r.MethodFunc("POST", "/api/v0/settings/roles-save", handler.SaveRole)
+	r.MethodFunc("POST", "/api/v0/settings/roles-delete", handler.DeleteRole)
 }
 
 type webPermissionServiceHandler struct {
@@ -532,6 +586,32 @@ func (h *webPermissionServiceHandler) GetPermissionByID(w http.ResponseWriter, r
 	render.JSON(w, r, resp)
 }
 
+func (h *webPermissionServiceHandler) ListAvailablePermissions(w http.ResponseWriter, r *http.Request) {
+	req := &ListAvailablePermissionsRequest{}
+	resp := &ListAvailablePermissionsResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.ListAvailablePermissions(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		if merr, ok := merrors.As(err); ok && merr.Code == http.StatusNotFound {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
 func RegisterPermissionServiceWeb(r chi.Router, i PermissionServiceHandler, middlewares ...func(http.Handler) http.Handler) {
 	handler := &webPermissionServiceHandler{
 		r: r,
@@ -541,6 +621,7 @@ func RegisterPermissionServiceWeb(r chi.Router, i PermissionServiceHandler, midd
 	r.MethodFunc("POST", "/api/v0/settings/permissions-list", handler.ListPermissions)
 	r.MethodFunc("POST", "/api/v0/settings/permissions-list-by-resource", handler.ListPermissionsByResource)
 	r.MethodFunc("POST", "/api/v0/settings/permissions-get-by-id", handler.GetPermissionByID)
+	r.MethodFunc("POST", "/api/v0/settings/permissions-list-available", handler.ListAvailablePermissions)
 }
 
 // SaveBundleRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
@@ -1335,6 +1416,114 @@ func (m *RemoveRoleFromUserRequest) UnmarshalJSON(b []byte) error {
 
 var _ json.Unmarshaler = (*RemoveRoleFromUserRequest)(nil)
 
+// SaveRoleRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of SaveRoleRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SaveRoleRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *SaveRoleRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := SaveRoleRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*SaveRoleRequest)(nil)
+
+// SaveRoleRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of SaveRoleRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SaveRoleRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *SaveRoleRequest) UnmarshalJSON(b []byte) error {
+	return SaveRoleRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*SaveRoleRequest)(nil)
+
+// SaveRoleResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of SaveRoleResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SaveRoleResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *SaveRoleResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := SaveRoleResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*SaveRoleResponse)(nil)
+
+// SaveRoleResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of SaveRoleResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SaveRoleResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *SaveRoleResponse) UnmarshalJSON(b []byte) error {
+	return SaveRoleResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*SaveRoleResponse)(nil)
+
+// DeleteRoleRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of DeleteRoleRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var DeleteRoleRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *DeleteRoleRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := DeleteRoleRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*DeleteRoleRequest)(nil)
+
+// DeleteRoleRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of DeleteRoleRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var DeleteRoleRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *DeleteRoleRequest) UnmarshalJSON(b []byte) error {
+	return DeleteRoleRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*DeleteRoleRequest)(nil)
+
 // ListPermissionsRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
 // instances of ListPermissionsRequest. This struct is safe to replace or modify but
 // should not be done so concurrently.
@@ -1550,3 +1739,75 @@ func (m *GetPermissionByIDResponse) UnmarshalJSON(b []byte) error {
 }
 
 var _ json.Unmarshaler = (*GetPermissionByIDResponse)(nil)
+
+// ListAvailablePermissionsRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListAvailablePermissionsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListAvailablePermissionsRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListAvailablePermissionsRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListAvailablePermissionsRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListAvailablePermissionsRequest)(nil)
+
+// ListAvailablePermissionsRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListAvailablePermissionsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListAvailablePermissionsRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListAvailablePermissionsRequest) UnmarshalJSON(b []byte) error {
+	return ListAvailablePermissionsRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListAvailablePermissionsRequest)(nil)
+
+// ListAvailablePermissionsResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListAvailablePermissionsResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListAvailablePermissionsResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListAvailablePermissionsResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListAvailablePermissionsResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListAvailablePermissionsResponse)(nil)
+
+// ListAvailablePermissionsResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListAvailablePermissionsResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListAvailablePermissionsResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListAvailablePermissionsResponse) UnmarshalJSON(b []byte) error {
+	return ListAvailablePermissionsResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListAvailablePermissionsResponse)(nil)
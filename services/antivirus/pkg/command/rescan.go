@@ -0,0 +1,95 @@
+package command
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/opencloud-eu/reva/v2/pkg/events/stream"
+	"github.com/urfave/cli/v2"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/opencloud-eu/opencloud/pkg/config/configlog"
+	"github.com/opencloud-eu/opencloud/pkg/generators"
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/antivirus/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/antivirus/pkg/config/parser"
+	"github.com/opencloud-eu/opencloud/services/antivirus/pkg/service"
+)
+
+// Rescan is the entrypoint for the rescan command.
+func Rescan(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:  "rescan",
+		Usage: "rescan existing files with the current virus signatures",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "since",
+				Usage: "only rescan files modified since this date, e.g. '2024-12-24' or '2024-12-24T10:00:00Z'. Rescans all files if not set.",
+			},
+			&cli.StringFlag{
+				Name:  "space",
+				Usage: "only rescan the space with this id",
+			},
+			&cli.BoolFlag{
+				Name:  "dry-run",
+				Usage: "only report infected files, do not apply the infected file handling",
+			},
+		},
+		Before: func(c *cli.Context) error {
+			if err := configlog.ReturnFatal(parser.ParseConfig(cfg)); err != nil {
+				return err
+			}
+			return configlog.ReturnFatal(parser.ValidateServiceAccount(cfg))
+		},
+		Action: func(c *cli.Context) error {
+			opts := service.RescanOptions{
+				SpaceID: c.String("space"),
+				DryRun:  c.Bool("dry-run"),
+			}
+			if since := c.String("since"); since != "" {
+				t, err := parseDate(since)
+				if err != nil {
+					return cli.Exit(err.Error(), 1)
+				}
+				opts.ModifiedSince = t
+			}
+
+			logger := log.NewLogger(
+				log.Name(cfg.Service.Name),
+				log.Level(cfg.Log.Level),
+				log.Pretty(cfg.Log.Pretty),
+				log.Color(cfg.Log.Color),
+				log.File(cfg.Log.File),
+			)
+
+			av, err := service.NewAntivirus(cfg, logger, noop.NewTracerProvider())
+			if err != nil {
+				return cli.Exit(err.Error(), 1)
+			}
+
+			connName := generators.GenerateConnectionName(cfg.Service.Name, generators.NTypeBus)
+			natsStream, err := stream.NatsFromConfig(connName, false, stream.NatsConfig(cfg.Events))
+			if err != nil {
+				return err
+			}
+
+			summary, err := av.Rescan(c.Context, natsStream, opts)
+			if err != nil {
+				return err
+			}
+
+			// the stream publishes synchronously, all events have been sent at this point
+			fmt.Printf("scanned: %d, infected: %d, failed: %d\n", summary.Scanned, summary.Infected, summary.Failed)
+			return nil
+		},
+	}
+}
+
+func parseDate(s string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date '%s', use the format YYYY-MM-DD or RFC3339", s)
+}
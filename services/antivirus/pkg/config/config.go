@@ -37,6 +37,10 @@ type Config struct {
 
 	Service Service `yaml:"-"`
 
+	GRPCClientTLS  *shared.GRPCClientTLS `yaml:"grpc_client_tls"`
+	RevaGateway    string                `yaml:"reva_gateway" env:"OC_REVA_GATEWAY" desc:"CS3 gateway used to walk the spaces when rescanning files." introductionVersion:"%%NEXT%%"`
+	ServiceAccount ServiceAccount        `yaml:"service_account"`
+
 	InfectedFileHandling string `yaml:"infected-file-handling" env:"ANTIVIRUS_INFECTED_FILE_HANDLING" desc:"Defines the behaviour when a virus has been found. Supported options are: 'delete', 'continue' and 'abort '. Delete will delete the file. Continue will mark the file as infected but continues further processing. Abort will keep the file in the uploads folder for further admin inspection and will not move it to its final destination." introductionVersion:"1.0.0"`
 	Events               Events
 	Workers              int `yaml:"workers" env:"ANTIVIRUS_WORKERS" desc:"The number of concurrent go routines that fetch events from the event queue." introductionVersion:"1.0.0"`
@@ -45,6 +49,9 @@ type Config struct {
 	MaxScanSize     string          `yaml:"max-scan-size" env:"ANTIVIRUS_MAX_SCAN_SIZE" desc:"The maximum scan size the virus scanner can handle.0 means unlimited. Usable common abbreviations: [KB, KiB, MB, MiB, GB, GiB, TB, TiB, PB, PiB, EB, EiB], example: 2GB." introductionVersion:"1.0.0"`
 	MaxScanSizeMode MaxScanSizeMode `yaml:"max-scan-size-mode" env:"ANTIVIRUS_MAX_SCAN_SIZE_MODE" desc:"Defines the mode of handling files that exceed the maximum scan size. Supported options are: 'skip', which skips files that are bigger than the max scan size, and 'truncate' (default), which only uses the file up to the max size." introductionVersion:"2.1.0"`
 
+	ScanCache ScanCache `yaml:"scan_cache"`
+	Rescan    Rescan    `yaml:"rescan"`
+
 	Context context.Context `json:"-" yaml:"-"`
 
 	DebugScanOutcome string `yaml:"-" env:"ANTIVIRUS_DEBUG_SCAN_OUTCOME" desc:"A predefined outcome for virus scanning, FOR DEBUG PURPOSES ONLY! (example values: 'found,infected')" introductionVersion:"1.0.0"`
@@ -101,4 +108,30 @@ type ICAP struct {
 	Timeout time.Duration `yaml:"scan_timeout" env:"ANTIVIRUS_ICAP_SCAN_TIMEOUT" desc:"Scan timeout for the ICAP client. Defaults to '5m' (5 minutes). See the Environment Variable Types description for more details." introductionVersion:"1.0.0"`
 	URL     string        `yaml:"url" env:"ANTIVIRUS_ICAP_URL" desc:"URL of the ICAP server." introductionVersion:"1.0.0"`
 	Service string        `yaml:"service" env:"ANTIVIRUS_ICAP_SERVICE" desc:"The name of the ICAP service." introductionVersion:"1.0.0"`
+
+	RequestHeaders []string `yaml:"request_headers" env:"ANTIVIRUS_ICAP_REQUEST_HEADERS" desc:"A list of additional headers sent with each scan request in the format 'Name: value', e.g. 'X-Authenticated-User: {user}'. The placeholders '{user}' and '{filename}' are replaced with the username of the uploader and the name of the file. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+}
+
+// ScanCache configures the cache for scan results
+type ScanCache struct {
+	Enabled      bool          `yaml:"enabled" env:"ANTIVIRUS_SCAN_CACHE_ENABLED" desc:"Cache scan results by the SHA-1 checksum of the scanned content. Files with a known checksum are not downloaded and scanned again as long as the signature version of the scanner did not change." introductionVersion:"%%NEXT%%"`
+	Store        string        `yaml:"store" env:"OC_CACHE_STORE;ANTIVIRUS_SCAN_CACHE_STORE" desc:"The type of the cache store. Supported values are: 'memory', 'redis-sentinel', 'nats-js-kv', 'noop'. See the text description for details." introductionVersion:"%%NEXT%%"`
+	Nodes        []string      `yaml:"nodes" env:"OC_CACHE_STORE_NODES;ANTIVIRUS_SCAN_CACHE_STORE_NODES" desc:"A list of nodes to access the configured store. This has no effect when 'memory' store is configured. Note that the behaviour how nodes are used is dependent on the library of the configured store. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	Database     string        `yaml:"database" env:"OC_CACHE_DATABASE" desc:"The database name the configured store should use." introductionVersion:"%%NEXT%%"`
+	Table        string        `yaml:"table" env:"ANTIVIRUS_SCAN_CACHE_TABLE" desc:"The database table the store should use." introductionVersion:"%%NEXT%%"`
+	TTL          time.Duration `yaml:"ttl" env:"OC_CACHE_TTL;ANTIVIRUS_SCAN_CACHE_TTL" desc:"Time to live for cached scan results. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	AuthUsername string        `yaml:"username" env:"OC_CACHE_AUTH_USERNAME;ANTIVIRUS_SCAN_CACHE_AUTH_USERNAME" desc:"The username to authenticate with the cache. Only applies when store type 'nats-js-kv' is configured." introductionVersion:"%%NEXT%%"`
+	AuthPassword string        `yaml:"password" env:"OC_CACHE_AUTH_PASSWORD;ANTIVIRUS_SCAN_CACHE_AUTH_PASSWORD" desc:"The password to authenticate with the cache. Only applies when store type 'nats-js-kv' is configured." introductionVersion:"%%NEXT%%"`
+}
+
+// Rescan configures the periodic rescan of files at rest
+type Rescan struct {
+	Interval time.Duration `yaml:"interval" env:"ANTIVIRUS_RESCAN_INTERVAL" desc:"The interval in which all spaces are rescanned with the current virus signatures. Set to '0' (default) to disable the periodic rescan. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	MaxAge   time.Duration `yaml:"max_age" env:"ANTIVIRUS_RESCAN_MAX_AGE" desc:"Only files modified within this duration are rescanned by the periodic rescan. Set to '0' to rescan all files. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+}
+
+// ServiceAccount is the configuration for the used service account
+type ServiceAccount struct {
+	ServiceAccountID     string `yaml:"service_account_id" env:"OC_SERVICE_ACCOUNT_ID;ANTIVIRUS_SERVICE_ACCOUNT_ID" desc:"The ID of the service account the service should use to rescan files. See the 'auth-service' service description for more details." introductionVersion:"%%NEXT%%"`
+	ServiceAccountSecret string `yaml:"service_account_secret" env:"OC_SERVICE_ACCOUNT_SECRET;ANTIVIRUS_SERVICE_ACCOUNT_SECRET" desc:"The service account secret." introductionVersion:"%%NEXT%%"`
 }
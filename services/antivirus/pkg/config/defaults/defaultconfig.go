@@ -3,6 +3,8 @@ package defaults
 import (
 	"time"
 
+	"github.com/opencloud-eu/opencloud/pkg/shared"
+	"github.com/opencloud-eu/opencloud/pkg/structs"
 	"github.com/opencloud-eu/opencloud/services/antivirus/pkg/config"
 )
 
@@ -24,6 +26,7 @@ func DefaultConfig() *config.Config {
 		Service: config.Service{
 			Name: "antivirus",
 		},
+		RevaGateway: shared.DefaultRevaConfig().Address,
 		Events: config.Events{
 			Endpoint: "127.0.0.1:9233",
 			Cluster:  "opencloud-cluster",
@@ -34,6 +37,15 @@ func DefaultConfig() *config.Config {
 		// https://github.com/Cisco-Talos/clamav/blob/main/etc/clamd.conf.sample
 		MaxScanSize:     "100MB",
 		MaxScanSizeMode: config.MaxScanSizeModePartial,
+		ScanCache: config.ScanCache{
+			Store:    "memory",
+			Nodes:    []string{"127.0.0.1:9233"},
+			Database: "cache-antivirus",
+			TTL:      7 * 24 * time.Hour,
+		},
+		Rescan: config.Rescan{
+			MaxAge: 7 * 24 * time.Hour,
+		},
 		Scanner: config.Scanner{
 			Type: config.ScannerTypeClamAV,
 			ClamAV: config.ClamAV{
@@ -54,6 +66,12 @@ func EnsureDefaults(cfg *config.Config) {
 	if cfg.Log == nil {
 		cfg.Log = &config.Log{}
 	}
+
+	if cfg.GRPCClientTLS == nil && cfg.Commons != nil {
+		cfg.GRPCClientTLS = structs.CopyOrZeroValue(cfg.Commons.GRPCClientTLS)
+	} else if cfg.GRPCClientTLS == nil {
+		cfg.GRPCClientTLS = &shared.GRPCClientTLS{}
+	}
 }
 
 // Sanitize sanitizes the configuration
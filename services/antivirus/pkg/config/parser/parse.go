@@ -8,6 +8,7 @@ import (
 	"github.com/opencloud-eu/opencloud/services/antivirus/pkg/config/defaults"
 
 	"github.com/opencloud-eu/opencloud/pkg/config/envdecode"
+	"github.com/opencloud-eu/opencloud/pkg/shared"
 )
 
 // ParseConfig loads configuration from known paths.
@@ -34,5 +35,19 @@ func ParseConfig(cfg *config.Config) error {
 
 // Validate validates our little config
 func Validate(cfg *config.Config) error {
+	if cfg.Rescan.Interval > 0 {
+		return ValidateServiceAccount(cfg)
+	}
+	return nil
+}
+
+// ValidateServiceAccount makes sure the service account needed to rescan files is configured
+func ValidateServiceAccount(cfg *config.Config) error {
+	if cfg.ServiceAccount.ServiceAccountID == "" {
+		return shared.MissingServiceAccountID(cfg.Service.Name)
+	}
+	if cfg.ServiceAccount.ServiceAccountSecret == "" {
+		return shared.MissingServiceAccountSecret(cfg.Service.Name)
+	}
 	return nil
 }
@@ -0,0 +1,48 @@
+package event
+
+import (
+	"encoding/json"
+	"time"
+
+	user "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
+)
+
+// InfectedFileFound is emitted when a rescan finds an infection in a file at rest
+type InfectedFileFound struct {
+	ResourceID  *provider.ResourceId
+	Owner       *user.UserId
+	Path        string
+	Filename    string
+	Description string
+	// Outcome is the infected file handling applied to the file
+	Outcome   events.PostprocessingOutcome
+	Scandate  time.Time
+	Timestamp time.Time
+}
+
+// Unmarshal to fulfill umarshaller interface
+func (InfectedFileFound) Unmarshal(v []byte) (interface{}, error) {
+	e := InfectedFileFound{}
+	err := json.Unmarshal(v, &e)
+	return e, err
+}
+
+// RescanFinished is emitted when a rescan of files at rest is done
+type RescanFinished struct {
+	// ModifiedSince is the modification time files had to have at least to be rescanned, zero for all files
+	ModifiedSince time.Time
+	Started       time.Time
+	Scanned       int
+	Infected      int
+	Failed        int
+	Timestamp     time.Time
+}
+
+// Unmarshal to fulfill umarshaller interface
+func (RescanFinished) Unmarshal(v []byte) (interface{}, error) {
+	e := RescanFinished{}
+	err := json.Unmarshal(v, &e)
+	return e, err
+}
@@ -2,6 +2,7 @@ package scanners
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/dutchcoders/go-clamd"
@@ -49,3 +50,31 @@ func (s ClamAV) Scan(in Input) (Result, error) {
 		}, nil
 	}
 }
+
+// SignatureVersion returns the engine and signature database version reported by clamd, e.g. '1.0.5/27500'
+func (s ClamAV) SignatureVersion() (string, error) {
+	ch, err := s.clamd.Version()
+	if err != nil {
+		return "", err
+	}
+
+	select {
+	case <-time.After(s.timeout):
+		return "", fmt.Errorf("%w: version", ErrScanTimeout)
+	case res := <-ch:
+		if res == nil {
+			return "", fmt.Errorf("empty version response")
+		}
+		return parseClamAVVersion(res.Raw), nil
+	}
+}
+
+// parseClamAVVersion strips the product name and the database date from a clamd version
+// string like 'ClamAV 1.0.5/27500/Sat Dec 21 09:24:59 2024'
+func parseClamAVVersion(raw string) string {
+	v := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(raw), "ClamAV"))
+	if parts := strings.Split(v, "/"); len(parts) > 2 {
+		v = strings.Join(parts[:2], "/")
+	}
+	return v
+}
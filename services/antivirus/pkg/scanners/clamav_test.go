@@ -118,3 +118,17 @@ func TestNewClamAV_Scan(t *testing.T) {
 		assert.True(t, <-done)
 	})
 }
+
+func TestClamAV_SignatureVersion(t *testing.T) {
+	ul := newUnixListener(t, net.ListenConfig{}, "PONG\n", "ClamAV 1.0.5/27500/Sat Dec 21 09:24:59 2024\n")
+	defer func() {
+		assert.NoError(t, ul.Close())
+	}()
+
+	scanner, err := scanners.NewClamAV(ul.Addr().String(), 10*time.Second)
+	require.NoError(t, err)
+
+	version, err := scanner.SignatureVersion()
+	assert.NoError(t, err)
+	assert.Equal(t, "1.0.5/27500", version)
+}
@@ -7,6 +7,9 @@ import (
 	"net/url"
 	"path"
 	"regexp"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/opencloud-eu/reva/v2/pkg/mime"
@@ -19,8 +22,9 @@ type Scanner interface {
 	Do(req ic.Request) (ic.Response, error)
 }
 
-// NewICAP returns a Scanner talking to an ICAP server
-func NewICAP(icapURL string, icapService string, timeout time.Duration) (ICAP, error) {
+// NewICAP returns a Scanner talking to an ICAP server.
+// The request headers are given as 'Name: value', see ICAP.Headers for the supported placeholders.
+func NewICAP(icapURL string, icapService string, timeout time.Duration, requestHeaders []string) (ICAP, error) {
 	endpoint, err := url.Parse(icapURL)
 	if err != nil {
 		return ICAP{}, err
@@ -29,20 +33,102 @@ func NewICAP(icapURL string, icapService string, timeout time.Duration) (ICAP, e
 	endpoint.Scheme = "icap"
 	endpoint.Path = icapService
 
-	client, err := ic.NewClient(
-		ic.WithICAPConnectionTimeout(timeout),
-	)
-	if err != nil {
-		return ICAP{}, err
+	headers := make(map[string]string, len(requestHeaders))
+	for _, h := range requestHeaders {
+		name, value, ok := strings.Cut(h, ":")
+		if !ok || strings.TrimSpace(name) == "" {
+			return ICAP{}, fmt.Errorf("invalid icap request header '%s', use the format 'Name: value'", h)
+		}
+		headers[strings.TrimSpace(name)] = strings.TrimSpace(value)
 	}
 
-	return ICAP{Client: &client, URL: endpoint.String()}, nil
+	return ICAP{Client: ICAPClient{Timeout: timeout}, URL: endpoint.String(), Headers: headers, options: &icapOptions{}}, nil
+}
+
+// defaultOptionsTTL is used for OPTIONS responses without Options-TTL header
+const defaultOptionsTTL = time.Minute
+
+// icapOptions caches the OPTIONS response of the ICAP service, it is shared by all copies of a scanner
+type icapOptions struct {
+	mu      sync.Mutex
+	res     ic.Response
+	expires time.Time
 }
 
 // ICAP is responsible for scanning files using an ICAP server
 type ICAP struct {
 	Client Scanner
 	URL    string
+	// Headers are added to each REQMOD request. The placeholders '{user}' and '{filename}' in the values
+	// are replaced with the username of the uploader and the name of the scanned file.
+	// Headers with an empty value after replacing the placeholders are not sent.
+	Headers map[string]string
+
+	// options caches the OPTIONS response, it is nil if every scan asks for the options
+	options *icapOptions
+}
+
+// getOptions returns the OPTIONS response of the ICAP service. The response is cached for
+// the Options-TTL the service sent, see RFC 3507 section 4.10.2.
+func (s ICAP) getOptions(ctx context.Context) (ic.Response, error) {
+	if s.options != nil {
+		s.options.mu.Lock()
+		defer s.options.mu.Unlock()
+		if time.Now().Before(s.options.expires) {
+			return s.options.res, nil
+		}
+	}
+
+	req, err := ic.NewRequest(ctx, ic.MethodOPTIONS, s.URL, nil, nil)
+	if err != nil {
+		return ic.Response{}, err
+	}
+
+	res, err := s.Client.Do(req)
+	if err != nil {
+		return ic.Response{}, err
+	}
+
+	if s.options != nil {
+		ttl := defaultOptionsTTL
+		if seconds, err := strconv.Atoi(res.Header.Get("Options-TTL")); err == nil && seconds >= 0 {
+			ttl = time.Duration(seconds) * time.Second
+		}
+		s.options.res = res
+		s.options.expires = time.Now().Add(ttl)
+	}
+	return res, nil
+}
+
+// checkISTag drops the cached options if a response carries another ISTag,
+// the service or its signatures changed in the meantime.
+func (s ICAP) checkISTag(options, res ic.Response) {
+	tag := istag(res.Header)
+	if s.options == nil || tag == "" || tag == istag(options.Header) {
+		return
+	}
+	s.options.mu.Lock()
+	s.options.expires = time.Time{}
+	s.options.mu.Unlock()
+}
+
+func istag(header http.Header) string {
+	return strings.Trim(header.Get("ISTag"), `"`)
+}
+
+// SignatureVersion returns the ISTag of the ICAP service. The ISTag changes whenever the service
+// or its signature database changes, see RFC 3507 section 4.7.
+func (s ICAP) SignatureVersion() (string, error) {
+	res, err := s.getOptions(context.TODO())
+	if err != nil {
+		return "", err
+	}
+
+	tag := istag(res.Header)
+	if tag == "" {
+		return "", fmt.Errorf("icap service did not send an ISTag")
+	}
+	return tag, nil
 }
 
 // Scan scans a file using the ICAP server
@@ -50,12 +136,7 @@ func (s ICAP) Scan(in Input) (Result, error) {
 	ctx := context.TODO()
 	result := Result{}
 
-	optReq, err := ic.NewRequest(ctx, ic.MethodOPTIONS, s.URL, nil, nil)
-	if err != nil {
-		return result, err
-	}
-
-	optRes, err := s.Client.Do(optReq)
+	optRes, err := s.getOptions(ctx)
 	if err != nil {
 		return result, err
 	}
@@ -75,10 +156,17 @@ func (s ICAP) Scan(in Input) (Result, error) {
 		return result, err
 	}
 
-	if optRes.PreviewBytes > 0 {
-		err = req.SetPreview(optRes.PreviewBytes)
-		if err != nil {
-			return result, err
+	// the client streams the preview and the rest of the body,
+	// there is no need to read the body upfront
+	req.PreviewBytes = optRes.PreviewBytes
+	if allows204(optRes.Header) {
+		req.Header.Set("Allow", "204")
+	}
+
+	replacer := strings.NewReplacer("{user}", in.User, "{filename}", in.Name)
+	for name, value := range s.Headers {
+		if v := replacer.Replace(value); v != "" {
+			req.Header.Set(name, v)
 		}
 	}
 
@@ -87,19 +175,15 @@ func (s ICAP) Scan(in Input) (Result, error) {
 		return result, err
 	}
 	result.ScanTime = time.Now()
+	s.checkISTag(optRes, res)
 
-	// TODO: make header configurable
-	if data, infected := res.Header["X-Infection-Found"]; infected {
-		result.Infected = infected
-
-		match := regexp.MustCompile(`Threat=(.*);`).FindStringSubmatch(fmt.Sprint(data))
-
-		if len(match) > 1 {
-			result.Description = match[1]
-		}
+	if description, infected := infection(res.Header); infected {
+		result.Infected = true
+		result.Description = description
+		return result, nil
 	}
 
-	if result.Infected || res.ContentResponse == nil {
+	if res.ContentResponse == nil {
 		return result, nil
 	}
 
@@ -110,3 +194,71 @@ func (s ICAP) Scan(in Input) (Result, error) {
 
 	return result, nil
 }
+
+// infectionHeaders are the ICAP response headers used by the different vendors to report an infection,
+// each with a parser extracting the threat description.
+var infectionHeaders = []struct {
+	name  string
+	parse func(value string) string
+}{
+	// c-icap, clamav, sophos, eset, kaspersky and symantec: 'Type=0; Resolution=2; Threat=Eicar-Test-Signature;'
+	{name: "X-Infection-Found", parse: parseInfectionFound},
+	// mcafee, sophos and symantec: the number of violations followed by four lines per violation,
+	// the filename, the threat, the threat id and the disposition
+	{name: "X-Violations-Found", parse: parseViolationsFound},
+	// kaspersky and trend micro
+	{name: "X-Virus-Id", parse: strings.TrimSpace},
+	// mcafee web gateway
+	{name: "X-Virus-Name", parse: strings.TrimSpace},
+}
+
+var threatRegexp = regexp.MustCompile(`Threat=([^;]*)`)
+
+// infection checks the response headers for a reported infection and returns its description
+func infection(header http.Header) (string, bool) {
+	for _, h := range infectionHeaders {
+		values := header.Values(h.name)
+		if len(values) == 0 {
+			continue
+		}
+
+		descriptions := make([]string, 0, len(values))
+		for _, v := range values {
+			if d := h.parse(v); d != "" {
+				descriptions = append(descriptions, d)
+			}
+		}
+		if h.name == "X-Violations-Found" && len(descriptions) == 0 {
+			// zero violations found
+			continue
+		}
+		return strings.Join(descriptions, ", "), true
+	}
+	return "", false
+}
+
+func parseInfectionFound(value string) string {
+	if match := threatRegexp.FindStringSubmatch(value); len(match) > 1 {
+		return strings.TrimSpace(match[1])
+	}
+	return strings.TrimSpace(value)
+}
+
+func parseViolationsFound(value string) string {
+	lines := strings.FieldsFunc(value, func(r rune) bool { return r == '\n' || r == '\r' })
+	if len(lines) == 0 {
+		return ""
+	}
+
+	count, err := strconv.Atoi(strings.TrimSpace(lines[0]))
+	if err != nil {
+		// not the multi line format, use the whole value
+		return strings.TrimSpace(value)
+	}
+
+	threats := make([]string, 0, count)
+	for i := 0; i < count && 2+i*4 < len(lines); i++ {
+		threats = append(threats, strings.TrimSpace(lines[2+i*4]))
+	}
+	return strings.Join(threats, ", ")
+}
@@ -0,0 +1,382 @@
+package scanners
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/textproto"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	ic "github.com/opencloud-eu/icap-client"
+)
+
+const (
+	icapVersion     = "ICAP/1.0"
+	icapDefaultPort = "1344"
+	icapChunkSize   = 64 * 1024
+)
+
+// ICAPClient is an RFC 3507 client which streams the encapsulated body to the ICAP server
+// in chunks, large files are never held in memory.
+// It sends the preview announced by the request first and only streams the remaining body
+// if the server asks for it with a '100 Continue'.
+type ICAPClient struct {
+	// Timeout is the time to wait for the server when connecting, sending a chunk or waiting for the response
+	Timeout time.Duration
+}
+
+// Do sends the OPTIONS or REQMOD request to the ICAP server and returns its response.
+func (c ICAPClient) Do(req ic.Request) (ic.Response, error) {
+	if req.Method != ic.MethodOPTIONS && req.Method != ic.MethodREQMOD {
+		return ic.Response{}, fmt.Errorf("unsupported icap method '%s'", req.Method)
+	}
+
+	host := req.URL.Host
+	if req.URL.Port() == "" {
+		host = net.JoinHostPort(req.URL.Hostname(), icapDefaultPort)
+	}
+
+	conn, err := net.DialTimeout("tcp", host, c.Timeout)
+	if err != nil {
+		return ic.Response{}, fmt.Errorf("%w: %w", ErrScannerNotReachable, err)
+	}
+	defer conn.Close()
+
+	w := bufio.NewWriterSize(conn, icapChunkSize+32)
+	r := bufio.NewReader(conn)
+
+	var httpHeader []byte
+	var body io.Reader
+	if req.Method == ic.MethodREQMOD && req.HTTPRequest != nil {
+		httpHeader = encapsulatedRequestHeader(req.HTTPRequest)
+		body = req.HTTPRequest.Body
+	}
+
+	if err := c.writeHeader(conn, w, req, httpHeader, body != nil); err != nil {
+		return ic.Response{}, err
+	}
+
+	if body == nil {
+		if err := c.flush(conn, w); err != nil {
+			return ic.Response{}, err
+		}
+		return c.readResponse(conn, r)
+	}
+
+	var pending []byte
+	if req.PreviewBytes > 0 {
+		// read one byte more than the preview to know whether the body fits into the preview
+		buf := make([]byte, req.PreviewBytes+1)
+		n, err := io.ReadFull(body, buf)
+		switch {
+		case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+			// the complete body fits into the preview, tell the server that there is nothing more to come
+			if err := c.writeChunk(conn, w, buf[:n]); err != nil {
+				return ic.Response{}, err
+			}
+			if _, err := w.WriteString("0; ieof\r\n\r\n"); err != nil {
+				return ic.Response{}, err
+			}
+			if err := c.flush(conn, w); err != nil {
+				return ic.Response{}, err
+			}
+			return c.readResponse(conn, r)
+		case err != nil:
+			return ic.Response{}, err
+		}
+
+		if err := c.writeChunk(conn, w, buf[:req.PreviewBytes]); err != nil {
+			return ic.Response{}, err
+		}
+		if _, err := w.WriteString("0\r\n\r\n"); err != nil {
+			return ic.Response{}, err
+		}
+		if err := c.flush(conn, w); err != nil {
+			return ic.Response{}, err
+		}
+
+		res, err := c.readResponse(conn, r)
+		if err != nil || res.StatusCode != http.StatusContinue {
+			// the server already knows the result
+			return res, err
+		}
+		pending = buf[req.PreviewBytes:]
+	}
+
+	return c.streamBody(conn, w, r, pending, body)
+}
+
+// writeHeader writes the ICAP request line, the ICAP headers and the encapsulated HTTP header
+func (c ICAPClient) writeHeader(conn net.Conn, w *bufio.Writer, req ic.Request, httpHeader []byte, hasBody bool) error {
+	header := req.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	header.Set("Host", req.URL.Host)
+	switch {
+	case httpHeader == nil:
+		header.Set("Encapsulated", "null-body=0")
+	case hasBody:
+		header.Set("Encapsulated", fmt.Sprintf("req-hdr=0, req-body=%d", len(httpHeader)))
+	default:
+		header.Set("Encapsulated", fmt.Sprintf("req-hdr=0, null-body=%d", len(httpHeader)))
+	}
+	if req.PreviewBytes > 0 && hasBody {
+		header.Set("Preview", strconv.Itoa(req.PreviewBytes))
+	} else {
+		header.Del("Preview")
+	}
+
+	if _, err := fmt.Fprintf(w, "%s %s %s\r\n", req.Method, req.URL.String(), icapVersion); err != nil {
+		return err
+	}
+	if err := header.Write(w); err != nil {
+		return err
+	}
+	if _, err := w.WriteString("\r\n"); err != nil {
+		return err
+	}
+	_, err := w.Write(httpHeader)
+	return err
+}
+
+// streamBody sends the remaining body in chunks. The server may answer before the whole body
+// was sent, e.g. when it detected an infection early, sending stops in that case.
+func (c ICAPClient) streamBody(conn net.Conn, w *bufio.Writer, r *bufio.Reader, pending []byte, body io.Reader) (ic.Response, error) {
+	type result struct {
+		res ic.Response
+		err error
+	}
+	done := make(chan result, 1)
+
+	// no read deadline while streaming, the write deadlines take care of a stuck server
+	if err := conn.SetReadDeadline(time.Time{}); err != nil {
+		return ic.Response{}, err
+	}
+	go func() {
+		res, err := readICAPResponse(r)
+		done <- result{res, err}
+	}()
+
+	var early *result
+	writeErr := func() error {
+		if err := c.writeChunk(conn, w, pending); err != nil {
+			return err
+		}
+
+		buf := make([]byte, icapChunkSize)
+		for {
+			select {
+			case res := <-done:
+				early = &res
+				return nil
+			default:
+			}
+
+			n, err := body.Read(buf)
+			if n > 0 {
+				if err := c.writeChunk(conn, w, buf[:n]); err != nil {
+					return err
+				}
+			}
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		if _, err := w.WriteString("0\r\n\r\n"); err != nil {
+			return err
+		}
+		return c.flush(conn, w)
+	}()
+
+	if early != nil {
+		return early.res, early.err
+	}
+
+	if writeErr != nil {
+		// an answer of the server wins over the error caused by the server not reading anymore
+		select {
+		case res := <-done:
+			if res.err == nil {
+				return res.res, nil
+			}
+			return ic.Response{}, writeErr
+		case <-time.After(c.Timeout):
+			return ic.Response{}, writeErr
+		}
+	}
+
+	if err := conn.SetReadDeadline(c.deadline()); err != nil {
+		return ic.Response{}, err
+	}
+	res := <-done
+	return res.res, res.err
+}
+
+func (c ICAPClient) writeChunk(conn net.Conn, w *bufio.Writer, p []byte) error {
+	if len(p) == 0 {
+		return nil
+	}
+	if err := conn.SetWriteDeadline(c.deadline()); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "%x\r\n", len(p)); err != nil {
+		return err
+	}
+	if _, err := w.Write(p); err != nil {
+		return err
+	}
+	_, err := w.WriteString("\r\n")
+	return err
+}
+
+func (c ICAPClient) flush(conn net.Conn, w *bufio.Writer) error {
+	if err := conn.SetWriteDeadline(c.deadline()); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+func (c ICAPClient) readResponse(conn net.Conn, r *bufio.Reader) (ic.Response, error) {
+	if err := conn.SetReadDeadline(c.deadline()); err != nil {
+		return ic.Response{}, err
+	}
+	return readICAPResponse(r)
+}
+
+func (c ICAPClient) deadline() time.Time {
+	if c.Timeout <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(c.Timeout)
+}
+
+// encapsulatedRequestHeader returns the header of the HTTP request sent to the ICAP server
+func encapsulatedRequestHeader(req *http.Request) []byte {
+	b := &bytes.Buffer{}
+	method := req.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	host := req.Host
+	if host == "" && req.URL != nil {
+		host = req.URL.Host
+	}
+	uri := "/"
+	if req.URL != nil {
+		uri = req.URL.RequestURI()
+	}
+
+	fmt.Fprintf(b, "%s %s HTTP/1.1\r\n", method, uri)
+	if host != "" {
+		fmt.Fprintf(b, "Host: %s\r\n", host)
+	}
+	header := req.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	if req.ContentLength > 0 {
+		header.Set("Content-Length", strconv.FormatInt(req.ContentLength, 10))
+	}
+	_ = header.Write(b)
+	b.WriteString("\r\n")
+	return b.Bytes()
+}
+
+// readICAPResponse reads the status line, the headers and the encapsulated HTTP headers of an ICAP response.
+// The encapsulated body is not read.
+func readICAPResponse(r *bufio.Reader) (ic.Response, error) {
+	tp := textproto.NewReader(r)
+	line, err := tp.ReadLine()
+	if err != nil {
+		return ic.Response{}, err
+	}
+
+	proto, status, _ := strings.Cut(line, " ")
+	code, text, _ := strings.Cut(status, " ")
+	statusCode, err := strconv.Atoi(code)
+	if proto != icapVersion || err != nil {
+		return ic.Response{}, fmt.Errorf("malformed icap status line '%s'", line)
+	}
+
+	header, err := readICAPHeader(tp)
+	if err != nil {
+		return ic.Response{}, err
+	}
+
+	res := ic.Response{StatusCode: statusCode, Status: strings.TrimSpace(text), Header: header}
+	if preview := header.Get("Preview"); preview != "" {
+		res.PreviewBytes, _ = strconv.Atoi(preview)
+	}
+
+	if statusCode != http.StatusOK {
+		return res, nil
+	}
+
+	for _, section := range strings.Split(header.Get("Encapsulated"), ",") {
+		name, _, _ := strings.Cut(strings.TrimSpace(section), "=")
+		switch name {
+		case "req-hdr":
+			if res.ContentRequest, err = http.ReadRequest(r); err != nil {
+				return ic.Response{}, err
+			}
+		case "res-hdr":
+			if res.ContentResponse, err = http.ReadResponse(r, res.ContentRequest); err != nil {
+				return ic.Response{}, err
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// readICAPHeader reads a header block. Other than textproto, continuation lines are joined
+// with a newline, some scanners report one detail per line.
+func readICAPHeader(tp *textproto.Reader) (http.Header, error) {
+	header := http.Header{}
+	key := ""
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return nil, err
+		}
+		if line == "" {
+			return header, nil
+		}
+
+		if (line[0] == ' ' || line[0] == '\t') && key != "" {
+			values := header[key]
+			values[len(values)-1] += "\n" + strings.TrimSpace(line)
+			continue
+		}
+
+		k, v, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, fmt.Errorf("malformed icap header line '%s'", line)
+		}
+		key = textproto.CanonicalMIMEHeaderKey(strings.TrimSpace(k))
+		header[key] = append(header[key], strings.TrimSpace(v))
+	}
+}
+
+// allows204 checks if the OPTIONS response allows to answer with '204 No Content' outside a preview
+func allows204(header http.Header) bool {
+	for _, v := range header.Values("Allow") {
+		if slices.Contains(strings.Split(strings.ReplaceAll(v, " ", ""), ","), "204") {
+			return true
+		}
+	}
+	return false
+}
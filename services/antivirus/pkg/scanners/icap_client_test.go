@@ -0,0 +1,193 @@
+package scanners_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ic "github.com/opencloud-eu/icap-client"
+
+	"github.com/opencloud-eu/opencloud/services/antivirus/pkg/scanners"
+)
+
+// icapRequest is a request received by the fake icap server
+type icapRequest struct {
+	header     textproto.MIMEHeader
+	httpHeader string
+	preview    []byte
+	ieof       bool
+	body       []byte
+}
+
+// serveICAP starts a fake icap server handling a single connection. If continueAfterPreview is set,
+// the server asks for the rest of the body after the preview. The response is sent in the end.
+func serveICAP(t *testing.T, continueAfterPreview bool, response string) (string, <-chan icapRequest) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = l.Close() })
+
+	received := make(chan icapRequest, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		r := bufio.NewReader(conn)
+		tp := textproto.NewReader(r)
+		if _, err := tp.ReadLine(); err != nil {
+			return
+		}
+		header, err := tp.ReadMIMEHeader()
+		if err != nil {
+			return
+		}
+		req := icapRequest{header: header}
+
+		var offset int
+		if _, err := fmt.Sscanf(header.Get("Encapsulated"), "req-hdr=0, req-body=%d", &offset); err != nil {
+			_, _ = io.WriteString(conn, response)
+			received <- req
+			return
+		}
+		httpHeader := make([]byte, offset)
+		if _, err := io.ReadFull(r, httpHeader); err != nil {
+			return
+		}
+		req.httpHeader = string(httpHeader)
+
+		if header.Get("Preview") != "" {
+			req.preview, req.ieof = readChunks(r)
+			if !req.ieof && continueAfterPreview {
+				_, _ = io.WriteString(conn, "ICAP/1.0 100 Continue\r\n\r\n")
+				req.body, _ = readChunks(r)
+			}
+		} else {
+			req.body, _ = readChunks(r)
+		}
+
+		_, _ = io.WriteString(conn, response)
+		received <- req
+	}()
+
+	return "icap://" + l.Addr().String() + "/avscan", received
+}
+
+func readChunks(r *bufio.Reader) ([]byte, bool) {
+	body := &bytes.Buffer{}
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return body.Bytes(), false
+		}
+		size, ext, _ := strings.Cut(strings.TrimSpace(line), ";")
+		n, _ := strconv.ParseInt(size, 16, 64)
+		if n == 0 {
+			_, _ = r.ReadString('\n')
+			return body.Bytes(), strings.TrimSpace(ext) == "ieof"
+		}
+		_, _ = io.CopyN(body, r, n)
+		_, _ = r.ReadString('\n')
+	}
+}
+
+func newREQMOD(t *testing.T, url string, body []byte, preview int) ic.Request {
+	httpReq, err := http.NewRequest(http.MethodPost, "http://localhost/data", bytes.NewReader(body))
+	require.NoError(t, err)
+	req, err := ic.NewRequest(context.TODO(), ic.MethodREQMOD, url, httpReq, nil)
+	require.NoError(t, err)
+	req.PreviewBytes = preview
+	return req
+}
+
+func TestICAPClient_Do(t *testing.T) {
+	client := scanners.ICAPClient{Timeout: 5 * time.Second}
+
+	t.Run("OPTIONS", func(t *testing.T) {
+		url, received := serveICAP(t, false, "ICAP/1.0 200 OK\r\nISTag: \"5BDEEEA9-12E4-2\"\r\nPreview: 1024\r\nAllow: 204\r\nEncapsulated: null-body=0\r\n\r\n")
+		req, err := ic.NewRequest(context.TODO(), ic.MethodOPTIONS, url, nil, nil)
+		require.NoError(t, err)
+
+		res, err := client.Do(req)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, 1024, res.PreviewBytes)
+		assert.Equal(t, `"5BDEEEA9-12E4-2"`, res.Header.Get("ISTag"))
+		assert.Equal(t, "null-body=0", (<-received).header.Get("Encapsulated"))
+	})
+
+	t.Run("streams the body after the preview", func(t *testing.T) {
+		body := bytes.Repeat([]byte("0123456789"), 20000)
+		url, received := serveICAP(t, true, "ICAP/1.0 204 No Content\r\n\r\n")
+
+		res, err := client.Do(newREQMOD(t, url, body, 10))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, res.StatusCode)
+
+		req := <-received
+		assert.Equal(t, "10", req.header.Get("Preview"))
+		assert.Equal(t, body[:10], req.preview)
+		assert.False(t, req.ieof)
+		assert.Equal(t, body[10:], req.body)
+		assert.Contains(t, req.httpHeader, "POST /data HTTP/1.1\r\n")
+		assert.Contains(t, req.httpHeader, "Content-Length: 200000\r\n")
+	})
+
+	t.Run("signals a body which fits into the preview", func(t *testing.T) {
+		url, received := serveICAP(t, false, "ICAP/1.0 204 No Content\r\n\r\n")
+
+		_, err := client.Do(newREQMOD(t, url, []byte("small"), 10))
+		require.NoError(t, err)
+
+		req := <-received
+		assert.Equal(t, []byte("small"), req.preview)
+		assert.True(t, req.ieof)
+	})
+
+	t.Run("stops after the preview if the server already knows the result", func(t *testing.T) {
+		url, received := serveICAP(t, false, "ICAP/1.0 200 OK\r\nX-Infection-Found: Type=0; Resolution=2; Threat=Eicar-Test-Signature;\r\nEncapsulated: res-hdr=0, null-body=19\r\n\r\nHTTP/1.1 403 Forbidden\r\n\r\n")
+
+		res, err := client.Do(newREQMOD(t, url, bytes.Repeat([]byte("x"), 100), 10))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, http.StatusForbidden, res.ContentResponse.StatusCode)
+		assert.Empty(t, (<-received).body)
+	})
+
+	t.Run("streams the body without preview", func(t *testing.T) {
+		body := bytes.Repeat([]byte("x"), 150000)
+		url, received := serveICAP(t, false, "ICAP/1.0 200 OK\r\nX-Violations-Found: 1\r\n\teicar.com\r\n\tEICAR test file\r\n\t11101\r\n\t0\r\nEncapsulated: null-body=0\r\n\r\n")
+
+		res, err := client.Do(newREQMOD(t, url, body, 0))
+		require.NoError(t, err)
+		assert.Equal(t, "1\neicar.com\nEICAR test file\n11101\n0", res.Header.Get("X-Violations-Found"))
+		assert.Equal(t, body, (<-received).body)
+	})
+
+	t.Run("sends the request headers", func(t *testing.T) {
+		url, received := serveICAP(t, false, "ICAP/1.0 204 No Content\r\n\r\n")
+		req := newREQMOD(t, url, []byte("data"), 0)
+		req.Header.Set("X-Authenticated-User", "einstein")
+		req.Header.Set("Allow", "204")
+
+		_, err := client.Do(req)
+		require.NoError(t, err)
+
+		header := (<-received).header
+		assert.Equal(t, "einstein", header.Get("X-Authenticated-User"))
+		assert.Equal(t, "204", header.Get("Allow"))
+	})
+}
@@ -131,6 +131,74 @@ func TestICAP_Scan(t *testing.T) {
 		})
 	})
 
+	t.Run("it negotiates the request options", func(t *testing.T) {
+		t.Run("Allow 204", func(t *testing.T) {
+			client.EXPECT().Do(mock.Anything).Return(ic.Response{Header: http.Header{"Allow": []string{"204"}}}, nil).Once()
+
+			client.EXPECT().Do(mock.Anything).RunAndReturn(func(request ic.Request) (ic.Response, error) {
+				assert.Equal(t, "204", request.Header.Get("Allow"))
+				return ic.Response{}, earlyExitErr
+			}).Once()
+
+			_, err := scanner.Scan(scanners.Input{})
+			assert.ErrorIs(t, earlyExitErr, err)
+		})
+
+		t.Run("without Allow 204", func(t *testing.T) {
+			client.EXPECT().Do(mock.Anything).Return(ic.Response{}, nil).Once()
+
+			client.EXPECT().Do(mock.Anything).RunAndReturn(func(request ic.Request) (ic.Response, error) {
+				assert.Empty(t, request.Header.Get("Allow"))
+				return ic.Response{}, earlyExitErr
+			}).Once()
+
+			_, err := scanner.Scan(scanners.Input{})
+			assert.ErrorIs(t, earlyExitErr, err)
+		})
+
+		t.Run("it does not read the body for the preview", func(t *testing.T) {
+			body := bytes.NewReader(make([]byte, 888))
+			client.EXPECT().Do(mock.Anything).Return(ic.Response{PreviewBytes: 444}, nil).Once()
+
+			client.EXPECT().Do(mock.Anything).RunAndReturn(func(request ic.Request) (ic.Response, error) {
+				assert.Equal(t, 888, body.Len())
+				return ic.Response{}, earlyExitErr
+			}).Once()
+
+			_, err := scanner.Scan(scanners.Input{Body: body})
+			assert.ErrorIs(t, earlyExitErr, err)
+		})
+	})
+
+	t.Run("it sends the configured request headers", func(t *testing.T) {
+		scanner := &scanners.ICAP{Client: client, URL: testUrl, Headers: map[string]string{
+			"X-Authenticated-User": "{user}",
+			"X-Client-IP":          "10.0.0.1",
+			"X-Filename":           "{filename}",
+		}}
+
+		client.EXPECT().Do(mock.Anything).Return(ic.Response{}, nil).Once()
+		client.EXPECT().Do(mock.Anything).RunAndReturn(func(request ic.Request) (ic.Response, error) {
+			assert.Equal(t, "einstein", request.Header.Get("X-Authenticated-User"))
+			assert.Equal(t, "10.0.0.1", request.Header.Get("X-Client-IP"))
+			assert.Equal(t, "report.pdf", request.Header.Get("X-Filename"))
+			return ic.Response{}, earlyExitErr
+		}).Once()
+
+		_, err := scanner.Scan(scanners.Input{Name: "report.pdf", User: "einstein"})
+		assert.ErrorIs(t, earlyExitErr, err)
+
+		client.EXPECT().Do(mock.Anything).Return(ic.Response{}, nil).Once()
+		client.EXPECT().Do(mock.Anything).RunAndReturn(func(request ic.Request) (ic.Response, error) {
+			_, ok := request.Header["X-Authenticated-User"]
+			assert.False(t, ok, "headers with an empty value must not be sent")
+			return ic.Response{}, earlyExitErr
+		}).Once()
+
+		_, err = scanner.Scan(scanners.Input{Name: "report.pdf"})
+		assert.ErrorIs(t, earlyExitErr, err)
+	})
+
 	t.Run("request with the OPTIONS response preview size ", func(t *testing.T) {
 		t.Run("with PreviewBytes set", func(t *testing.T) {
 			client.EXPECT().Do(mock.Anything).Return(ic.Response{PreviewBytes: 444}, nil).Once()
@@ -165,6 +233,46 @@ func TestICAP_Scan(t *testing.T) {
 				assert.Equal(t, "bad threat", result.Description)
 			})
 
+			for name, tc := range map[string]struct {
+				header      http.Header
+				infected    bool
+				description string
+			}{
+				"c-icap X-Infection-Found": {
+					header:      http.Header{"X-Infection-Found": []string{"Type=0; Resolution=2; Threat=Eicar-Test-Signature;"}},
+					infected:    true,
+					description: "Eicar-Test-Signature",
+				},
+				"sophos X-Violations-Found": {
+					header:      http.Header{"X-Violations-Found": []string{"2\neicar.com\nEICAR-AV-Test\n0\n0\neicar2.com\nEICAR-AV-Test2\n0\n0"}},
+					infected:    true,
+					description: "EICAR-AV-Test, EICAR-AV-Test2",
+				},
+				"no X-Violations-Found": {
+					header: http.Header{"X-Violations-Found": []string{"0"}},
+				},
+				"kaspersky X-Virus-ID": {
+					header:      http.Header{"X-Virus-Id": []string{"EICAR-Test-File"}},
+					infected:    true,
+					description: "EICAR-Test-File",
+				},
+				"mcafee X-Virus-Name": {
+					header:      http.Header{"X-Virus-Name": []string{"EICAR test file"}},
+					infected:    true,
+					description: "EICAR test file",
+				},
+			} {
+				t.Run(name, func(t *testing.T) {
+					client.EXPECT().Do(mock.Anything).Return(ic.Response{}, nil).Once()
+					client.EXPECT().Do(mock.Anything).Return(ic.Response{Header: tc.header}, nil).Once()
+
+					result, err := scanner.Scan(scanners.Input{})
+					assert.Nil(t, err)
+					assert.Equal(t, tc.infected, result.Infected)
+					assert.Equal(t, tc.description, result.Description)
+				})
+			}
+
 			// skyhigh returns the information via the content response
 			t.Run("X-Infection-Found header", func(t *testing.T) {
 				client.EXPECT().Do(mock.Anything).Return(ic.Response{}, nil).Once()
@@ -185,3 +293,52 @@ func TestICAP_Scan(t *testing.T) {
 		})
 	})
 }
+
+func TestICAP_SignatureVersion(t *testing.T) {
+	client := mocks.NewScanner(t)
+	scanner := &scanners.ICAP{Client: client, URL: "icap://test"}
+
+	t.Run("returns the ISTag", func(t *testing.T) {
+		client.EXPECT().Do(mock.Anything).RunAndReturn(func(request ic.Request) (ic.Response, error) {
+			assert.Equal(t, ic.MethodOPTIONS, request.Method)
+			return ic.Response{Header: http.Header{"Istag": []string{`"5BDEEEA9-12E4-2"`}}}, nil
+		}).Once()
+
+		version, err := scanner.SignatureVersion()
+		assert.NoError(t, err)
+		assert.Equal(t, "5BDEEEA9-12E4-2", version)
+	})
+
+	t.Run("fails without ISTag", func(t *testing.T) {
+		client.EXPECT().Do(mock.Anything).Return(ic.Response{Header: http.Header{}}, nil).Once()
+
+		_, err := scanner.SignatureVersion()
+		assert.Error(t, err)
+	})
+}
+
+func TestICAP_OptionsCache(t *testing.T) {
+	client := mocks.NewScanner(t)
+	scanner, err := scanners.NewICAP("icap://test", "avscan", 0, nil)
+	assert.NoError(t, err)
+	scanner.Client = client
+
+	options := 0
+	client.EXPECT().Do(mock.Anything).RunAndReturn(func(request ic.Request) (ic.Response, error) {
+		if request.Method == ic.MethodOPTIONS {
+			options++
+			return ic.Response{Header: http.Header{"Istag": []string{`"tag-1"`}}}, nil
+		}
+		return ic.Response{Header: http.Header{"Istag": []string{`"tag-2"`}}}, nil
+	})
+
+	_, err = scanner.SignatureVersion()
+	assert.NoError(t, err)
+	_, err = scanner.Scan(scanners.Input{Body: bytes.NewReader([]byte("clean")), Size: 5})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, options, "the OPTIONS response must be reused by the scan")
+
+	_, err = scanner.SignatureVersion()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, options, "a changed ISTag must invalidate the cached OPTIONS response")
+}
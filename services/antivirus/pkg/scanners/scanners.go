@@ -27,5 +27,7 @@ type (
 		Size int64
 		Url  string
 		Name string
+		// User is the username of the user who uploaded the file, empty for files at rest
+		User string
 	}
 )
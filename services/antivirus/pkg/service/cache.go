@@ -0,0 +1,99 @@
+package service
+
+import (
+	"crypto/sha1" //nolint:gosec // the storages report sha1 checksums
+	"encoding/hex"
+	"encoding/json"
+	"hash"
+	"io"
+	"strings"
+	"time"
+
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	microstore "go-micro.dev/v4/store"
+
+	"github.com/opencloud-eu/opencloud/services/antivirus/pkg/scanners"
+)
+
+// SignatureVersioner is implemented by scanners which can tell the version of their signature database
+type SignatureVersioner interface {
+	SignatureVersion() (string, error)
+}
+
+// scanCache remembers scan results by the checksum of the scanned content.
+// Entries are only valid for the signature version they were scanned with.
+type scanCache struct {
+	store microstore.Store
+	ttl   time.Duration
+}
+
+type cacheEntry struct {
+	SignatureVersion string
+	Result           scanners.Result
+}
+
+// get returns the cached result for the checksum if it was scanned with the given signature version
+func (c *scanCache) get(checksum, version string) (scanners.Result, bool) {
+	recs, err := c.store.Read(checksum)
+	if err != nil || len(recs) == 0 {
+		return scanners.Result{}, false
+	}
+
+	var e cacheEntry
+	if err := json.Unmarshal(recs[0].Value, &e); err != nil || e.SignatureVersion != version {
+		return scanners.Result{}, false
+	}
+	return e.Result, true
+}
+
+// set caches the result for the checksum, replacing entries of older signature versions
+func (c *scanCache) set(checksum, version string, res scanners.Result) error {
+	b, err := json.Marshal(cacheEntry{SignatureVersion: version, Result: res})
+	if err != nil {
+		return err
+	}
+	return c.store.Write(&microstore.Record{Key: checksum, Value: b, Expiry: c.ttl})
+}
+
+// contentHash computes the sha1 checksum of the content read through it. Storages report
+// sha1 checksums for their files, so results cached for uploads are found by later rescans.
+type contentHash struct {
+	r io.Reader
+	h hash.Hash
+	n int64
+}
+
+func newContentHash(r io.Reader) *contentHash {
+	return &contentHash{r: r, h: sha1.New()}
+}
+
+func (c *contentHash) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.h.Write(p[:n])
+	c.n += int64(n)
+	return n, err
+}
+
+// checksum returns the checksum in the format of the cache keys if exactly size bytes were read
+func (c *contentHash) checksum(size int64) string {
+	if c.n != size {
+		return ""
+	}
+	return "sha1:" + hex.EncodeToString(c.h.Sum(nil))
+}
+
+// storageChecksum returns the sha1 checksum of a file in the format of the cache keys or an empty string
+func storageChecksum(info *provider.ResourceInfo) string {
+	if info.GetChecksum().GetType() != provider.ResourceChecksumType_RESOURCE_CHECKSUM_TYPE_SHA1 || info.GetChecksum().GetSum() == "" {
+		return ""
+	}
+	return "sha1:" + strings.ToLower(info.GetChecksum().GetSum())
+}
+
+// scanDate returns the time the content was actually scanned, cached results keep the time of the original scan
+func scanDate(res scanners.Result) time.Time {
+	if res.ScanTime.IsZero() {
+		return time.Now()
+	}
+	return res.ScanTime
+}
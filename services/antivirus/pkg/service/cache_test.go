@@ -0,0 +1,104 @@
+package service
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
+	"github.com/opencloud-eu/reva/v2/pkg/store"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/antivirus/pkg/scanners"
+)
+
+type countingScanner struct {
+	version string
+	scans   int
+	content []string
+}
+
+func (s *countingScanner) Scan(in scanners.Input) (scanners.Result, error) {
+	s.scans++
+	b, err := io.ReadAll(in.Body)
+	s.content = append(s.content, string(b))
+	return scanners.Result{Infected: string(b) == "EICAR"}, err
+}
+
+func (s *countingScanner) SignatureVersion() (string, error) {
+	return s.version, nil
+}
+
+func TestScanCached(t *testing.T) {
+	scanner := &countingScanner{version: "1.0.5/27500"}
+	av := Antivirus{
+		log:     log.NopLogger(),
+		scanner: scanner,
+		cache:   &scanCache{store: store.Create()},
+	}
+	ev := events.StartPostprocessingStep{UploadID: "upload"}
+
+	downloads := 0
+	content := func(s string) func() (io.ReadCloser, error) {
+		return func() (io.ReadCloser, error) {
+			downloads++
+			return io.NopCloser(strings.NewReader(s)), nil
+		}
+	}
+	eicarChecksum := "sha1:" + fmt.Sprintf("%x", sha1.Sum([]byte("EICAR")))
+
+	res, err := av.scanCached(ev, "", content("EICAR"), 5)
+	assert.NoError(t, err)
+	assert.True(t, res.Infected)
+	assert.Equal(t, []string{"EICAR"}, scanner.content, "the downloaded content must be scanned")
+
+	res, err = av.scanCached(ev, eicarChecksum, content("EICAR"), 5)
+	assert.NoError(t, err)
+	assert.True(t, res.Infected)
+	assert.Equal(t, 1, scanner.scans, "identical content must not be scanned again")
+	assert.Equal(t, 1, downloads, "content with a cached checksum must not be downloaded")
+
+	_, err = av.scanCached(ev, "sha1:0000", content("clean"), 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, scanner.scans, "different content must be scanned")
+
+	_, err = av.scanCached(ev, "sha1:0000", content("clean"), 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, scanner.scans, "the result must be cached under the storage checksum")
+
+	scanner.version = "1.0.5/27501"
+	_, err = av.scanCached(ev, eicarChecksum, content("EICAR"), 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, scanner.scans, "new signatures must invalidate the cached result")
+}
+
+func TestScanCachedKeepsScanTime(t *testing.T) {
+	scanner := &countingScanner{version: "1.0.5/27500"}
+	av := Antivirus{
+		log:     log.NopLogger(),
+		scanner: scanner,
+		cache:   &scanCache{store: store.Create()},
+	}
+	scanned := time.Now().Add(-time.Hour).Truncate(time.Second)
+	assert.NoError(t, av.cache.set("sha1:1234", "1.0.5/27500", scanners.Result{ScanTime: scanned}))
+
+	res, err := av.scanCached(events.StartPostprocessingStep{}, "sha1:1234", nil, 5)
+	assert.NoError(t, err)
+	assert.True(t, scanned.Equal(scanDate(res)), "cached results must report the time of the original scan")
+	assert.WithinDuration(t, time.Now(), scanDate(scanners.Result{}), time.Second)
+}
+
+func TestStorageChecksum(t *testing.T) {
+	assert.Equal(t, "sha1:abcd", storageChecksum(&provider.ResourceInfo{
+		Checksum: &provider.ResourceChecksum{Type: provider.ResourceChecksumType_RESOURCE_CHECKSUM_TYPE_SHA1, Sum: "ABCD"},
+	}))
+	assert.Empty(t, storageChecksum(&provider.ResourceInfo{
+		Checksum: &provider.ResourceChecksum{Type: provider.ResourceChecksumType_RESOURCE_CHECKSUM_TYPE_MD5, Sum: "abcd"},
+	}))
+	assert.Empty(t, storageChecksum(&provider.ResourceInfo{}))
+}
@@ -0,0 +1,300 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	ctxpkg "github.com/opencloud-eu/reva/v2/pkg/ctx"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
+	"github.com/opencloud-eu/reva/v2/pkg/storage/utils/walker"
+	"github.com/opencloud-eu/reva/v2/pkg/storagespace"
+	"github.com/opencloud-eu/reva/v2/pkg/utils"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/opencloud-eu/opencloud/services/antivirus/pkg/event"
+	"github.com/opencloud-eu/opencloud/services/antivirus/pkg/scanners"
+)
+
+// RescanOptions select the files to rescan
+type RescanOptions struct {
+	// ModifiedSince limits the rescan to files modified after the given time, zero rescans all files
+	ModifiedSince time.Time
+	// SpaceID limits the rescan to a single space
+	SpaceID string
+	// DryRun only reports infected files without applying the infected file handling
+	DryRun bool
+}
+
+// RescanSummary sums up the result of a rescan
+type RescanSummary struct {
+	Scanned  int
+	Infected int
+	// Failed counts the files which could not be scanned and the spaces and folders which could not be listed
+	Failed int
+}
+
+// Rescan scans the files at rest with the current signatures and applies the configured infected file handling.
+// Infected files and the summary are published as events.
+func (av Antivirus) Rescan(ctx context.Context, pub events.Publisher, opts RescanOptions) (RescanSummary, error) {
+	started := time.Now()
+	summary := RescanSummary{}
+
+	if av.gatewaySelector == nil {
+		return summary, errors.New("rescanning needs a gateway")
+	}
+
+	spaces, err := av.rescanSpaces(ctx, opts.SpaceID)
+	if err != nil {
+		return summary, err
+	}
+
+	for _, space := range spaces {
+		if ctx.Err() != nil {
+			return summary, ctx.Err()
+		}
+		if err := av.rescanSpace(ctx, pub, space, opts, &summary); err != nil {
+			av.log.Error().Err(err).Str("spaceid", space.GetId().GetOpaqueId()).Msg("error rescanning space")
+			summary.Failed++
+		}
+	}
+
+	av.log.Info().Int("scanned", summary.Scanned).Int("infected", summary.Infected).Int("failed", summary.Failed).Dur("duration", time.Since(started)).Msg("Rescan finished")
+
+	if pub != nil {
+		if err := events.Publish(ctx, pub, event.RescanFinished{
+			ModifiedSince: opts.ModifiedSince,
+			Started:       started,
+			Scanned:       summary.Scanned,
+			Infected:      summary.Infected,
+			Failed:        summary.Failed,
+			Timestamp:     time.Now(),
+		}); err != nil {
+			av.log.Error().Err(err).Msg("cannot publish rescan finished event")
+		}
+	}
+
+	return summary, nil
+}
+
+// runPeriodicRescan rescans the files modified within the configured max age until the service stops
+func (av Antivirus) runPeriodicRescan(pub events.Publisher) {
+	ticker := time.NewTicker(av.config.Rescan.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-av.stopCh:
+			return
+		case <-ticker.C:
+			opts := RescanOptions{}
+			if av.config.Rescan.MaxAge > 0 {
+				opts.ModifiedSince = time.Now().Add(-av.config.Rescan.MaxAge)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			go func() {
+				select {
+				case <-av.stopCh:
+					cancel()
+				case <-ctx.Done():
+				}
+			}()
+			if _, err := av.Rescan(ctx, pub, opts); err != nil {
+				av.log.Error().Err(err).Msg("periodic rescan failed")
+			}
+			cancel()
+		}
+	}
+}
+
+func (av Antivirus) rescanSpaces(ctx context.Context, spaceID string) ([]*provider.StorageSpace, error) {
+	gwc, authCtx, _, err := av.serviceUserContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &provider.ListStorageSpacesRequest{}
+	if spaceID != "" {
+		req.Filters = []*provider.ListStorageSpacesRequest_Filter{{
+			Type: provider.ListStorageSpacesRequest_Filter_TYPE_ID,
+			Term: &provider.ListStorageSpacesRequest_Filter_Id{Id: &provider.StorageSpaceId{OpaqueId: spaceID}},
+		}}
+	}
+
+	res, err := gwc.ListStorageSpaces(authCtx, req)
+	if err != nil {
+		return nil, err
+	}
+	if res.GetStatus().GetCode() != rpc.Code_CODE_OK {
+		return nil, fmt.Errorf("could not list spaces: %s", res.GetStatus().GetMessage())
+	}
+
+	spaces := make([]*provider.StorageSpace, 0, len(res.GetStorageSpaces()))
+	for _, space := range res.GetStorageSpaces() {
+		if typ := space.GetSpaceType(); typ != "personal" && typ != "project" {
+			// ignore spaces that are neither personal nor project
+			continue
+		}
+		spaces = append(spaces, space)
+	}
+	return spaces, nil
+}
+
+func (av Antivirus) rescanSpace(ctx context.Context, pub events.Publisher, space *provider.StorageSpace, opts RescanOptions, summary *RescanSummary) error {
+	// get a fresh token for every space, walking big spaces takes a while
+	_, authCtx, token, err := av.serviceUserContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	rootID, err := storagespace.ParseID(space.GetId().GetOpaqueId())
+	if err != nil {
+		return err
+	}
+	rootID.OpaqueId = rootID.SpaceId
+
+	return walker.NewWalker(av.gatewaySelector).Walk(authCtx, &rootID, func(wd string, info *provider.ResourceInfo, err error) error {
+		if err != nil {
+			// skip the folder and continue with the rest of the space
+			av.log.Error().Err(err).Str("spaceid", space.GetId().GetOpaqueId()).Str("path", filepath.Join(wd, info.GetPath())).Msg("error listing folder")
+			summary.Failed++
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if info == nil || info.GetType() != provider.ResourceType_RESOURCE_TYPE_FILE {
+			return nil
+		}
+		if !opts.ModifiedSince.IsZero() && utils.TSToTime(info.GetMtime()).Before(opts.ModifiedSince) {
+			return nil
+		}
+
+		path := filepath.Join(wd, info.GetPath())
+		res, err := av.rescanFile(authCtx, token, info)
+		if err != nil {
+			av.log.Error().Err(err).Str("path", path).Interface("resourceID", info.GetId()).Msg("error rescanning file")
+			summary.Failed++
+			return nil
+		}
+		summary.Scanned++
+
+		if !res.Infected {
+			return nil
+		}
+		summary.Infected++
+
+		outcome := av.outcome
+		if opts.DryRun {
+			outcome = events.PPOutcomeContinue
+		}
+		av.log.Warn().Str("path", path).Interface("resourceID", info.GetId()).Str("virus", res.Description).Str("outcome", string(outcome)).Msg("Infected file found at rest")
+
+		if outcome == events.PPOutcomeDelete {
+			if err := av.deleteInfected(authCtx, space, info); err != nil {
+				av.log.Error().Err(err).Interface("resourceID", info.GetId()).Msg("error deleting infected file")
+			}
+		}
+
+		if pub != nil {
+			if err := events.Publish(ctx, pub, event.InfectedFileFound{
+				ResourceID:  info.GetId(),
+				Owner:       info.GetOwner(),
+				Path:        path,
+				Filename:    info.GetName(),
+				Description: res.Description,
+				Outcome:     outcome,
+				Scandate:    res.ScanTime,
+				Timestamp:   time.Now(),
+			}); err != nil {
+				av.log.Error().Err(err).Interface("resourceID", info.GetId()).Msg("cannot publish infected file event")
+			}
+		}
+		return nil
+	})
+}
+
+// rescanFile downloads and scans a single file the same way as on-demand scans
+func (av Antivirus) rescanFile(ctx context.Context, token string, info *provider.ResourceInfo) (scanners.Result, error) {
+	gwc, err := av.gatewaySelector.Next()
+	if err != nil {
+		return scanners.Result{}, err
+	}
+
+	res, err := gwc.InitiateFileDownload(ctx, &provider.InitiateFileDownloadRequest{Ref: &provider.Reference{ResourceId: info.GetId(), Path: "."}})
+	if err != nil {
+		return scanners.Result{}, err
+	}
+	if res.GetStatus().GetCode() != rpc.Code_CODE_OK {
+		return scanners.Result{}, fmt.Errorf("could not initiate download: %s", res.GetStatus().GetMessage())
+	}
+
+	var ep, tt string
+	for _, p := range res.GetProtocols() {
+		if p.GetProtocol() == "spaces" {
+			ep, tt = p.GetDownloadEndpoint(), p.GetToken()
+			break
+		}
+	}
+	if (ep == "" || tt == "") && len(res.GetProtocols()) > 0 {
+		ep, tt = res.GetProtocols()[0].GetDownloadEndpoint(), res.GetProtocols()[0].GetToken()
+	}
+
+	// an empty upload id makes process download the file from the dataprovider
+	return av.process(events.StartPostprocessingStep{
+		URL:        ep,
+		Token:      tt,
+		RevaToken:  token,
+		Filename:   info.GetName(),
+		Filesize:   info.GetSize(),
+		ResourceID: info.GetId(),
+	}, storageChecksum(info))
+}
+
+// deleteInfected deletes the file and purges it from the trash-bin
+func (av Antivirus) deleteInfected(ctx context.Context, space *provider.StorageSpace, info *provider.ResourceInfo) error {
+	gwc, err := av.gatewaySelector.Next()
+	if err != nil {
+		return err
+	}
+
+	res, err := gwc.Delete(ctx, &provider.DeleteRequest{Ref: &provider.Reference{ResourceId: info.GetId(), Path: "."}})
+	if err != nil {
+		return err
+	}
+	if res.GetStatus().GetCode() != rpc.Code_CODE_OK {
+		return fmt.Errorf("could not delete file: %s", res.GetStatus().GetMessage())
+	}
+
+	pres, err := gwc.PurgeRecycle(ctx, &provider.PurgeRecycleRequest{
+		Ref: &provider.Reference{ResourceId: space.GetRoot()},
+		Key: info.GetId().GetOpaqueId(),
+	})
+	if err != nil {
+		return err
+	}
+	if pres.GetStatus().GetCode() != rpc.Code_CODE_OK {
+		return fmt.Errorf("could not purge file from trash-bin: %s", pres.GetStatus().GetMessage())
+	}
+	return nil
+}
+
+func (av Antivirus) serviceUserContext(ctx context.Context) (gateway.GatewayAPIClient, context.Context, string, error) {
+	gwc, err := av.gatewaySelector.Next()
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	token, err := utils.GetServiceUserToken(ctx, gwc, av.config.ServiceAccount.ServiceAccountID, av.config.ServiceAccount.ServiceAccountSecret)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	return gwc, metadata.AppendToOutgoingContext(ctxpkg.ContextSetToken(ctx, token), ctxpkg.TokenHeader, token), token, nil
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
+	eventsmocks "github.com/opencloud-eu/reva/v2/pkg/events/mocks"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
+	cs3mocks "github.com/opencloud-eu/reva/v2/tests/cs3mocks/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/antivirus/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/antivirus/pkg/event"
+)
+
+func okStatus() *rpc.Status {
+	return &rpc.Status{Code: rpc.Code_CODE_OK}
+}
+
+func resourceID(id string) *provider.ResourceId {
+	return &provider.ResourceId{StorageId: "storage", SpaceId: "space", OpaqueId: id}
+}
+
+// newRescanAntivirus returns an antivirus service rescanning a personal space with a clean file, an infected file
+// and a folder which can't be listed and a project space which can't be accessed
+func newRescanAntivirus(t *testing.T) Antivirus {
+	files := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/clean":
+			_, _ = w.Write([]byte("clean"))
+		case "/infected":
+			_, _ = w.Write([]byte("EICAR"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(files.Close)
+
+	gwc := &cs3mocks.GatewayAPIClient{}
+	gwc.On("Authenticate", mock.Anything, mock.Anything).Return(&gateway.AuthenticateResponse{Status: okStatus(), Token: "token"}, nil)
+	gwc.On("ListStorageSpaces", mock.Anything, mock.Anything).Return(&provider.ListStorageSpacesResponse{
+		Status: okStatus(),
+		StorageSpaces: []*provider.StorageSpace{
+			{Id: &provider.StorageSpaceId{OpaqueId: "storage$space"}, SpaceType: "personal", Root: resourceID("space")},
+			{Id: &provider.StorageSpaceId{OpaqueId: "storage$broken"}, SpaceType: "project"},
+			{Id: &provider.StorageSpaceId{OpaqueId: "storage$shares"}, SpaceType: "virtual"},
+		},
+	}, nil)
+	gwc.On("Stat", mock.Anything, mock.MatchedBy(func(req *provider.StatRequest) bool {
+		return req.GetRef().GetResourceId().GetSpaceId() == "broken"
+	})).Return(&provider.StatResponse{Status: &rpc.Status{Code: rpc.Code_CODE_PERMISSION_DENIED}}, nil)
+	gwc.On("Stat", mock.Anything, mock.Anything).Return(&provider.StatResponse{
+		Status: okStatus(),
+		Info:   &provider.ResourceInfo{Id: resourceID("space"), Type: provider.ResourceType_RESOURCE_TYPE_CONTAINER, Path: "."},
+	}, nil)
+	gwc.On("ListContainer", mock.Anything, mock.MatchedBy(func(req *provider.ListContainerRequest) bool {
+		return req.GetRef().GetResourceId().GetOpaqueId() == "space"
+	})).Return(&provider.ListContainerResponse{
+		Status: okStatus(),
+		Infos: []*provider.ResourceInfo{
+			{Id: resourceID("folder"), Type: provider.ResourceType_RESOURCE_TYPE_CONTAINER, Path: "folder"},
+			{Id: resourceID("clean"), Type: provider.ResourceType_RESOURCE_TYPE_FILE, Path: "clean.txt", Name: "clean.txt", Size: 5},
+			{Id: resourceID("infected"), Type: provider.ResourceType_RESOURCE_TYPE_FILE, Path: "infected.txt", Name: "infected.txt", Size: 5},
+		},
+	}, nil)
+	gwc.On("ListContainer", mock.Anything, mock.Anything).Return(nil, errors.New("listing failed"))
+	gwc.On("InitiateFileDownload", mock.Anything, mock.Anything).Return(func(_ context.Context, req *provider.InitiateFileDownloadRequest, _ ...grpc.CallOption) (*gateway.InitiateFileDownloadResponse, error) {
+		return &gateway.InitiateFileDownloadResponse{
+			Status: okStatus(),
+			Protocols: []*gateway.FileDownloadProtocol{{
+				Protocol:         "spaces",
+				DownloadEndpoint: files.URL + "/" + req.GetRef().GetResourceId().GetOpaqueId(),
+				Token:            "transfer",
+			}},
+		}, nil
+	})
+
+	pool.RemoveSelector("GatewaySelector" + "eu.opencloud.api.gateway")
+	gatewaySelector := pool.GetSelector[gateway.GatewayAPIClient](
+		"GatewaySelector",
+		"eu.opencloud.api.gateway",
+		func(cc grpc.ClientConnInterface) gateway.GatewayAPIClient {
+			return gwc
+		},
+	)
+
+	return Antivirus{
+		config:          &config.Config{},
+		log:             log.NopLogger(),
+		scanner:         &countingScanner{},
+		outcome:         events.PPOutcomeAbort,
+		gatewaySelector: gatewaySelector,
+		client:          http.DefaultClient,
+		stopCh:          make(chan struct{}, 1),
+		stopped:         new(atomic.Bool),
+	}
+}
+
+func TestRescan(t *testing.T) {
+	av := newRescanAntivirus(t)
+	pub := &eventsmocks.Stream{}
+	pub.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	summary, err := av.Rescan(context.Background(), pub, RescanOptions{DryRun: true})
+	require.NoError(t, err)
+
+	// the broken space and the folder which can't be listed are counted as failures, the rest is scanned
+	assert.Equal(t, RescanSummary{Scanned: 2, Infected: 1, Failed: 2}, summary)
+
+	pub.AssertNumberOfCalls(t, "Publish", 2)
+	infected, ok := pub.Calls[0].Arguments.Get(1).(event.InfectedFileFound)
+	require.True(t, ok)
+	assert.Equal(t, "infected.txt", infected.Filename)
+	assert.Equal(t, events.PPOutcomeContinue, infected.Outcome, "a dry run must not apply the infected file handling")
+	finished, ok := pub.Calls[1].Arguments.Get(1).(event.RescanFinished)
+	require.True(t, ok)
+	assert.Equal(t, 2, finished.Failed)
+}
+
+func TestRescanModifiedSince(t *testing.T) {
+	av := newRescanAntivirus(t)
+
+	summary, err := av.Rescan(context.Background(), nil, RescanOptions{ModifiedSince: time.Now()})
+	require.NoError(t, err)
+	assert.Equal(t, RescanSummary{Failed: 2}, summary)
+	assert.Zero(t, av.scanner.(*countingScanner).scans)
+}
+
+func TestRunPeriodicRescan(t *testing.T) {
+	av := newRescanAntivirus(t)
+	av.config.Rescan.Interval = 10 * time.Millisecond
+
+	finished := make(chan event.RescanFinished, 10)
+	pub := &eventsmocks.Stream{}
+	pub.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		if ev, ok := args.Get(1).(event.RescanFinished); ok {
+			finished <- ev
+		}
+	})
+
+	done := make(chan struct{})
+	go func() {
+		av.runPeriodicRescan(pub)
+		close(done)
+	}()
+
+	for i := 0; i < 2; i++ {
+		select {
+		case ev := <-finished:
+			assert.Equal(t, 2, ev.Scanned)
+		case <-time.After(5 * time.Second):
+			t.Fatal("periodic rescan did not run")
+		}
+	}
+
+	av.Close()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("periodic rescan did not stop")
+	}
+}
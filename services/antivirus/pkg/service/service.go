@@ -14,15 +14,20 @@ import (
 	"sync/atomic"
 	"time"
 
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
 	"github.com/opencloud-eu/reva/v2/pkg/bytesize"
 	ctxpkg "github.com/opencloud-eu/reva/v2/pkg/ctx"
 	"github.com/opencloud-eu/reva/v2/pkg/events"
 	"github.com/opencloud-eu/reva/v2/pkg/events/stream"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
 	"github.com/opencloud-eu/reva/v2/pkg/rhttp"
+	"github.com/opencloud-eu/reva/v2/pkg/store"
+	microstore "go-micro.dev/v4/store"
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/opencloud-eu/opencloud/pkg/generators"
 	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/pkg/registry"
 	"github.com/opencloud-eu/opencloud/services/antivirus/pkg/config"
 	"github.com/opencloud-eu/opencloud/services/antivirus/pkg/scanners"
 )
@@ -49,20 +54,36 @@ func NewAntivirus(cfg *config.Config, logger log.Logger, tracerProvider trace.Tr
 	case config.ScannerTypeClamAV:
 		scanner, err = scanners.NewClamAV(cfg.Scanner.ClamAV.Socket, cfg.Scanner.ClamAV.Timeout)
 	case config.ScannerTypeICap:
-		scanner, err = scanners.NewICAP(cfg.Scanner.ICAP.URL, cfg.Scanner.ICAP.Service, cfg.Scanner.ICAP.Timeout)
+		scanner, err = scanners.NewICAP(cfg.Scanner.ICAP.URL, cfg.Scanner.ICAP.Service, cfg.Scanner.ICAP.Timeout, cfg.Scanner.ICAP.RequestHeaders)
 	}
 	if err != nil {
 		return Antivirus{}, err
 	}
 
+	tm, err := pool.StringToTLSMode(cfg.GRPCClientTLS.Mode)
+	if err != nil {
+		return Antivirus{}, err
+	}
+	gatewaySelector, err := pool.GatewaySelector(
+		cfg.RevaGateway,
+		pool.WithTLSCACert(cfg.GRPCClientTLS.CACert),
+		pool.WithTLSMode(tm),
+		pool.WithRegistry(registry.GetRegistry()),
+		pool.WithTracerProvider(tracerProvider),
+	)
+	if err != nil {
+		return Antivirus{}, err
+	}
+
 	av := Antivirus{
-		config:         cfg,
-		log:            logger,
-		tracerProvider: tracerProvider,
-		scanner:        scanner,
-		client:         rhttp.GetHTTPClient(rhttp.Insecure(true)),
-		stopCh:         make(chan struct{}, 1),
-		stopped:        new(atomic.Bool),
+		config:          cfg,
+		gatewaySelector: gatewaySelector,
+		log:             logger,
+		tracerProvider:  tracerProvider,
+		scanner:         scanner,
+		client:          rhttp.GetHTTPClient(rhttp.Insecure(true)),
+		stopCh:          make(chan struct{}, 1),
+		stopped:         new(atomic.Bool),
 	}
 
 	switch mode := cfg.MaxScanSizeMode; mode {
@@ -88,6 +109,23 @@ func NewAntivirus(cfg *config.Config, logger log.Logger, tracerProvider trace.Tr
 		av.maxScanSize = b.Bytes()
 	}
 
+	if cfg.ScanCache.Enabled {
+		if _, ok := scanner.(SignatureVersioner); !ok {
+			return av, fmt.Errorf("scanner '%s' does not support the scan cache", cfg.Scanner.Type)
+		}
+		av.cache = &scanCache{
+			store: store.Create(
+				store.Store(cfg.ScanCache.Store),
+				store.TTL(cfg.ScanCache.TTL),
+				microstore.Nodes(cfg.ScanCache.Nodes...),
+				microstore.Database(cfg.ScanCache.Database),
+				microstore.Table(cfg.ScanCache.Table),
+				store.Authentication(cfg.ScanCache.AuthUsername, cfg.ScanCache.AuthPassword),
+			),
+			ttl: cfg.ScanCache.TTL,
+		}
+	}
+
 	return av, nil
 }
 
@@ -99,6 +137,9 @@ type Antivirus struct {
 	outcome        events.PostprocessingOutcome
 	maxScanSize    uint64
 	tracerProvider trace.TracerProvider
+	cache          *scanCache
+
+	gatewaySelector pool.Selectable[gateway.GatewayAPIClient]
 
 	client  *http.Client
 	stopCh  chan struct{}
@@ -138,6 +179,14 @@ func (av Antivirus) Run() error {
 	}
 
 	wg := sync.WaitGroup{}
+	if av.config.Rescan.Interval > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			av.runPeriodicRescan(natsStream)
+		}()
+	}
+
 	for range av.config.Workers {
 		wg.Add(1)
 		go func() {
@@ -219,7 +268,7 @@ func (av Antivirus) processEvent(e events.Event, s events.Publisher) error {
 
 	var errmsg string
 	start := time.Now()
-	res, err := av.process(ev)
+	res, err := av.process(ev, "")
 	if err != nil {
 		errmsg = err.Error()
 	}
@@ -248,7 +297,7 @@ func (av Antivirus) processEvent(e events.Event, s events.Publisher) error {
 		Result: events.VirusscanResult{
 			Infected:    res.Infected,
 			Description: res.Description,
-			Scandate:    time.Now(),
+			Scandate:    scanDate(res),
 			ResourceID:  ev.ResourceID,
 			ErrorMsg:    errmsg,
 		},
@@ -259,8 +308,8 @@ func (av Antivirus) processEvent(e events.Event, s events.Publisher) error {
 	return nil
 }
 
-// process the scan
-func (av Antivirus) process(ev events.StartPostprocessingStep) (scanners.Result, error) {
+// process the scan. The checksum is the one the storage keeps for the file, it is empty if unknown.
+func (av Antivirus) process(ev events.StartPostprocessingStep, checksum string) (scanners.Result, error) {
 	if ev.Filesize == 0 {
 		av.log.Info().Str("uploadid", ev.UploadID).Msg("Skipping file to be virus scanned, file size is 0.")
 		return scanners.Result{ScanTime: time.Now()}, nil
@@ -283,15 +332,19 @@ func (av Antivirus) process(ev events.StartPostprocessingStep) (scanners.Result,
 		filesize = av.maxScanSize // inform the scanner that we are only scanning part of the file
 	}
 
-	var err error
-	var rrc io.ReadCloser
+	download := func() (io.ReadCloser, error) {
+		if ev.UploadID == "" {
+			return av.downloadViaReva(ev.URL, ev.Token, ev.RevaToken, headers)
+		}
+		return av.downloadViaToken(ev.URL, headers)
+	}
 
-	switch ev.UploadID {
-	default:
-		rrc, err = av.downloadViaToken(ev.URL, headers)
-	case "":
-		rrc, err = av.downloadViaReva(ev.URL, ev.Token, ev.RevaToken, headers)
+	// the result of a partial scan must not be reused for the whole content
+	if av.cache != nil && headers["Range"] == "" {
+		return av.scanCached(ev, checksum, download, int64(filesize))
 	}
+
+	rrc, err := download()
 	if err != nil {
 		av.log.Error().Err(err).Str("uploadid", ev.UploadID).Msg("error downloading file")
 		return scanners.Result{}, err
@@ -302,7 +355,7 @@ func (av Antivirus) process(ev events.StartPostprocessingStep) (scanners.Result,
 
 	av.log.Debug().Str("uploadid", ev.UploadID).Msg("Downloaded file successfully, starting virusscan")
 
-	res, err := av.scanner.Scan(scanners.Input{Body: rrc, Size: int64(filesize), Url: ev.URL, Name: ev.Filename})
+	res, err := av.scanner.Scan(scanners.Input{Body: rrc, Size: int64(filesize), Url: ev.URL, Name: ev.Filename, User: ev.ExecutingUser.GetUsername()})
 	if err != nil {
 		av.log.Error().Err(err).Str("uploadid", ev.UploadID).Msg("error scanning file")
 	}
@@ -310,6 +363,56 @@ func (av Antivirus) process(ev events.StartPostprocessingStep) (scanners.Result,
 	return res, err
 }
 
+// scanCached reuses the result of a previous scan of the same content if the signatures did not change since.
+// The lookup is keyed on the checksum the storage keeps for the file, so files with a cached result are not
+// downloaded at all. Without a checksum, e.g. for uploads, the content is hashed while it is scanned and the
+// result is cached for later scans of the same content. If the signature version is unknown the file is
+// scanned without consulting the cache.
+func (av Antivirus) scanCached(ev events.StartPostprocessingStep, checksum string, download func() (io.ReadCloser, error), size int64) (scanners.Result, error) {
+	version, err := av.scanner.(SignatureVersioner).SignatureVersion()
+	if err != nil {
+		av.log.Error().Err(err).Str("uploadid", ev.UploadID).Msg("error getting the signature version, not using the scan cache")
+		version = ""
+	}
+
+	if version != "" && checksum != "" {
+		if res, ok := av.cache.get(checksum, version); ok {
+			av.log.Debug().Str("uploadid", ev.UploadID).Str("checksum", checksum).Str("signatures", version).Time("scantime", res.ScanTime).Msg("Content already scanned, using cached result")
+			return res, nil
+		}
+	}
+
+	body, err := download()
+	if err != nil {
+		av.log.Error().Err(err).Str("uploadid", ev.UploadID).Msg("error downloading file")
+		return scanners.Result{}, err
+	}
+	defer func() {
+		_ = body.Close()
+	}()
+
+	av.log.Debug().Str("uploadid", ev.UploadID).Msg("Downloaded file successfully, starting virusscan")
+
+	h := newContentHash(body)
+	res, err := av.scanner.Scan(scanners.Input{Body: h, Size: size, Url: ev.URL, Name: ev.Filename, User: ev.ExecutingUser.GetUsername()})
+	if err != nil {
+		av.log.Error().Err(err).Str("uploadid", ev.UploadID).Msg("error scanning file")
+		return res, err
+	}
+
+	if checksum == "" {
+		// only content the scanner read completely is identified by the hash
+		checksum = h.checksum(size)
+	}
+	if version != "" && checksum != "" {
+		if err := av.cache.set(checksum, version, res); err != nil {
+			av.log.Error().Err(err).Str("uploadid", ev.UploadID).Msg("error caching scan result")
+		}
+	}
+
+	return res, nil
+}
+
 // download will download the file
 func (av Antivirus) downloadViaToken(url string, headers map[string]string) (io.ReadCloser, error) {
 	req, err := http.NewRequest(http.MethodGet, url, nil)
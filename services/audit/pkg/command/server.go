@@ -56,8 +56,7 @@ func Server(cfg *config.Config) *cli.Command {
 			defer svcCancel()
 
 			gr.Add(runner.New(cfg.Service.Name+".svc", func() error {
-				svc.AuditLoggerFromConfig(svcCtx, cfg.Auditlog, evts, logger)
-				return nil
+				return svc.AuditLoggerFromConfig(svcCtx, cfg.Auditlog, evts, logger)
 			}, func() {
 				svcCancel()
 			}))
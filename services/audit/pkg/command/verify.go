@@ -0,0 +1,111 @@
+package command
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/opencloud-eu/opencloud/pkg/config/configlog"
+	"github.com/opencloud-eu/opencloud/services/audit/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/audit/pkg/config/parser"
+	svc "github.com/opencloud-eu/opencloud/services/audit/pkg/service"
+)
+
+// Verify is the entrypoint for the verify command.
+func Verify(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:      "verify",
+		Usage:     "verify the hash chain of audit log files",
+		ArgsUsage: "[file...]",
+		Description: "Checks that the records of the given audit log files form an unbroken hash chain. Pass rotated files " +
+			"from the oldest to the newest one, gzip compressed files are supported. Defaults to the configured audit log file.",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "state",
+				Usage: "the hash chain state file to detect records missing at the end, defaults to the configured state file. Set to '' to skip the check.",
+			},
+		},
+		Before: func(c *cli.Context) error {
+			return configlog.ReturnFatal(parser.ParseConfig(cfg))
+		},
+		Action: func(c *cli.Context) error {
+			files := c.Args().Slice()
+			if len(files) == 0 {
+				if cfg.Auditlog.FilePath == "" {
+					return cli.Exit("no audit log file given", 1)
+				}
+				files = []string{cfg.Auditlog.FilePath}
+			}
+
+			if cfg.Auditlog.HashChain.Key == "" {
+				return cli.Exit("no hash chain key configured, set AUDIT_HASH_CHAIN_KEY", 1)
+			}
+			verifier := svc.NewChainVerifier(cfg.Auditlog.HashChain.Key)
+			for _, file := range files {
+				if err := verifyFile(verifier, file); err != nil {
+					return cli.Exit(err.Error(), 1)
+				}
+			}
+
+			statePath := cfg.Auditlog.HashChain.StatePath
+			if c.IsSet("state") {
+				statePath = c.String("state")
+			}
+			if statePath != "" {
+				seq, hash, err := svc.ChainHead(statePath)
+				switch {
+				case errors.Is(err, os.ErrNotExist):
+					fmt.Printf("state file '%s' not found, records missing at the end cannot be detected\n", statePath)
+				case err != nil:
+					return cli.Exit(err.Error(), 1)
+				default:
+					verifier.VerifyHead(seq, hash)
+				}
+			}
+			result := verifier.Result
+
+			for _, v := range result.Violations {
+				if v.Line > 0 {
+					fmt.Printf("%s:%d: record %d: %s\n", v.File, v.Line, v.Seq, v.Message)
+				} else {
+					fmt.Println(v.Message)
+				}
+			}
+
+			fmt.Printf("verified %d records from %d to %d, %d violations\n", result.Records, result.FirstSeq, result.LastSeq, len(result.Violations))
+			if result.FirstSeq > 1 {
+				fmt.Printf("the chain starts at record %d, older records were not verified\n", result.FirstSeq)
+			}
+			if len(result.Violations) > 0 {
+				return cli.Exit("the audit log was tampered with", 1)
+			}
+			return nil
+		},
+	}
+}
+
+// verifyFile verifies an audit log file, gzip compressed files are decompressed
+func verifyFile(verifier *svc.ChainVerifier, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		zr, err := gzip.NewReader(f)
+		if err != nil {
+			return err
+		}
+		defer zr.Close()
+		r = zr
+	}
+
+	return verifier.Verify(r, path)
+}
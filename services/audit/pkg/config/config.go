@@ -2,6 +2,7 @@ package config
 
 import (
 	"context"
+	"time"
 
 	"github.com/opencloud-eu/opencloud/pkg/shared"
 )
@@ -36,8 +37,52 @@ type Events struct {
 type Auditlog struct {
 	LogToConsole bool   `yaml:"log_to_console" env:"AUDIT_LOG_TO_CONSOLE" desc:"Logs to stdout if set to 'true'. Independent of the LOG_TO_FILE option." introductionVersion:"1.0.0"`
 	LogToFile    bool   `yaml:"log_to_file" env:"AUDIT_LOG_TO_FILE" desc:"Logs to file if set to 'true'. Independent of the LOG_TO_CONSOLE option." introductionVersion:"1.0.0"`
+	LogToSyslog  bool   `yaml:"log_to_syslog" env:"AUDIT_LOG_TO_SYSLOG" desc:"Logs to a syslog server if set to 'true'. See the AUDIT_SYSLOG_* options for the details." introductionVersion:"%%NEXT%%"`
+	LogToHTTP    bool   `yaml:"log_to_http" env:"AUDIT_LOG_TO_HTTP" desc:"Forwards the audit log to an HTTP endpoint like a SIEM if set to 'true'. See the AUDIT_HTTP_* options for the details." introductionVersion:"%%NEXT%%"`
 	FilePath     string `yaml:"filepath" env:"AUDIT_FILEPATH" desc:"Filepath of the logfile. Mandatory if LOG_TO_FILE is set to 'true'." introductionVersion:"1.0.0"`
-	Format       string `yaml:"format" env:"AUDIT_FORMAT" desc:"Log format. Supported values are '' (empty) and 'json'. Using 'json' is advised, '' (empty) renders the 'minimal' format. See the text description for more details." introductionVersion:"1.0.0"`
+	Format       string `yaml:"format" env:"AUDIT_FORMAT" desc:"Log format. Supported values are '' (empty), 'json', 'minimal', 'cef' and 'ocsf'. Using 'json' is advised, '' (empty) renders the 'minimal' format. 'cef' and 'ocsf' render the ArcSight Common Event Format and the Open Cybersecurity Schema Framework format understood by most SIEMs. See the text description for more details." introductionVersion:"1.0.0"`
+
+	FileRotation FileRotation `yaml:"file_rotation"`
+	Syslog       Syslog       `yaml:"syslog"`
+	HTTP         HTTP         `yaml:"http"`
+	HashChain    HashChain    `yaml:"hash_chain"`
+}
+
+// HashChain configures the tamper-evident hash chain of the audit log
+type HashChain struct {
+	Enabled   bool   `yaml:"enabled" env:"AUDIT_HASH_CHAIN_ENABLED" desc:"Wraps each audit event into a record carrying a sequence number, the hash of the previous record and its own hash. Use 'opencloud audit verify' to detect modified, missing and reordered records." introductionVersion:"%%NEXT%%"`
+	Key       string `yaml:"key" env:"AUDIT_HASH_CHAIN_KEY" desc:"The secret key used to compute the HMAC-SHA256 of the records. Mandatory if AUDIT_HASH_CHAIN_ENABLED is set to 'true'." introductionVersion:"%%NEXT%%"`
+	StatePath string `yaml:"state_path" env:"AUDIT_HASH_CHAIN_STATE_PATH" desc:"The file storing the sequence number and the hash of the last record to continue the chain after a restart. Defaults to the AUDIT_FILEPATH with the suffix '.chain'." introductionVersion:"%%NEXT%%"`
+}
+
+// FileRotation configures the rotation of the audit log file
+type FileRotation struct {
+	MaxSize    string        `yaml:"max_size" env:"AUDIT_FILE_ROTATION_MAX_SIZE" desc:"Rotates the audit log file when it exceeds the given size. Usable common abbreviations: [KB, KiB, MB, MiB, GB, GiB, TB, TiB, PB, PiB, EB, EiB], example: 100MB. No size-based rotation when empty or 0." introductionVersion:"%%NEXT%%"`
+	Interval   time.Duration `yaml:"interval" env:"AUDIT_FILE_ROTATION_INTERVAL" desc:"Rotates the audit log file after the given time, e.g. '24h'. No time-based rotation when set to 0. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	MaxBackups int           `yaml:"max_backups" env:"AUDIT_FILE_ROTATION_MAX_BACKUPS" desc:"The number of rotated audit log files to keep. 0 keeps all rotated files." introductionVersion:"%%NEXT%%"`
+	Compress   bool          `yaml:"compress" env:"AUDIT_FILE_ROTATION_COMPRESS" desc:"Compress rotated audit log files with gzip." introductionVersion:"%%NEXT%%"`
+}
+
+// Syslog configures the syslog output
+type Syslog struct {
+	Network              string `yaml:"network" env:"AUDIT_SYSLOG_NETWORK" desc:"The transport used to reach the syslog server. Supported values are 'udp', 'tcp' and 'tls'." introductionVersion:"%%NEXT%%"`
+	Address              string `yaml:"address" env:"AUDIT_SYSLOG_ADDRESS" desc:"The address of the syslog server in the format 'host:port'. Mandatory if AUDIT_LOG_TO_SYSLOG is set to 'true'." introductionVersion:"%%NEXT%%"`
+	Facility             string `yaml:"facility" env:"AUDIT_SYSLOG_FACILITY" desc:"The syslog facility of the audit messages, e.g. 'auth', 'authpriv' or 'local0' to 'local7'." introductionVersion:"%%NEXT%%"`
+	AppName              string `yaml:"app_name" env:"AUDIT_SYSLOG_APP_NAME" desc:"The APP-NAME of the syslog messages." introductionVersion:"%%NEXT%%"`
+	TLSInsecure          bool   `yaml:"tls_insecure" env:"OC_INSECURE;AUDIT_SYSLOG_TLS_INSECURE" desc:"Disables the verification of the syslog server certificate when using 'tls'." introductionVersion:"%%NEXT%%"`
+	TLSRootCACertificate string `yaml:"tls_root_ca_certificate" env:"AUDIT_SYSLOG_TLS_ROOT_CA_CERTIFICATE" desc:"The root CA certificate used to validate the syslog server certificate when using 'tls'." introductionVersion:"%%NEXT%%"`
+}
+
+// HTTP configures the forwarding of the audit log to an HTTP endpoint
+type HTTP struct {
+	Endpoint      string        `yaml:"endpoint" env:"AUDIT_HTTP_ENDPOINT" desc:"The URL the audit log is sent to with POST requests. Mandatory if AUDIT_LOG_TO_HTTP is set to 'true'." introductionVersion:"%%NEXT%%"`
+	Headers       []string      `yaml:"headers" env:"AUDIT_HTTP_HEADERS" desc:"A list of additional headers sent with each request in the format 'Name: value', e.g. 'Authorization: Splunk <token>'. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	BatchSize     int           `yaml:"batch_size" env:"AUDIT_HTTP_BATCH_SIZE" desc:"The maximum number of audit events sent with one request." introductionVersion:"%%NEXT%%"`
+	FlushInterval time.Duration `yaml:"flush_interval" env:"AUDIT_HTTP_FLUSH_INTERVAL" desc:"The time after which collected audit events are sent even if the batch is not full. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	Timeout       time.Duration `yaml:"timeout" env:"AUDIT_HTTP_TIMEOUT" desc:"The timeout of a single request. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	MaxRetries    int           `yaml:"max_retries" env:"AUDIT_HTTP_MAX_RETRIES" desc:"The number of retries with an exponential backoff before a batch is moved to the buffer directory." introductionVersion:"%%NEXT%%"`
+	BufferPath    string        `yaml:"buffer_path" env:"AUDIT_HTTP_BUFFER_PATH" desc:"A directory where batches are stored which could not be delivered. They are sent again once the endpoint is reachable. Undeliverable batches are dropped when empty." introductionVersion:"%%NEXT%%"`
+	Insecure      bool          `yaml:"insecure" env:"OC_INSECURE;AUDIT_HTTP_INSECURE" desc:"Disables the verification of the endpoint certificate." introductionVersion:"%%NEXT%%"`
 }
 
 // Tracing defines the available tracing configuration.
@@ -1,6 +1,8 @@
 package defaults
 
 import (
+	"time"
+
 	"github.com/opencloud-eu/opencloud/services/audit/pkg/config"
 )
 
@@ -31,6 +33,17 @@ func DefaultConfig() *config.Config {
 		Auditlog: config.Auditlog{
 			LogToConsole: true,
 			Format:       "json",
+			Syslog: config.Syslog{
+				Network:  "udp",
+				Facility: "local0",
+				AppName:  "opencloud-audit",
+			},
+			HTTP: config.HTTP{
+				BatchSize:     100,
+				FlushInterval: 5 * time.Second,
+				Timeout:       10 * time.Second,
+				MaxRetries:    3,
+			},
 		},
 	}
 }
@@ -52,5 +65,7 @@ func EnsureDefaults(cfg *config.Config) {
 
 // Sanitize sanitized the configuration
 func Sanitize(cfg *config.Config) {
-	// sanitize config
+	if cfg.Auditlog.HashChain.StatePath == "" && cfg.Auditlog.FilePath != "" {
+		cfg.Auditlog.HashChain.StatePath = cfg.Auditlog.FilePath + ".chain"
+	}
 }
@@ -2,6 +2,7 @@ package parser
 
 import (
 	"errors"
+	"fmt"
 
 	occfg "github.com/opencloud-eu/opencloud/pkg/config"
 	"github.com/opencloud-eu/opencloud/services/audit/pkg/config"
@@ -34,5 +35,8 @@ func ParseConfig(cfg *config.Config) error {
 
 // Validate validates the configuration
 func Validate(cfg *config.Config) error {
+	if cfg.Auditlog.HashChain.Enabled && cfg.Auditlog.HashChain.Key == "" {
+		return fmt.Errorf("the hash chain of the %s service needs a key, set AUDIT_HASH_CHAIN_KEY", cfg.Service.Name)
+	}
 	return nil
 }
@@ -0,0 +1,133 @@
+package svc
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/opencloud-eu/opencloud/pkg/version"
+	"github.com/opencloud-eu/opencloud/services/audit/pkg/types"
+)
+
+// the fields of the basic audit event, all other fields depend on the event type
+var _baseFields = []string{"RemoteAddr", "User", "URL", "Method", "UserAgent", "Time", "App", "Message", "Action", "CLI", "Level"}
+
+// _cefExtensions maps audit event fields to CEF extension keys. Fields without
+// a matching key are added with the 'opencloud' prefix.
+var _cefExtensions = map[string]string{
+	"RemoteAddr": "src",
+	"User":       "suser",
+	"URL":        "request",
+	"Method":     "requestMethod",
+	"UserAgent":  "requestClientApplication",
+	"Action":     "act",
+	"FileID":     "fileId",
+	"Path":       "filePath",
+	"Filename":   "fname",
+	"Owner":      "duser",
+}
+
+// _cefSeverity overrides the CEF severity of an action, the default is 3
+var _cefSeverity = map[string]int{
+	types.ActionFileInfected:           8,
+	types.ActionUserSignInFailed:       5,
+	types.ActionLockedOut:              7,
+	types.ActionRoleAssigned:           5,
+	types.ActionRoleUnassigned:         5,
+	types.ActionRoleCreated:            5,
+	types.ActionRoleUpdated:            5,
+	types.ActionRoleDeleted:            5,
+	types.ActionPolicyDenied:           4,
+	types.ActionUserDeleted:            5,
+	types.ActionGroupDeleted:           5,
+	types.ActionSpaceDeleted:           5,
+	types.ActionFilePurged:             5,
+	types.ActionSharePermissionUpdated: 4,
+}
+
+// MarshalCEF renders an audit event in the ArcSight Common Event Format
+func MarshalCEF(ev interface{}) ([]byte, error) {
+	m, err := eventFields(ev)
+	if err != nil {
+		return nil, err
+	}
+
+	action := fmt.Sprint(m["Action"])
+	severity, ok := _cefSeverity[action]
+	if !ok {
+		severity = 3
+	}
+
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "CEF:0|OpenCloud|OpenCloud|%s|%s|%s|%d|",
+		cefHeaderEscape(version.GetString()), cefHeaderEscape(action), cefHeaderEscape(fmt.Sprint(m["Message"])), severity)
+
+	ext := []string{fmt.Sprintf("rt=%d", eventTime(m).UnixMilli())}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		v := fieldString(m[k])
+		if v == "" || k == "Time" || k == "Message" || k == "App" || k == "Level" {
+			continue
+		}
+		key, ok := _cefExtensions[k]
+		if !ok {
+			key = "opencloud" + k
+		}
+		ext = append(ext, key+"="+cefExtensionEscape(v))
+	}
+	b.WriteString(strings.Join(ext, " "))
+
+	return []byte(b.String()), nil
+}
+
+// eventFields returns the fields of an audit event
+func eventFields(ev interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(ev)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]interface{})
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// eventTime returns the time of an audit event, or now if the event has no valid time
+func eventTime(m map[string]interface{}) time.Time {
+	s, _ := m["Time"].(string)
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Now()
+	}
+	return t
+}
+
+func fieldString(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool, float64:
+		return fmt.Sprint(v)
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
+
+func cefHeaderEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\r", " ", "\n", " ").Replace(s)
+}
+
+func cefExtensionEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\r", `\r`, "\n", `\n`).Replace(s)
+}
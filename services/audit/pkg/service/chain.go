@@ -0,0 +1,340 @@
+package svc
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+)
+
+// ChainRecord is a record of the hash-chained audit log. The hash covers the sequence number,
+// the hash of the previous record and the event, which makes modifications, removed and reordered
+// records detectable.
+type ChainRecord struct {
+	Seq   uint64          `json:"seq"`
+	Prev  string          `json:"prev"`
+	Event json.RawMessage `json:"event"`
+	Hash  string          `json:"hash"`
+}
+
+// chainState is the position of the chain persisted across restarts
+type chainState struct {
+	Seq  uint64 `json:"seq"`
+	Hash string `json:"hash"`
+}
+
+// chainStateInterval is the interval the state file is written at. Syncing it for every record
+// would limit the audit log to the number of syncs the disk can do per second.
+var chainStateInterval = time.Second
+
+// HashChain chains the audit events. The sequence number and the hash of the last record are
+// stored in the state file to continue the chain after a restart.
+type HashChain struct {
+	key       []byte
+	statePath string
+	log       log.Logger
+
+	mu     sync.Mutex
+	state  chainState
+	logged chainState
+
+	// stored is only accessed by flush
+	stored  chainState
+	stop    chan struct{}
+	stopped chan struct{}
+	once    sync.Once
+}
+
+// NewHashChain returns a HashChain continuing the chain stored in the state file. The records
+// are chained with HMAC-SHA256, a plain hash could be recomputed by everyone editing the log.
+// The HashChain has to be closed to store the position of the last records.
+func NewHashChain(key string, statePath string, log log.Logger) (*HashChain, error) {
+	if key == "" {
+		return nil, errors.New("the hash chain needs a key")
+	}
+	if statePath == "" {
+		return nil, errors.New("the hash chain needs a state file")
+	}
+
+	c := &HashChain{key: []byte(key), statePath: statePath, log: log, stop: make(chan struct{}), stopped: make(chan struct{})}
+	seq, hash, err := ChainHead(statePath)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return nil, err
+	default:
+		c.state = chainState{Seq: seq, Hash: hash}
+		c.logged, c.stored = c.state, c.state
+	}
+
+	go c.run()
+	return c, nil
+}
+
+// Marshaller returns a Marshaller wrapping the events marshalled by m into chained records.
+// The position of the chain is only persisted by Persist once the records were logged.
+func (c *HashChain) Marshaller(m Marshaller) Marshaller {
+	return func(ev interface{}) ([]byte, error) {
+		b, err := m(ev)
+		if err != nil {
+			return nil, err
+		}
+		event, err := chainEvent(b)
+		if err != nil {
+			return nil, err
+		}
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		record := ChainRecord{Seq: c.state.Seq + 1, Prev: c.state.Hash, Event: event}
+		record.Hash = chainHash(c.key, record)
+		out, err := json.Marshal(record)
+		if err != nil {
+			return nil, err
+		}
+
+		c.state = chainState{Seq: record.Seq, Hash: record.Hash}
+		return out, nil
+	}
+}
+
+// Persist remembers the position of a logged record, it is written to the state file periodically.
+// It has to be the last Log, so the state file never points beyond the records written by the other
+// sinks. After a crash the state file may lag behind the log, which verify doesn't report.
+func (c *HashChain) Persist(content []byte) {
+	record := ChainRecord{}
+	if err := json.Unmarshal(content, &record); err != nil {
+		c.log.Error().Err(err).Msg("error reading the hash chain record")
+		return
+	}
+	c.mu.Lock()
+	c.logged = chainState{Seq: record.Seq, Hash: record.Hash}
+	c.mu.Unlock()
+}
+
+// Close stops the periodic writes and stores the position of the last logged record
+func (c *HashChain) Close() error {
+	c.once.Do(func() { close(c.stop) })
+	<-c.stopped
+	return c.flush()
+}
+
+func (c *HashChain) run() {
+	defer close(c.stopped)
+	t := time.NewTicker(chainStateInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-c.stop:
+			return
+		case <-t.C:
+			if err := c.flush(); err != nil {
+				c.log.Error().Err(err).Str("path", c.statePath).Msg("error storing the hash chain state")
+			}
+		}
+	}
+}
+
+// flush writes the position of the last logged record to the state file if it changed
+func (c *HashChain) flush() error {
+	c.mu.Lock()
+	logged := c.logged
+	c.mu.Unlock()
+
+	if logged == c.stored {
+		return nil
+	}
+	if err := writeChainState(c.statePath, logged); err != nil {
+		return err
+	}
+	c.stored = logged
+	return nil
+}
+
+// ChainHead returns the sequence number and the hash of the last record stored in the state file
+func ChainHead(statePath string) (uint64, string, error) {
+	b, err := os.ReadFile(statePath)
+	if err != nil {
+		return 0, "", err
+	}
+	state := chainState{}
+	if err := json.Unmarshal(b, &state); err != nil {
+		return 0, "", fmt.Errorf("invalid hash chain state '%s': %w", statePath, err)
+	}
+	return state.Seq, state.Hash, nil
+}
+
+// ChainViolation describes a record breaking the chain
+type ChainViolation struct {
+	// File and Line locate the record, they are empty for violations not related to a single record
+	File    string
+	Line    int
+	Seq     uint64
+	Message string
+}
+
+// ChainVerification is the result of a verification
+type ChainVerification struct {
+	Records    int
+	FirstSeq   uint64
+	LastSeq    uint64
+	Violations []ChainViolation
+}
+
+// ChainVerifier checks that records form an unbroken chain. The first record is trusted as the
+// start of the chain, e.g. when the older records were rotated away.
+type ChainVerifier struct {
+	key  []byte
+	prev *ChainRecord
+
+	Result ChainVerification
+}
+
+// NewChainVerifier returns a ChainVerifier for records chained with the given key
+func NewChainVerifier(key string) *ChainVerifier {
+	return &ChainVerifier{key: []byte(key)}
+}
+
+// Verify reads the records of a file. The chain continues across several calls, so rotated files
+// have to be verified from the oldest to the newest one.
+func (v *ChainVerifier) Verify(r io.Reader, file string) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		record := ChainRecord{}
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil || record.Seq == 0 || record.Hash == "" {
+			v.Result.Violations = append(v.Result.Violations, ChainViolation{File: file, Line: line, Message: "not a hash chain record"})
+			continue
+		}
+		v.Result.Records++
+		if v.Result.FirstSeq == 0 {
+			v.Result.FirstSeq = record.Seq
+		}
+
+		violation := func(format string, args ...interface{}) {
+			v.Result.Violations = append(v.Result.Violations, ChainViolation{File: file, Line: line, Seq: record.Seq, Message: fmt.Sprintf(format, args...)})
+		}
+
+		if event, err := chainEvent(record.Event); err != nil || chainHash(v.key, ChainRecord{Seq: record.Seq, Prev: record.Prev, Event: event}) != record.Hash {
+			violation("record was modified, the hash does not match")
+		}
+
+		switch {
+		case v.prev == nil:
+		case record.Seq <= v.prev.Seq:
+			// keep following the chain from the highest record seen
+			violation("record is out of order, it follows record %d", v.prev.Seq)
+			continue
+		case record.Seq > v.prev.Seq+1:
+			violation("records %d to %d are missing", v.prev.Seq+1, record.Seq-1)
+		case record.Prev != v.prev.Hash:
+			violation("record does not follow record %d, the previous hash does not match", v.prev.Seq)
+		}
+		v.prev = &record
+		v.Result.LastSeq = record.Seq
+	}
+
+	return scanner.Err()
+}
+
+// VerifyHead checks that the chain ends with the record stored in the state file. Records removed
+// from the end of the log can't be detected otherwise.
+func (v *ChainVerifier) VerifyHead(seq uint64, hash string) {
+	switch {
+	case seq > v.Result.LastSeq:
+		v.Result.Violations = append(v.Result.Violations, ChainViolation{
+			Seq:     seq,
+			Message: fmt.Sprintf("records %d to %d at the end are missing", v.Result.LastSeq+1, seq),
+		})
+	case seq == v.Result.LastSeq && hash != v.LastHash():
+		v.Result.Violations = append(v.Result.Violations, ChainViolation{
+			Seq:     seq,
+			Message: fmt.Sprintf("record %d does not match the state file", seq),
+		})
+	}
+}
+
+// LastHash returns the hash of the last record in the chain
+func (v *ChainVerifier) LastHash() string {
+	if v.prev == nil {
+		return ""
+	}
+	return v.prev.Hash
+}
+
+// chainEvent returns the compacted JSON of the event, events in other formats are encoded as JSON string
+func chainEvent(b []byte) (json.RawMessage, error) {
+	buf := &bytes.Buffer{}
+	if json.Valid(b) {
+		if err := json.Compact(buf, b); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+	return json.Marshal(string(b))
+}
+
+func chainHash(key []byte, record ChainRecord) string {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(strconv.FormatUint(record.Seq, 10)))
+	h.Write([]byte{'\n'})
+	h.Write([]byte(record.Prev))
+	h.Write([]byte{'\n'})
+	h.Write(record.Event)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// writeChainState replaces the state file atomically and durably
+func writeChainState(path string, state chainState) error {
+	b, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(b)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return err
+	}
+
+	// persist the rename
+	dir, err := os.Open(filepath.Dir(path))
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+	return dir.Sync()
+}
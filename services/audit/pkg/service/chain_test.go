@@ -0,0 +1,146 @@
+package svc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/audit/pkg/types"
+)
+
+func chainedLog(t *testing.T, key string, statePath string, user string, n int) []string {
+	chain, err := NewHashChain(key, statePath, log.NopLogger())
+	require.NoError(t, err)
+	marshal := chain.Marshaller(json.Marshal)
+
+	lines := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		b, err := marshal(types.BasicAuditEvent(user, "", "message", types.ActionFileRead))
+		require.NoError(t, err)
+		chain.Persist(b)
+		lines = append(lines, string(b))
+	}
+	require.NoError(t, chain.Close())
+	return lines
+}
+
+func verify(t *testing.T, key string, lines ...string) ChainVerification {
+	v := NewChainVerifier(key)
+	require.NoError(t, v.Verify(strings.NewReader(strings.Join(lines, "\n")+"\n"), "audit.log"))
+	return v.Result
+}
+
+func TestHashChain(t *testing.T) {
+	statePath := filepath.Join(t.TempDir(), "audit.log.chain")
+	lines := chainedLog(t, "secret", statePath, "user", 3)
+
+	record := ChainRecord{}
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &record))
+	require.EqualValues(t, 2, record.Seq)
+	require.Contains(t, string(record.Event), `"Action":"file_read"`)
+
+	t.Run("continues the chain after a restart", func(t *testing.T) {
+		lines := append(lines, chainedLog(t, "secret", statePath, "user", 2)...)
+		result := verify(t, "secret", lines...)
+		require.Empty(t, result.Violations)
+		require.Equal(t, 5, result.Records)
+		require.EqualValues(t, 5, result.LastSeq)
+
+		seq, hash, err := ChainHead(statePath)
+		require.NoError(t, err)
+		require.EqualValues(t, 5, seq)
+		require.NoError(t, json.Unmarshal([]byte(lines[4]), &record))
+		require.Equal(t, record.Hash, hash)
+	})
+
+	t.Run("persists the state only for logged records", func(t *testing.T) {
+		statePath := filepath.Join(t.TempDir(), "audit.log.chain")
+		chain, err := NewHashChain("secret", statePath, log.NopLogger())
+		require.NoError(t, err)
+		_, err = chain.Marshaller(json.Marshal)(types.BasicAuditEvent("user", "", "message", types.ActionFileRead))
+		require.NoError(t, err)
+		require.NoError(t, chain.Close())
+		_, _, err = ChainHead(statePath)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	t.Run("writes the state periodically", func(t *testing.T) {
+		statePath := filepath.Join(t.TempDir(), "audit.log.chain")
+		chain, err := NewHashChain("secret", statePath, log.NopLogger())
+		require.NoError(t, err)
+		defer chain.Close()
+
+		b, err := chain.Marshaller(json.Marshal)(types.BasicAuditEvent("user", "", "message", types.ActionFileRead))
+		require.NoError(t, err)
+		chain.Persist(b)
+		require.Eventually(t, func() bool {
+			seq, _, err := ChainHead(statePath)
+			return err == nil && seq == 1
+		}, 5*chainStateInterval, chainStateInterval/10)
+	})
+
+	t.Run("needs a key", func(t *testing.T) {
+		_, err := NewHashChain("", filepath.Join(t.TempDir(), "audit.log.chain"), log.NopLogger())
+		require.Error(t, err)
+	})
+
+	t.Run("detects records removed from the end", func(t *testing.T) {
+		seq, hash, err := ChainHead(statePath)
+		require.NoError(t, err)
+
+		v := NewChainVerifier("secret")
+		require.NoError(t, v.Verify(strings.NewReader(strings.Join(lines[:2], "\n")), "audit.log"))
+		v.VerifyHead(seq, hash)
+		require.Len(t, v.Result.Violations, 1)
+		require.Equal(t, fmt.Sprintf("records 3 to %d at the end are missing", seq), v.Result.Violations[0].Message)
+	})
+
+	t.Run("detects modifications", func(t *testing.T) {
+		modified := strings.Replace(lines[1], `"User":"user"`, `"User":"admin"`, 1)
+		result := verify(t, "secret", lines[0], modified, lines[2])
+		require.Len(t, result.Violations, 1)
+		require.Equal(t, 2, result.Violations[0].Line)
+		require.Contains(t, result.Violations[0].Message, "modified")
+	})
+
+	t.Run("detects a recomputed hash with the wrong key", func(t *testing.T) {
+		result := verify(t, "other", lines...)
+		require.Len(t, result.Violations, 3)
+	})
+
+	t.Run("detects gaps", func(t *testing.T) {
+		result := verify(t, "secret", lines[0], lines[2])
+		require.Len(t, result.Violations, 1)
+		require.Equal(t, "records 2 to 2 are missing", result.Violations[0].Message)
+	})
+
+	t.Run("detects reordering", func(t *testing.T) {
+		result := verify(t, "secret", lines[0], lines[2], lines[1])
+		require.Len(t, result.Violations, 2)
+		require.Contains(t, result.Violations[1].Message, "out of order")
+	})
+
+	t.Run("detects replaced records", func(t *testing.T) {
+		other := chainedLog(t, "secret", filepath.Join(t.TempDir(), "other.chain"), "admin", 2)
+		result := verify(t, "secret", lines[0], other[1], lines[2])
+		require.Len(t, result.Violations, 2)
+		require.Contains(t, result.Violations[0].Message, "previous hash does not match")
+	})
+
+	t.Run("wraps other formats", func(t *testing.T) {
+		chain, err := NewHashChain("secret", filepath.Join(t.TempDir(), "minimal.chain"), log.NopLogger())
+		require.NoError(t, err)
+		defer chain.Close()
+		b, err := chain.Marshaller(func(interface{}) ([]byte, error) { return []byte("file_read)\n   message"), nil })(nil)
+		require.NoError(t, err)
+		require.False(t, bytes.Contains(b, []byte("\n")))
+		require.Empty(t, verify(t, "secret", string(b)).Violations)
+	})
+}
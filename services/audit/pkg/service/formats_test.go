@@ -0,0 +1,71 @@
+package svc
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opencloud-eu/opencloud/services/audit/pkg/types"
+)
+
+func testFileEvent() types.AuditEventFileRenamed {
+	return types.AuditEventFileRenamed{
+		AuditEventFiles: types.AuditEventFiles{
+			AuditEvent: types.BasicAuditEvent("user-id", "2024-12-24T10:00:00Z", "user 'user-id' renamed file 'a=b|c' to 'd'", types.ActionFileRenamed),
+			Path:       "/a=b|c",
+			FileID:     "file-id",
+			Owner:      "owner-id",
+		},
+		OldPath: "/old\nname",
+	}
+}
+
+func TestMarshalCEF(t *testing.T) {
+	b, err := MarshalCEF(testFileEvent())
+	require.NoError(t, err)
+
+	header, ext, ok := strings.Cut(string(b), "|3|")
+	require.True(t, ok)
+	require.True(t, strings.HasPrefix(header, "CEF:0|OpenCloud|OpenCloud|"))
+	require.True(t, strings.HasSuffix(header, `|file_rename|user 'user-id' renamed file 'a=b\|c' to 'd'`))
+
+	require.Contains(t, ext, "rt=1735034400000")
+	require.Contains(t, ext, "suser=user-id")
+	require.Contains(t, ext, "act=file_rename")
+	require.Contains(t, ext, "fileId=file-id")
+	require.Contains(t, ext, `filePath=/a\=b|c`)
+	require.Contains(t, ext, "duser=owner-id")
+	require.Contains(t, ext, `opencloudOldPath=/old\nname`)
+	require.NotContains(t, ext, "src=", "empty fields must be omitted")
+}
+
+func TestMarshalOCSF(t *testing.T) {
+	b, err := MarshalOCSF(testFileEvent())
+	require.NoError(t, err)
+
+	m := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(b, &m))
+	require.EqualValues(t, 1001, m["class_uid"])
+	require.EqualValues(t, 5, m["activity_id"])
+	require.EqualValues(t, 100105, m["type_uid"])
+	require.EqualValues(t, 1735034400000, m["time"])
+	require.Equal(t, "user 'user-id' renamed file 'a=b|c' to 'd'", m["message"])
+	require.Equal(t, "user-id", m["actor"].(map[string]interface{})["user"].(map[string]interface{})["uid"])
+	require.Equal(t, "file-id", m["file"].(map[string]interface{})["uid"])
+	require.Equal(t, "/old\nname", m["unmapped"].(map[string]interface{})["OldPath"])
+	require.NotContains(t, m, "src_endpoint")
+
+	b, err = MarshalOCSF(types.BasicAuditEvent("user-id", "", "msg", "unknown_action"))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(b, &m))
+	require.EqualValues(t, 6003, m["class_uid"])
+	require.EqualValues(t, 99, m["activity_id"])
+
+	b, err = MarshalOCSF(types.BasicAuditEvent("", "", "msg", types.ActionUserSignInFailed))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(b, &m))
+	require.EqualValues(t, 3002, m["class_uid"])
+	require.EqualValues(t, 2, m["status_id"])
+}
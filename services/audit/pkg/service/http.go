@@ -0,0 +1,308 @@
+package svc
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/audit/pkg/config"
+)
+
+const (
+	// eventQueueSize is the number of events the sink queues while a batch is collected
+	eventQueueSize = 1000
+	// batchQueueSize is the number of batches the sink queues while a batch is sent
+	batchQueueSize = 10
+)
+
+// HTTPSink forwards audit events in batches to an HTTP endpoint like a SIEM. Each batch is sent as a POST
+// request with one event per line. Failed requests are retried with an exponential backoff. Batches which
+// still could not be delivered are stored in the buffer directory and sent again before any newer batch.
+// Logging never blocks, if the endpoint can't keep up the sink moves the batches to the buffer directory
+// or, without one, drops the events.
+type HTTPSink struct {
+	endpoint      string
+	header        http.Header
+	batchSize     int
+	flushInterval time.Duration
+	maxRetries    int
+	bufferPath    string
+	client        *http.Client
+	log           log.Logger
+
+	// backoff is the wait time before the first retry, it doubles with every retry
+	backoff time.Duration
+	// dropped counts the events dropped since they were last reported
+	dropped atomic.Int64
+
+	events  chan []byte
+	batches chan batch
+	closing chan struct{}
+	done    chan struct{}
+}
+
+// batch is a collected batch of events
+type batch struct {
+	created time.Time
+	body    []byte
+	events  int
+}
+
+// NewHTTPSink returns an HTTPSink sending batches with the given content type. It has to be closed to send the last batch.
+func NewHTTPSink(cfg config.HTTP, contentType string, log log.Logger) (*HTTPSink, error) {
+	if cfg.Endpoint == "" {
+		return nil, errors.New("the http endpoint is missing")
+	}
+
+	header := http.Header{}
+	header.Set("Content-Type", contentType)
+	for _, h := range cfg.Headers {
+		name, value, ok := strings.Cut(h, ":")
+		if !ok || strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("invalid http header '%s', use the format 'Name: value'", h)
+		}
+		header.Set(strings.TrimSpace(name), strings.TrimSpace(value))
+	}
+
+	if cfg.BufferPath != "" {
+		if err := os.MkdirAll(cfg.BufferPath, 0700); err != nil {
+			return nil, err
+		}
+	}
+
+	batchSize := max(cfg.BatchSize, 1)
+	s := &HTTPSink{
+		endpoint:      cfg.Endpoint,
+		header:        header,
+		batchSize:     batchSize,
+		flushInterval: cfg.FlushInterval,
+		maxRetries:    cfg.MaxRetries,
+		bufferPath:    cfg.BufferPath,
+		client: &http.Client{
+			Timeout: cfg.Timeout,
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: cfg.Insecure}, //nolint:gosec
+			},
+		},
+		log:     log,
+		backoff: time.Second,
+		events:  make(chan []byte, max(batchSize, eventQueueSize)),
+		batches: make(chan batch, batchQueueSize),
+		closing: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+
+	go s.collect()
+	go s.deliver()
+	return s, nil
+}
+
+// Log adds the content to the current batch. If the queue is full, the content is dropped.
+func (s *HTTPSink) Log(content []byte) {
+	select {
+	case s.events <- bytes.Clone(content):
+	default:
+		s.dropped.Add(1)
+	}
+}
+
+// Close sends the current batch and stops the sink. Pending retries are given up and their batches buffered.
+func (s *HTTPSink) Close() error {
+	close(s.closing)
+	close(s.events)
+	<-s.done
+	s.reportDropped()
+	return nil
+}
+
+func (s *HTTPSink) interval() time.Duration {
+	if s.flushInterval <= 0 {
+		return time.Second
+	}
+	return s.flushInterval
+}
+
+// collect collects the events into batches and hands them over to deliver
+func (s *HTTPSink) collect() {
+	defer close(s.batches)
+
+	ticker := time.NewTicker(s.interval())
+	defer ticker.Stop()
+
+	events := make([][]byte, 0, s.batchSize)
+	for {
+		select {
+		case content, ok := <-s.events:
+			if !ok {
+				s.enqueue(events)
+				return
+			}
+			events = append(events, content)
+			if len(events) >= s.batchSize {
+				s.enqueue(events)
+				events = events[:0]
+			}
+		case <-ticker.C:
+			s.enqueue(events)
+			events = events[:0]
+			s.reportDropped()
+		}
+	}
+}
+
+// enqueue hands the events over to deliver. If deliver can't keep up, the batch is buffered or dropped.
+func (s *HTTPSink) enqueue(events [][]byte) {
+	if len(events) == 0 {
+		return
+	}
+
+	b := batch{created: time.Now(), body: joinBatch(events), events: len(events)}
+	select {
+	case s.batches <- b:
+	default:
+		if s.bufferPath == "" {
+			s.dropped.Add(int64(b.events))
+			return
+		}
+		s.buffer(b)
+	}
+}
+
+// deliver sends the collected batches until the sink is closed
+func (s *HTTPSink) deliver() {
+	defer close(s.done)
+
+	ticker := time.NewTicker(s.interval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case b, ok := <-s.batches:
+			if !ok {
+				return
+			}
+			s.flush(b)
+		case <-ticker.C:
+			// send the batches buffered while the queue was full
+			s.sendBuffered(time.Now())
+		}
+	}
+}
+
+// flush sends the batch. Older buffered batches are sent first to keep the order of the events.
+func (s *HTTPSink) flush(b batch) {
+	if !s.sendBuffered(b.created) {
+		s.buffer(b)
+		return
+	}
+
+	var err error
+	for attempt := 0; attempt <= s.maxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(s.backoff << (attempt - 1)):
+			case <-s.closing:
+				attempt = s.maxRetries
+			}
+		}
+		if err = s.send(b.body); err == nil {
+			return
+		}
+	}
+
+	s.log.Error().Err(err).Str("endpoint", s.endpoint).Int("events", b.events).Msg("error sending audit events")
+	s.buffer(b)
+}
+
+// sendBuffered sends the batches buffered before the given time from the oldest to the newest one.
+// It returns false if a batch could not be sent.
+func (s *HTTPSink) sendBuffered(before time.Time) bool {
+	if s.bufferPath == "" {
+		return true
+	}
+
+	files, err := filepath.Glob(filepath.Join(s.bufferPath, "*.batch"))
+	if err != nil {
+		s.log.Error().Err(err).Str("path", s.bufferPath).Msg("error listing buffered audit events")
+		return false
+	}
+	slices.Sort(files)
+
+	for _, file := range files {
+		if file > s.bufferFile(before) {
+			break
+		}
+		body, err := os.ReadFile(file)
+		if err != nil {
+			s.log.Error().Err(err).Str("file", file).Msg("error reading buffered audit events")
+			return false
+		}
+		if err := s.send(body); err != nil {
+			return false
+		}
+		if err := os.Remove(file); err != nil {
+			s.log.Error().Err(err).Str("file", file).Msg("error removing buffered audit events")
+			return false
+		}
+	}
+	return true
+}
+
+// buffer stores a batch which could not be sent. Without a buffer directory the batch is dropped.
+func (s *HTTPSink) buffer(b batch) {
+	if s.bufferPath == "" {
+		s.log.Error().Str("endpoint", s.endpoint).Int("events", b.events).Msg("dropping undeliverable audit events, no buffer configured")
+		return
+	}
+
+	file := s.bufferFile(b.created)
+	if err := os.WriteFile(file, b.body, 0600); err != nil {
+		s.log.Error().Err(err).Str("file", file).Msg("error buffering audit events, dropping them")
+	}
+}
+
+// bufferFile returns the name of the buffer file of a batch, it sorts the batches in the order they were created
+func (s *HTTPSink) bufferFile(created time.Time) string {
+	return filepath.Join(s.bufferPath, fmt.Sprintf("%020d.batch", created.UnixNano()))
+}
+
+// reportDropped logs the number of events dropped since the last report
+func (s *HTTPSink) reportDropped() {
+	if n := s.dropped.Swap(0); n > 0 {
+		s.log.Error().Str("endpoint", s.endpoint).Int64("events", n).Msg("dropped audit events, the endpoint can't keep up")
+	}
+}
+
+func (s *HTTPSink) send(body []byte) error {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, s.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header = s.header.Clone()
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("unexpected status '%s'", res.Status)
+	}
+	return nil
+}
+
+func joinBatch(batch [][]byte) []byte {
+	return append(bytes.Join(batch, []byte("\n")), '\n')
+}
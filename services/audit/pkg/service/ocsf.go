@@ -0,0 +1,179 @@
+package svc
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/opencloud-eu/opencloud/pkg/version"
+	"github.com/opencloud-eu/opencloud/services/audit/pkg/types"
+)
+
+const _ocsfVersion = "1.3.0"
+
+type ocsfClass struct {
+	uid          int
+	name         string
+	categoryUID  int
+	categoryName string
+}
+
+var (
+	_ocsfFileSystemActivity = ocsfClass{1001, "File System Activity", 1, "System Activity"}
+	_ocsfDetectionFinding   = ocsfClass{2004, "Detection Finding", 2, "Findings"}
+	_ocsfAccountChange      = ocsfClass{3001, "Account Change", 3, "Identity & Access Management"}
+	_ocsfAuthentication     = ocsfClass{3002, "Authentication", 3, "Identity & Access Management"}
+	_ocsfEntityManagement   = ocsfClass{3004, "Entity Management", 3, "Identity & Access Management"}
+	_ocsfUserAccess         = ocsfClass{3005, "User Access Management", 3, "Identity & Access Management"}
+	_ocsfGroupManagement    = ocsfClass{3006, "Group Management", 3, "Identity & Access Management"}
+	_ocsfAPIActivity        = ocsfClass{6003, "API Activity", 6, "Application Activity"}
+)
+
+type ocsfActivity struct {
+	class ocsfClass
+	id    int
+	name  string
+}
+
+// _ocsfActivities maps the audit actions to OCSF event classes and activities.
+// Unknown actions are reported as 'Other' API activity.
+var _ocsfActivities = map[string]ocsfActivity{
+	types.ActionContainerCreated:    {_ocsfFileSystemActivity, 1, "Create"},
+	types.ActionFileCreated:         {_ocsfFileSystemActivity, 1, "Create"},
+	types.ActionFileRead:            {_ocsfFileSystemActivity, 2, "Read"},
+	types.ActionLinkAccessed:        {_ocsfFileSystemActivity, 2, "Read"},
+	types.ActionFileRestored:        {_ocsfFileSystemActivity, 3, "Update"},
+	types.ActionFileVersionRestored: {_ocsfFileSystemActivity, 3, "Update"},
+	types.ActionFileTrashed:         {_ocsfFileSystemActivity, 4, "Delete"},
+	types.ActionFilePurged:          {_ocsfFileSystemActivity, 4, "Delete"},
+	types.ActionFileRenamed:         {_ocsfFileSystemActivity, 5, "Rename"},
+
+	types.ActionShareCreated:            {_ocsfFileSystemActivity, 7, "Set Security"},
+	types.ActionSharePermissionUpdated:  {_ocsfFileSystemActivity, 7, "Set Security"},
+	types.ActionShareDisplayNameUpdated: {_ocsfFileSystemActivity, 7, "Set Security"},
+	types.ActionSharePasswordUpdated:    {_ocsfFileSystemActivity, 7, "Set Security"},
+	types.ActionShareExpirationUpdated:  {_ocsfFileSystemActivity, 7, "Set Security"},
+	types.ActionShareRemoved:            {_ocsfFileSystemActivity, 7, "Set Security"},
+
+	types.ActionSpaceCreated:  {_ocsfEntityManagement, 1, "Create"},
+	types.ActionSpaceRenamed:  {_ocsfEntityManagement, 3, "Update"},
+	types.ActionSpaceUpdated:  {_ocsfEntityManagement, 3, "Update"},
+	types.ActionSpaceShared:   {_ocsfEntityManagement, 3, "Update"},
+	types.ActionSpaceUnshared: {_ocsfEntityManagement, 3, "Update"},
+	types.ActionSpaceDeleted:  {_ocsfEntityManagement, 4, "Delete"},
+	types.ActionSpaceEnabled:  {_ocsfEntityManagement, 6, "Enable"},
+	types.ActionSpaceDisabled: {_ocsfEntityManagement, 7, "Disable"},
+
+	types.ActionUserCreated:     {_ocsfAccountChange, 1, "Create"},
+	types.ActionPasswordChanged: {_ocsfAccountChange, 3, "Password Change"},
+	types.ActionUserDeleted:     {_ocsfAccountChange, 6, "Delete"},
+	types.ActionLockedOut:       {_ocsfAccountChange, 9, "Lock"},
+
+	types.ActionUserSignedIn:     {_ocsfAuthentication, 1, "Logon"},
+	types.ActionUserSignInFailed: {_ocsfAuthentication, 1, "Logon"},
+	types.ActionUserSignedOut:    {_ocsfAuthentication, 2, "Logoff"},
+
+	types.ActionRoleAssigned:   {_ocsfUserAccess, 1, "Assign Privileges"},
+	types.ActionRoleUnassigned: {_ocsfUserAccess, 2, "Revoke Privileges"},
+	types.ActionRoleCreated:    {_ocsfEntityManagement, 1, "Create"},
+	types.ActionRoleUpdated:    {_ocsfEntityManagement, 3, "Update"},
+	types.ActionRoleDeleted:    {_ocsfEntityManagement, 4, "Delete"},
+
+	types.ActionAppTokenCreated:      {_ocsfAPIActivity, 1, "Create"},
+	types.ActionPersonalDataExported: {_ocsfAPIActivity, 2, "Read"},
+	types.ActionAppTokenDeleted:      {_ocsfAPIActivity, 4, "Delete"},
+
+	types.ActionGroupMemberAdded:   {_ocsfGroupManagement, 3, "Add User"},
+	types.ActionGroupMemberRemoved: {_ocsfGroupManagement, 4, "Remove User"},
+	types.ActionGroupDeleted:       {_ocsfGroupManagement, 5, "Delete"},
+	types.ActionGroupCreated:       {_ocsfGroupManagement, 6, "Create"},
+
+	types.ActionFileInfected: {_ocsfDetectionFinding, 1, "Create"},
+}
+
+// _ocsfFailures are the actions reported with the 'Failure' status
+var _ocsfFailures = []string{types.ActionUserSignInFailed, types.ActionPolicyDenied}
+
+// MarshalOCSF renders an audit event in the Open Cybersecurity Schema Framework format
+func MarshalOCSF(ev interface{}) ([]byte, error) {
+	m, err := eventFields(ev)
+	if err != nil {
+		return nil, err
+	}
+
+	action := fmt.Sprint(m["Action"])
+	activity, ok := _ocsfActivities[action]
+	if !ok {
+		activity = ocsfActivity{_ocsfAPIActivity, 99, "Other"}
+	}
+
+	severityID, severity := 1, "Informational"
+	if action == types.ActionFileInfected {
+		severityID, severity = 4, "High"
+	}
+
+	statusID, status := 1, "Success"
+	if slices.Contains(_ocsfFailures, action) {
+		statusID, status = 2, "Failure"
+	}
+
+	out := map[string]interface{}{
+		"class_uid":     activity.class.uid,
+		"class_name":    activity.class.name,
+		"category_uid":  activity.class.categoryUID,
+		"category_name": activity.class.categoryName,
+		"activity_id":   activity.id,
+		"activity_name": activity.name,
+		"type_uid":      activity.class.uid*100 + activity.id,
+		"time":          eventTime(m).UnixMilli(),
+		"severity_id":   severityID,
+		"severity":      severity,
+		"status_id":     statusID,
+		"status":        status,
+		"message":       m["Message"],
+		"metadata": map[string]interface{}{
+			"version":    _ocsfVersion,
+			"log_name":   "audit",
+			"event_code": action,
+			"product": map[string]interface{}{
+				"name":        "OpenCloud",
+				"vendor_name": "OpenCloud",
+				"version":     version.GetString(),
+			},
+		},
+		"actor": map[string]interface{}{
+			"app_name": m["App"],
+			"user":     map[string]interface{}{"uid": m["User"]},
+		},
+	}
+
+	if ip := fieldString(m["RemoteAddr"]); ip != "" {
+		out["src_endpoint"] = map[string]interface{}{"ip": ip}
+	}
+	if url := fieldString(m["URL"]); url != "" {
+		out["http_request"] = map[string]interface{}{
+			"url":         map[string]interface{}{"url_string": url},
+			"http_method": m["Method"],
+			"user_agent":  m["UserAgent"],
+		}
+	}
+	if activity.class == _ocsfFileSystemActivity && fieldString(m["FileID"]) != "" {
+		out["file"] = map[string]interface{}{
+			"uid":  m["FileID"],
+			"path": m["Path"],
+			"type": "File",
+		}
+	}
+
+	unmapped := map[string]interface{}{}
+	for k, v := range m {
+		if !slices.Contains(_baseFields, k) {
+			unmapped[k] = v
+		}
+	}
+	if len(unmapped) > 0 {
+		out["unmapped"] = unmapped
+	}
+
+	return json.Marshal(out)
+}
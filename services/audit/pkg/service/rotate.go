@@ -0,0 +1,217 @@
+package svc
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opencloud-eu/reva/v2/pkg/bytesize"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/audit/pkg/config"
+)
+
+// the timestamp added to the name of rotated files, it sorts in chronological order
+const _rotationTimeFormat = "20060102T150405.000"
+
+// RotatingFile is a Log writing to a file which is rotated by size and time. Rotated files get the
+// rotation time added to their name and are optionally compressed. Only the newest backups are kept.
+type RotatingFile struct {
+	path       string
+	maxSize    int64
+	interval   time.Duration
+	maxBackups int
+	compress   bool
+	log        log.Logger
+
+	mu     sync.Mutex
+	file   *os.File
+	size   int64
+	opened time.Time
+	wg     sync.WaitGroup
+}
+
+// NewRotatingFile opens the file at path for appending
+func NewRotatingFile(path string, cfg config.FileRotation, log log.Logger) (*RotatingFile, error) {
+	f := &RotatingFile{
+		path:       path,
+		interval:   cfg.Interval,
+		maxBackups: cfg.MaxBackups,
+		compress:   cfg.Compress,
+		log:        log,
+	}
+
+	if cfg.MaxSize != "" {
+		b, err := bytesize.Parse(cfg.MaxSize)
+		if err != nil {
+			return nil, err
+		}
+		f.maxSize = int64(b.Bytes())
+	}
+
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Log appends the content as a line to the file, which is rotated first if necessary
+func (f *RotatingFile) Log(content []byte) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.needsRotation(int64(len(content)) + 1) {
+		if err := f.rotate(); err != nil {
+			f.log.Error().Err(err).Msgf("error rotating file '%s'", f.path)
+		}
+	}
+
+	if f.file == nil {
+		if err := f.open(); err != nil {
+			f.log.Error().Err(err).Msgf("error opening file '%s'", f.path)
+			return
+		}
+	}
+
+	n, err := fmt.Fprintln(f.file, string(content))
+	f.size += int64(n)
+	if err != nil {
+		f.log.Error().Err(err).Msgf("error writing to file '%s'", f.path)
+	}
+}
+
+// Close closes the file and waits for pending compressions
+func (f *RotatingFile) Close() error {
+	f.mu.Lock()
+	var err error
+	if f.file != nil {
+		err = f.file.Close()
+		f.file = nil
+	}
+	f.mu.Unlock()
+
+	// the compression needs the lock to remove old backups
+	f.wg.Wait()
+	return err
+}
+
+func (f *RotatingFile) needsRotation(n int64) bool {
+	if f.size == 0 {
+		return false
+	}
+	if f.maxSize > 0 && f.size+n > f.maxSize {
+		return true
+	}
+	return f.interval > 0 && time.Since(f.opened) >= f.interval
+}
+
+func (f *RotatingFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	f.file = file
+	f.size = info.Size()
+	f.opened = time.Now()
+	return nil
+}
+
+func (f *RotatingFile) rotate() error {
+	if f.file != nil {
+		if err := f.file.Close(); err != nil {
+			return err
+		}
+		f.file = nil
+	}
+
+	ext := filepath.Ext(f.path)
+	backup := fmt.Sprintf("%s-%s%s", strings.TrimSuffix(f.path, ext), time.Now().UTC().Format(_rotationTimeFormat), ext)
+	if err := os.Rename(f.path, backup); err != nil {
+		return err
+	}
+
+	if err := f.open(); err != nil {
+		return err
+	}
+
+	if !f.compress {
+		f.removeBackups()
+		return nil
+	}
+
+	// compress in the background to not block the audit log
+	f.wg.Add(1)
+	go func() {
+		defer f.wg.Done()
+		if err := compressFile(backup); err != nil {
+			f.log.Error().Err(err).Msgf("error compressing file '%s'", backup)
+		}
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.removeBackups()
+	}()
+	return nil
+}
+
+// removeBackups removes all but the newest backups
+func (f *RotatingFile) removeBackups() {
+	if f.maxBackups <= 0 {
+		return
+	}
+
+	ext := filepath.Ext(f.path)
+	backups, err := filepath.Glob(strings.TrimSuffix(f.path, ext) + "-*" + ext + "*")
+	if err != nil {
+		f.log.Error().Err(err).Msgf("error listing backups of file '%s'", f.path)
+		return
+	}
+
+	// the timestamp in the name sorts the backups from the oldest to the newest one
+	slices.Sort(backups)
+	for len(backups) > f.maxBackups {
+		if err := os.Remove(backups[0]); err != nil && !errors.Is(err, os.ErrNotExist) {
+			f.log.Error().Err(err).Msgf("error removing backup '%s'", backups[0])
+		}
+		backups = backups[1:]
+	}
+}
+
+func compressFile(path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(path+".gz", os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	zw := gzip.NewWriter(dst)
+	if _, err := io.Copy(zw, src); err != nil {
+		_ = dst.Close()
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		_ = dst.Close()
+		return err
+	}
+	if err := dst.Close(); err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
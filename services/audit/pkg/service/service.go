@@ -4,11 +4,18 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/opencloud-eu/opencloud/pkg/log"
+	avevent "github.com/opencloud-eu/opencloud/services/antivirus/pkg/event"
 	"github.com/opencloud-eu/opencloud/services/audit/pkg/config"
 	"github.com/opencloud-eu/opencloud/services/audit/pkg/types"
+	authappevent "github.com/opencloud-eu/opencloud/services/auth-app/pkg/event"
+	graphevent "github.com/opencloud-eu/opencloud/services/graph/pkg/event"
+	policiesevent "github.com/opencloud-eu/opencloud/services/policies/pkg/event"
+	proxyevent "github.com/opencloud-eu/opencloud/services/proxy/pkg/event"
+	settingsevent "github.com/opencloud-eu/opencloud/services/settings/pkg/event"
 	"github.com/opencloud-eu/reva/v2/pkg/events"
 )
 
@@ -19,19 +26,69 @@ type Log func([]byte)
 type Marshaller func(interface{}) ([]byte, error)
 
 // AuditLoggerFromConfig will start a new AuditLogger generated from the config
-func AuditLoggerFromConfig(ctx context.Context, cfg config.Auditlog, ch <-chan events.Event, log log.Logger) {
+func AuditLoggerFromConfig(ctx context.Context, cfg config.Auditlog, ch <-chan events.Event, log log.Logger) error {
 	var logs []Log
+	var closers []io.Closer
+	defer func() {
+		for _, c := range closers {
+			if err := c.Close(); err != nil {
+				log.Error().Err(err).Msg("error closing audit log")
+			}
+		}
+	}()
 
 	if cfg.LogToConsole {
 		logs = append(logs, WriteToStdout())
 	}
 
 	if cfg.LogToFile {
-		logs = append(logs, WriteToFile(cfg.FilePath, log))
+		if cfg.FileRotation.MaxSize == "" && cfg.FileRotation.Interval == 0 {
+			logs = append(logs, WriteToFile(cfg.FilePath, log))
+		} else {
+			f, err := NewRotatingFile(cfg.FilePath, cfg.FileRotation, log)
+			if err != nil {
+				return err
+			}
+			logs = append(logs, f.Log)
+			closers = append(closers, f)
+		}
+	}
+
+	if cfg.LogToSyslog {
+		s, err := NewSyslogSink(cfg.Syslog, log)
+		if err != nil {
+			return err
+		}
+		logs = append(logs, s.Log)
+		closers = append(closers, s)
 	}
 
-	StartAuditLogger(ctx, ch, log, Marshal(cfg.Format, log), logs...)
+	if cfg.LogToHTTP {
+		contentType := "text/plain; charset=utf-8"
+		if cfg.Format == "json" || cfg.Format == "ocsf" {
+			contentType = "application/x-ndjson"
+		}
+		s, err := NewHTTPSink(cfg.HTTP, contentType, log)
+		if err != nil {
+			return err
+		}
+		logs = append(logs, s.Log)
+		closers = append(closers, s)
+	}
+
+	marshaller := Marshal(cfg.Format, log)
+	if cfg.HashChain.Enabled {
+		chain, err := NewHashChain(cfg.HashChain.Key, cfg.HashChain.StatePath, log)
+		if err != nil {
+			return err
+		}
+		marshaller = chain.Marshaller(marshaller)
+		logs = append(logs, chain.Persist)
+		closers = append(closers, chain)
+	}
 
+	StartAuditLogger(ctx, ch, log, marshaller, logs...)
+	return nil
 }
 
 // StartAuditLogger will block. run in separate go routine
@@ -115,6 +172,38 @@ func StartAuditLogger(ctx context.Context, ch <-chan events.Event, log log.Logge
 				auditEvent = types.GroupMemberRemoved(ev)
 			case events.ScienceMeshInviteTokenGenerated:
 				auditEvent = types.ScienceMeshInviteTokenGenerated(ev)
+			case avevent.InfectedFileFound:
+				auditEvent = types.FileInfected(ev)
+			case avevent.RescanFinished:
+				auditEvent = types.VirusRescanFinished(ev)
+			case events.UserSignedIn:
+				auditEvent = types.UserSignedIn(ev)
+			case proxyevent.SignInFailed:
+				auditEvent = types.UserSignInFailed(ev)
+			case proxyevent.LockedOut:
+				auditEvent = types.LockedOut(ev)
+			case events.BackchannelLogout:
+				auditEvent = types.UserSignedOut(ev)
+			case authappevent.AppTokenCreated:
+				auditEvent = types.AppTokenCreated(ev)
+			case authappevent.AppTokenDeleted:
+				auditEvent = types.AppTokenDeleted(ev)
+			case graphevent.PasswordChanged:
+				auditEvent = types.PasswordChanged(ev)
+			case settingsevent.RoleAssigned:
+				auditEvent = types.RoleAssigned(ev)
+			case settingsevent.RoleUnassigned:
+				auditEvent = types.RoleUnassigned(ev)
+			case settingsevent.RoleCreated:
+				auditEvent = types.RoleCreated(ev)
+			case settingsevent.RoleUpdated:
+				auditEvent = types.RoleUpdated(ev)
+			case settingsevent.RoleDeleted:
+				auditEvent = types.RoleDeleted(ev)
+			case events.PersonalDataExtracted:
+				auditEvent = types.PersonalDataExported(ev)
+			case policiesevent.PolicyDenied:
+				auditEvent = types.PolicyDenied(ev)
 			default:
 				log.Error().Interface("event", ev).Msg(fmt.Sprintf("can't handle event of type '%T'", ev))
 				if ctx.Err() != nil {
@@ -191,5 +280,9 @@ func Marshal(format string, log log.Logger) Marshaller {
 			format := fmt.Sprintf("%s)\n   %s", m["Action"], m["Message"])
 			return []byte(format), nil
 		}
+	case "cef":
+		return MarshalCEF
+	case "ocsf":
+		return MarshalOCSF
 	}
 }
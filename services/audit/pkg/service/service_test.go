@@ -4,11 +4,18 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/opencloud-eu/opencloud/pkg/log"
+	avevent "github.com/opencloud-eu/opencloud/services/antivirus/pkg/event"
 	"github.com/opencloud-eu/opencloud/services/audit/pkg/types"
+	authappevent "github.com/opencloud-eu/opencloud/services/auth-app/pkg/event"
+	graphevent "github.com/opencloud-eu/opencloud/services/graph/pkg/event"
+	policiesevent "github.com/opencloud-eu/opencloud/services/policies/pkg/event"
+	proxyevent "github.com/opencloud-eu/opencloud/services/proxy/pkg/event"
+	settingsevent "github.com/opencloud-eu/opencloud/services/settings/pkg/event"
 	"github.com/opencloud-eu/reva/v2/pkg/events"
 
 	group "github.com/cs3org/go-cs3apis/cs3/identity/group/v1beta1"
@@ -585,6 +592,378 @@ var testCases = []struct {
 			require.Equal(t, uint64(10e8), ev.Expiration)
 			require.Equal(t, "http://opencloud.test/invite", ev.InviteLink)
 		},
+	}, {
+		Alias: "Antivirus - InfectedFileFound",
+		SystemEvent: events.Event{
+			Event: avevent.InfectedFileFound{
+				ResourceID:  resourceID("provider-1", "storage-1", "itemid-1"),
+				Owner:       userID("owner-id"),
+				Path:        "./eicar.com",
+				Description: "Win.Test.EICAR_HDB-1",
+				Outcome:     events.PPOutcomeDelete,
+				Timestamp:   time.Unix(10e8, 0),
+			},
+		},
+		CheckAuditEvent: func(t *testing.T, b []byte) {
+			ev := types.AuditEventFileInfected{}
+			require.NoError(t, json.Unmarshal(b, &ev))
+
+			// AuditEvent fields
+			checkBaseAuditEvent(t, ev.AuditEvent, "owner-id", "2001-09-09T01:46:40Z", "virus 'Win.Test.EICAR_HDB-1' found in file 'provider-1$storage-1!itemid-1' during rescan. Outcome: 'delete'", "file_infected")
+			// AuditEventFiles fields
+			checkFilesAuditEvent(t, ev.AuditEventFiles, "provider-1$storage-1!itemid-1", "owner-id", "./eicar.com")
+			// AuditEventFileInfected fields
+			require.Equal(t, "Win.Test.EICAR_HDB-1", ev.Virus)
+			require.Equal(t, "delete", ev.Outcome)
+		},
+	}, {
+		Alias: "Antivirus - RescanFinished",
+		SystemEvent: events.Event{
+			Event: avevent.RescanFinished{
+				Scanned:   10,
+				Infected:  1,
+				Failed:    2,
+				Timestamp: time.Unix(10e8, 0),
+			},
+		},
+		CheckAuditEvent: func(t *testing.T, b []byte) {
+			ev := types.AuditEventVirusRescanFinished{}
+			require.NoError(t, json.Unmarshal(b, &ev))
+
+			// AuditEvent fields
+			checkBaseAuditEvent(t, ev.AuditEvent, "", "2001-09-09T01:46:40Z", "virus rescan finished. Scanned: 10, infected: 1, failed: 2", "virus_rescan_finished")
+			// AuditEventVirusRescanFinished fields
+			require.Equal(t, "", ev.ModifiedSince)
+			require.Equal(t, 10, ev.Scanned)
+		},
+	}, {
+		Alias: "User signed in",
+		SystemEvent: events.Event{
+			Event: events.UserSignedIn{
+				Executant: userID("uid-123"),
+				Timestamp: timestamp(10e8),
+			},
+		},
+		CheckAuditEvent: func(t *testing.T, b []byte) {
+			ev := types.AuditEventUserSignedIn{}
+			require.NoError(t, json.Unmarshal(b, &ev))
+
+			// AuditEvent fields
+			checkBaseAuditEvent(t, ev.AuditEvent, "uid-123", "2001-09-09T01:46:40Z", "user 'uid-123' signed in", "user_signed_in")
+			// AuditEventUserSignedIn fields
+			require.Equal(t, "uid-123", ev.UserID)
+		},
+	}, {
+		Alias: "User sign in failed",
+		SystemEvent: events.Event{
+			Event: proxyevent.SignInFailed{
+				Username:   "einstein",
+				Method:     "basic",
+				RemoteAddr: "192.0.2.1",
+				UserAgent:  "curl/8.0",
+				URL:        "/dav/spaces/",
+				Timestamp:  timestamp(10e8),
+			},
+		},
+		CheckAuditEvent: func(t *testing.T, b []byte) {
+			ev := types.AuditEventUserSignInFailed{}
+			require.NoError(t, json.Unmarshal(b, &ev))
+
+			// AuditEvent fields
+			require.Equal(t, "", ev.User)
+			require.Equal(t, "192.0.2.1", ev.RemoteAddr)
+			require.Equal(t, "curl/8.0", ev.UserAgent)
+			require.Equal(t, "/dav/spaces/", ev.URL)
+			require.Equal(t, "2001-09-09T01:46:40Z", ev.Time)
+			require.Equal(t, "sign in of user 'einstein' with basic authentication failed", ev.Message)
+			require.Equal(t, "user_sign_in_failed", ev.Action)
+			// AuditEventUserSignInFailed fields
+			require.Equal(t, "einstein", ev.Username)
+			require.Equal(t, "basic", ev.AuthMethod)
+		},
+	}, {
+		Alias: "Locked out",
+		SystemEvent: events.Event{
+			Event: proxyevent.LockedOut{
+				Kind:        "user",
+				Subject:     "einstein",
+				Failures:    10,
+				LockedUntil: timestamp(10e8 + 900),
+				RemoteAddr:  "192.0.2.1",
+				UserAgent:   "curl/8.0",
+				URL:         "/dav/spaces/",
+				Timestamp:   timestamp(10e8),
+			},
+		},
+		CheckAuditEvent: func(t *testing.T, b []byte) {
+			ev := types.AuditEventLockedOut{}
+			require.NoError(t, json.Unmarshal(b, &ev))
+
+			// AuditEvent fields
+			require.Equal(t, "", ev.User)
+			require.Equal(t, "192.0.2.1", ev.RemoteAddr)
+			require.Equal(t, "curl/8.0", ev.UserAgent)
+			require.Equal(t, "/dav/spaces/", ev.URL)
+			require.Equal(t, "2001-09-09T01:46:40Z", ev.Time)
+			require.Equal(t, "user 'einstein' locked out after 10 failed sign in attempts", ev.Message)
+			require.Equal(t, "locked_out", ev.Action)
+			// AuditEventLockedOut fields
+			require.Equal(t, "user", ev.Kind)
+			require.Equal(t, "einstein", ev.Subject)
+			require.Equal(t, 10, ev.Failures)
+			require.Equal(t, "2001-09-09T02:01:40Z", ev.LockedUntil)
+		},
+	}, {
+		Alias: "User signed out",
+		SystemEvent: events.Event{
+			Event: events.BackchannelLogout{
+				Executant: userID("uid-123"),
+				SessionId: "session-1",
+				Timestamp: timestamp(10e8),
+			},
+		},
+		CheckAuditEvent: func(t *testing.T, b []byte) {
+			ev := types.AuditEventUserSignedOut{}
+			require.NoError(t, json.Unmarshal(b, &ev))
+
+			// AuditEvent fields
+			checkBaseAuditEvent(t, ev.AuditEvent, "uid-123", "2001-09-09T01:46:40Z", "the identity provider signed out user 'uid-123' from session 'session-1'", "user_signed_out")
+			// AuditEventUserSignedOut fields
+			require.Equal(t, "uid-123", ev.UserID)
+			require.Equal(t, "session-1", ev.SessionID)
+		},
+	}, {
+		Alias: "App token created - impersonation",
+		SystemEvent: events.Event{
+			Event: authappevent.AppTokenCreated{
+				Executant:  userID("admin-id"),
+				UserID:     userID("uid-123"),
+				Label:      "migration (Impersonation)",
+				Expiration: timestamp(10e8 + 3600),
+				Timestamp:  timestamp(10e8),
+			},
+		},
+		CheckAuditEvent: func(t *testing.T, b []byte) {
+			ev := types.AuditEventAppTokenCreated{}
+			require.NoError(t, json.Unmarshal(b, &ev))
+
+			// AuditEvent fields
+			checkBaseAuditEvent(t, ev.AuditEvent, "admin-id", "2001-09-09T01:46:40Z", "user 'admin-id' created the app token 'migration (Impersonation)' for user 'uid-123'", "app_token_created")
+			// AuditEventAppTokenCreated fields
+			require.Equal(t, "uid-123", ev.UserID)
+			require.Equal(t, "migration (Impersonation)", ev.Label)
+			require.Equal(t, "2001-09-09T02:46:40Z", ev.ExpirationDate)
+		},
+	}, {
+		Alias: "App token deleted",
+		SystemEvent: events.Event{
+			Event: authappevent.AppTokenDeleted{
+				Executant: userID("uid-123"),
+				Timestamp: timestamp(10e8),
+			},
+		},
+		CheckAuditEvent: func(t *testing.T, b []byte) {
+			ev := types.AuditEventAppTokenDeleted{}
+			require.NoError(t, json.Unmarshal(b, &ev))
+
+			// AuditEvent fields
+			checkBaseAuditEvent(t, ev.AuditEvent, "uid-123", "2001-09-09T01:46:40Z", "user 'uid-123' deleted an app token", "app_token_deleted")
+		},
+	}, {
+		Alias: "Password changed",
+		SystemEvent: events.Event{
+			Event: graphevent.PasswordChanged{
+				Executant: userID("uid-123"),
+				Timestamp: timestamp(10e8),
+			},
+		},
+		CheckAuditEvent: func(t *testing.T, b []byte) {
+			ev := types.AuditEventPasswordChanged{}
+			require.NoError(t, json.Unmarshal(b, &ev))
+
+			// AuditEvent fields
+			checkBaseAuditEvent(t, ev.AuditEvent, "uid-123", "2001-09-09T01:46:40Z", "user 'uid-123' changed their password", "password_changed")
+			// AuditEventPasswordChanged fields
+			require.Equal(t, "uid-123", ev.UserID)
+		},
+	}, {
+		Alias: "Role assigned",
+		SystemEvent: events.Event{
+			Event: settingsevent.RoleAssigned{
+				Executant:      userID("admin-id"),
+				UserID:         "uid-123",
+				RoleID:         "role-admin",
+				RoleName:       "admin",
+				PreviousRoleID: "role-user",
+				Timestamp:      timestamp(10e8),
+			},
+		},
+		CheckAuditEvent: func(t *testing.T, b []byte) {
+			ev := types.AuditEventRoleAssigned{}
+			require.NoError(t, json.Unmarshal(b, &ev))
+
+			// AuditEvent fields
+			checkBaseAuditEvent(t, ev.AuditEvent, "admin-id", "2001-09-09T01:46:40Z", "user 'admin-id' assigned the role 'admin' to user 'uid-123'", "role_assigned")
+			// AuditEventRoleAssigned fields
+			require.Equal(t, "uid-123", ev.UserID)
+			require.Equal(t, "role-admin", ev.RoleID)
+			require.Equal(t, "admin", ev.RoleName)
+			require.Equal(t, "role-user", ev.PreviousRoleID)
+		},
+	}, {
+		Alias: "Role unassigned",
+		SystemEvent: events.Event{
+			Event: settingsevent.RoleUnassigned{
+				Executant:    userID("admin-id"),
+				AssignmentID: "assignment-1",
+				UserID:       "uid-123",
+				RoleID:       "role-admin",
+				Timestamp:    timestamp(10e8),
+			},
+		},
+		CheckAuditEvent: func(t *testing.T, b []byte) {
+			ev := types.AuditEventRoleUnassigned{}
+			require.NoError(t, json.Unmarshal(b, &ev))
+
+			// AuditEvent fields
+			checkBaseAuditEvent(t, ev.AuditEvent, "admin-id", "2001-09-09T01:46:40Z", "user 'admin-id' removed the role 'role-admin' from user 'uid-123'", "role_unassigned")
+			// AuditEventRoleUnassigned fields
+			require.Equal(t, "assignment-1", ev.AssignmentID)
+			require.Equal(t, "uid-123", ev.UserID)
+			require.Equal(t, "role-admin", ev.RoleID)
+		},
+	}, {
+		Alias: "Role created",
+		SystemEvent: events.Event{
+			Event: settingsevent.RoleCreated{
+				Executant:   userID("admin-id"),
+				RoleID:      "role-1",
+				RoleName:    "helpdesk",
+				Permissions: []string{"Accounts.ResetPassword", "Drives.List"},
+				Timestamp:   timestamp(10e8),
+			},
+		},
+		CheckAuditEvent: func(t *testing.T, b []byte) {
+			ev := types.AuditEventRoleCreated{}
+			require.NoError(t, json.Unmarshal(b, &ev))
+
+			// AuditEvent fields
+			checkBaseAuditEvent(t, ev.AuditEvent, "admin-id", "2001-09-09T01:46:40Z", "user 'admin-id' created the role 'helpdesk'", "role_created")
+			// AuditEventRoleCreated fields
+			require.Equal(t, "role-1", ev.RoleID)
+			require.Equal(t, "helpdesk", ev.RoleName)
+			require.Equal(t, []string{"Accounts.ResetPassword", "Drives.List"}, ev.Permissions)
+		},
+	}, {
+		Alias: "Role updated",
+		SystemEvent: events.Event{
+			Event: settingsevent.RoleUpdated{
+				Executant:   userID("admin-id"),
+				RoleID:      "role-1",
+				RoleName:    "helpdesk",
+				Permissions: []string{"Accounts.ResetPassword"},
+				Timestamp:   timestamp(10e8),
+			},
+		},
+		CheckAuditEvent: func(t *testing.T, b []byte) {
+			ev := types.AuditEventRoleUpdated{}
+			require.NoError(t, json.Unmarshal(b, &ev))
+
+			// AuditEvent fields
+			checkBaseAuditEvent(t, ev.AuditEvent, "admin-id", "2001-09-09T01:46:40Z", "user 'admin-id' updated the role 'helpdesk'", "role_updated")
+			// AuditEventRoleUpdated fields
+			require.Equal(t, "role-1", ev.RoleID)
+			require.Equal(t, []string{"Accounts.ResetPassword"}, ev.Permissions)
+		},
+	}, {
+		Alias: "Role deleted",
+		SystemEvent: events.Event{
+			Event: settingsevent.RoleDeleted{
+				Executant: userID("admin-id"),
+				RoleID:    "role-1",
+				RoleName:  "helpdesk",
+				Timestamp: timestamp(10e8),
+			},
+		},
+		CheckAuditEvent: func(t *testing.T, b []byte) {
+			ev := types.AuditEventRoleDeleted{}
+			require.NoError(t, json.Unmarshal(b, &ev))
+
+			// AuditEvent fields
+			checkBaseAuditEvent(t, ev.AuditEvent, "admin-id", "2001-09-09T01:46:40Z", "user 'admin-id' deleted the role 'helpdesk'", "role_deleted")
+			// AuditEventRoleDeleted fields
+			require.Equal(t, "role-1", ev.RoleID)
+			require.Equal(t, "helpdesk", ev.RoleName)
+		},
+	}, {
+		Alias: "Personal data exported - failure",
+		SystemEvent: events.Event{
+			Event: events.PersonalDataExtracted{
+				Executant: userID("uid-123"),
+				Timestamp: timestamp(10e8),
+				ErrorMsg:  "upload failed",
+			},
+		},
+		CheckAuditEvent: func(t *testing.T, b []byte) {
+			ev := types.AuditEventPersonalDataExported{}
+			require.NoError(t, json.Unmarshal(b, &ev))
+
+			// AuditEvent fields
+			checkBaseAuditEvent(t, ev.AuditEvent, "uid-123", "2001-09-09T01:46:40Z", "personal data export of user 'uid-123' failed: upload failed", "personal_data_exported")
+			// AuditEventPersonalDataExported fields
+			require.Equal(t, "uid-123", ev.UserID)
+			require.Equal(t, "upload failed", ev.Error)
+		},
+	}, {
+		Alias: "Policy denied - postprocessing",
+		SystemEvent: events.Event{
+			Event: policiesevent.PolicyDenied{
+				Executant:  userID("uid-123"),
+				Stage:      "pp",
+				Query:      "data.postprocessing.granted",
+				ResourceID: resourceID("provider-1", "storage-1", "itemid-1"),
+				Filename:   "virus.exe",
+				Timestamp:  timestamp(10e8),
+			},
+		},
+		CheckAuditEvent: func(t *testing.T, b []byte) {
+			ev := types.AuditEventPolicyDenied{}
+			require.NoError(t, json.Unmarshal(b, &ev))
+
+			// AuditEvent fields
+			checkBaseAuditEvent(t, ev.AuditEvent, "uid-123", "2001-09-09T01:46:40Z", "policy denied the upload of file 'virus.exe' by user 'uid-123'", "policy_denied")
+			// AuditEventPolicyDenied fields
+			require.Equal(t, "pp", ev.Stage)
+			require.Equal(t, "data.postprocessing.granted", ev.Query)
+			require.Equal(t, "provider-1$storage-1!itemid-1", ev.FileID)
+			require.Equal(t, "virus.exe", ev.Filename)
+		},
+	}, {
+		Alias: "Policy denied - request",
+		SystemEvent: events.Event{
+			Event: policiesevent.PolicyDenied{
+				Executant: userID("uid-123"),
+				Stage:     "http",
+				Query:     "data.proxy.granted",
+				Method:    "PUT",
+				Path:      "/dav/spaces/a.exe",
+				Timestamp: timestamp(10e8),
+			},
+		},
+		CheckAuditEvent: func(t *testing.T, b []byte) {
+			ev := types.AuditEventPolicyDenied{}
+			require.NoError(t, json.Unmarshal(b, &ev))
+
+			// AuditEvent fields
+			require.Equal(t, "uid-123", ev.User)
+			require.Equal(t, "PUT", ev.Method)
+			require.Equal(t, "/dav/spaces/a.exe", ev.URL)
+			require.Equal(t, "policy denied the request 'PUT /dav/spaces/a.exe' of user 'uid-123'", ev.Message)
+			require.Equal(t, "policy_denied", ev.Action)
+			// AuditEventPolicyDenied fields
+			require.Equal(t, "http", ev.Stage)
+			require.Equal(t, "", ev.FileID)
+		},
 	},
 }
 
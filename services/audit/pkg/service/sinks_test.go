@@ -0,0 +1,221 @@
+package svc
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/audit/pkg/config"
+)
+
+func TestSyslogSink(t *testing.T) {
+	t.Run("udp", func(t *testing.T) {
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer conn.Close()
+
+		s, err := NewSyslogSink(config.Syslog{Network: "udp", Address: conn.LocalAddr().String(), Facility: "local0", AppName: "opencloud audit"}, log.NopLogger())
+		require.NoError(t, err)
+		defer s.Close()
+
+		s.Log([]byte(`{"Action":"file_read"}`))
+
+		buf := make([]byte, 1024)
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+		n, _, err := conn.ReadFrom(buf)
+		require.NoError(t, err)
+		// local0 (16) * 8 + notice (5) = 133
+		require.Regexp(t, regexp.MustCompile(`^<133>1 \d{4}-\d\d-\d\dT\d\d:\d\d:\d\d\.\d{6}Z \S+ opencloudaudit \d+ audit - \{"Action":"file_read"\}$`), string(buf[:n]))
+	})
+
+	t.Run("tcp uses octet counting", func(t *testing.T) {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer l.Close()
+
+		s, err := NewSyslogSink(config.Syslog{Network: "tcp", Address: l.Addr().String(), Facility: "auth", AppName: "audit"}, log.NopLogger())
+		require.NoError(t, err)
+		defer s.Close()
+
+		s.Log([]byte("first"))
+		s.Log([]byte("second"))
+
+		conn, err := l.Accept()
+		require.NoError(t, err)
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		for _, content := range []string{"first", "second"} {
+			length, err := r.ReadString(' ')
+			require.NoError(t, err)
+			n, err := strconv.Atoi(strings.TrimSpace(length))
+			require.NoError(t, err)
+			msg := make([]byte, n)
+			_, err = io.ReadFull(r, msg)
+			require.NoError(t, err)
+			require.True(t, strings.HasPrefix(string(msg), "<37>1 "))
+			require.True(t, strings.HasSuffix(string(msg), " audit - "+content))
+		}
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		_, err := NewSyslogSink(config.Syslog{Network: "carrier-pigeon", Address: "localhost:514", Facility: "local0"}, log.NopLogger())
+		require.Error(t, err)
+		_, err = NewSyslogSink(config.Syslog{Network: "udp", Address: "localhost:514", Facility: "local9"}, log.NopLogger())
+		require.Error(t, err)
+		_, err = NewSyslogSink(config.Syslog{Network: "udp", Facility: "local0"}, log.NopLogger())
+		require.Error(t, err)
+	})
+}
+
+func TestRotatingFile(t *testing.T) {
+	t.Run("rotates by size and keeps the newest backups", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "audit.log")
+		f, err := NewRotatingFile(path, config.FileRotation{MaxSize: "10", MaxBackups: 2}, log.NopLogger())
+		require.NoError(t, err)
+
+		for _, line := range []string{"line-1", "line-2", "line-3", "line-4"} {
+			f.Log([]byte(line))
+			// the rotation time is part of the backup name
+			time.Sleep(2 * time.Millisecond)
+		}
+		require.NoError(t, f.Close())
+
+		b, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Equal(t, "line-4\n", string(b))
+
+		backups, err := filepath.Glob(filepath.Join(dir, "audit-*.log"))
+		require.NoError(t, err)
+		require.Len(t, backups, 2)
+		b, err = os.ReadFile(backups[0])
+		require.NoError(t, err)
+		require.Equal(t, "line-2\n", string(b))
+	})
+
+	t.Run("rotates by time and compresses", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "audit.log")
+		f, err := NewRotatingFile(path, config.FileRotation{Interval: time.Millisecond, Compress: true}, log.NopLogger())
+		require.NoError(t, err)
+
+		f.Log([]byte("line-1"))
+		time.Sleep(5 * time.Millisecond)
+		f.Log([]byte("line-2"))
+		require.NoError(t, f.Close())
+
+		backups, err := filepath.Glob(filepath.Join(dir, "audit-*.log.gz"))
+		require.NoError(t, err)
+		require.Len(t, backups, 1)
+		uncompressed, err := filepath.Glob(filepath.Join(dir, "audit-*.log"))
+		require.NoError(t, err)
+		require.Empty(t, uncompressed)
+	})
+}
+
+func TestHTTPSink(t *testing.T) {
+	t.Run("sends batches", func(t *testing.T) {
+		var mu sync.Mutex
+		var bodies []string
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "Splunk token", r.Header.Get("Authorization"))
+			require.Equal(t, "application/x-ndjson", r.Header.Get("Content-Type"))
+			b, _ := io.ReadAll(r.Body)
+			mu.Lock()
+			bodies = append(bodies, string(b))
+			mu.Unlock()
+		}))
+		defer srv.Close()
+
+		s, err := NewHTTPSink(config.HTTP{Endpoint: srv.URL, Headers: []string{"Authorization: Splunk token"}, BatchSize: 2, FlushInterval: time.Hour}, "application/x-ndjson", log.NopLogger())
+		require.NoError(t, err)
+
+		s.Log([]byte("1"))
+		s.Log([]byte("2"))
+		s.Log([]byte("3"))
+		require.NoError(t, s.Close())
+
+		require.Equal(t, []string{"1\n2\n", "3\n"}, bodies)
+	})
+
+	t.Run("buffers undeliverable batches", func(t *testing.T) {
+		var mu sync.Mutex
+		available := false
+		var bodies []string
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			if !available {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			b, _ := io.ReadAll(r.Body)
+			bodies = append(bodies, string(b))
+		}))
+		defer srv.Close()
+
+		buffer := t.TempDir()
+		s, err := NewHTTPSink(config.HTTP{Endpoint: srv.URL, BatchSize: 1, FlushInterval: time.Hour, MaxRetries: 1, BufferPath: buffer}, "text/plain", log.NopLogger())
+		require.NoError(t, err)
+		s.backoff = time.Millisecond
+
+		s.Log([]byte("1"))
+		s.Log([]byte("2"))
+		require.Eventually(t, func() bool {
+			files, _ := filepath.Glob(filepath.Join(buffer, "*.batch"))
+			return len(files) == 2
+		}, 5*time.Second, 10*time.Millisecond)
+
+		mu.Lock()
+		available = true
+		mu.Unlock()
+
+		s.Log([]byte("3"))
+		require.NoError(t, s.Close())
+
+		require.Equal(t, []string{"1\n", "2\n", "3\n"}, bodies)
+		files, _ := filepath.Glob(filepath.Join(buffer, "*.batch"))
+		require.Empty(t, files)
+	})
+
+	t.Run("never blocks", func(t *testing.T) {
+		release := make(chan struct{})
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-release
+		}))
+		defer srv.Close()
+
+		s, err := NewHTTPSink(config.HTTP{Endpoint: srv.URL, BatchSize: 1, FlushInterval: time.Hour}, "text/plain", log.NopLogger())
+		require.NoError(t, err)
+
+		logged := make(chan struct{})
+		go func() {
+			for i := 0; i < 2*eventQueueSize; i++ {
+				s.Log([]byte(strconv.Itoa(i)))
+			}
+			close(logged)
+		}()
+		select {
+		case <-logged:
+		case <-time.After(5 * time.Second):
+			t.Fatal("logging blocked while the endpoint was hanging")
+		}
+		require.Positive(t, s.dropped.Load())
+
+		close(release)
+		require.NoError(t, s.Close())
+	})
+}
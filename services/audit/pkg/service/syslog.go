@@ -0,0 +1,167 @@
+package svc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/audit/pkg/config"
+)
+
+const (
+	_syslogTimeout = 10 * time.Second
+	// audit events are logged with the severity 'notice'
+	_syslogSeverity = 5
+)
+
+var _syslogFacilities = map[string]int{
+	"kern": 0, "user": 1, "mail": 2, "daemon": 3, "auth": 4, "syslog": 5, "lpr": 6, "news": 7,
+	"uucp": 8, "cron": 9, "authpriv": 10, "ftp": 11,
+	"local0": 16, "local1": 17, "local2": 18, "local3": 19, "local4": 20, "local5": 21, "local6": 22, "local7": 23,
+}
+
+// SyslogSink sends audit events as RFC 5424 messages to a syslog server. Each message is sent as a
+// datagram via UDP and with octet counting framing (RFC 6587) via TCP and TLS.
+type SyslogSink struct {
+	network   string
+	address   string
+	tlsConfig *tls.Config
+	priority  string
+	header    string
+	log       log.Logger
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+// NewSyslogSink returns a SyslogSink for the given configuration. The connection is established with the first message.
+func NewSyslogSink(cfg config.Syslog, log log.Logger) (*SyslogSink, error) {
+	s := &SyslogSink{
+		network: cfg.Network,
+		address: cfg.Address,
+		log:     log,
+	}
+
+	switch cfg.Network {
+	case "udp", "tcp":
+	case "tls":
+		s.tlsConfig = &tls.Config{InsecureSkipVerify: cfg.TLSInsecure} //nolint:gosec
+		if cfg.TLSRootCACertificate != "" {
+			pem, err := os.ReadFile(cfg.TLSRootCACertificate)
+			if err != nil {
+				return nil, err
+			}
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("no certificates found in '%s'", cfg.TLSRootCACertificate)
+			}
+			s.tlsConfig.RootCAs = pool
+		}
+	default:
+		return nil, fmt.Errorf("unknown syslog network '%s'", cfg.Network)
+	}
+
+	if cfg.Address == "" {
+		return nil, errors.New("the syslog address is missing")
+	}
+
+	facility, ok := _syslogFacilities[cfg.Facility]
+	if !ok {
+		return nil, fmt.Errorf("unknown syslog facility '%s'", cfg.Facility)
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+
+	// all parts of the header except the timestamp are the same for all messages
+	s.priority = fmt.Sprintf("<%d>1 ", facility*8+_syslogSeverity)
+	s.header = fmt.Sprintf(" %s %s %d audit - ", syslogField(hostname, 255), syslogField(cfg.AppName, 48), os.Getpid())
+
+	return s, nil
+}
+
+// Log sends the content to the syslog server. If sending fails, the message is sent again with a new connection.
+func (s *SyslogSink) Log(content []byte) {
+	msg := s.message(content, time.Now())
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for attempt := 0; attempt < 2; attempt++ {
+		if s.conn == nil {
+			conn, err := s.dial()
+			if err != nil {
+				s.log.Error().Err(err).Str("address", s.address).Msg("error connecting to the syslog server")
+				return
+			}
+			s.conn = conn
+		}
+
+		_ = s.conn.SetWriteDeadline(time.Now().Add(_syslogTimeout))
+		if _, err := s.conn.Write(msg); err == nil {
+			return
+		} else if attempt > 0 {
+			s.log.Error().Err(err).Str("address", s.address).Msg("error sending to the syslog server")
+		}
+
+		_ = s.conn.Close()
+		s.conn = nil
+	}
+}
+
+// Close closes the connection to the syslog server
+func (s *SyslogSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
+
+// message returns the RFC 5424 message, framed for the stream based transports
+func (s *SyslogSink) message(content []byte, t time.Time) []byte {
+	msg := s.priority + t.UTC().Format("2006-01-02T15:04:05.000000Z07:00") + s.header + string(content)
+	if s.network == "udp" {
+		return []byte(msg)
+	}
+	return []byte(strconv.Itoa(len(msg)) + " " + msg)
+}
+
+func (s *SyslogSink) dial() (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: _syslogTimeout}
+	if s.network == "tls" {
+		return tls.DialWithDialer(dialer, "tcp", s.address, s.tlsConfig)
+	}
+	return dialer.Dial(s.network, s.address)
+}
+
+// syslogField returns a valid RFC 5424 header field, which consists of printable ASCII characters only
+func syslogField(s string, maxLen int) string {
+	s = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return -1
+		}
+		return r
+	}, s)
+	if len(s) > maxLen {
+		s = s[:maxLen]
+	}
+	if s == "" {
+		return "-"
+	}
+	return s
+}
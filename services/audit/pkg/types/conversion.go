@@ -15,6 +15,13 @@ import (
 	types "github.com/cs3org/go-cs3apis/cs3/types/v1beta1"
 
 	sdk "github.com/opencloud-eu/reva/v2/pkg/sdk/common"
+
+	avevent "github.com/opencloud-eu/opencloud/services/antivirus/pkg/event"
+	authappevent "github.com/opencloud-eu/opencloud/services/auth-app/pkg/event"
+	graphevent "github.com/opencloud-eu/opencloud/services/graph/pkg/event"
+	policiesevent "github.com/opencloud-eu/opencloud/services/policies/pkg/event"
+	proxyevent "github.com/opencloud-eu/opencloud/services/proxy/pkg/event"
+	settingsevent "github.com/opencloud-eu/opencloud/services/settings/pkg/event"
 )
 
 const _linktype = "link"
@@ -520,6 +527,212 @@ func ScienceMeshInviteTokenGenerated(ev events.ScienceMeshInviteTokenGenerated)
 	}
 }
 
+// FileInfected converts an InfectedFileFound event to an AuditEventFileInfected
+func FileInfected(ev avevent.InfectedFileFound) AuditEventFileInfected {
+	iid := storagespace.FormatResourceID(ev.ResourceID)
+	uid := ev.Owner.GetOpaqueId()
+	base := BasicAuditEvent(uid, ev.Timestamp.UTC().Format(time.RFC3339), MessageFileInfected(iid, ev.Description, string(ev.Outcome)), ActionFileInfected)
+	return AuditEventFileInfected{
+		AuditEventFiles: FilesAuditEvent(base, iid, uid, ev.Path),
+		Virus:           ev.Description,
+		Outcome:         string(ev.Outcome),
+	}
+}
+
+// VirusRescanFinished converts a RescanFinished event to an AuditEventVirusRescanFinished
+func VirusRescanFinished(ev avevent.RescanFinished) AuditEventVirusRescanFinished {
+	since := ""
+	if !ev.ModifiedSince.IsZero() {
+		since = ev.ModifiedSince.UTC().Format(time.RFC3339)
+	}
+	base := BasicAuditEvent("", ev.Timestamp.UTC().Format(time.RFC3339), MessageVirusRescanFinished(ev.Scanned, ev.Infected, ev.Failed), ActionVirusRescanFinished)
+	return AuditEventVirusRescanFinished{
+		AuditEvent:    base,
+		ModifiedSince: since,
+		Scanned:       ev.Scanned,
+		Infected:      ev.Infected,
+		Failed:        ev.Failed,
+	}
+}
+
+// UserSignedIn converts a UserSignedIn event to an AuditEventUserSignedIn
+func UserSignedIn(ev events.UserSignedIn) AuditEventUserSignedIn {
+	uid := ev.Executant.GetOpaqueId()
+	base := BasicAuditEvent(uid, formatTime(ev.Timestamp), MessageUserSignedIn(uid), ActionUserSignedIn)
+	return AuditEventUserSignedIn{
+		AuditEvent: base,
+		UserID:     uid,
+	}
+}
+
+// UserSignInFailed converts a SignInFailed event to an AuditEventUserSignInFailed
+func UserSignInFailed(ev proxyevent.SignInFailed) AuditEventUserSignInFailed {
+	base := BasicAuditEvent("", formatTime(ev.Timestamp), MessageUserSignInFailed(ev.Username, ev.Method), ActionUserSignInFailed)
+	base.RemoteAddr = ev.RemoteAddr
+	base.UserAgent = ev.UserAgent
+	base.URL = ev.URL
+	return AuditEventUserSignInFailed{
+		AuditEvent: base,
+		Username:   ev.Username,
+		AuthMethod: ev.Method,
+	}
+}
+
+// LockedOut converts a LockedOut event to an AuditEventLockedOut
+func LockedOut(ev proxyevent.LockedOut) AuditEventLockedOut {
+	base := BasicAuditEvent("", formatTime(ev.Timestamp), MessageLockedOut(ev.Kind, ev.Subject, ev.Failures), ActionLockedOut)
+	base.RemoteAddr = ev.RemoteAddr
+	base.UserAgent = ev.UserAgent
+	base.URL = ev.URL
+	return AuditEventLockedOut{
+		AuditEvent:  base,
+		Kind:        ev.Kind,
+		Subject:     ev.Subject,
+		Failures:    ev.Failures,
+		LockedUntil: formatTime(ev.LockedUntil),
+	}
+}
+
+// UserSignedOut converts a BackchannelLogout event to an AuditEventUserSignedOut
+func UserSignedOut(ev events.BackchannelLogout) AuditEventUserSignedOut {
+	uid := ev.Executant.GetOpaqueId()
+	base := BasicAuditEvent(uid, formatTime(ev.Timestamp), MessageUserSignedOut(uid, ev.SessionId), ActionUserSignedOut)
+	return AuditEventUserSignedOut{
+		AuditEvent: base,
+		UserID:     uid,
+		SessionID:  ev.SessionId,
+	}
+}
+
+// AppTokenCreated converts a AppTokenCreated event to an AuditEventAppTokenCreated
+func AppTokenCreated(ev authappevent.AppTokenCreated) AuditEventAppTokenCreated {
+	uid := ev.Executant.GetOpaqueId()
+	base := BasicAuditEvent(uid, formatTime(ev.Timestamp), MessageAppTokenCreated(uid, ev.UserID.GetOpaqueId(), ev.Label), ActionAppTokenCreated)
+	return AuditEventAppTokenCreated{
+		AuditEvent:     base,
+		UserID:         ev.UserID.GetOpaqueId(),
+		Label:          ev.Label,
+		ExpirationDate: formatTime(ev.Expiration),
+	}
+}
+
+// AppTokenDeleted converts a AppTokenDeleted event to an AuditEventAppTokenDeleted
+func AppTokenDeleted(ev authappevent.AppTokenDeleted) AuditEventAppTokenDeleted {
+	uid := ev.Executant.GetOpaqueId()
+	base := BasicAuditEvent(uid, formatTime(ev.Timestamp), MessageAppTokenDeleted(uid), ActionAppTokenDeleted)
+	return AuditEventAppTokenDeleted{
+		AuditEvent: base,
+	}
+}
+
+// PasswordChanged converts a PasswordChanged event to an AuditEventPasswordChanged
+func PasswordChanged(ev graphevent.PasswordChanged) AuditEventPasswordChanged {
+	uid := ev.Executant.GetOpaqueId()
+	base := BasicAuditEvent(uid, formatTime(ev.Timestamp), MessagePasswordChanged(uid), ActionPasswordChanged)
+	return AuditEventPasswordChanged{
+		AuditEvent: base,
+		UserID:     uid,
+	}
+}
+
+// RoleAssigned converts a RoleAssigned event to an AuditEventRoleAssigned
+func RoleAssigned(ev settingsevent.RoleAssigned) AuditEventRoleAssigned {
+	uid := ev.Executant.GetOpaqueId()
+	role := ev.RoleName
+	if role == "" {
+		role = ev.RoleID
+	}
+	base := BasicAuditEvent(uid, formatTime(ev.Timestamp), MessageRoleAssigned(uid, ev.UserID, role), ActionRoleAssigned)
+	return AuditEventRoleAssigned{
+		AuditEvent:     base,
+		UserID:         ev.UserID,
+		RoleID:         ev.RoleID,
+		RoleName:       ev.RoleName,
+		PreviousRoleID: ev.PreviousRoleID,
+	}
+}
+
+// RoleUnassigned converts a RoleUnassigned event to an AuditEventRoleUnassigned
+func RoleUnassigned(ev settingsevent.RoleUnassigned) AuditEventRoleUnassigned {
+	uid := ev.Executant.GetOpaqueId()
+	base := BasicAuditEvent(uid, formatTime(ev.Timestamp), MessageRoleUnassigned(uid, ev.AssignmentID, ev.UserID, ev.RoleID), ActionRoleUnassigned)
+	return AuditEventRoleUnassigned{
+		AuditEvent:   base,
+		AssignmentID: ev.AssignmentID,
+		UserID:       ev.UserID,
+		RoleID:       ev.RoleID,
+	}
+}
+
+// RoleCreated converts a RoleCreated event to an AuditEventRoleCreated
+func RoleCreated(ev settingsevent.RoleCreated) AuditEventRoleCreated {
+	uid := ev.Executant.GetOpaqueId()
+	base := BasicAuditEvent(uid, formatTime(ev.Timestamp), MessageRoleCreated(uid, ev.RoleName), ActionRoleCreated)
+	return AuditEventRoleCreated{
+		AuditEvent:  base,
+		RoleID:      ev.RoleID,
+		RoleName:    ev.RoleName,
+		Permissions: ev.Permissions,
+	}
+}
+
+// RoleUpdated converts a RoleUpdated event to an AuditEventRoleUpdated
+func RoleUpdated(ev settingsevent.RoleUpdated) AuditEventRoleUpdated {
+	uid := ev.Executant.GetOpaqueId()
+	base := BasicAuditEvent(uid, formatTime(ev.Timestamp), MessageRoleUpdated(uid, ev.RoleName), ActionRoleUpdated)
+	return AuditEventRoleUpdated{
+		AuditEvent:  base,
+		RoleID:      ev.RoleID,
+		RoleName:    ev.RoleName,
+		Permissions: ev.Permissions,
+	}
+}
+
+// RoleDeleted converts a RoleDeleted event to an AuditEventRoleDeleted
+func RoleDeleted(ev settingsevent.RoleDeleted) AuditEventRoleDeleted {
+	uid := ev.Executant.GetOpaqueId()
+	base := BasicAuditEvent(uid, formatTime(ev.Timestamp), MessageRoleDeleted(uid, ev.RoleName), ActionRoleDeleted)
+	return AuditEventRoleDeleted{
+		AuditEvent: base,
+		RoleID:     ev.RoleID,
+		RoleName:   ev.RoleName,
+	}
+}
+
+// PersonalDataExported converts a PersonalDataExtracted event to an AuditEventPersonalDataExported
+func PersonalDataExported(ev events.PersonalDataExtracted) AuditEventPersonalDataExported {
+	uid := ev.Executant.GetOpaqueId()
+	base := BasicAuditEvent(uid, formatTime(ev.Timestamp), MessagePersonalDataExported(uid, ev.ErrorMsg), ActionPersonalDataExported)
+	return AuditEventPersonalDataExported{
+		AuditEvent: base,
+		UserID:     uid,
+		Error:      ev.ErrorMsg,
+	}
+}
+
+// PolicyDenied converts a PolicyDenied event to an AuditEventPolicyDenied
+func PolicyDenied(ev policiesevent.PolicyDenied) AuditEventPolicyDenied {
+	uid := ev.Executant.GetOpaqueId()
+	target := ev.Method + " " + ev.Path
+	if ev.Stage == "pp" {
+		target = ev.Filename
+	}
+	base := BasicAuditEvent(uid, formatTime(ev.Timestamp), MessagePolicyDenied(uid, ev.Stage, target), ActionPolicyDenied)
+	base.Method = ev.Method
+	base.URL = ev.Path
+	fileID := ""
+	if ev.ResourceID != nil {
+		fileID = storagespace.FormatResourceID(ev.ResourceID)
+	}
+	return AuditEventPolicyDenied{
+		AuditEvent: base,
+		Stage:      ev.Stage,
+		Query:      ev.Query,
+		FileID:     fileID,
+		Filename:   ev.Filename,
+	}
+}
+
 func extractGrantee(uid *user.UserId, gid *group.GroupId) (string, string) {
 	switch {
 	case uid != nil && uid.OpaqueId != "":
@@ -2,6 +2,13 @@ package types
 
 import (
 	"github.com/opencloud-eu/reva/v2/pkg/events"
+
+	avevent "github.com/opencloud-eu/opencloud/services/antivirus/pkg/event"
+	authappevent "github.com/opencloud-eu/opencloud/services/auth-app/pkg/event"
+	graphevent "github.com/opencloud-eu/opencloud/services/graph/pkg/event"
+	policiesevent "github.com/opencloud-eu/opencloud/services/policies/pkg/event"
+	proxyevent "github.com/opencloud-eu/opencloud/services/proxy/pkg/event"
+	settingsevent "github.com/opencloud-eu/opencloud/services/settings/pkg/event"
 )
 
 // RegisteredEvents returns the events the service is registered for
@@ -41,5 +48,20 @@ func RegisteredEvents() []events.Unmarshaller {
 		events.GroupMemberRemoved{},
 		events.BackchannelLogout{},
 		events.ScienceMeshInviteTokenGenerated{},
+		avevent.InfectedFileFound{},
+		avevent.RescanFinished{},
+		events.UserSignedIn{},
+		proxyevent.SignInFailed{},
+		proxyevent.LockedOut{},
+		authappevent.AppTokenCreated{},
+		authappevent.AppTokenDeleted{},
+		graphevent.PasswordChanged{},
+		settingsevent.RoleAssigned{},
+		settingsevent.RoleUnassigned{},
+		settingsevent.RoleCreated{},
+		settingsevent.RoleUpdated{},
+		settingsevent.RoleDeleted{},
+		events.PersonalDataExtracted{},
+		policiesevent.PolicyDenied{},
 	}
 }
@@ -54,6 +54,28 @@ const (
 
 	// ScienceMesh
 	ActionScienceMeshInviteTokenGenerated = "science_mesh_invite_token_generated"
+
+	// Antivirus
+	ActionFileInfected        = "file_infected"
+	ActionVirusRescanFinished = "virus_rescan_finished"
+
+	// Authentication
+	ActionUserSignedIn     = "user_signed_in"
+	ActionUserSignInFailed = "user_sign_in_failed"
+	ActionLockedOut        = "locked_out"
+	ActionUserSignedOut    = "user_signed_out"
+	ActionAppTokenCreated  = "app_token_created"
+	ActionAppTokenDeleted  = "app_token_deleted"
+	ActionPasswordChanged  = "password_changed"
+
+	// Administration
+	ActionRoleAssigned         = "role_assigned"
+	ActionRoleUnassigned       = "role_unassigned"
+	ActionRoleCreated          = "role_created"
+	ActionRoleUpdated          = "role_updated"
+	ActionRoleDeleted          = "role_deleted"
+	ActionPersonalDataExported = "personal_data_exported"
+	ActionPolicyDenied         = "policy_denied"
 )
 
 // MessageShareCreated returns the human-readable string that describes the action
@@ -242,3 +264,102 @@ func MessageGroupMemberRemoved(executant, userID, groupID string) string {
 func MessageScienceMeshInviteTokenGenerated(user, token string) string {
 	return fmt.Sprintf("user '%s' generated a ScienceMesh invite with token '%s'", user, token)
 }
+
+// MessageFileInfected returns the human-readable string that describes the action
+func MessageFileInfected(item, virus, outcome string) string {
+	return fmt.Sprintf("virus '%s' found in file '%s' during rescan. Outcome: '%s'", virus, item, outcome)
+}
+
+// MessageVirusRescanFinished returns the human-readable string that describes the action
+func MessageVirusRescanFinished(scanned, infected, failed int) string {
+	return fmt.Sprintf("virus rescan finished. Scanned: %d, infected: %d, failed: %d", scanned, infected, failed)
+}
+
+// MessageUserSignedIn returns the human-readable string that describes the action
+func MessageUserSignedIn(userID string) string {
+	return fmt.Sprintf("user '%s' signed in", userID)
+}
+
+// MessageUserSignInFailed returns the human-readable string that describes the action
+func MessageUserSignInFailed(username, method string) string {
+	if username == "" {
+		return fmt.Sprintf("sign in with %s authentication failed", method)
+	}
+	return fmt.Sprintf("sign in of user '%s' with %s authentication failed", username, method)
+}
+
+// MessageLockedOut returns the human-readable string that describes the action
+func MessageLockedOut(kind, subject string, failures int) string {
+	switch kind {
+	case "user":
+		return fmt.Sprintf("user '%s' locked out after %d failed sign in attempts", subject, failures)
+	case "public_link":
+		return fmt.Sprintf("public link '%s' locked out after %d failed password attempts", subject, failures)
+	default:
+		return fmt.Sprintf("IP address '%s' locked out after %d failed sign in attempts", subject, failures)
+	}
+}
+
+// MessageUserSignedOut returns the human-readable string that describes the action
+func MessageUserSignedOut(userID, sessionID string) string {
+	return fmt.Sprintf("the identity provider signed out user '%s' from session '%s'", userID, sessionID)
+}
+
+// MessageAppTokenCreated returns the human-readable string that describes the action
+func MessageAppTokenCreated(executant, userID, label string) string {
+	return fmt.Sprintf("user '%s' created the app token '%s' for user '%s'", executant, label, userID)
+}
+
+// MessageAppTokenDeleted returns the human-readable string that describes the action
+func MessageAppTokenDeleted(executant string) string {
+	return fmt.Sprintf("user '%s' deleted an app token", executant)
+}
+
+// MessagePasswordChanged returns the human-readable string that describes the action
+func MessagePasswordChanged(userID string) string {
+	return fmt.Sprintf("user '%s' changed their password", userID)
+}
+
+// MessageRoleAssigned returns the human-readable string that describes the action
+func MessageRoleAssigned(executant, userID, role string) string {
+	return fmt.Sprintf("user '%s' assigned the role '%s' to user '%s'", executant, role, userID)
+}
+
+// MessageRoleUnassigned returns the human-readable string that describes the action
+func MessageRoleUnassigned(executant, assignmentID, userID, role string) string {
+	if userID == "" {
+		return fmt.Sprintf("user '%s' removed the role assignment '%s'", executant, assignmentID)
+	}
+	return fmt.Sprintf("user '%s' removed the role '%s' from user '%s'", executant, role, userID)
+}
+
+// MessageRoleCreated returns the human-readable string that describes the action
+func MessageRoleCreated(executant, role string) string {
+	return fmt.Sprintf("user '%s' created the role '%s'", executant, role)
+}
+
+// MessageRoleUpdated returns the human-readable string that describes the action
+func MessageRoleUpdated(executant, role string) string {
+	return fmt.Sprintf("user '%s' updated the role '%s'", executant, role)
+}
+
+// MessageRoleDeleted returns the human-readable string that describes the action
+func MessageRoleDeleted(executant, role string) string {
+	return fmt.Sprintf("user '%s' deleted the role '%s'", executant, role)
+}
+
+// MessagePersonalDataExported returns the human-readable string that describes the action
+func MessagePersonalDataExported(userID, errmsg string) string {
+	if errmsg != "" {
+		return fmt.Sprintf("personal data export of user '%s' failed: %s", userID, errmsg)
+	}
+	return fmt.Sprintf("user '%s' exported their personal data", userID)
+}
+
+// MessagePolicyDenied returns the human-readable string that describes the action
+func MessagePolicyDenied(userID, stage, target string) string {
+	if stage == "pp" {
+		return fmt.Sprintf("policy denied the upload of file '%s' by user '%s'", target, userID)
+	}
+	return fmt.Sprintf("policy denied the request '%s' of user '%s'", target, userID)
+}
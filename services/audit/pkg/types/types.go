@@ -284,3 +284,136 @@ type AuditEventScienceMeshInviteTokenGenerated struct {
 	Expiration    uint64
 	InviteLink    string
 }
+
+/*
+   Antivirus
+*/
+
+// AuditEventFileInfected is the event logged when a rescan finds an infected file at rest
+type AuditEventFileInfected struct {
+	AuditEventFiles
+	Virus   string // The name of the virus.
+	Outcome string // The infected file handling applied: delete, abort or continue.
+}
+
+// AuditEventVirusRescanFinished is the event logged when a rescan of files at rest is done
+type AuditEventVirusRescanFinished struct {
+	AuditEvent
+	ModifiedSince string // Files modified before this time were not rescanned.
+	Scanned       int
+	Infected      int
+	Failed        int
+}
+
+/*
+   Authentication
+*/
+
+// AuditEventUserSignedIn is the event logged when a user signs in
+type AuditEventUserSignedIn struct {
+	AuditEvent
+	UserID string
+}
+
+// AuditEventUserSignInFailed is the event logged when the credentials of a request are rejected
+type AuditEventUserSignInFailed struct {
+	AuditEvent
+	Username   string // The username of basic auth credentials, empty for tokens.
+	AuthMethod string // The authentication scheme, e.g. basic or bearer.
+}
+
+// AuditEventLockedOut is the event logged when a username, public link or IP address is locked out
+// after too many failed sign in attempts
+type AuditEventLockedOut struct {
+	AuditEvent
+	Kind        string // The kind of the subject, either user, public_link or ip.
+	Subject     string // The username, the public link token or the IP address.
+	Failures    int
+	LockedUntil string
+}
+
+// AuditEventUserSignedOut is the event logged when the identity provider ends the session of a user
+type AuditEventUserSignedOut struct {
+	AuditEvent
+	UserID    string
+	SessionID string
+}
+
+// AuditEventAppTokenCreated is the event logged when an app token is created
+type AuditEventAppTokenCreated struct {
+	AuditEvent
+	UserID         string // The owner of the token, differs from User when an admin impersonates the owner.
+	Label          string
+	ExpirationDate string
+}
+
+// AuditEventAppTokenDeleted is the event logged when an app token is deleted
+type AuditEventAppTokenDeleted struct {
+	AuditEvent
+}
+
+// AuditEventPasswordChanged is the event logged when a user changes their own password
+type AuditEventPasswordChanged struct {
+	AuditEvent
+	UserID string
+}
+
+/*
+   Administration
+*/
+
+// AuditEventRoleAssigned is the event logged when a role is assigned to a user
+type AuditEventRoleAssigned struct {
+	AuditEvent
+	UserID         string
+	RoleID         string
+	RoleName       string
+	PreviousRoleID string // The role replaced by the assignment, empty for the first assignment.
+}
+
+// AuditEventRoleUnassigned is the event logged when a role assignment is removed
+type AuditEventRoleUnassigned struct {
+	AuditEvent
+	AssignmentID string
+	UserID       string
+	RoleID       string
+}
+
+// AuditEventRoleCreated is the event logged when a custom role is created
+type AuditEventRoleCreated struct {
+	AuditEvent
+	RoleID      string
+	RoleName    string
+	Permissions []string
+}
+
+// AuditEventRoleUpdated is the event logged when a custom role is changed
+type AuditEventRoleUpdated struct {
+	AuditEvent
+	RoleID      string
+	RoleName    string
+	Permissions []string
+}
+
+// AuditEventRoleDeleted is the event logged when a custom role is deleted
+type AuditEventRoleDeleted struct {
+	AuditEvent
+	RoleID   string
+	RoleName string
+}
+
+// AuditEventPersonalDataExported is the event logged when the personal data export of a user is done
+type AuditEventPersonalDataExported struct {
+	AuditEvent
+	UserID string
+	Error  string // The reason the export failed, empty on success.
+}
+
+// AuditEventPolicyDenied is the event logged when a policy denies a request or an upload
+type AuditEventPolicyDenied struct {
+	AuditEvent
+	Stage    string // http for requests, pp for uploads in postprocessing.
+	Query    string // The policy query that denied the action.
+	FileID   string
+	Filename string
+}
@@ -6,6 +6,7 @@ import (
 	"os/signal"
 
 	"github.com/opencloud-eu/opencloud/pkg/config/configlog"
+	"github.com/opencloud-eu/opencloud/pkg/generators"
 	"github.com/opencloud-eu/opencloud/pkg/registry"
 	"github.com/opencloud-eu/opencloud/pkg/runner"
 	ogrpc "github.com/opencloud-eu/opencloud/pkg/service/grpc"
@@ -19,6 +20,8 @@ import (
 	"github.com/opencloud-eu/opencloud/services/auth-app/pkg/server/debug"
 	"github.com/opencloud-eu/opencloud/services/auth-app/pkg/server/http"
 	"github.com/opencloud-eu/reva/v2/cmd/revad/runtime"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
+	"github.com/opencloud-eu/reva/v2/pkg/events/stream"
 	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
 	"github.com/urfave/cli/v2"
 )
@@ -113,6 +116,15 @@ func Server(cfg *config.Config) *cli.Command {
 				return err
 			}
 
+			var publisher events.Stream
+			if cfg.Events.Endpoint != "" {
+				connName := generators.GenerateConnectionName(cfg.Service.Name, generators.NTypeBus)
+				publisher, err = stream.NatsFromConfig(connName, false, stream.NatsConfig(cfg.Events))
+				if err != nil {
+					return fmt.Errorf("could not initialize events publisher: %w", err)
+				}
+			}
+
 			{
 				rClient := settingssvc.NewRoleService("eu.opencloud.api.settings", grpcClient)
 				server, err := http.Server(
@@ -122,6 +134,7 @@ func Server(cfg *config.Config) *cli.Command {
 					http.GatewaySelector(gatewaySelector),
 					http.RoleClient(rClient),
 					http.TracerProvider(traceProvider),
+					http.EventsPublisher(publisher),
 				)
 				if err != nil {
 					logger.Fatal().Err(err).Msg("failed to initialize http server")
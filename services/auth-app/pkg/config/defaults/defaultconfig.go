@@ -44,6 +44,11 @@ func DefaultConfig() *config.Config {
 		Service: config.Service{
 			Name: "auth-app",
 		},
+		Events: config.Events{
+			Endpoint:  "127.0.0.1:9233",
+			Cluster:   "opencloud-cluster",
+			EnableTLS: false,
+		},
 		StorageDriver: "jsoncs3",
 		StorageDrivers: config.StorageDrivers{
 			JSONCS3: config.JSONCS3Driver{
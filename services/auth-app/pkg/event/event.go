@@ -0,0 +1,38 @@
+package event
+
+import (
+	"encoding/json"
+
+	user "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	types "github.com/cs3org/go-cs3apis/cs3/types/v1beta1"
+)
+
+// AppTokenCreated is emitted when an app token is created
+type AppTokenCreated struct {
+	Executant *user.UserId
+	// UserID is the owner of the token, it differs from the executant when an admin impersonates the user
+	UserID     *user.UserId
+	Label      string
+	Expiration *types.Timestamp
+	Timestamp  *types.Timestamp
+}
+
+// Unmarshal to fulfill umarshaller interface
+func (AppTokenCreated) Unmarshal(v []byte) (interface{}, error) {
+	e := AppTokenCreated{}
+	err := json.Unmarshal(v, &e)
+	return e, err
+}
+
+// AppTokenDeleted is emitted when an app token is deleted
+type AppTokenDeleted struct {
+	Executant *user.UserId
+	Timestamp *types.Timestamp
+}
+
+// Unmarshal to fulfill umarshaller interface
+func (AppTokenDeleted) Unmarshal(v []byte) (interface{}, error) {
+	e := AppTokenDeleted{}
+	err := json.Unmarshal(v, &e)
+	return e, err
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/opencloud-eu/opencloud/pkg/log"
 	settingssvc "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/settings/v0"
 	"github.com/opencloud-eu/opencloud/services/auth-app/pkg/config"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
 	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
 	"github.com/urfave/cli/v2"
 	"go.opentelemetry.io/otel/trace"
@@ -25,6 +26,7 @@ type Options struct {
 	GatewaySelector pool.Selectable[gateway.GatewayAPIClient]
 	RoleClient      settingssvc.RoleService
 	TracerProvider  trace.TracerProvider
+	EventsPublisher events.Publisher
 }
 
 // newOptions initializes the available default options.
@@ -93,3 +95,10 @@ func TracerProvider(val trace.TracerProvider) Option {
 		o.TracerProvider = val
 	}
 }
+
+// EventsPublisher provides a function to set the EventsPublisher option
+func EventsPublisher(val events.Publisher) Option {
+	return func(o *Options) {
+		o.EventsPublisher = val
+	}
+}
@@ -84,6 +84,7 @@ func Server(opts ...Option) (http.Service, error) {
 		svc.GatewaySelector(options.GatewaySelector),
 		svc.RoleClient(options.RoleClient),
 		svc.TraceProvider(options.TracerProvider),
+		svc.EventsPublisher(options.EventsPublisher),
 	)
 	if err != nil {
 		return http.Service{}, err
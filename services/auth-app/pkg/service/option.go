@@ -8,6 +8,7 @@ import (
 	"github.com/opencloud-eu/opencloud/pkg/log"
 	settingssvc "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/settings/v0"
 	"github.com/opencloud-eu/opencloud/services/auth-app/pkg/config"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
 	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -24,6 +25,7 @@ type Options struct {
 	Mux             *chi.Mux
 	TracerProvider  trace.TracerProvider
 	RoleClient      settingssvc.RoleService
+	EventsPublisher events.Publisher
 }
 
 // Logger provides a function to set the logger option.
@@ -74,3 +76,10 @@ func RoleClient(rs settingssvc.RoleService) Option {
 		o.RoleClient = rs
 	}
 }
+
+// EventsPublisher provides a function to set the EventsPublisher option
+func EventsPublisher(val events.Publisher) Option {
+	return func(o *Options) {
+		o.EventsPublisher = val
+	}
+}
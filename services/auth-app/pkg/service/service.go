@@ -17,10 +17,12 @@ import (
 	"github.com/opencloud-eu/opencloud/pkg/log"
 	"github.com/opencloud-eu/opencloud/pkg/roles"
 	"github.com/opencloud-eu/opencloud/services/auth-app/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/auth-app/pkg/event"
 	settings "github.com/opencloud-eu/opencloud/services/settings/pkg/service/v0"
 	"github.com/opencloud-eu/reva/v2/pkg/appctx"
 	"github.com/opencloud-eu/reva/v2/pkg/auth/scope"
 	ctxpkg "github.com/opencloud-eu/reva/v2/pkg/ctx"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
 	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
 	"github.com/opencloud-eu/reva/v2/pkg/utils"
 	"google.golang.org/grpc/metadata"
@@ -43,6 +45,7 @@ type AuthAppService struct {
 	gws pool.Selectable[gateway.GatewayAPIClient]
 	m   *chi.Mux
 	r   *roles.Manager
+	pub events.Publisher
 }
 
 // NewAuthAppService initializes a new AuthAppService.
@@ -63,6 +66,7 @@ func NewAuthAppService(opts ...Option) (*AuthAppService, error) {
 		gws: o.GatewaySelector,
 		m:   o.Mux,
 		r:   &r,
+		pub: o.EventsPublisher,
 	}
 
 	a.m.Route("/auth-app/tokens", func(r chi.Router) {
@@ -82,7 +86,8 @@ func (a *AuthAppService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 // HandleCreate handles the creation of app tokens
 func (a *AuthAppService) HandleCreate(w http.ResponseWriter, r *http.Request) {
 	ctx := getContext(r)
-	sublog := a.log.With().Str("actor", ctxpkg.ContextMustGetUser(ctx).GetId().GetOpaqueId()).Logger()
+	actor := ctxpkg.ContextMustGetUser(ctx).GetId()
+	sublog := a.log.With().Str("actor", actor.GetOpaqueId()).Logger()
 
 	gwc, err := a.gws.Next()
 	if err != nil {
@@ -160,6 +165,14 @@ func (a *AuthAppService) HandleCreate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	a.publishEvent(ctx, event.AppTokenCreated{
+		Executant:  actor,
+		UserID:     ctxpkg.ContextMustGetUser(ctx).GetId(),
+		Label:      label,
+		Expiration: res.GetAppPassword().GetExpiration(),
+		Timestamp:  utils.TSNow(),
+	})
+
 	b, err := json.Marshal(convert(res.GetAppPassword()))
 	if err != nil {
 		sublog.Error().Err(err).Msg("error marshaling app password")
@@ -250,9 +263,24 @@ func (a *AuthAppService) HandleDelete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	a.publishEvent(ctx, event.AppTokenDeleted{
+		Executant: ctxpkg.ContextMustGetUser(ctx).GetId(),
+		Timestamp: utils.TSNow(),
+	})
+
 	w.WriteHeader(http.StatusOK)
 }
 
+// publishEvent publishes the event if an events publisher is configured
+func (a *AuthAppService) publishEvent(ctx context.Context, ev interface{}) {
+	if a.pub == nil {
+		return
+	}
+	if err := events.Publish(ctx, a.pub, ev); err != nil {
+		a.log.Error().Err(err).Msg("could not publish event")
+	}
+}
+
 func (a *AuthAppService) authenticateUser(userID, userName string, gwc gateway.GatewayAPIClient) (context.Context, error) {
 	ctx := context.Background()
 	authRes, err := gwc.Authenticate(ctx, &gateway.AuthenticateRequest{
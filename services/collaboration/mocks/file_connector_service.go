@@ -39,6 +39,68 @@ func (_m *FileConnectorService) EXPECT() *FileConnectorService_Expecter {
 	return &FileConnectorService_Expecter{mock: &_m.Mock}
 }
 
+// CheckContainerInfo provides a mock function for the type FileConnectorService
+func (_mock *FileConnectorService) CheckContainerInfo(ctx context.Context) (*connector.ConnectorResponse, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckContainerInfo")
+	}
+
+	var r0 *connector.ConnectorResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*connector.ConnectorResponse, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *connector.ConnectorResponse); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connector.ConnectorResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// FileConnectorService_CheckContainerInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckContainerInfo'
+type FileConnectorService_CheckContainerInfo_Call struct {
+	*mock.Call
+}
+
+// CheckContainerInfo is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *FileConnectorService_Expecter) CheckContainerInfo(ctx interface{}) *FileConnectorService_CheckContainerInfo_Call {
+	return &FileConnectorService_CheckContainerInfo_Call{Call: _e.mock.On("CheckContainerInfo", ctx)}
+}
+
+func (_c *FileConnectorService_CheckContainerInfo_Call) Run(run func(ctx context.Context)) *FileConnectorService_CheckContainerInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *FileConnectorService_CheckContainerInfo_Call) Return(connectorResponse *connector.ConnectorResponse, err error) *FileConnectorService_CheckContainerInfo_Call {
+	_c.Call.Return(connectorResponse, err)
+	return _c
+}
+
+func (_c *FileConnectorService_CheckContainerInfo_Call) RunAndReturn(run func(ctx context.Context) (*connector.ConnectorResponse, error)) *FileConnectorService_CheckContainerInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckFileInfo provides a mock function for the type FileConnectorService
 func (_mock *FileConnectorService) CheckFileInfo(ctx context.Context) (*connector.ConnectorResponse, error) {
 	ret := _mock.Called(ctx)
@@ -169,6 +231,198 @@ func (_c *FileConnectorService_DeleteFile_Call) RunAndReturn(run func(ctx contex
 	return _c
 }
 
+// EnumerateAncestors provides a mock function for the type FileConnectorService
+func (_mock *FileConnectorService) EnumerateAncestors(ctx context.Context) (*connector.ConnectorResponse, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnumerateAncestors")
+	}
+
+	var r0 *connector.ConnectorResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*connector.ConnectorResponse, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *connector.ConnectorResponse); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connector.ConnectorResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// FileConnectorService_EnumerateAncestors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnumerateAncestors'
+type FileConnectorService_EnumerateAncestors_Call struct {
+	*mock.Call
+}
+
+// EnumerateAncestors is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *FileConnectorService_Expecter) EnumerateAncestors(ctx interface{}) *FileConnectorService_EnumerateAncestors_Call {
+	return &FileConnectorService_EnumerateAncestors_Call{Call: _e.mock.On("EnumerateAncestors", ctx)}
+}
+
+func (_c *FileConnectorService_EnumerateAncestors_Call) Run(run func(ctx context.Context)) *FileConnectorService_EnumerateAncestors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *FileConnectorService_EnumerateAncestors_Call) Return(connectorResponse *connector.ConnectorResponse, err error) *FileConnectorService_EnumerateAncestors_Call {
+	_c.Call.Return(connectorResponse, err)
+	return _c
+}
+
+func (_c *FileConnectorService_EnumerateAncestors_Call) RunAndReturn(run func(ctx context.Context) (*connector.ConnectorResponse, error)) *FileConnectorService_EnumerateAncestors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnumerateChildren provides a mock function for the type FileConnectorService
+func (_mock *FileConnectorService) EnumerateChildren(ctx context.Context, extensions []string) (*connector.ConnectorResponse, error) {
+	ret := _mock.Called(ctx, extensions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnumerateChildren")
+	}
+
+	var r0 *connector.ConnectorResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) (*connector.ConnectorResponse, error)); ok {
+		return returnFunc(ctx, extensions)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) *connector.ConnectorResponse); ok {
+		r0 = returnFunc(ctx, extensions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connector.ConnectorResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, extensions)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// FileConnectorService_EnumerateChildren_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnumerateChildren'
+type FileConnectorService_EnumerateChildren_Call struct {
+	*mock.Call
+}
+
+// EnumerateChildren is a helper method to define mock.On call
+//   - ctx context.Context
+//   - extensions []string
+func (_e *FileConnectorService_Expecter) EnumerateChildren(ctx interface{}, extensions interface{}) *FileConnectorService_EnumerateChildren_Call {
+	return &FileConnectorService_EnumerateChildren_Call{Call: _e.mock.On("EnumerateChildren", ctx, extensions)}
+}
+
+func (_c *FileConnectorService_EnumerateChildren_Call) Run(run func(ctx context.Context, extensions []string)) *FileConnectorService_EnumerateChildren_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *FileConnectorService_EnumerateChildren_Call) Return(connectorResponse *connector.ConnectorResponse, err error) *FileConnectorService_EnumerateChildren_Call {
+	_c.Call.Return(connectorResponse, err)
+	return _c
+}
+
+func (_c *FileConnectorService_EnumerateChildren_Call) RunAndReturn(run func(ctx context.Context, extensions []string) (*connector.ConnectorResponse, error)) *FileConnectorService_EnumerateChildren_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEcosystem provides a mock function for the type FileConnectorService
+func (_mock *FileConnectorService) GetEcosystem(ctx context.Context) (*connector.ConnectorResponse, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEcosystem")
+	}
+
+	var r0 *connector.ConnectorResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*connector.ConnectorResponse, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *connector.ConnectorResponse); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connector.ConnectorResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// FileConnectorService_GetEcosystem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEcosystem'
+type FileConnectorService_GetEcosystem_Call struct {
+	*mock.Call
+}
+
+// GetEcosystem is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *FileConnectorService_Expecter) GetEcosystem(ctx interface{}) *FileConnectorService_GetEcosystem_Call {
+	return &FileConnectorService_GetEcosystem_Call{Call: _e.mock.On("GetEcosystem", ctx)}
+}
+
+func (_c *FileConnectorService_GetEcosystem_Call) Run(run func(ctx context.Context)) *FileConnectorService_GetEcosystem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *FileConnectorService_GetEcosystem_Call) Return(connectorResponse *connector.ConnectorResponse, err error) *FileConnectorService_GetEcosystem_Call {
+	_c.Call.Return(connectorResponse, err)
+	return _c
+}
+
+func (_c *FileConnectorService_GetEcosystem_Call) RunAndReturn(run func(ctx context.Context) (*connector.ConnectorResponse, error)) *FileConnectorService_GetEcosystem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLock provides a mock function for the type FileConnectorService
 func (_mock *FileConnectorService) GetLock(ctx context.Context) (*connector.ConnectorResponse, error) {
 	ret := _mock.Called(ctx)
@@ -231,6 +485,136 @@ func (_c *FileConnectorService_GetLock_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// GetRootContainer provides a mock function for the type FileConnectorService
+func (_mock *FileConnectorService) GetRootContainer(ctx context.Context) (*connector.ConnectorResponse, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRootContainer")
+	}
+
+	var r0 *connector.ConnectorResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*connector.ConnectorResponse, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *connector.ConnectorResponse); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connector.ConnectorResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// FileConnectorService_GetRootContainer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRootContainer'
+type FileConnectorService_GetRootContainer_Call struct {
+	*mock.Call
+}
+
+// GetRootContainer is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *FileConnectorService_Expecter) GetRootContainer(ctx interface{}) *FileConnectorService_GetRootContainer_Call {
+	return &FileConnectorService_GetRootContainer_Call{Call: _e.mock.On("GetRootContainer", ctx)}
+}
+
+func (_c *FileConnectorService_GetRootContainer_Call) Run(run func(ctx context.Context)) *FileConnectorService_GetRootContainer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *FileConnectorService_GetRootContainer_Call) Return(connectorResponse *connector.ConnectorResponse, err error) *FileConnectorService_GetRootContainer_Call {
+	_c.Call.Return(connectorResponse, err)
+	return _c
+}
+
+func (_c *FileConnectorService_GetRootContainer_Call) RunAndReturn(run func(ctx context.Context) (*connector.ConnectorResponse, error)) *FileConnectorService_GetRootContainer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetShareUrl provides a mock function for the type FileConnectorService
+func (_mock *FileConnectorService) GetShareUrl(ctx context.Context, urlType string) (*connector.ConnectorResponse, error) {
+	ret := _mock.Called(ctx, urlType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShareUrl")
+	}
+
+	var r0 *connector.ConnectorResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*connector.ConnectorResponse, error)); ok {
+		return returnFunc(ctx, urlType)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *connector.ConnectorResponse); ok {
+		r0 = returnFunc(ctx, urlType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connector.ConnectorResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, urlType)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// FileConnectorService_GetShareUrl_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetShareUrl'
+type FileConnectorService_GetShareUrl_Call struct {
+	*mock.Call
+}
+
+// GetShareUrl is a helper method to define mock.On call
+//   - ctx context.Context
+//   - urlType string
+func (_e *FileConnectorService_Expecter) GetShareUrl(ctx interface{}, urlType interface{}) *FileConnectorService_GetShareUrl_Call {
+	return &FileConnectorService_GetShareUrl_Call{Call: _e.mock.On("GetShareUrl", ctx, urlType)}
+}
+
+func (_c *FileConnectorService_GetShareUrl_Call) Run(run func(ctx context.Context, urlType string)) *FileConnectorService_GetShareUrl_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *FileConnectorService_GetShareUrl_Call) Return(connectorResponse *connector.ConnectorResponse, err error) *FileConnectorService_GetShareUrl_Call {
+	_c.Call.Return(connectorResponse, err)
+	return _c
+}
+
+func (_c *FileConnectorService_GetShareUrl_Call) RunAndReturn(run func(ctx context.Context, urlType string) (*connector.ConnectorResponse, error)) *FileConnectorService_GetShareUrl_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Lock provides a mock function for the type FileConnectorService
 func (_mock *FileConnectorService) Lock(ctx context.Context, lockID string, oldLockID string) (*connector.ConnectorResponse, error) {
 	ret := _mock.Called(ctx, lockID, oldLockID)
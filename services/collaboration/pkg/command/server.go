@@ -22,6 +22,7 @@ import (
 	"github.com/opencloud-eu/opencloud/services/collaboration/pkg/server/debug"
 	"github.com/opencloud-eu/opencloud/services/collaboration/pkg/server/grpc"
 	"github.com/opencloud-eu/opencloud/services/collaboration/pkg/server/http"
+	"github.com/opencloud-eu/opencloud/services/collaboration/pkg/sessions"
 	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
 	"github.com/opencloud-eu/reva/v2/pkg/store"
 )
@@ -71,25 +72,31 @@ func Server(cfg *config.Config) *cli.Command {
 
 			// use the AppURLs helper (an atomic pointer) to fetch and store the app URLs
 			// this is required as the app URLs are fetched periodically in the background
-			// and read when handling requests
-			appURLs := helpers.NewAppURLs()
+			// and read when handling requests. Every app has its own discovery.
+			apps := cfg.EnabledApps()
+			appURLs := make([]*helpers.AppURLs, len(apps))
+			for i := range apps {
+				appURLs[i] = helpers.NewAppURLs()
+			}
 
 			ticker := time.NewTicker(cfg.CS3Api.APPRegistrationInterval)
 			defer ticker.Stop()
 			go func() {
 				for ; true; <-ticker.C {
-					// fetch and store the app URLs
-					v, err := helpers.GetAppURLs(cfg, logger)
-					if err != nil {
-						logger.Warn().Err(err).Msg("Failed to get app URLs")
-						// empty map to clear previous URLs
-						v = make(map[string]map[string]string)
-					}
-					appURLs.Store(v)
-
-					// register the app provider
-					if err := helpers.RegisterAppProvider(ctx, cfg, logger, gatewaySelector, appURLs); err != nil {
-						logger.Warn().Err(err).Msg("Failed to register app provider")
+					for i, app := range apps {
+						// fetch and store the app URLs
+						v, err := helpers.GetAppURLs(app, logger)
+						if err != nil {
+							logger.Warn().Err(err).Str("AppName", app.Name).Msg("Failed to get app URLs")
+							// empty map to clear previous URLs
+							v = make(map[string]map[string]string)
+						}
+						appURLs[i].Store(v)
+
+						// register the app provider
+						if err := helpers.RegisterAppProvider(ctx, cfg, app, logger, gatewaySelector, appURLs[i]); err != nil {
+							logger.Warn().Err(err).Str("AppName", app.Name).Msg("Failed to register app provider")
+						}
 					}
 				}
 			}()
@@ -103,27 +110,46 @@ func Server(cfg *config.Config) *cli.Command {
 				store.Authentication(cfg.Store.AuthUsername, cfg.Store.AuthPassword),
 			)
 
+			// the revocations have to outlive the access tokens, which live
+			// much longer than the sessions without heartbeat
+			revocationStore := store.Create(
+				store.Store(cfg.Store.Store),
+				store.TTL(cfg.Store.RevocationTTL),
+				microstore.Nodes(cfg.Store.Nodes...),
+				microstore.Database(cfg.Store.Database+"-revocations"),
+				microstore.Table(cfg.Store.Table),
+				store.Authentication(cfg.Store.AuthUsername, cfg.Store.AuthPassword),
+			)
+
+			// the editing sessions are kept in the store, so they're shared
+			// with the other instances using the same store
+			sessionRegistry := sessions.NewRegistry(st, revocationStore, cfg.Store.TTL)
+
 			gr := runner.NewGroup()
 
-			// start GRPC server
-			grpcServer, teardown, err := grpc.Server(
-				grpc.AppURLs(appURLs),
-				grpc.Config(cfg),
-				grpc.Logger(logger),
-				grpc.TraceProvider(traceProvider),
-				grpc.Store(st),
-			)
-			defer teardown()
-			if err != nil {
-				logger.Error().Err(err).Str("transport", "grpc").Msg("Failed to initialize server")
-				return err
-			}
+			// start a GRPC server for every app, the app registry tells the apps
+			// apart by their address
+			for i, app := range apps {
+				grpcServer, teardown, err := grpc.Server(
+					grpc.App(app),
+					grpc.AppURLs(appURLs[i]),
+					grpc.Config(cfg),
+					grpc.Logger(logger),
+					grpc.TraceProvider(traceProvider),
+					grpc.Store(st),
+				)
+				defer teardown()
+				if err != nil {
+					logger.Error().Err(err).Str("transport", "grpc").Str("AppName", app.Name).Msg("Failed to initialize server")
+					return err
+				}
 
-			l, err := net.Listen("tcp", cfg.GRPC.Addr)
-			if err != nil {
-				return err
+				l, err := net.Listen("tcp", cfg.AppGRPCAddr(app))
+				if err != nil {
+					return err
+				}
+				gr.Add(runner.NewGolangGrpcServerRunner(cfg.AppServiceName(app)+".grpc", grpcServer, l))
 			}
-			gr.Add(runner.NewGolangGrpcServerRunner(cfg.Service.Name+".grpc", grpcServer, l))
 
 			// start debug server
 			debugServer, err := debug.Server(
@@ -145,6 +171,7 @@ func Server(cfg *config.Config) *cli.Command {
 				http.Context(ctx),
 				http.TracerProvider(traceProvider),
 				http.Store(st),
+				http.Sessions(sessionRegistry, sessions.NewHandler(gatewaySelector, cfg, sessionRegistry)),
 			)
 			if err != nil {
 				logger.Info().Err(err).Str("transport", "http").Msg("Failed to initialize server")
@@ -1,5 +1,7 @@
 package config
 
+import "strings"
+
 // App defines the available app configuration.
 type App struct {
 	Name        string `yaml:"name" env:"COLLABORATION_APP_NAME" desc:"The name of the app which is shown to the user. You can chose freely but you are limited to a single word without special characters or whitespaces. We recommend to use pascalCase like 'CollaboraOnline'." introductionVersion:"1.0.0"`
@@ -10,6 +12,8 @@ type App struct {
 	Addr     string `yaml:"addr" env:"COLLABORATION_APP_ADDR" desc:"The URL where the WOPI app is located, such as https://127.0.0.1:8080." introductionVersion:"1.0.0"`
 	Insecure bool   `yaml:"insecure" env:"COLLABORATION_APP_INSECURE" desc:"Skip TLS certificate verification when connecting to the WOPI app" introductionVersion:"1.0.0"`
 
+	GRPCAddr string `yaml:"grpc_addr" desc:"The bind address of the GRPC service of the app. Only used for the apps configured in the 'apps' list, each of them needs its own address." introductionVersion:"%%NEXT%%"`
+
 	ProofKeys          ProofKeys `yaml:"proofkeys"`
 	LicenseCheckEnable bool      `yaml:"licensecheckenable" env:"COLLABORATION_APP_LICENSE_CHECK_ENABLE" desc:"Enable license checking to edit files. Needs to be enabled when using Microsoft365 with the business flow." introductionVersion:"1.0.0"`
 }
@@ -18,3 +22,48 @@ type ProofKeys struct {
 	Disable  bool   `yaml:"disable" env:"COLLABORATION_APP_PROOF_DISABLE" desc:"Disable the proof keys verification" introductionVersion:"1.0.0"`
 	Duration string `yaml:"duration" env:"COLLABORATION_APP_PROOF_DURATION" desc:"Duration for the proof keys to be cached in memory, using time.ParseDuration format. If the duration can't be parsed, we'll use the default 12h as duration" introductionVersion:"1.0.0"`
 }
+
+// EnabledApps returns the apps served by this service. If the "apps" list is
+// configured, it replaces the single "app" configuration.
+func (c *Config) EnabledApps() []App {
+	if len(c.Apps) == 0 {
+		return []App{c.App}
+	}
+	return c.Apps
+}
+
+// GetApp returns the enabled app with the given name. The name is compared
+// case-insensitively. With a single app, or if the name is empty, the first
+// enabled app is returned. This keeps access tokens issued before the app
+// was renamed or before several apps were configured working.
+func (c *Config) GetApp(name string) (App, bool) {
+	apps := c.EnabledApps()
+	if name == "" || len(c.Apps) == 0 {
+		return apps[0], true
+	}
+	for _, app := range apps {
+		if strings.EqualFold(app.Name, name) {
+			return app, true
+		}
+	}
+	return App{}, false
+}
+
+// AppServiceName returns the name of the GRPC service the app is registered
+// with. With a single app, the name of the service is used. With several
+// apps, every app gets its own service with the app name as suffix.
+func (c *Config) AppServiceName(app App) string {
+	name := c.GRPC.Namespace + "." + c.Service.Name
+	if len(c.Apps) == 0 {
+		return name
+	}
+	return name + "." + strings.ToLower(app.Name)
+}
+
+// AppGRPCAddr returns the bind address of the GRPC service of the app.
+func (c *Config) AppGRPCAddr(app App) string {
+	if len(c.Apps) == 0 {
+		return c.GRPC.Addr
+	}
+	return app.GRPCAddr
+}
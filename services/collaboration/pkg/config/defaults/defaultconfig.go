@@ -34,11 +34,12 @@ func DefaultConfig() *config.Config {
 			},
 		},
 		Store: config.Store{
-			Store:    "nats-js-kv",
-			Nodes:    []string{"127.0.0.1:9233"},
-			Database: "collaboration",
-			Table:    "",
-			TTL:      30 * time.Minute,
+			Store:         "nats-js-kv",
+			Nodes:         []string{"127.0.0.1:9233"},
+			Database:      "collaboration",
+			Table:         "",
+			TTL:           30 * time.Minute,
+			RevocationTTL: 24 * time.Hour,
 		},
 		GRPC: config.GRPC{
 			Addr:      "127.0.0.1:9301",
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"strings"
 
 	occfg "github.com/opencloud-eu/opencloud/pkg/config"
 	ocdefaults "github.com/opencloud-eu/opencloud/pkg/config/defaults"
@@ -59,5 +60,31 @@ func Validate(cfg *config.Config) error {
 			cfg.Service.Name, ocdefaults.BaseConfigPath())
 	}
 
+	return validateApps(cfg)
+}
+
+// validateApps checks that the apps configured in the "apps" list can be
+// told apart by their name and GRPC address
+func validateApps(cfg *config.Config) error {
+	names := make(map[string]bool, len(cfg.Apps))
+	addrs := make(map[string]bool, len(cfg.Apps))
+	for i, app := range cfg.Apps {
+		switch {
+		case app.Name == "":
+			return fmt.Errorf("the app %d of the %s config has no name", i, cfg.Service.Name)
+		case strings.ContainsAny(app.Name, " ./"):
+			return fmt.Errorf("the name of the app '%s' of the %s config must be a single word", app.Name, cfg.Service.Name)
+		case names[strings.ToLower(app.Name)]:
+			return fmt.Errorf("the app name '%s' is used more than once in the %s config", app.Name, cfg.Service.Name)
+		case app.Addr == "":
+			return fmt.Errorf("the app '%s' of the %s config has no address", app.Name, cfg.Service.Name)
+		case app.GRPCAddr == "":
+			return fmt.Errorf("the app '%s' of the %s config has no GRPC address", app.Name, cfg.Service.Name)
+		case addrs[app.GRPCAddr]:
+			return fmt.Errorf("the GRPC address '%s' is used by more than one app in the %s config", app.GRPCAddr, cfg.Service.Name)
+		}
+		names[strings.ToLower(app.Name)] = true
+		addrs[app.GRPCAddr] = true
+	}
 	return nil
 }
@@ -6,10 +6,12 @@ import (
 	"encoding/base64"
 	"encoding/binary"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"net/url"
 	"path"
+	"slices"
 	"strings"
 	"time"
 
@@ -42,6 +44,9 @@ const (
 	lockDuration time.Duration = 30 * time.Minute
 )
 
+// supportedShareURLTypes are the URL types supported by the GetShareUrl operation
+var supportedShareURLTypes = []string{"ReadOnly", "ReadWrite"}
+
 // FileConnectorService is the interface to implement the "Files"
 // endpoint. Basically lock operations on the file plus the CheckFileInfo.
 // All operations need a context containing a WOPI context and, optionally,
@@ -94,6 +99,24 @@ type FileConnectorService interface {
 	// In case of conflict, this method will return the actual lockId in
 	// the file as second return value.
 	RenameFile(ctx context.Context, lockID, target string) (*ConnectorResponse, error)
+	// GetShareUrl will return the URL to share the target file. The urlType
+	// must be one of the supported share URL types ("ReadOnly" or "ReadWrite")
+	GetShareUrl(ctx context.Context, urlType string) (*ConnectorResponse, error)
+	// CheckContainerInfo will return the information of the target container
+	CheckContainerInfo(ctx context.Context) (*ConnectorResponse, error)
+	// EnumerateChildren will return the files and containers inside the
+	// target container. If extensions are provided, only the files matching
+	// any of those extensions will be returned.
+	EnumerateChildren(ctx context.Context, extensions []string) (*ConnectorResponse, error)
+	// EnumerateAncestors will return the containers above the target file
+	// or container, the root container first
+	EnumerateAncestors(ctx context.Context) (*ConnectorResponse, error)
+	// GetEcosystem will return the URL of the ecosystem endpoint for the
+	// target file or container
+	GetEcosystem(ctx context.Context) (*ConnectorResponse, error)
+	// GetRootContainer will return the topmost container the user can
+	// access above the target file or container
+	GetRootContainer(ctx context.Context) (*ConnectorResponse, error)
 }
 
 // FileConnector implements the "File" endpoint.
@@ -215,7 +238,7 @@ func (f *FileConnector) Lock(ctx context.Context, lockID, oldLockID string) (*Co
 			Ref: wopiContext.FileReference,
 			Lock: &providerv1beta1.Lock{
 				LockId:  lockID,
-				AppName: f.cfg.App.Name,
+				AppName: f.app(wopiContext).Name,
 				Type:    providerv1beta1.LockType_LOCK_TYPE_WRITE,
 				Expiration: &typesv1beta1.Timestamp{
 					Seconds: uint64(time.Now().Add(lockDuration).Unix()),
@@ -241,7 +264,7 @@ func (f *FileConnector) Lock(ctx context.Context, lockID, oldLockID string) (*Co
 			Ref: wopiContext.FileReference,
 			Lock: &providerv1beta1.Lock{
 				LockId:  lockID,
-				AppName: f.cfg.App.Name,
+				AppName: f.app(wopiContext).Name,
 				Type:    providerv1beta1.LockType_LOCK_TYPE_WRITE,
 				Expiration: &typesv1beta1.Timestamp{
 					Seconds: uint64(time.Now().Add(lockDuration).Unix()),
@@ -384,7 +407,7 @@ func (f *FileConnector) RefreshLock(ctx context.Context, lockID string) (*Connec
 		Ref: wopiContext.FileReference,
 		Lock: &providerv1beta1.Lock{
 			LockId:  lockID,
-			AppName: f.cfg.App.Name,
+			AppName: f.app(wopiContext).Name,
 			Type:    providerv1beta1.LockType_LOCK_TYPE_WRITE,
 			Expiration: &typesv1beta1.Timestamp{
 				Seconds: uint64(time.Now().Add(lockDuration).Unix()),
@@ -524,7 +547,7 @@ func (f *FileConnector) UnLock(ctx context.Context, lockID string) (*ConnectorRe
 		Ref: wopiContext.FileReference,
 		Lock: &providerv1beta1.Lock{
 			LockId:  lockID,
-			AppName: f.cfg.App.Name,
+			AppName: f.app(wopiContext).Name,
 		},
 	}
 
@@ -742,8 +765,8 @@ func (f *FileConnector) PutRelativeFileSuggested(ctx context.Context, ccs Conten
 	return NewResponseSuccessBodyNameUrl(
 		finalTarget,
 		wopiSrcURL.String(),
-		createHostUrl("write", webURL, strings.ToLower(f.cfg.App.Name), newInfo),
-		createHostUrl("view", webURL, strings.ToLower(f.cfg.App.Name), newInfo),
+		createHostUrl("write", webURL, strings.ToLower(f.app(wopiContext).Name), newInfo),
+		createHostUrl("view", webURL, strings.ToLower(f.app(wopiContext).Name), newInfo),
 	), nil
 }
 
@@ -867,8 +890,8 @@ func (f *FileConnector) PutRelativeFileRelative(ctx context.Context, ccs Content
 			Body: map[string]interface{}{
 				"Name":        target,
 				"Url":         wopiSrcURL.String(),
-				"HostViewUrl": createHostUrl("view", webURL, strings.ToLower(f.cfg.App.Name), newInfo),
-				"HostEditUrl": createHostUrl("write", webURL, strings.ToLower(f.cfg.App.Name), newInfo),
+				"HostViewUrl": createHostUrl("view", webURL, strings.ToLower(f.app(wopiContext).Name), newInfo),
+				"HostEditUrl": createHostUrl("write", webURL, strings.ToLower(f.app(wopiContext).Name), newInfo),
 			},
 		}, nil
 	default:
@@ -893,8 +916,8 @@ func (f *FileConnector) PutRelativeFileRelative(ctx context.Context, ccs Content
 	return NewResponseSuccessBodyNameUrl(
 		target,
 		wopiSrcURL.String(),
-		createHostUrl("write", webURL, strings.ToLower(f.cfg.App.Name), newInfo),
-		createHostUrl("view", webURL, strings.ToLower(f.cfg.App.Name), newInfo),
+		createHostUrl("write", webURL, strings.ToLower(f.app(wopiContext).Name), newInfo),
+		createHostUrl("view", webURL, strings.ToLower(f.app(wopiContext).Name), newInfo),
 	), nil
 }
 
@@ -1178,7 +1201,7 @@ func (f *FileConnector) CheckFileInfo(ctx context.Context) (*ConnectorResponse,
 	// This will help with the CI because we're using a "FakeOffice" app
 	// for the wopi validator, which requires a Microsoft fileinfo
 	var info fileinfo.FileInfo
-	switch strings.ToLower(f.cfg.App.Product) {
+	switch strings.ToLower(f.app(wopiContext).Product) {
 	case "collabora":
 		info = &fileinfo.Collabora{}
 	case "onlyoffice":
@@ -1260,8 +1283,8 @@ func (f *FileConnector) CheckFileInfo(ctx context.Context) (*ConnectorResponse,
 		fileinfo.KeyBreadcrumbFolderName: breadcrumbFolderName,
 		fileinfo.KeyBreadcrumbFolderURL:  parentFolderURL.String(),
 
-		fileinfo.KeyHostViewURL:    createHostUrl("view", ocURL, f.cfg.App.Name, statRes.GetInfo()),
-		fileinfo.KeyHostEditURL:    createHostUrl("write", ocURL, f.cfg.App.Name, statRes.GetInfo()),
+		fileinfo.KeyHostViewURL:    createHostUrl("view", ocURL, f.app(wopiContext).Name, statRes.GetInfo()),
+		fileinfo.KeyHostEditURL:    createHostUrl("write", ocURL, f.app(wopiContext).Name, statRes.GetInfo()),
 		fileinfo.KeyFileSharingURL: createShareUrl(privateLinkURL),
 		fileinfo.KeyFileVersionURL: createVersionsUrl(privateLinkURL),
 
@@ -1273,6 +1296,9 @@ func (f *FileConnector) CheckFileInfo(ctx context.Context) (*ConnectorResponse,
 		fileinfo.KeySupportsUpdate:             true,
 		fileinfo.KeySupportsDeleteFile:         true,
 		fileinfo.KeySupportsRename:             true,
+		fileinfo.KeySupportsContainers:         true,
+		fileinfo.KeySupportsEcosystem:          true,
+		fileinfo.KeySupportedShareURLTypes:     supportedShareURLTypes,
 
 		fileinfo.KeyIsAnonymousUser:  isAnonymousUser,
 		fileinfo.KeyIsAdminUser:      isAdminUser,
@@ -1280,7 +1306,7 @@ func (f *FileConnector) CheckFileInfo(ctx context.Context) (*ConnectorResponse,
 		fileinfo.KeyUserID:           userId,
 
 		fileinfo.KeyPostMessageOrigin:            f.cfg.Commons.OpenCloudURL,
-		fileinfo.KeyLicenseCheckForEditIsEnabled: f.cfg.App.LicenseCheckEnable,
+		fileinfo.KeyLicenseCheckForEditIsEnabled: f.app(wopiContext).LicenseCheckEnable,
 
 		fileinfo.KeyUserCanNotWriteRelative: false,
 	}
@@ -1315,6 +1341,410 @@ func (f *FileConnector) CheckFileInfo(ctx context.Context) (*ConnectorResponse,
 	return NewResponseSuccessBody(info), nil
 }
 
+// GetShareUrl returns the URL to share the file
+// https://learn.microsoft.com/en-us/microsoft-365/cloud-storage-partner-program/rest/files/getshareurl
+//
+// The context MUST have a WOPI context, otherwise an error will be returned.
+// You can pass a pre-configured zerologger instance through the context that
+// will be used to log messages.
+//
+// Both supported URL types point to the sharing panel of the file in the
+// web UI, where the user can decide how to share the file. A 501 response
+// will be returned for any other URL type.
+func (f *FileConnector) GetShareUrl(ctx context.Context, urlType string) (*ConnectorResponse, error) {
+	wopiContext, err := middleware.WopiContextFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := zerolog.Ctx(ctx).With().
+		Str("UrlType", urlType).
+		Logger()
+
+	if !slices.Contains(supportedShareURLTypes, urlType) {
+		logger.Debug().Msg("GetShareUrl: unsupported url type")
+		return NewResponse(501), nil
+	}
+
+	ocURL, err := url.Parse(f.cfg.Commons.OpenCloudURL)
+	if err != nil {
+		return nil, err
+	}
+	privateLinkURL := *ocURL
+	privateLinkURL.Path = path.Join(ocURL.Path, "f", storagespace.FormatResourceID(wopiContext.FileReference.GetResourceId()))
+
+	logger.Debug().Msg("GetShareUrl: success")
+	return NewResponseSuccessBody(map[string]interface{}{
+		"ShareUrl": createShareUrl(&privateLinkURL),
+	}), nil
+}
+
+// CheckContainerInfo returns information about the container
+// https://learn.microsoft.com/en-us/microsoft-365/cloud-storage-partner-program/rest/containers/checkcontainerinfo
+//
+// The context MUST have a WOPI context, otherwise an error will be returned.
+// You can pass a pre-configured zerologger instance through the context that
+// will be used to log messages.
+//
+// A 404 response will be returned if the target isn't a container. The
+// operations to create, rename or delete containers aren't supported, so the
+// user won't be allowed to use them.
+func (f *FileConnector) CheckContainerInfo(ctx context.Context) (*ConnectorResponse, error) {
+	wopiContext, err := middleware.WopiContextFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := zerolog.Ctx(ctx)
+
+	gwc, err := f.gws.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := f.statResource(ctx, gwc, wopiContext.FileReference, logger)
+	if err != nil {
+		return connectorErrorResponse(err)
+	}
+	if info.GetType() != providerv1beta1.ResourceType_RESOURCE_TYPE_CONTAINER {
+		logger.Error().Msg("CheckContainerInfo: target isn't a container")
+		return NewResponse(404), nil
+	}
+
+	ocURL, err := url.Parse(f.cfg.Commons.OpenCloudURL)
+	if err != nil {
+		return nil, err
+	}
+	privateLinkURL := *ocURL
+	privateLinkURL.Path = path.Join(ocURL.Path, "f", storagespace.FormatResourceID(info.GetId()))
+
+	logger.Debug().Msg("CheckContainerInfo: success")
+	return NewResponseSuccessBody(map[string]interface{}{
+		"Name":                         resourceName(info),
+		"HostUrl":                      privateLinkURL.String(),
+		"SharingUrl":                   createShareUrl(&privateLinkURL),
+		"UserCanCreateChildContainer":  false,
+		"UserCanCreateChildFile":       false,
+		"UserCanDelete":                false,
+		"UserCanRename":                false,
+		"LicenseCheckForEditIsEnabled": f.app(wopiContext).LicenseCheckEnable,
+	}), nil
+}
+
+// EnumerateChildren returns the contents of the container
+// https://learn.microsoft.com/en-us/microsoft-365/cloud-storage-partner-program/rest/containers/enumeratechildren
+//
+// The context MUST have a WOPI context, otherwise an error will be returned.
+// You can pass a pre-configured zerologger instance through the context that
+// will be used to log messages.
+//
+// The files will be returned in the "Children" list and the containers in
+// the "ChildContainers" list. Each of them comes with its own WOPI URL,
+// including a new access token for that resource. If extensions (such as
+// ".docx") are provided, only the files with any of those extensions will
+// be returned.
+func (f *FileConnector) EnumerateChildren(ctx context.Context, extensions []string) (*ConnectorResponse, error) {
+	wopiContext, err := middleware.WopiContextFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := zerolog.Ctx(ctx)
+
+	gwc, err := f.gws.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	listRes, err := gwc.ListContainer(ctx, &providerv1beta1.ListContainerRequest{
+		Ref: wopiContext.FileReference,
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("EnumerateChildren: list container failed")
+		return nil, err
+	}
+
+	if listRes.GetStatus().GetCode() != rpcv1beta1.Code_CODE_OK {
+		logger.Error().
+			Str("StatusCode", listRes.GetStatus().GetCode().String()).
+			Str("StatusMsg", listRes.GetStatus().GetMessage()).
+			Msg("EnumerateChildren: list container failed with unexpected status")
+
+		if listRes.GetStatus().GetCode() == rpcv1beta1.Code_CODE_NOT_FOUND {
+			return NewResponse(404), nil
+		}
+		return NewResponse(500), nil
+	}
+
+	children := []map[string]interface{}{}
+	childContainers := []map[string]interface{}{}
+	for _, info := range listRes.GetInfos() {
+		switch info.GetType() {
+		case providerv1beta1.ResourceType_RESOURCE_TYPE_CONTAINER:
+			pointer, err := f.resourcePointer(wopiContext, info, *logger)
+			if err != nil {
+				return nil, err
+			}
+			childContainers = append(childContainers, pointer)
+		case providerv1beta1.ResourceType_RESOURCE_TYPE_FILE:
+			if len(extensions) > 0 && !slices.ContainsFunc(extensions, func(ext string) bool {
+				return strings.EqualFold(path.Ext(resourceName(info)), ext)
+			}) {
+				continue
+			}
+			pointer, err := f.resourcePointer(wopiContext, info, *logger)
+			if err != nil {
+				return nil, err
+			}
+			pointer["Version"] = getVersion(info.GetMtime())
+			pointer["Size"] = int64(info.GetSize())
+			children = append(children, pointer)
+		}
+	}
+
+	logger.Debug().
+		Int("Children", len(children)).
+		Int("ChildContainers", len(childContainers)).
+		Msg("EnumerateChildren: success")
+	return NewResponseSuccessBody(map[string]interface{}{
+		"Children":        children,
+		"ChildContainers": childContainers,
+	}), nil
+}
+
+// EnumerateAncestors returns the containers above the file or container
+// https://learn.microsoft.com/en-us/microsoft-365/cloud-storage-partner-program/rest/files/enumerateancestors
+// https://learn.microsoft.com/en-us/microsoft-365/cloud-storage-partner-program/rest/containers/enumerateancestors
+//
+// The context MUST have a WOPI context, otherwise an error will be returned.
+// You can pass a pre-configured zerologger instance through the context that
+// will be used to log messages.
+//
+// The ancestors are returned with the root container first. The list stops
+// at the space root or at the first container the user can't access, for
+// example above the shared folder of a public link.
+func (f *FileConnector) EnumerateAncestors(ctx context.Context) (*ConnectorResponse, error) {
+	wopiContext, err := middleware.WopiContextFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := zerolog.Ctx(ctx)
+
+	gwc, err := f.gws.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := f.statResource(ctx, gwc, wopiContext.FileReference, logger)
+	if err != nil {
+		return connectorErrorResponse(err)
+	}
+
+	ancestors, err := f.ancestors(ctx, gwc, info, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	pointers := make([]map[string]interface{}, 0, len(ancestors))
+	for _, ancestor := range ancestors {
+		pointer, err := f.resourcePointer(wopiContext, ancestor, *logger)
+		if err != nil {
+			return nil, err
+		}
+		pointers = append(pointers, pointer)
+	}
+
+	logger.Debug().Int("Ancestors", len(pointers)).Msg("EnumerateAncestors: success")
+	return NewResponseSuccessBody(map[string]interface{}{
+		"AncestorsWithRootFirst": pointers,
+	}), nil
+}
+
+// GetEcosystem returns the URL of the ecosystem endpoint
+// https://learn.microsoft.com/en-us/microsoft-365/cloud-storage-partner-program/rest/files/getecosystem
+// https://learn.microsoft.com/en-us/microsoft-365/cloud-storage-partner-program/rest/containers/getecosystem
+//
+// The context MUST have a WOPI context, otherwise an error will be returned.
+// You can pass a pre-configured zerologger instance through the context that
+// will be used to log messages.
+//
+// The ecosystem URL contains the id of the file or container, so the
+// access token is bound to it like for any other WOPI URL.
+func (f *FileConnector) GetEcosystem(ctx context.Context) (*ConnectorResponse, error) {
+	wopiContext, err := middleware.WopiContextFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := zerolog.Ctx(ctx)
+
+	ecosystemURL, err := f.generateWOPIUrl(wopiContext, wopisrc.GenerateWopiEcosystemSrc, *logger)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug().Msg("GetEcosystem: success")
+	return NewResponseSuccessBody(map[string]interface{}{
+		"Url": ecosystemURL.String(),
+	}), nil
+}
+
+// GetRootContainer returns the root container
+// https://learn.microsoft.com/en-us/microsoft-365/cloud-storage-partner-program/rest/ecosystem/getrootcontainer
+//
+// The context MUST have a WOPI context, otherwise an error will be returned.
+// You can pass a pre-configured zerologger instance through the context that
+// will be used to log messages.
+//
+// The root container is the topmost ancestor returned by EnumerateAncestors.
+// If there are no ancestors, a container will be its own root container,
+// and a 404 response will be returned for a file.
+func (f *FileConnector) GetRootContainer(ctx context.Context) (*ConnectorResponse, error) {
+	wopiContext, err := middleware.WopiContextFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := zerolog.Ctx(ctx)
+
+	gwc, err := f.gws.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := f.statResource(ctx, gwc, wopiContext.FileReference, logger)
+	if err != nil {
+		return connectorErrorResponse(err)
+	}
+
+	ancestors, err := f.ancestors(ctx, gwc, info, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	root := info
+	if len(ancestors) > 0 {
+		root = ancestors[0]
+	}
+	if root.GetType() != providerv1beta1.ResourceType_RESOURCE_TYPE_CONTAINER {
+		logger.Error().Msg("GetRootContainer: no accessible container found")
+		return NewResponse(404), nil
+	}
+
+	pointer, err := f.resourcePointer(wopiContext, root, *logger)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug().Msg("GetRootContainer: success")
+	return NewResponseSuccessBody(map[string]interface{}{
+		"ContainerPointer": pointer,
+	}), nil
+}
+
+// statResource stats the reference and returns its resource info. A
+// ConnectorError will be returned if the stat response isn't successful.
+func (f *FileConnector) statResource(ctx context.Context, gwc gatewayv1beta1.GatewayAPIClient, ref *providerv1beta1.Reference, logger *zerolog.Logger) (*providerv1beta1.ResourceInfo, error) {
+	statRes, err := gwc.Stat(ctx, &providerv1beta1.StatRequest{
+		Ref: ref,
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("stat failed")
+		return nil, err
+	}
+
+	if statRes.GetStatus().GetCode() != rpcv1beta1.Code_CODE_OK {
+		logger.Error().
+			Str("StatusCode", statRes.GetStatus().GetCode().String()).
+			Str("StatusMsg", statRes.GetStatus().GetMessage()).
+			Msg("stat failed with unexpected status")
+
+		if statRes.GetStatus().GetCode() == rpcv1beta1.Code_CODE_NOT_FOUND {
+			return nil, NewConnectorError(404, statRes.GetStatus().GetMessage())
+		}
+		return nil, NewConnectorError(500, statRes.GetStatus().GetCode().String()+" "+statRes.GetStatus().GetMessage())
+	}
+	return statRes.GetInfo(), nil
+}
+
+// ancestors returns the containers above the resource, the root container
+// first. It walks up the parents until the space root is reached or the
+// parent can't be accessed by the user.
+func (f *FileConnector) ancestors(ctx context.Context, gwc gatewayv1beta1.GatewayAPIClient, info *providerv1beta1.ResourceInfo, logger *zerolog.Logger) ([]*providerv1beta1.ResourceInfo, error) {
+	ancestors := []*providerv1beta1.ResourceInfo{}
+	current := info
+	for !isSpaceRoot(current.GetId()) && current.GetParentId().GetOpaqueId() != "" {
+		statRes, err := gwc.Stat(ctx, &providerv1beta1.StatRequest{
+			Ref: &providerv1beta1.Reference{ResourceId: current.GetParentId()},
+		})
+		if err != nil {
+			logger.Error().Err(err).Msg("stat of the parent failed")
+			return nil, err
+		}
+		if statRes.GetStatus().GetCode() != rpcv1beta1.Code_CODE_OK {
+			// the user has no access to the parent, e.g. above the root of a public link
+			break
+		}
+		parent := statRes.GetInfo()
+		if utils.ResourceIDEqual(parent.GetId(), current.GetId()) {
+			break
+		}
+		ancestors = append([]*providerv1beta1.ResourceInfo{parent}, ancestors...)
+		current = parent
+	}
+	return ancestors, nil
+}
+
+// resourcePointer returns the name and the WOPI URL of the resource. The
+// URL contains a new access token for the resource based on the current
+// WOPI context.
+func (f *FileConnector) resourcePointer(wopiContext middleware.WopiContext, info *providerv1beta1.ResourceInfo, logger zerolog.Logger) (map[string]interface{}, error) {
+	wopiContext.FileReference = &providerv1beta1.Reference{
+		ResourceId: info.GetId(),
+	}
+	wopiContext.TemplateReference = nil
+
+	generator := wopisrc.GenerateWopiSrc
+	if info.GetType() == providerv1beta1.ResourceType_RESOURCE_TYPE_CONTAINER {
+		generator = wopisrc.GenerateWopiContainerSrc
+	}
+	wopiURL, err := f.generateWOPIUrl(wopiContext, generator, logger)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"Name": resourceName(info),
+		"Url":  wopiURL.String(),
+	}, nil
+}
+
+// resourceName returns the name of the resource. The space name will be
+// used for the root of a space.
+func resourceName(info *providerv1beta1.ResourceInfo) string {
+	if isSpaceRoot(info.GetId()) && info.GetSpace().GetName() != "" {
+		return info.GetSpace().GetName()
+	}
+	if info.GetName() != "" {
+		return info.GetName()
+	}
+	return path.Base(info.GetPath())
+}
+
+func isSpaceRoot(id *providerv1beta1.ResourceId) bool {
+	return id.GetOpaqueId() != "" && id.GetOpaqueId() == id.GetSpaceId()
+}
+
+// connectorErrorResponse converts a ConnectorError into a response with the
+// error code as status. Any other error is returned as is.
+func connectorErrorResponse(err error) (*ConnectorResponse, error) {
+	var connErr *ConnectorError
+	if errors.As(err, &connErr) {
+		return NewResponse(connErr.HttpCodeOut), nil
+	}
+	return nil, err
+}
+
 // createDownloadURL will create a download URL for the template file.
 // It uses a new wopi context with the template reference set as the file reference
 // and a reva access token to download the file.
@@ -1446,20 +1876,27 @@ func (f *FileConnector) generatePrefix() string {
 // (storage, opaque and space points directly to the file). The path component
 // will be ignored
 func (f *FileConnector) generateWOPISrc(wopiContext middleware.WopiContext, logger zerolog.Logger) (*url.URL, error) {
+	return f.generateWOPIUrl(wopiContext, wopisrc.GenerateWopiSrc, logger)
+}
+
+// generateWOPIUrl generates the URL for the file reference of the WOPI
+// context using the provided generator, such as wopisrc.GenerateWopiSrc.
+// A new access token for the WOPI context will be added to the URL.
+func (f *FileConnector) generateWOPIUrl(wopiContext middleware.WopiContext, generator func(string, *config.Config) (*url.URL, error), logger zerolog.Logger) (*url.URL, error) {
 	// get the WOPI token for the new file
 	accessToken, _, err := middleware.GenerateWopiToken(wopiContext, f.cfg, f.store)
 	if err != nil {
-		logger.Error().Err(err).Msg("generateWOPISrc: failed to generate access token for the new file")
+		logger.Error().Err(err).Msg("generateWOPIUrl: failed to generate access token")
 		return nil, err
 	}
 
 	// get the reference
 	fileRef := helpers.HashResourceId(wopiContext.FileReference.GetResourceId())
 
-	// generate the URL for the WOPI app to access the new created file
-	wopiSrcURL, err := wopisrc.GenerateWopiSrc(fileRef, f.cfg)
+	// generate the URL for the WOPI app to access the resource
+	wopiSrcURL, err := generator(fileRef, f.cfg)
 	if err != nil {
-		logger.Error().Err(err).Msg("generateWOPISrc: failed to generate WOPISrc URL for the new file")
+		logger.Error().Err(err).Msg("generateWOPIUrl: failed to generate WOPI URL")
 		return nil, err
 	}
 	q := wopiSrcURL.Query()
@@ -1498,6 +1935,12 @@ func (f *FileConnector) adjustWopiReference(ctx context.Context, wopiContext *mi
 	return newStatRes.GetInfo(), nil
 }
 
+// app returns the configuration of the app the WOPI context was issued for
+func (f *FileConnector) app(wopiContext middleware.WopiContext) config.App {
+	app, _ := f.cfg.GetApp(wopiContext.AppName)
+	return app
+}
+
 // getScopeByKeyPrefix returns the scope from the AccessToken Scope map by key prefix
 func (f *FileConnector) getScopeByKeyPrefix(scopes map[string]*auth.Scope, keyPrefix string, m proto.Message) error {
 	for k, v := range scopes {
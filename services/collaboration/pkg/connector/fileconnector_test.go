@@ -1720,6 +1720,9 @@ var _ = Describe("FileConnector", func() {
 				SupportsUpdate:             true,
 				SupportsDeleteFile:         true,
 				SupportsRename:             true,
+				SupportsContainers:         true,
+				SupportsEcosystem:          true,
+				SupportedShareURLTypes:     []string{"ReadOnly", "ReadWrite"},
 				UserCanWrite:               true,
 				UserCanRename:              true,
 				UserID:                     "61646d696e40637573746f6d496470", // hex of admin@customIdp
@@ -2095,4 +2098,289 @@ var _ = Describe("FileConnector", func() {
 			Expect(templateSource).To(HavePrefix(expectedTemplateSource))
 		})
 	})
+
+	Describe("GetShareUrl", func() {
+		It("No valid context", func() {
+			gatewaySelector.EXPECT().Next().Unset()
+			ctx := context.Background()
+			response, err := fc.GetShareUrl(ctx, "ReadOnly")
+			Expect(err).To(HaveOccurred())
+			Expect(response).To(BeNil())
+		})
+
+		It("Unsupported url type", func() {
+			gatewaySelector.EXPECT().Next().Unset()
+			ctx := middleware.WopiContextToCtx(context.Background(), wopiCtx)
+
+			response, err := fc.GetShareUrl(ctx, "Unknown")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.Status).To(Equal(501))
+			Expect(response.Body).To(BeNil())
+		})
+
+		It("Success", func() {
+			gatewaySelector.EXPECT().Next().Unset()
+			ctx := middleware.WopiContextToCtx(context.Background(), wopiCtx)
+
+			response, err := fc.GetShareUrl(ctx, "ReadWrite")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.Status).To(Equal(200))
+			Expect(response.Body).To(Equal(map[string]interface{}{
+				"ShareUrl": "https://cloud.opencloud.test/f/abc$zzz%2112345?details=sharing",
+			}))
+		})
+	})
+
+	Describe("Containers", func() {
+		var (
+			spaceRoot *providerv1beta1.ResourceInfo
+			folder    *providerv1beta1.ResourceInfo
+			file      *providerv1beta1.ResourceInfo
+		)
+
+		statRef := func(id *providerv1beta1.ResourceId) interface{} {
+			return mock.MatchedBy(func(req *providerv1beta1.StatRequest) bool {
+				return utils.ResourceIDEqual(req.GetRef().GetResourceId(), id)
+			})
+		}
+
+		BeforeEach(func() {
+			spaceRoot = &providerv1beta1.ResourceInfo{
+				Type:  providerv1beta1.ResourceType_RESOURCE_TYPE_CONTAINER,
+				Id:    &providerv1beta1.ResourceId{StorageId: "abc", SpaceId: "zzz", OpaqueId: "zzz"},
+				Path:  ".",
+				Space: &providerv1beta1.StorageSpace{Name: "Project"},
+			}
+			folder = &providerv1beta1.ResourceInfo{
+				Type:     providerv1beta1.ResourceType_RESOURCE_TYPE_CONTAINER,
+				Id:       &providerv1beta1.ResourceId{StorageId: "abc", SpaceId: "zzz", OpaqueId: "folder"},
+				ParentId: spaceRoot.GetId(),
+				Name:     "Documents",
+			}
+			file = &providerv1beta1.ResourceInfo{
+				Type:     providerv1beta1.ResourceType_RESOURCE_TYPE_FILE,
+				Id:       wopiCtx.FileReference.GetResourceId(),
+				ParentId: folder.GetId(),
+				Name:     "test.docx",
+				Size:     uint64(1234),
+				Mtime:    &typesv1beta1.Timestamp{Seconds: uint64(16273849)},
+			}
+		})
+
+		Describe("CheckContainerInfo", func() {
+			It("No valid context", func() {
+				gatewaySelector.EXPECT().Next().Unset()
+				ctx := context.Background()
+				response, err := fc.CheckContainerInfo(ctx)
+				Expect(err).To(HaveOccurred())
+				Expect(response).To(BeNil())
+			})
+
+			It("Stat fails status not found", func() {
+				ctx := middleware.WopiContextToCtx(context.Background(), wopiCtx)
+
+				gatewayClient.On("Stat", mock.Anything, mock.Anything).Times(1).Return(&providerv1beta1.StatResponse{
+					Status: status.NewNotFound(ctx, "something not found"),
+				}, nil)
+
+				response, err := fc.CheckContainerInfo(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Status).To(Equal(404))
+			})
+
+			It("Not a container", func() {
+				ctx := middleware.WopiContextToCtx(context.Background(), wopiCtx)
+
+				gatewayClient.On("Stat", mock.Anything, mock.Anything).Times(1).Return(&providerv1beta1.StatResponse{
+					Status: status.NewOK(ctx),
+					Info:   file,
+				}, nil)
+
+				response, err := fc.CheckContainerInfo(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Status).To(Equal(404))
+			})
+
+			It("Success", func() {
+				ctx := middleware.WopiContextToCtx(context.Background(), wopiCtx)
+
+				gatewayClient.On("Stat", mock.Anything, mock.Anything).Times(1).Return(&providerv1beta1.StatResponse{
+					Status: status.NewOK(ctx),
+					Info:   folder,
+				}, nil)
+
+				response, err := fc.CheckContainerInfo(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Status).To(Equal(200))
+				body := response.Body.(map[string]interface{})
+				Expect(body["Name"]).To(Equal("Documents"))
+				Expect(body["HostUrl"]).To(Equal("https://cloud.opencloud.test/f/abc$zzz%21folder"))
+				Expect(body["SharingUrl"]).To(Equal("https://cloud.opencloud.test/f/abc$zzz%21folder?details=sharing"))
+				Expect(body["UserCanCreateChildFile"]).To(BeFalse())
+			})
+		})
+
+		Describe("EnumerateChildren", func() {
+			It("List container fails status not found", func() {
+				ctx := middleware.WopiContextToCtx(context.Background(), wopiCtx)
+
+				gatewayClient.On("ListContainer", mock.Anything, mock.Anything).Times(1).Return(&providerv1beta1.ListContainerResponse{
+					Status: status.NewNotFound(ctx, "something not found"),
+				}, nil)
+
+				response, err := fc.EnumerateChildren(ctx, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Status).To(Equal(404))
+			})
+
+			It("Success with extension filter", func() {
+				ctx := middleware.WopiContextToCtx(context.Background(), wopiCtx)
+
+				otherFile := &providerv1beta1.ResourceInfo{
+					Type: providerv1beta1.ResourceType_RESOURCE_TYPE_FILE,
+					Id:   &providerv1beta1.ResourceId{StorageId: "abc", SpaceId: "zzz", OpaqueId: "other"},
+					Name: "image.png",
+				}
+				gatewayClient.On("ListContainer", mock.Anything, mock.Anything).Times(1).Return(&providerv1beta1.ListContainerResponse{
+					Status: status.NewOK(ctx),
+					Infos:  []*providerv1beta1.ResourceInfo{folder, file, otherFile},
+				}, nil)
+
+				response, err := fc.EnumerateChildren(ctx, []string{".DOCX"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Status).To(Equal(200))
+
+				body := response.Body.(map[string]interface{})
+				children := body["Children"].([]map[string]interface{})
+				Expect(children).To(HaveLen(1))
+				Expect(children[0]["Name"]).To(Equal("test.docx"))
+				Expect(children[0]["Url"]).To(HavePrefix("https://wopi.opencloud.test/wopi/files/acec9ea008d2e2979556f82e0ec0c4f47c7a906a4cfd84b64bce41db93b64b1a?access_token="))
+				Expect(children[0]["Version"]).To(Equal("v162738490"))
+				Expect(children[0]["Size"]).To(Equal(int64(1234)))
+
+				childContainers := body["ChildContainers"].([]map[string]interface{})
+				Expect(childContainers).To(HaveLen(1))
+				Expect(childContainers[0]["Name"]).To(Equal("Documents"))
+				Expect(childContainers[0]["Url"]).To(HavePrefix("https://wopi.opencloud.test/wopi/containers/"))
+			})
+		})
+
+		Describe("EnumerateAncestors", func() {
+			It("Stat fails", func() {
+				ctx := middleware.WopiContextToCtx(context.Background(), wopiCtx)
+
+				gatewayClient.On("Stat", mock.Anything, mock.Anything).Times(1).Return(nil, errors.New("something failed"))
+
+				response, err := fc.EnumerateAncestors(ctx)
+				Expect(err).To(HaveOccurred())
+				Expect(response).To(BeNil())
+			})
+
+			It("Success", func() {
+				ctx := middleware.WopiContextToCtx(context.Background(), wopiCtx)
+
+				gatewayClient.On("Stat", mock.Anything, statRef(file.GetId())).Times(1).Return(&providerv1beta1.StatResponse{
+					Status: status.NewOK(ctx),
+					Info:   file,
+				}, nil)
+				gatewayClient.On("Stat", mock.Anything, statRef(folder.GetId())).Times(1).Return(&providerv1beta1.StatResponse{
+					Status: status.NewOK(ctx),
+					Info:   folder,
+				}, nil)
+				gatewayClient.On("Stat", mock.Anything, statRef(spaceRoot.GetId())).Times(1).Return(&providerv1beta1.StatResponse{
+					Status: status.NewOK(ctx),
+					Info:   spaceRoot,
+				}, nil)
+
+				response, err := fc.EnumerateAncestors(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Status).To(Equal(200))
+
+				ancestors := response.Body.(map[string]interface{})["AncestorsWithRootFirst"].([]map[string]interface{})
+				Expect(ancestors).To(HaveLen(2))
+				Expect(ancestors[0]["Name"]).To(Equal("Project"))
+				Expect(ancestors[1]["Name"]).To(Equal("Documents"))
+				Expect(ancestors[1]["Url"]).To(HavePrefix("https://wopi.opencloud.test/wopi/containers/"))
+			})
+
+			It("Success stops at inaccessible parent", func() {
+				ctx := middleware.WopiContextToCtx(context.Background(), wopiCtx)
+
+				gatewayClient.On("Stat", mock.Anything, statRef(file.GetId())).Times(1).Return(&providerv1beta1.StatResponse{
+					Status: status.NewOK(ctx),
+					Info:   file,
+				}, nil)
+				gatewayClient.On("Stat", mock.Anything, statRef(folder.GetId())).Times(1).Return(&providerv1beta1.StatResponse{
+					Status: status.NewOK(ctx),
+					Info:   folder,
+				}, nil)
+				gatewayClient.On("Stat", mock.Anything, statRef(spaceRoot.GetId())).Times(1).Return(&providerv1beta1.StatResponse{
+					Status: status.NewPermissionDenied(ctx, nil, "no access"),
+				}, nil)
+
+				response, err := fc.EnumerateAncestors(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Status).To(Equal(200))
+
+				ancestors := response.Body.(map[string]interface{})["AncestorsWithRootFirst"].([]map[string]interface{})
+				Expect(ancestors).To(HaveLen(1))
+				Expect(ancestors[0]["Name"]).To(Equal("Documents"))
+			})
+		})
+
+		Describe("GetEcosystem", func() {
+			It("Success", func() {
+				gatewaySelector.EXPECT().Next().Unset()
+				ctx := middleware.WopiContextToCtx(context.Background(), wopiCtx)
+
+				response, err := fc.GetEcosystem(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Status).To(Equal(200))
+				Expect(response.Body.(map[string]interface{})["Url"]).To(HavePrefix("https://wopi.opencloud.test/wopi/ecosystem/acec9ea008d2e2979556f82e0ec0c4f47c7a906a4cfd84b64bce41db93b64b1a?access_token="))
+			})
+		})
+
+		Describe("GetRootContainer", func() {
+			It("Success", func() {
+				ctx := middleware.WopiContextToCtx(context.Background(), wopiCtx)
+
+				gatewayClient.On("Stat", mock.Anything, statRef(file.GetId())).Times(1).Return(&providerv1beta1.StatResponse{
+					Status: status.NewOK(ctx),
+					Info:   file,
+				}, nil)
+				gatewayClient.On("Stat", mock.Anything, statRef(folder.GetId())).Times(1).Return(&providerv1beta1.StatResponse{
+					Status: status.NewOK(ctx),
+					Info:   folder,
+				}, nil)
+				gatewayClient.On("Stat", mock.Anything, statRef(spaceRoot.GetId())).Times(1).Return(&providerv1beta1.StatResponse{
+					Status: status.NewOK(ctx),
+					Info:   spaceRoot,
+				}, nil)
+
+				response, err := fc.GetRootContainer(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Status).To(Equal(200))
+
+				pointer := response.Body.(map[string]interface{})["ContainerPointer"].(map[string]interface{})
+				Expect(pointer["Name"]).To(Equal("Project"))
+				Expect(pointer["Url"]).To(HavePrefix("https://wopi.opencloud.test/wopi/containers/"))
+			})
+
+			It("File without accessible parent", func() {
+				ctx := middleware.WopiContextToCtx(context.Background(), wopiCtx)
+
+				gatewayClient.On("Stat", mock.Anything, statRef(file.GetId())).Times(1).Return(&providerv1beta1.StatResponse{
+					Status: status.NewOK(ctx),
+					Info:   file,
+				}, nil)
+				gatewayClient.On("Stat", mock.Anything, statRef(folder.GetId())).Times(1).Return(&providerv1beta1.StatResponse{
+					Status: status.NewNotFound(ctx, "not found"),
+				}, nil)
+
+				response, err := fc.GetRootContainer(ctx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Status).To(Equal(404))
+			})
+		})
+	})
 })
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 
 	gatewayv1beta1 "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
 	"github.com/opencloud-eu/opencloud/services/collaboration/pkg/config"
@@ -28,6 +29,7 @@ const (
 	HeaderContentLength         string = "Content-Length"
 	HeaderContentType           string = "Content-Type"
 	HeaderWopiVersion           string = "X-WOPI-ItemVersion"
+	HeaderWopiUrlType           string = "X-WOPI-UrlType"
 )
 
 // HttpAdapter will adapt the responses from the connector to HTTP.
@@ -301,6 +303,112 @@ func (h *HttpAdapter) RenameFile(w http.ResponseWriter, r *http.Request) {
 	h.writeConnectorResponse(w, r, response)
 }
 
+// GetShareUrl will return the URL to share the file in json format.
+// The request's context is needed in order to extract the WOPI context. In
+// addition, the "X-WOPI-UrlType" header is needed (check spec).
+// The operation's response will be sent through the response writer and
+// the headers according to the spec
+func (h *HttpAdapter) GetShareUrl(w http.ResponseWriter, r *http.Request) {
+	urlType := r.Header.Get(HeaderWopiUrlType)
+
+	fileCon := h.con.GetFileConnector()
+	response, err := fileCon.GetShareUrl(r.Context(), urlType)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	h.writeConnectorResponse(w, r, response)
+}
+
+// CheckContainerInfo will retrieve the information of the container in json
+// format.
+// Only the request's context is needed in order to extract the WOPI context.
+// The operation's response will be sent through the response writer and
+// the headers according to the spec
+func (h *HttpAdapter) CheckContainerInfo(w http.ResponseWriter, r *http.Request) {
+	fileCon := h.con.GetFileConnector()
+	response, err := fileCon.CheckContainerInfo(r.Context())
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	h.writeConnectorResponse(w, r, response)
+}
+
+// EnumerateChildren will list the contents of the container in json format.
+// The request's context is needed in order to extract the WOPI context. In
+// addition, the optional "file_extension_filter" query parameter can contain
+// a comma separated list of file extensions to filter the files.
+// The operation's response will be sent through the response writer and
+// the headers according to the spec
+func (h *HttpAdapter) EnumerateChildren(w http.ResponseWriter, r *http.Request) {
+	var extensions []string
+	if filter := r.URL.Query().Get("file_extension_filter"); filter != "" {
+		for _, ext := range strings.Split(filter, ",") {
+			if ext = strings.TrimSpace(ext); ext != "" {
+				extensions = append(extensions, ext)
+			}
+		}
+	}
+
+	fileCon := h.con.GetFileConnector()
+	response, err := fileCon.EnumerateChildren(r.Context(), extensions)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	h.writeConnectorResponse(w, r, response)
+}
+
+// EnumerateAncestors will list the containers above the file or container in
+// json format, the root container first.
+// Only the request's context is needed in order to extract the WOPI context.
+// The operation's response will be sent through the response writer and
+// the headers according to the spec
+func (h *HttpAdapter) EnumerateAncestors(w http.ResponseWriter, r *http.Request) {
+	fileCon := h.con.GetFileConnector()
+	response, err := fileCon.EnumerateAncestors(r.Context())
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	h.writeConnectorResponse(w, r, response)
+}
+
+// GetEcosystem will return the URL of the ecosystem endpoint in json format.
+// Only the request's context is needed in order to extract the WOPI context.
+// The operation's response will be sent through the response writer and
+// the headers according to the spec
+func (h *HttpAdapter) GetEcosystem(w http.ResponseWriter, r *http.Request) {
+	fileCon := h.con.GetFileConnector()
+	response, err := fileCon.GetEcosystem(r.Context())
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	h.writeConnectorResponse(w, r, response)
+}
+
+// GetRootContainer will return the root container in json format.
+// Only the request's context is needed in order to extract the WOPI context.
+// The operation's response will be sent through the response writer and
+// the headers according to the spec
+func (h *HttpAdapter) GetRootContainer(w http.ResponseWriter, r *http.Request) {
+	fileCon := h.con.GetFileConnector()
+	response, err := fileCon.GetRootContainer(r.Context())
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	h.writeConnectorResponse(w, r, response)
+}
+
 func (h *HttpAdapter) writeConnectorResponse(w http.ResponseWriter, r *http.Request, response *ConnectorResponse) {
 	jsonBody := []byte{}
 	if response.Body != nil {
@@ -597,4 +597,121 @@ var _ = Describe("HttpAdapter", func() {
 			Expect(resp.Header.Get(connector.HeaderWopiVersion)).To(Equal("v1234567"))
 		})
 	})
+
+	Describe("GetShareUrl", func() {
+		It("General error", func() {
+			req := httptest.NewRequest("POST", "/wopi/files/abcdef", nil)
+			req.Header.Set("X-WOPI-Override", "GET_SHARE_URL")
+			req.Header.Set(connector.HeaderWopiUrlType, "ReadOnly")
+
+			w := httptest.NewRecorder()
+
+			fc.On("GetShareUrl", mock.Anything, "ReadOnly").Times(1).Return(nil, errors.New("Something happened"))
+
+			httpAdapter.GetShareUrl(w, req)
+			resp := w.Result()
+			Expect(resp.StatusCode).To(Equal(500))
+		})
+
+		It("Success", func() {
+			req := httptest.NewRequest("POST", "/wopi/files/abcdef", nil)
+			req.Header.Set("X-WOPI-Override", "GET_SHARE_URL")
+			req.Header.Set(connector.HeaderWopiUrlType, "ReadWrite")
+
+			w := httptest.NewRecorder()
+
+			fc.On("GetShareUrl", mock.Anything, "ReadWrite").Times(1).Return(connector.NewResponseSuccessBody(map[string]interface{}{
+				"ShareUrl": "https://cloud.opencloud.test/f/abcdef?details=sharing",
+			}), nil)
+
+			httpAdapter.GetShareUrl(w, req)
+			resp := w.Result()
+			Expect(resp.StatusCode).To(Equal(200))
+
+			jsonBody, _ := io.ReadAll(resp.Body)
+			Expect(string(jsonBody)).To(Equal(`{"ShareUrl":"https://cloud.opencloud.test/f/abcdef?details=sharing"}`))
+		})
+	})
+
+	Describe("CheckContainerInfo", func() {
+		It("General error", func() {
+			req := httptest.NewRequest("GET", "/wopi/containers/abcdef", nil)
+
+			w := httptest.NewRecorder()
+
+			fc.On("CheckContainerInfo", mock.Anything).Times(1).Return(nil, errors.New("Something happened"))
+
+			httpAdapter.CheckContainerInfo(w, req)
+			resp := w.Result()
+			Expect(resp.StatusCode).To(Equal(500))
+		})
+
+		It("Not found", func() {
+			req := httptest.NewRequest("GET", "/wopi/containers/abcdef", nil)
+
+			w := httptest.NewRecorder()
+
+			fc.On("CheckContainerInfo", mock.Anything).Times(1).Return(connector.NewResponse(404), nil)
+
+			httpAdapter.CheckContainerInfo(w, req)
+			resp := w.Result()
+			Expect(resp.StatusCode).To(Equal(404))
+		})
+	})
+
+	Describe("EnumerateChildren", func() {
+		It("General error", func() {
+			req := httptest.NewRequest("GET", "/wopi/containers/abcdef/children", nil)
+
+			w := httptest.NewRecorder()
+
+			fc.On("EnumerateChildren", mock.Anything, []string(nil)).Times(1).Return(nil, errors.New("Something happened"))
+
+			httpAdapter.EnumerateChildren(w, req)
+			resp := w.Result()
+			Expect(resp.StatusCode).To(Equal(500))
+		})
+
+		It("Extension filter", func() {
+			req := httptest.NewRequest("GET", "/wopi/containers/abcdef/children?file_extension_filter=.docx,%20.xlsx,", nil)
+
+			w := httptest.NewRecorder()
+
+			fc.On("EnumerateChildren", mock.Anything, []string{".docx", ".xlsx"}).Times(1).Return(connector.NewResponseSuccessBody(map[string]interface{}{
+				"Children": []map[string]interface{}{},
+			}), nil)
+
+			httpAdapter.EnumerateChildren(w, req)
+			resp := w.Result()
+			Expect(resp.StatusCode).To(Equal(200))
+		})
+	})
+
+	Describe("EnumerateAncestors", func() {
+		It("General error", func() {
+			req := httptest.NewRequest("GET", "/wopi/files/abcdef/ancestry", nil)
+
+			w := httptest.NewRecorder()
+
+			fc.On("EnumerateAncestors", mock.Anything).Times(1).Return(nil, errors.New("Something happened"))
+
+			httpAdapter.EnumerateAncestors(w, req)
+			resp := w.Result()
+			Expect(resp.StatusCode).To(Equal(500))
+		})
+	})
+
+	Describe("GetRootContainer", func() {
+		It("Not found", func() {
+			req := httptest.NewRequest("GET", "/wopi/ecosystem/abcdef/root_container_pointer", nil)
+
+			w := httptest.NewRecorder()
+
+			fc.On("GetRootContainer", mock.Anything).Times(1).Return(connector.NewResponse(404), nil)
+
+			httpAdapter.GetRootContainer(w, req)
+			resp := w.Result()
+			Expect(resp.StatusCode).To(Equal(404))
+		})
+	})
 })
@@ -78,14 +78,14 @@ func (a *AppURLs) GetAppURLFor(action, fileExt string) string {
 // GetAppURLs gets the edit and view urls for different file types from the
 // target WOPI app (onlyoffice, collabora, etc) via their "/hosting/discovery"
 // endpoint.
-func GetAppURLs(cfg *config.Config, logger log.Logger) (map[string]map[string]string, error) {
-	wopiAppUrl := cfg.App.Addr + "/hosting/discovery"
+func GetAppURLs(app config.App, logger log.Logger) (map[string]map[string]string, error) {
+	wopiAppUrl := app.Addr + "/hosting/discovery"
 
 	httpClient := http.Client{
 		Transport: &http.Transport{
 			TLSClientConfig: &tls.Config{
 				MinVersion:         tls.VersionTLS12,
-				InsecureSkipVerify: cfg.App.Insecure,
+				InsecureSkipVerify: app.Insecure,
 			},
 		},
 	}
@@ -333,7 +333,7 @@ var _ = Describe("Discovery", func() {
 			}
 			logger := log.NopLogger()
 
-			appUrls, err := helpers.GetAppURLs(cfg, logger)
+			appUrls, err := helpers.GetAppURLs(cfg.App, logger)
 
 			expectedAppUrls := map[string]map[string]string{
 				"view": map[string]string{
@@ -361,7 +361,7 @@ var _ = Describe("Discovery", func() {
 			}
 			logger := log.NopLogger()
 
-			appUrls, err := helpers.GetAppURLs(cfg, logger)
+			appUrls, err := helpers.GetAppURLs(cfg.App, logger)
 			Expect(err).To(HaveOccurred())
 			Expect(appUrls).To(BeNil())
 		})
@@ -375,7 +375,7 @@ var _ = Describe("Discovery", func() {
 			}
 			logger := log.NopLogger()
 
-			appUrls, err := helpers.GetAppURLs(cfg, logger)
+			appUrls, err := helpers.GetAppURLs(cfg.App, logger)
 			Expect(err).To(HaveOccurred())
 			Expect(appUrls).To(BeNil())
 		})
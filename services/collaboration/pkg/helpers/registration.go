@@ -18,20 +18,29 @@ import (
 // RegisterOpenCloudService will register this service.
 // There are no explicit requirements for the context, and it will be passed
 // without changes to the underlying RegisterService method.
+//
+// The GRPC service of every enabled app is registered on its own.
 func RegisterOpenCloudService(ctx context.Context, cfg *config.Config, logger log.Logger) error {
-	svc := registry.BuildGRPCService(cfg.GRPC.Namespace+"."+cfg.Service.Name, cfg.GRPC.Protocol, cfg.GRPC.Addr, version.GetString())
-	return registry.RegisterService(ctx, logger, svc, cfg.Debug.Addr)
+	for _, app := range cfg.EnabledApps() {
+		svc := registry.BuildGRPCService(cfg.AppServiceName(app), cfg.GRPC.Protocol, cfg.AppGRPCAddr(app), version.GetString())
+		if err := registry.RegisterService(ctx, logger, svc, cfg.Debug.Addr); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-// RegisterAppProvider will register this service as app provider in REVA.
+// RegisterAppProvider will register the app as app provider in REVA.
 // The GatewayAPIClient is expected to be provided via `helpers.GetCS3apiClient`.
-// The appUrls are expected to be provided via `helpers.GetAppURLs`
+// The appUrls are expected to be provided via `helpers.GetAppURLs` for the
+// same app.
 //
 // Note that this method doesn't provide a re-registration mechanism, so it
 // will register the service once
 func RegisterAppProvider(
 	ctx context.Context,
 	cfg *config.Config,
+	app config.App,
 	logger log.Logger,
 	gws pool.Selectable[gatewayv1beta1.GatewayAPIClient],
 	appUrls *AppURLs,
@@ -39,7 +48,7 @@ func RegisterAppProvider(
 	mimeTypes := appUrls.GetMimeTypes()
 
 	logger.Debug().
-		Str("AppName", cfg.App.Name).
+		Str("AppName", app.Name).
 		Strs("Mimetypes", mimeTypes).
 		Msg("Registering mimetypes in the app provider")
 
@@ -48,12 +57,12 @@ func RegisterAppProvider(
 	// the users will be no longer available to choose to open a file with it (currently, opening a file just fails)
 	req := &registryv1beta1.AddAppProviderRequest{
 		Provider: &registryv1beta1.ProviderInfo{
-			Name:        cfg.App.Name,
-			Description: cfg.App.Description,
-			Icon:        cfg.App.Icon,
-			Address:     cfg.GRPC.Namespace + "." + cfg.Service.Name,
+			Name:        app.Name,
+			Description: app.Description,
+			Icon:        app.Icon,
+			Address:     cfg.AppServiceName(app),
 			MimeTypes:   mimeTypes,
-			ProductName: cfg.App.Product,
+			ProductName: app.Product,
 		},
 	}
 	gwc, err := gws.Next()
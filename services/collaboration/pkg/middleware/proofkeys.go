@@ -3,6 +3,7 @@ package middleware
 import (
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/opencloud-eu/opencloud/services/collaboration/pkg/config"
@@ -20,18 +21,41 @@ import (
 // WOPI app in order to get the keys. The keys will be cached in memory for
 // 12 hours (or the configured value) before hitting the endpoint again to
 // request new / updated keys.
+//
+// Each configured app has its own keys. The app is taken from the WOPI
+// context, so this middleware must run after the WopiContextAuthMiddleware.
+// Requests for apps with disabled proof keys won't be verified.
 func ProofKeysMiddleware(cfg *config.Config, next http.Handler) http.Handler {
-	wopiDiscovery := cfg.App.Addr + "/hosting/discovery"
-	insecure := cfg.App.Insecure
-	cacheDuration, err := time.ParseDuration(cfg.App.ProofKeys.Duration)
-	if err != nil {
-		cacheDuration = 12 * time.Hour
+	pkHandlers := make(map[string]proofkeys.Verifier)
+	for _, app := range cfg.EnabledApps() {
+		if app.ProofKeys.Disable {
+			continue
+		}
+
+		cacheDuration, err := time.ParseDuration(app.ProofKeys.Duration)
+		if err != nil {
+			cacheDuration = 12 * time.Hour
+		}
+		pkHandlers[strings.ToLower(app.Name)] = proofkeys.NewVerifyHandler(app.Addr+"/hosting/discovery", app.Insecure, cacheDuration)
 	}
 
-	pkHandler := proofkeys.NewVerifyHandler(wopiDiscovery, insecure, cacheDuration)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		logger := zerolog.Ctx(r.Context())
 
+		wopiContext, err := WopiContextFromCtx(r.Context())
+		if err != nil {
+			logger.Error().Err(err).Msg("ProofKeys verification failed")
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		app, _ := cfg.GetApp(wopiContext.AppName)
+		pkHandler, ok := pkHandlers[strings.ToLower(app.Name)]
+		if !ok {
+			// proof keys are disabled for the app
+			next.ServeHTTP(w, r)
+			return
+		}
+
 		// the url we need is the one being requested, but we need the
 		// scheme and host, so we'll get those from the configured WOPISrc
 		wopiSrcURL, _ := url.Parse(cfg.Wopi.WopiSrc)
@@ -45,7 +69,7 @@ func ProofKeysMiddleware(cfg *config.Config, next http.Handler) http.Handler {
 		accessToken := r.URL.Query().Get("access_token")
 		stamp := r.Header.Get("X-WOPI-TimeStamp")
 
-		err := pkHandler.Verify(
+		err = pkHandler.Verify(
 			accessToken,
 			currentURL.String(),
 			stamp,
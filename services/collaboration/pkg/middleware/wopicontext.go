@@ -36,6 +36,12 @@ type WopiContext struct {
 	FileReference     *providerv1beta1.Reference
 	TemplateReference *providerv1beta1.Reference
 	ViewMode          appproviderv1beta1.ViewMode
+	// AppName is the name of the app the token was issued for. It is
+	// empty for tokens issued before several apps could be configured.
+	AppName string
+	// SessionID identifies the editing session the token was issued for.
+	// It is empty for tokens issued before the sessions were tracked.
+	SessionID string
 }
 
 // WopiContextAuthMiddleware will prepare an HTTP handler to be used as
@@ -129,6 +135,12 @@ func WopiContextAuthMiddleware(cfg *config.Config, st microstore.Store, next htt
 
 		claims.WopiContext.AccessToken = wopiContextAccessToken
 
+		if _, ok := cfg.GetApp(claims.WopiContext.AppName); !ok {
+			wopiLogger.Error().Str("AppName", claims.WopiContext.AppName).Msg("the app of the access token isn't configured")
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
 		ctx = context.WithValue(ctx, wopiContextKey, claims.WopiContext)
 		// authentication for the CS3 api
 		ctx = metadata.AppendToOutgoingContext(ctx, ctxpkg.TokenHeader, claims.WopiContext.AccessToken)
@@ -139,6 +151,8 @@ func WopiContextAuthMiddleware(cfg *config.Config, st microstore.Store, next htt
 		wopiLogger = wopiLogger.With().
 			Str("FileReference", claims.WopiContext.FileReference.String()).
 			Str("ViewMode", claims.WopiContext.ViewMode.String()).
+			Str("AppName", claims.WopiContext.AppName).
+			Str("SessionID", claims.WopiContext.SessionID).
 			Str("Requester", user.GetId().String()).
 			Logger()
 		ctx = wopiLogger.WithContext(ctx)
@@ -15,6 +15,7 @@ type Option func(o *Options)
 
 // Options defines the available options for this package.
 type Options struct {
+	App           *config.App
 	AppURLs       *helpers.AppURLs
 	Name          string
 	Logger        log.Logger
@@ -35,6 +36,13 @@ func newOptions(opts ...Option) Options {
 	return opt
 }
 
+// App provides the app served by the service.
+func App(val config.App) Option {
+	return func(o *Options) {
+		o.App = &val
+	}
+}
+
 // AppURLs provides app urls based on mimetypes.
 func AppURLs(val *helpers.AppURLs) Option {
 	return func(o *Options) {
@@ -23,12 +23,16 @@ func Server(opts ...Option) (*grpc.Server, func(), error) {
 	}
 	grpcServer := grpc.NewServer(grpcOpts...)
 
-	handle, teardown, err := svc.NewHandler(
+	svcOpts := []svc.Option{
 		svc.Config(options.Config),
 		svc.Logger(options.Logger),
 		svc.AppURLs(options.AppURLs),
 		svc.Store(options.Store),
-	)
+	}
+	if options.App != nil {
+		svcOpts = append(svcOpts, svc.App(*options.App))
+	}
+	handle, teardown, err := svc.NewHandler(svcOpts...)
 	if err != nil {
 		options.Logger.Error().
 			Err(err).
@@ -6,6 +6,7 @@ import (
 	"github.com/opencloud-eu/opencloud/pkg/log"
 	"github.com/opencloud-eu/opencloud/services/collaboration/pkg/config"
 	"github.com/opencloud-eu/opencloud/services/collaboration/pkg/connector"
+	"github.com/opencloud-eu/opencloud/services/collaboration/pkg/sessions"
 	microstore "go-micro.dev/v4/store"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -15,12 +16,14 @@ type Option func(o *Options)
 
 // Options defines the available options for this package.
 type Options struct {
-	Adapter        *connector.HttpAdapter
-	Logger         log.Logger
-	Context        context.Context
-	Config         *config.Config
-	TracerProvider trace.TracerProvider
-	Store          microstore.Store
+	Adapter         *connector.HttpAdapter
+	Logger          log.Logger
+	Context         context.Context
+	Config          *config.Config
+	TracerProvider  trace.TracerProvider
+	Store           microstore.Store
+	SessionRegistry *sessions.Registry
+	SessionHandler  *sessions.Handler
 }
 
 // newOptions initializes the available default options.
@@ -75,3 +78,13 @@ func Store(val microstore.Store) Option {
 		o.Store = val
 	}
 }
+
+// Sessions provides a function to set the Sessions option. The session
+// registry is used to track and revoke the WOPI editing sessions, the
+// handler serves the session API.
+func Sessions(registry *sessions.Registry, handler *sessions.Handler) Option {
+	return func(o *Options) {
+		o.SessionRegistry = registry
+		o.SessionHandler = handler
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/opencloud-eu/opencloud/pkg/tracing"
 	"github.com/opencloud-eu/opencloud/pkg/version"
 	colabmiddleware "github.com/opencloud-eu/opencloud/services/collaboration/pkg/middleware"
+	"github.com/opencloud-eu/opencloud/services/collaboration/pkg/sessions"
 	"github.com/riandyrn/otelchi"
 	"go-micro.dev/v4"
 )
@@ -124,10 +125,17 @@ func prepareRoutes(r *chi.Mux, options Options) {
 				colabmiddleware.CollaborationTracingMiddleware,
 			)
 
-			// check whether we should check for proof keys
-			if !options.Config.App.ProofKeys.Disable {
+			// check the proof keys, unless they are disabled for the app of the request
+			r.Use(func(h stdhttp.Handler) stdhttp.Handler {
+				return colabmiddleware.ProofKeysMiddleware(options.Config, h)
+			})
+
+			if options.SessionRegistry != nil {
+				// reject the requests of closed sessions and track the others
 				r.Use(func(h stdhttp.Handler) stdhttp.Handler {
-					return colabmiddleware.ProofKeysMiddleware(options.Config, h)
+					return sessions.RevocationMiddleware(options.SessionRegistry, h)
+				}, func(h stdhttp.Handler) stdhttp.Handler {
+					return sessions.TrackingMiddleware(options.Config, options.SessionRegistry, h)
 				})
 			}
 
@@ -158,12 +166,22 @@ func prepareRoutes(r *chi.Mux, options Options) {
 					adapter.RenameFile(w, r)
 				case "DELETE":
 					adapter.DeleteFile(w, r)
+				case "GET_SHARE_URL":
+					adapter.GetShareUrl(w, r)
 
 				default:
 					stdhttp.Error(w, stdhttp.StatusText(stdhttp.StatusInternalServerError), stdhttp.StatusInternalServerError)
 				}
 			})
 
+			r.Get("/ancestry", func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+				adapter.EnumerateAncestors(w, r)
+			})
+
+			r.Get("/ecosystem_pointer", func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+				adapter.GetEcosystem(w, r)
+			})
+
 			r.Route("/contents", func(r chi.Router) {
 				r.Get("/", func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
 					adapter.GetFile(w, r)
@@ -182,6 +200,77 @@ func prepareRoutes(r *chi.Mux, options Options) {
 				})
 			})
 		})
+		r.Route("/containers/{containerid}", func(r chi.Router) {
+
+			r.Use(
+				func(h stdhttp.Handler) stdhttp.Handler {
+					// authentication and wopi context
+					return colabmiddleware.WopiContextAuthMiddleware(options.Config, options.Store, h)
+				},
+				colabmiddleware.CollaborationTracingMiddleware,
+			)
+
+			// check the proof keys, unless they are disabled for the app of the request
+			r.Use(func(h stdhttp.Handler) stdhttp.Handler {
+				return colabmiddleware.ProofKeysMiddleware(options.Config, h)
+			})
+
+			if options.SessionRegistry != nil {
+				// reject the requests of closed sessions
+				r.Use(func(h stdhttp.Handler) stdhttp.Handler {
+					return sessions.RevocationMiddleware(options.SessionRegistry, h)
+				})
+			}
+
+			r.Get("/", func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+				adapter.CheckContainerInfo(w, r)
+			})
+
+			r.Post("/", func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+				// CREATE_CHILD_CONTAINER, CREATE_CHILD_FILE, DELETE_CONTAINER
+				// and RENAME_CONTAINER aren't supported
+				// https://learn.microsoft.com/en-us/microsoft-365/cloud-storage-partner-program/rest/endpoints#containers-endpoint
+				stdhttp.Error(w, stdhttp.StatusText(stdhttp.StatusNotImplemented), stdhttp.StatusNotImplemented)
+			})
+
+			r.Get("/children", func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+				adapter.EnumerateChildren(w, r)
+			})
+
+			r.Get("/ancestry", func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+				adapter.EnumerateAncestors(w, r)
+			})
+
+			r.Get("/ecosystem_pointer", func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+				adapter.GetEcosystem(w, r)
+			})
+		})
+		r.Route("/ecosystem/{id}", func(r chi.Router) {
+
+			r.Use(
+				func(h stdhttp.Handler) stdhttp.Handler {
+					// authentication and wopi context
+					return colabmiddleware.WopiContextAuthMiddleware(options.Config, options.Store, h)
+				},
+				colabmiddleware.CollaborationTracingMiddleware,
+			)
+
+			// check the proof keys, unless they are disabled for the app of the request
+			r.Use(func(h stdhttp.Handler) stdhttp.Handler {
+				return colabmiddleware.ProofKeysMiddleware(options.Config, h)
+			})
+
+			if options.SessionRegistry != nil {
+				// reject the requests of closed sessions
+				r.Use(func(h stdhttp.Handler) stdhttp.Handler {
+					return sessions.RevocationMiddleware(options.SessionRegistry, h)
+				})
+			}
+
+			r.Get("/root_container_pointer", func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+				adapter.GetRootContainer(w, r)
+			})
+		})
 		r.Route("/templates/{templateID}", func(r chi.Router) {
 			r.Use(
 				func(h stdhttp.Handler) stdhttp.Handler {
@@ -190,9 +279,20 @@ func prepareRoutes(r *chi.Mux, options Options) {
 				},
 				colabmiddleware.CollaborationTracingMiddleware,
 			)
+			if options.SessionRegistry != nil {
+				// reject the requests of closed sessions
+				r.Use(func(h stdhttp.Handler) stdhttp.Handler {
+					return sessions.RevocationMiddleware(options.SessionRegistry, h)
+				})
+			}
 			r.Get("/", func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
 				adapter.GetFile(w, r)
 			})
 		})
 	})
+
+	if options.SessionHandler != nil {
+		// the session API for administrators
+		r.Mount("/api/v0/collaboration/sessions", options.SessionHandler.Routes())
+	}
 }
@@ -17,6 +17,7 @@ type Option func(o *Options)
 type Options struct {
 	Logger          log.Logger
 	Config          *config.Config
+	App             *config.App
 	AppURLs         *helpers.AppURLs
 	GatewaySelector pool.Selectable[gatewayv1beta1.GatewayAPIClient]
 	Store           microstore.Store
@@ -47,6 +48,13 @@ func Config(val *config.Config) Option {
 	}
 }
 
+// App provides a function to set the App option.
+func App(val config.App) Option {
+	return func(o *Options) {
+		o.App = &val
+	}
+}
+
 // AppURLs provides a function to set the AppURLs option.
 func AppURLs(val *helpers.AppURLs) Option {
 	return func(o *Options) {
@@ -13,6 +13,7 @@ import (
 	userv1beta1 "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
 	rpcv1beta1 "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
 	providerv1beta1 "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/google/uuid"
 	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
 	"github.com/opencloud-eu/reva/v2/pkg/storagespace"
 	"github.com/opencloud-eu/reva/v2/pkg/utils"
@@ -46,8 +47,15 @@ func NewHandler(opts ...Option) (*Service, func(), error) {
 		return nil, teardown, errors.New("AppURLs option is required")
 	}
 
+	// without an explicit app, the single app of the configuration is served
+	app := &options.Config.App
+	if options.App != nil {
+		app = options.App
+	}
+
 	return &Service{
-		id:              options.Config.GRPC.Namespace + "." + options.Config.Service.Name,
+		id:              options.Config.AppServiceName(*app),
+		app:             app,
 		appURLs:         options.AppURLs,
 		logger:          options.Logger,
 		config:          options.Config,
@@ -59,6 +67,7 @@ func NewHandler(opts ...Option) (*Service, func(), error) {
 // Service implements the OpenInApp interface
 type Service struct {
 	id              string
+	app             *config.App
 	appURLs         *helpers.AppURLs
 	logger          log.Logger
 	config          *config.Config
@@ -130,6 +139,8 @@ func (s *Service) OpenInApp(
 		ViewOnlyToken: utils.ReadPlainFromOpaque(req.GetOpaque(), "viewOnlyToken"),
 		FileReference: &providerFileRef,
 		ViewMode:      req.GetViewMode(),
+		AppName:       s.app.Name,
+		SessionID:     uuid.New().String(),
 	}
 
 	if templateID := utils.ReadPlainFromOpaque(req.GetOpaque(), "template"); templateID != "" {
@@ -186,7 +197,7 @@ func (s *Service) getAppUrl(fileExt string, viewMode appproviderv1beta1.ViewMode
 	// prioritize view action if possible
 	appURL := s.appURLs.GetAppURLFor("view", fileExt)
 
-	if strings.ToLower(s.config.App.Product) == "collabora" {
+	if strings.ToLower(s.app.Product) == "collabora" {
 		// collabora provides only one action per extension. usual options
 		// are "view" (checked above), "edit" or "view_comment" (this last one
 		// is exclusive of collabora)
@@ -274,7 +285,7 @@ func (s *Service) addQueryToURL(baseURL string, req *appproviderv1beta1.OpenInAp
 	}
 
 	if lang != "" {
-		switch strings.ToLower(s.config.App.Product) {
+		switch strings.ToLower(s.app.Product) {
 		case "collabora":
 			q.Add("lang", lang)
 		case "onlyoffice":
@@ -284,7 +295,7 @@ func (s *Service) addQueryToURL(baseURL string, req *appproviderv1beta1.OpenInAp
 		}
 	}
 
-	if strings.ToLower(s.config.App.Product) == "collabora" {
+	if strings.ToLower(s.app.Product) == "collabora" {
 		q.Add("closebutton", "false")
 	}
 
@@ -0,0 +1,227 @@
+package sessions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	gatewayv1beta1 "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	rpcv1beta1 "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	providerv1beta1 "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/go-chi/chi/v5"
+	"github.com/opencloud-eu/opencloud/services/collaboration/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/collaboration/pkg/middleware"
+	ctxpkg "github.com/opencloud-eu/reva/v2/pkg/ctx"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
+	"github.com/opencloud-eu/reva/v2/pkg/storagespace"
+	"github.com/opencloud-eu/reva/v2/pkg/utils"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/metadata"
+)
+
+// ManagePermission is the permission required to use the session API
+const ManagePermission = "WebOffice.Manage"
+
+// Handler implements the HTTP API to list and close the WOPI editing
+// sessions. The requests must be authenticated with a reva access token in
+// the "x-access-token" header of a user having the "WebOffice.Manage"
+// permission.
+type Handler struct {
+	gws      pool.Selectable[gatewayv1beta1.GatewayAPIClient]
+	cfg      *config.Config
+	registry *Registry
+}
+
+// NewHandler creates a new session API handler
+func NewHandler(gws pool.Selectable[gatewayv1beta1.GatewayAPIClient], cfg *config.Config, registry *Registry) *Handler {
+	return &Handler{
+		gws:      gws,
+		cfg:      cfg,
+		registry: registry,
+	}
+}
+
+// Routes returns the routes of the session API
+func (h *Handler) Routes() http.Handler {
+	r := chi.NewRouter()
+	r.Use(h.authorize)
+	r.Get("/", h.ListSessions)
+	r.Get("/{sessionid}", h.GetSession)
+	r.Delete("/{sessionid}", h.CloseSession)
+	return r
+}
+
+// ListSessions lists the active sessions. The sessions can be filtered by
+// the "fileId" and "userId" query parameters.
+func (h *Handler) ListSessions(w http.ResponseWriter, r *http.Request) {
+	logger := zerolog.Ctx(r.Context())
+
+	sessions, err := h.registry.List()
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to list the sessions")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	fileID := r.URL.Query().Get("fileId")
+	userID := r.URL.Query().Get("userId")
+	filtered := make([]Session, 0, len(sessions))
+	for _, s := range sessions {
+		if (fileID == "" || s.FileID == fileID) && (userID == "" || s.UserID == userID) {
+			filtered = append(filtered, s)
+		}
+	}
+
+	writeJSON(w, r, filtered)
+}
+
+// GetSession returns a single session
+func (h *Handler) GetSession(w http.ResponseWriter, r *http.Request) {
+	logger := zerolog.Ctx(r.Context())
+
+	session, err := h.registry.Get(chi.URLParam(r, "sessionid"))
+	switch {
+	case errors.Is(err, ErrNotFound):
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	case err != nil:
+		logger.Error().Err(err).Msg("failed to get the session")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, r, session)
+}
+
+// CloseSession force-closes a session. The access token of the session is
+// revoked, so the app can't read or write the file anymore, and the lock
+// of the session is removed from the file. If the file can't be unlocked,
+// the session stays in the registry and closing it can be retried.
+func (h *Handler) CloseSession(w http.ResponseWriter, r *http.Request) {
+	logger := zerolog.Ctx(r.Context()).With().Str("SessionID", chi.URLParam(r, "sessionid")).Logger()
+
+	session, accessToken, err := h.registry.Revoke(chi.URLParam(r, "sessionid"))
+	switch {
+	case errors.Is(err, ErrNotFound):
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	case err != nil:
+		logger.Error().Err(err).Msg("failed to revoke the session")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	if session.LockID != "" {
+		if err := h.unlock(r.Context(), session, accessToken); err != nil {
+			logger.Error().Err(err).Str("FileID", session.FileID).Str("LockID", session.LockID).Msg("failed to unlock the file of the session")
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	if err := h.registry.Remove(session.ID); err != nil {
+		logger.Error().Err(err).Msg("failed to remove the session")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	logger.Info().Str("FileID", session.FileID).Str("UserID", session.UserID).Msg("session closed")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// authorize checks that the user of the request has the ManagePermission.
+// The user is set in the context by the account middleware of the server.
+func (h *Handler) authorize(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := zerolog.Ctx(r.Context())
+
+		if _, ok := ctxpkg.ContextGetUser(r.Context()); !ok {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		gwc, err := h.gws.Next()
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to get a gateway client")
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		ctx := metadata.AppendToOutgoingContext(r.Context(), ctxpkg.TokenHeader, r.Header.Get(ctxpkg.TokenHeader))
+		ok, err := utils.CheckPermission(ctx, ManagePermission, gwc)
+		if err != nil {
+			logger.Error().Err(err).Msg("CheckPermission failed")
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		if !ok {
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// unlock removes the lock of the session from its file, acting as the user
+// of the session. The file not being locked by the session anymore isn't
+// an error.
+func (h *Handler) unlock(ctx context.Context, session Session, accessToken string) error {
+	revaToken, err := middleware.DecryptAES([]byte(h.cfg.Wopi.Secret), accessToken)
+	if err != nil {
+		return err
+	}
+
+	rid, err := storagespace.ParseID(session.FileID)
+	if err != nil {
+		return err
+	}
+
+	gwc, err := h.gws.Next()
+	if err != nil {
+		return err
+	}
+
+	ctx = metadata.AppendToOutgoingContext(ctx, ctxpkg.TokenHeader, revaToken)
+	resp, err := gwc.Unlock(ctx, &providerv1beta1.UnlockRequest{
+		Ref: &providerv1beta1.Reference{ResourceId: &rid},
+		Lock: &providerv1beta1.Lock{
+			LockId:  session.LockID,
+			AppName: session.AppName,
+			Type:    providerv1beta1.LockType_LOCK_TYPE_WRITE,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	switch resp.GetStatus().GetCode() {
+	case rpcv1beta1.Code_CODE_OK,
+		rpcv1beta1.Code_CODE_NOT_FOUND,
+		rpcv1beta1.Code_CODE_ABORTED,
+		rpcv1beta1.Code_CODE_LOCKED,
+		rpcv1beta1.Code_CODE_FAILED_PRECONDITION:
+		// the file is unlocked or locked by someone else
+		return nil
+	default:
+		return errors.New(resp.GetStatus().GetMessage())
+	}
+}
+
+func writeJSON(w http.ResponseWriter, r *http.Request, body interface{}) {
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		logger := zerolog.Ctx(r.Context())
+		logger.Error().Err(err).Msg("failed to marshal response")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(jsonBody); err != nil {
+		logger := zerolog.Ctx(r.Context())
+		logger.Error().Err(err).Msg("failed to write contents in the HTTP response")
+	}
+}
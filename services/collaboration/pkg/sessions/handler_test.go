@@ -0,0 +1,181 @@
+package sessions_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	gatewayv1beta1 "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	userv1beta1 "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	permissionsv1beta1 "github.com/cs3org/go-cs3apis/cs3/permissions/v1beta1"
+	rpcv1beta1 "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	providerv1beta1 "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/opencloud-eu/opencloud/services/collaboration/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/collaboration/pkg/middleware"
+	"github.com/opencloud-eu/opencloud/services/collaboration/pkg/sessions"
+	"github.com/opencloud-eu/opencloud/services/graph/mocks"
+	ctxpkg "github.com/opencloud-eu/reva/v2/pkg/ctx"
+	cs3mocks "github.com/opencloud-eu/reva/v2/tests/cs3mocks/mocks"
+	"github.com/stretchr/testify/mock"
+	microstore "go-micro.dev/v4/store"
+)
+
+var _ = Describe("Handler", func() {
+	var (
+		cfg             *config.Config
+		ctx             context.Context
+		registry        *sessions.Registry
+		handler         http.Handler
+		gatewayClient   *cs3mocks.GatewayAPIClient
+		gatewaySelector *mocks.Selectable[gatewayv1beta1.GatewayAPIClient]
+	)
+
+	BeforeEach(func() {
+		cfg = &config.Config{
+			Wopi: config.Wopi{
+				Secret: "wopiSecret",
+			},
+		}
+
+		gatewayClient = cs3mocks.NewGatewayAPIClient(GinkgoT())
+		gatewaySelector = mocks.NewSelectable[gatewayv1beta1.GatewayAPIClient](GinkgoT())
+		gatewaySelector.On("Next").Return(gatewayClient, nil)
+
+		registry = sessions.NewRegistry(microstore.NewMemoryStore(), microstore.NewMemoryStore(), time.Hour)
+		handler = sessions.NewHandler(gatewaySelector, cfg, registry).Routes()
+
+		ctx = ctxpkg.ContextSetUser(context.Background(), &userv1beta1.User{
+			Id: &userv1beta1.UserId{
+				Idp:      "example.com",
+				OpaqueId: "admin",
+			},
+		})
+
+		accessToken, err := middleware.EncryptAES([]byte(cfg.Wopi.Secret), "revaToken")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(registry.Heartbeat(sessions.Session{
+			ID:      "session1",
+			UserID:  "user1",
+			FileID:  "storage$space!file1",
+			AppName: "Collabora",
+		}, accessToken, "lock1")).To(Succeed())
+		Expect(registry.Open(sessions.Session{
+			ID:      "session2",
+			UserID:  "user2",
+			FileID:  "storage$space!file2",
+			AppName: "Collabora",
+		}, accessToken)).To(Succeed())
+	})
+
+	allowed := func(ok bool) {
+		code := rpcv1beta1.Code_CODE_OK
+		if !ok {
+			code = rpcv1beta1.Code_CODE_PERMISSION_DENIED
+		}
+		gatewayClient.EXPECT().CheckPermission(mock.Anything, mock.MatchedBy(func(req *permissionsv1beta1.CheckPermissionRequest) bool {
+			return req.GetPermission() == sessions.ManagePermission && req.GetSubjectRef().GetUserId().GetOpaqueId() == "admin"
+		})).Return(&permissionsv1beta1.CheckPermissionResponse{
+			Status: &rpcv1beta1.Status{Code: code},
+		}, nil)
+	}
+
+	It("rejects anonymous requests", func() {
+		gatewaySelector.EXPECT().Next().Unset()
+
+		req := httptest.NewRequest("GET", "/", nil)
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+		Expect(resp.Code).To(Equal(http.StatusUnauthorized))
+	})
+
+	It("rejects users without permission", func() {
+		allowed(false)
+
+		req := httptest.NewRequest("GET", "/", nil).WithContext(ctx)
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+		Expect(resp.Code).To(Equal(http.StatusForbidden))
+	})
+
+	It("lists the sessions of a file", func() {
+		allowed(true)
+
+		req := httptest.NewRequest("GET", "/?fileId=storage$space!file1", nil).WithContext(ctx)
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+		Expect(resp.Code).To(Equal(http.StatusOK))
+
+		var list []map[string]interface{}
+		Expect(json.Unmarshal(resp.Body.Bytes(), &list)).To(Succeed())
+		Expect(list).To(HaveLen(1))
+		Expect(list[0]["id"]).To(Equal("session1"))
+		Expect(list[0]["lockId"]).To(Equal("lock1"))
+		Expect(list[0]).ToNot(HaveKey("accessToken"))
+	})
+
+	It("gets a session", func() {
+		allowed(true)
+
+		req := httptest.NewRequest("GET", "/session2", nil).WithContext(ctx)
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+		Expect(resp.Code).To(Equal(http.StatusOK))
+
+		var session sessions.Session
+		Expect(json.Unmarshal(resp.Body.Bytes(), &session)).To(Succeed())
+		Expect(session.UserID).To(Equal("user2"))
+	})
+
+	It("returns 404 for unknown sessions", func() {
+		allowed(true)
+
+		req := httptest.NewRequest("DELETE", "/unknown", nil).WithContext(ctx)
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+		Expect(resp.Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("closes a session and unlocks the file", func() {
+		allowed(true)
+		gatewayClient.EXPECT().Unlock(mock.Anything, mock.MatchedBy(func(req *providerv1beta1.UnlockRequest) bool {
+			return req.GetRef().GetResourceId().GetOpaqueId() == "file1" &&
+				req.GetLock().GetLockId() == "lock1" &&
+				req.GetLock().GetAppName() == "Collabora"
+		})).Return(&providerv1beta1.UnlockResponse{
+			Status: &rpcv1beta1.Status{Code: rpcv1beta1.Code_CODE_OK},
+		}, nil)
+
+		req := httptest.NewRequest("DELETE", "/session1", nil).WithContext(ctx)
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+		Expect(resp.Code).To(Equal(http.StatusNoContent))
+
+		revoked, err := registry.IsRevoked("session1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revoked).To(BeTrue())
+		_, err = registry.Get("session1")
+		Expect(err).To(MatchError(sessions.ErrNotFound))
+	})
+
+	It("keeps the session if the file can't be unlocked", func() {
+		allowed(true)
+		gatewayClient.EXPECT().Unlock(mock.Anything, mock.Anything).Return(&providerv1beta1.UnlockResponse{
+			Status: &rpcv1beta1.Status{Code: rpcv1beta1.Code_CODE_INTERNAL, Message: "failure"},
+		}, nil)
+
+		req := httptest.NewRequest("DELETE", "/session1", nil).WithContext(ctx)
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+		Expect(resp.Code).To(Equal(http.StatusInternalServerError))
+
+		revoked, err := registry.IsRevoked("session1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revoked).To(BeTrue())
+		_, err = registry.Get("session1")
+		Expect(err).ToNot(HaveOccurred())
+	})
+})
@@ -0,0 +1,124 @@
+package sessions
+
+import (
+	"net/http"
+	"time"
+
+	chimiddleware "github.com/go-chi/chi/v5/middleware"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/opencloud-eu/opencloud/services/collaboration/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/collaboration/pkg/middleware"
+	ctxpkg "github.com/opencloud-eu/reva/v2/pkg/ctx"
+	"github.com/opencloud-eu/reva/v2/pkg/storagespace"
+	"github.com/rs/zerolog"
+)
+
+// RevocationMiddleware rejects the requests of revoked sessions with a 401
+// HTTP status. The session is taken from the WOPI context, so this
+// middleware must run after the WopiContextAuthMiddleware.
+func RevocationMiddleware(registry *Registry, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := zerolog.Ctx(r.Context())
+
+		wopiContext, err := middleware.WopiContextFromCtx(r.Context())
+		if err != nil || wopiContext.SessionID == "" {
+			// tokens issued before the sessions were tracked have no session
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		revoked, err := registry.IsRevoked(wopiContext.SessionID)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to check if the session is revoked")
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		if revoked {
+			logger.Error().Msg("the session has been closed")
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// TrackingMiddleware registers the session of the WOPI requests in the
+// registry. Successful lock, refresh lock and PutFile requests count as
+// heartbeat and update the lock of the session, successful unlock requests
+// clear it. The session is taken from the WOPI context, so this middleware
+// must run after the WopiContextAuthMiddleware.
+//
+// Failing to track the session doesn't fail the request.
+func TrackingMiddleware(cfg *config.Config, registry *Registry, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ww := chimiddleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		next.ServeHTTP(ww, r)
+
+		status := ww.Status()
+		if status < 200 || status >= 300 {
+			return
+		}
+
+		ctx := r.Context()
+		logger := zerolog.Ctx(ctx)
+
+		wopiContext, err := middleware.WopiContextFromCtx(ctx)
+		if err != nil || wopiContext.SessionID == "" {
+			return
+		}
+
+		accessToken, err := middleware.EncryptAES([]byte(cfg.Wopi.Secret), wopiContext.AccessToken)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to encrypt the access token of the session")
+			return
+		}
+
+		app, _ := cfg.GetApp(wopiContext.AppName)
+		user := ctxpkg.ContextMustGetUser(ctx)
+		userName := user.GetDisplayName()
+		if userName == "" {
+			userName = user.GetUsername()
+		}
+		session := Session{
+			ID:        wopiContext.SessionID,
+			UserID:    user.GetId().GetOpaqueId(),
+			UserName:  userName,
+			FileID:    storagespace.FormatResourceID(wopiContext.FileReference.GetResourceId()),
+			AppName:   app.Name,
+			ViewMode:  wopiContext.ViewMode.String(),
+			ExpiresAt: tokenExpiration(wopiContext.AccessToken),
+		}
+
+		switch wopiOperation(r) {
+		case "LOCK", "REFRESH_LOCK", "PUT":
+			err = registry.Heartbeat(session, accessToken, r.Header.Get("X-WOPI-Lock"))
+		case "UNLOCK":
+			err = registry.Heartbeat(session, accessToken, "")
+		default:
+			err = registry.Open(session, accessToken)
+		}
+		if err != nil {
+			logger.Error().Err(err).Str("SessionID", session.ID).Msg("failed to track the session")
+		}
+	})
+}
+
+// wopiOperation returns the WOPI operation of a POST request, or an empty
+// string for any other request
+func wopiOperation(r *http.Request) string {
+	if r.Method != http.MethodPost {
+		return ""
+	}
+	return r.Header.Get("X-WOPI-Override")
+}
+
+// tokenExpiration returns the expiration of the reva access token. The
+// token has already been verified when the WOPI context was created.
+func tokenExpiration(token string) time.Time {
+	claims := &jwt.RegisteredClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil || claims.ExpiresAt == nil {
+		return time.Time{}
+	}
+	return claims.ExpiresAt.Time
+}
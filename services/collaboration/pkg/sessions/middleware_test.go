@@ -0,0 +1,119 @@
+package sessions_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	appproviderv1beta1 "github.com/cs3org/go-cs3apis/cs3/app/provider/v1beta1"
+	userv1beta1 "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	providerv1beta1 "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/opencloud-eu/opencloud/services/collaboration/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/collaboration/pkg/middleware"
+	"github.com/opencloud-eu/opencloud/services/collaboration/pkg/sessions"
+	ctxpkg "github.com/opencloud-eu/reva/v2/pkg/ctx"
+	microstore "go-micro.dev/v4/store"
+)
+
+var _ = Describe("Middleware", func() {
+	var (
+		cfg      *config.Config
+		ctx      context.Context
+		registry *sessions.Registry
+		status   int
+		mw       http.Handler
+	)
+
+	BeforeEach(func() {
+		cfg = &config.Config{
+			App: config.App{
+				Name: "Collabora",
+			},
+			Wopi: config.Wopi{
+				Secret: "wopiSecret",
+			},
+		}
+		registry = sessions.NewRegistry(microstore.NewMemoryStore(), microstore.NewMemoryStore(), time.Hour)
+
+		status = http.StatusOK
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+		})
+		mw = sessions.RevocationMiddleware(registry, sessions.TrackingMiddleware(cfg, registry, next))
+
+		ctx = ctxpkg.ContextSetUser(context.Background(), &userv1beta1.User{
+			Id: &userv1beta1.UserId{
+				Idp:      "example.com",
+				OpaqueId: "user1",
+			},
+			DisplayName: "User One",
+		})
+		ctx = middleware.WopiContextToCtx(ctx, middleware.WopiContext{
+			AccessToken: "revaToken",
+			FileReference: &providerv1beta1.Reference{
+				ResourceId: &providerv1beta1.ResourceId{
+					StorageId: "storage",
+					SpaceId:   "space",
+					OpaqueId:  "file",
+				},
+			},
+			ViewMode:  appproviderv1beta1.ViewMode_VIEW_MODE_READ_WRITE,
+			SessionID: "session1",
+		})
+	})
+
+	request := func(method, override, lockID string) int {
+		req := httptest.NewRequest(method, "/wopi/files/abc", nil).WithContext(ctx)
+		if override != "" {
+			req.Header.Set("X-WOPI-Override", override)
+		}
+		if lockID != "" {
+			req.Header.Set("X-WOPI-Lock", lockID)
+		}
+		resp := httptest.NewRecorder()
+		mw.ServeHTTP(resp, req)
+		return resp.Code
+	}
+
+	It("tracks the session", func() {
+		Expect(request("GET", "", "")).To(Equal(http.StatusOK))
+
+		s, err := registry.Get("session1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(s.UserID).To(Equal("user1"))
+		Expect(s.UserName).To(Equal("User One"))
+		Expect(s.FileID).To(Equal("storage$space!file"))
+		Expect(s.AppName).To(Equal("Collabora"))
+		Expect(s.ViewMode).To(Equal("VIEW_MODE_READ_WRITE"))
+		Expect(s.LockID).To(BeEmpty())
+
+		Expect(request("POST", "LOCK", "lock1")).To(Equal(http.StatusOK))
+		s, err = registry.Get("session1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(s.LockID).To(Equal("lock1"))
+
+		Expect(request("POST", "UNLOCK", "lock1")).To(Equal(http.StatusOK))
+		s, err = registry.Get("session1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(s.LockID).To(BeEmpty())
+	})
+
+	It("doesn't track failed requests", func() {
+		status = http.StatusConflict
+		Expect(request("POST", "LOCK", "lock1")).To(Equal(http.StatusConflict))
+
+		_, err := registry.Get("session1")
+		Expect(err).To(MatchError(sessions.ErrNotFound))
+	})
+
+	It("rejects the requests of revoked sessions", func() {
+		Expect(request("GET", "", "")).To(Equal(http.StatusOK))
+		_, _, err := registry.Revoke("session1")
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(request("GET", "", "")).To(Equal(http.StatusUnauthorized))
+	})
+})
@@ -0,0 +1,209 @@
+package sessions
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	microstore "go-micro.dev/v4/store"
+)
+
+const (
+	sessionPrefix = "session."
+	revokedPrefix = "revoked."
+)
+
+// ErrNotFound is returned if the requested session doesn't exist
+var ErrNotFound = errors.New("session not found")
+
+// Session is a WOPI editing session: a user having a file open in an app.
+// A session starts with the first WOPI request for the file and lives as
+// long as the app keeps sending heartbeats (lock refreshes and PutFile calls).
+type Session struct {
+	ID            string    `json:"id"`
+	UserID        string    `json:"userId"`
+	UserName      string    `json:"userName"`
+	FileID        string    `json:"fileId"`
+	AppName       string    `json:"appName"`
+	ViewMode      string    `json:"viewMode"`
+	LockID        string    `json:"lockId,omitempty"`
+	StartedAt     time.Time `json:"startedAt"`
+	LastHeartbeat time.Time `json:"lastHeartbeat"`
+	ExpiresAt     time.Time `json:"expiresAt"`
+}
+
+// record is the session as it is persisted. It contains the encrypted
+// access token of the session, which is required to unlock the file when
+// the session is closed, and must never leave the service.
+type record struct {
+	Session
+	AccessToken string `json:"accessToken"`
+}
+
+// Registry keeps track of the WOPI editing sessions. The sessions are
+// persisted in the store, so all the instances of the service sharing the
+// store know about all the sessions.
+type Registry struct {
+	store       microstore.Store
+	revocations microstore.Store
+	ttl         time.Duration
+}
+
+// NewRegistry creates a new session registry. Sessions without heartbeat
+// for the ttl expire. The revocations of the access tokens are kept in their
+// own store, which must keep them at least as long as the access tokens are
+// valid.
+func NewRegistry(st microstore.Store, revocations microstore.Store, ttl time.Duration) *Registry {
+	return &Registry{
+		store:       st,
+		revocations: revocations,
+		ttl:         ttl,
+	}
+}
+
+// Open registers the session if it isn't registered yet. The encrypted
+// access token is stored along with the session.
+func (r *Registry) Open(s Session, accessToken string) error {
+	if _, err := r.read(s.ID); err == nil || !errors.Is(err, ErrNotFound) {
+		return err
+	}
+
+	now := time.Now()
+	s.StartedAt = now
+	s.LastHeartbeat = now
+	return r.write(record{Session: s, AccessToken: accessToken})
+}
+
+// Heartbeat updates the last heartbeat and the lock of the session. The
+// session is registered if it isn't registered yet. An empty lockID means
+// that the file isn't locked by the session anymore.
+func (r *Registry) Heartbeat(s Session, accessToken string, lockID string) error {
+	rec, err := r.read(s.ID)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		rec = record{Session: s, AccessToken: accessToken}
+		rec.StartedAt = time.Now()
+	case err != nil:
+		return err
+	}
+
+	rec.LockID = lockID
+	rec.LastHeartbeat = time.Now()
+	return r.write(rec)
+}
+
+// Get returns the session with the given id
+func (r *Registry) Get(id string) (Session, error) {
+	rec, err := r.read(id)
+	if err != nil {
+		return Session{}, err
+	}
+	return rec.Session, nil
+}
+
+// List returns all the active sessions, the most recently started first
+func (r *Registry) List() ([]Session, error) {
+	keys, err := r.store.List(microstore.ListPrefix(sessionPrefix))
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]Session, 0, len(keys))
+	for _, key := range keys {
+		rec, err := r.read(key[len(sessionPrefix):])
+		if errors.Is(err, ErrNotFound) {
+			// the session expired in the meantime
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, rec.Session)
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].StartedAt.After(sessions[j].StartedAt)
+	})
+	return sessions, nil
+}
+
+// Revoke revokes the access token of the session. Requests of the session
+// will be rejected as long as the revocation store keeps the revocation,
+// which should be at least until the access token expires. The session and
+// its encrypted access token are returned, the session is kept in the
+// registry until it is removed.
+func (r *Registry) Revoke(id string) (Session, string, error) {
+	rec, err := r.read(id)
+	if err != nil {
+		return Session{}, "", err
+	}
+
+	// the revocation isn't needed anymore once the access token expired,
+	// stores not supporting the expiry of single records keep it for the
+	// ttl of the revocation store
+	var expiry time.Duration
+	if !rec.ExpiresAt.IsZero() {
+		expiry = time.Until(rec.ExpiresAt)
+	}
+	err = r.revocations.Write(&microstore.Record{
+		Key:    revokedPrefix + id,
+		Value:  []byte(time.Now().Format(time.RFC3339)),
+		Expiry: expiry,
+	})
+	if err != nil {
+		return Session{}, "", err
+	}
+	return rec.Session, rec.AccessToken, nil
+}
+
+// IsRevoked checks if the access token of the session has been revoked
+func (r *Registry) IsRevoked(id string) (bool, error) {
+	_, err := r.revocations.Read(revokedPrefix + id)
+	switch {
+	case errors.Is(err, microstore.ErrNotFound):
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+	return true, nil
+}
+
+// Remove removes the session from the registry
+func (r *Registry) Remove(id string) error {
+	err := r.store.Delete(sessionPrefix + id)
+	if errors.Is(err, microstore.ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+func (r *Registry) read(id string) (record, error) {
+	records, err := r.store.Read(sessionPrefix + id)
+	switch {
+	case errors.Is(err, microstore.ErrNotFound):
+		return record{}, ErrNotFound
+	case err != nil:
+		return record{}, err
+	case len(records) == 0:
+		return record{}, ErrNotFound
+	}
+
+	var rec record
+	if err := json.Unmarshal(records[0].Value, &rec); err != nil {
+		return record{}, err
+	}
+	return rec, nil
+}
+
+func (r *Registry) write(rec record) error {
+	value, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	return r.store.Write(&microstore.Record{
+		Key:    sessionPrefix + rec.ID,
+		Value:  value,
+		Expiry: r.ttl,
+	})
+}
@@ -0,0 +1,134 @@
+package sessions_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/opencloud-eu/opencloud/services/collaboration/pkg/sessions"
+	microstore "go-micro.dev/v4/store"
+)
+
+var _ = Describe("Registry", func() {
+	var (
+		registry    *sessions.Registry
+		revocations microstore.Store
+		session     sessions.Session
+	)
+
+	BeforeEach(func() {
+		revocations = microstore.NewMemoryStore()
+		registry = sessions.NewRegistry(microstore.NewMemoryStore(), revocations, time.Hour)
+		session = sessions.Session{
+			ID:        "session1",
+			UserID:    "user1",
+			UserName:  "Admin",
+			FileID:    "storage$space!file",
+			AppName:   "Collabora",
+			ViewMode:  "VIEW_MODE_READ_WRITE",
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+	})
+
+	It("opens a session only once", func() {
+		Expect(registry.Open(session, "token")).To(Succeed())
+		s, err := registry.Get("session1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(s.UserName).To(Equal("Admin"))
+		Expect(s.StartedAt).ToNot(BeZero())
+		startedAt := s.StartedAt
+
+		session.UserName = "Other"
+		Expect(registry.Open(session, "token")).To(Succeed())
+		s, err = registry.Get("session1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(s.UserName).To(Equal("Admin"))
+		Expect(s.StartedAt).To(BeTemporally("==", startedAt))
+	})
+
+	It("records the heartbeats and the lock", func() {
+		Expect(registry.Open(session, "token")).To(Succeed())
+		s, err := registry.Get("session1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(s.LockID).To(BeEmpty())
+
+		Expect(registry.Heartbeat(session, "token", "lock1")).To(Succeed())
+		s2, err := registry.Get("session1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(s2.LockID).To(Equal("lock1"))
+		Expect(s2.StartedAt).To(BeTemporally("==", s.StartedAt))
+		Expect(s2.LastHeartbeat).To(BeTemporally(">=", s.LastHeartbeat))
+
+		Expect(registry.Heartbeat(session, "token", "")).To(Succeed())
+		s2, err = registry.Get("session1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(s2.LockID).To(BeEmpty())
+	})
+
+	It("registers unknown sessions on heartbeat", func() {
+		Expect(registry.Heartbeat(session, "token", "lock1")).To(Succeed())
+		s, err := registry.Get("session1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(s.LockID).To(Equal("lock1"))
+		Expect(s.StartedAt).ToNot(BeZero())
+	})
+
+	It("lists the sessions", func() {
+		Expect(registry.Open(session, "token")).To(Succeed())
+		session.ID = "session2"
+		Expect(registry.Open(session, "token")).To(Succeed())
+
+		list, err := registry.List()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list).To(HaveLen(2))
+		Expect(list[0].ID).To(Equal("session2"))
+		Expect(list[1].ID).To(Equal("session1"))
+	})
+
+	It("revokes and removes sessions", func() {
+		Expect(registry.Heartbeat(session, "token", "lock1")).To(Succeed())
+
+		revoked, err := registry.IsRevoked("session1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revoked).To(BeFalse())
+
+		s, token, err := registry.Revoke("session1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(s.LockID).To(Equal("lock1"))
+		Expect(token).To(Equal("token"))
+
+		revoked, err = registry.IsRevoked("session1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revoked).To(BeTrue())
+
+		Expect(registry.Remove("session1")).To(Succeed())
+		_, err = registry.Get("session1")
+		Expect(err).To(MatchError(sessions.ErrNotFound))
+
+		list, err := registry.List()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list).To(BeEmpty())
+	})
+
+	It("keeps the revocation in the revocation store until the access token expires", func() {
+		Expect(registry.Open(session, "token")).To(Succeed())
+		_, _, err := registry.Revoke("session1")
+		Expect(err).ToNot(HaveOccurred())
+
+		records, err := revocations.Read("revoked.session1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(records).To(HaveLen(1))
+		Expect(records[0].Expiry).To(BeNumerically("~", time.Hour, time.Minute))
+
+		// the revocation outlives the session
+		Expect(registry.Remove("session1")).To(Succeed())
+		revoked, err := registry.IsRevoked("session1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revoked).To(BeTrue())
+	})
+
+	It("fails to revoke unknown sessions", func() {
+		_, _, err := registry.Revoke("unknown")
+		Expect(err).To(MatchError(sessions.ErrNotFound))
+	})
+})
@@ -21,6 +21,28 @@ import (
 // Example:
 // https:/cloud.example.test/wopi/files/12312678470610632091729803710923
 func GenerateWopiSrc(fileRef string, cfg *config.Config) (*url.URL, error) {
+	return generateSrc("files", fileRef, cfg)
+}
+
+// GenerateWopiContainerSrc generates a WOPI src URL for the given container
+// reference. The URL is generated the same way as GenerateWopiSrc does, but
+// pointing to the "/wopi/containers" endpoint.
+// Example:
+// https:/cloud.example.test/wopi/containers/12312678470610632091729803710923
+func GenerateWopiContainerSrc(containerRef string, cfg *config.Config) (*url.URL, error) {
+	return generateSrc("containers", containerRef, cfg)
+}
+
+// GenerateWopiEcosystemSrc generates a WOPI src URL for the ecosystem of the
+// given file or container reference. The URL is generated the same way as
+// GenerateWopiSrc does, but pointing to the "/wopi/ecosystem" endpoint.
+// Example:
+// https:/cloud.example.test/wopi/ecosystem/12312678470610632091729803710923
+func GenerateWopiEcosystemSrc(ref string, cfg *config.Config) (*url.URL, error) {
+	return generateSrc("ecosystem", ref, cfg)
+}
+
+func generateSrc(endpoint, ref string, cfg *config.Config) (*url.URL, error) {
 	wopiSrcURL, err := url.Parse(cfg.Wopi.WopiSrc)
 	if err != nil {
 		return nil, err
@@ -30,18 +52,18 @@ func GenerateWopiSrc(fileRef string, cfg *config.Config) (*url.URL, error) {
 	}
 
 	if cfg.Wopi.ProxyURL != "" && cfg.Wopi.ProxySecret != "" {
-		return generateProxySrc(fileRef, cfg.Wopi.ProxyURL, cfg.Wopi.ProxySecret, wopiSrcURL)
+		return generateProxySrc(endpoint, ref, cfg.Wopi.ProxyURL, cfg.Wopi.ProxySecret, wopiSrcURL)
 	}
 
-	return generateDirectSrc(fileRef, wopiSrcURL)
+	return generateDirectSrc(endpoint, ref, wopiSrcURL)
 }
 
-func generateDirectSrc(fileRef string, wopiSrcURL *url.URL) (*url.URL, error) {
-	wopiSrcURL.Path = path.Join("wopi", "files", fileRef)
+func generateDirectSrc(endpoint, fileRef string, wopiSrcURL *url.URL) (*url.URL, error) {
+	wopiSrcURL.Path = path.Join("wopi", endpoint, fileRef)
 	return wopiSrcURL, nil
 }
 
-func generateProxySrc(fileRef string, proxyUrl string, proxySecret string, wopiSrcURL *url.URL) (*url.URL, error) {
+func generateProxySrc(endpoint, fileRef string, proxyUrl string, proxySecret string, wopiSrcURL *url.URL) (*url.URL, error) {
 	proxyURL, err := url.Parse(proxyUrl)
 	if err != nil {
 		return nil, err
@@ -50,7 +72,7 @@ func generateProxySrc(fileRef string, proxyUrl string, proxySecret string, wopiS
 		return nil, errors.New("invalid proxy URL")
 	}
 
-	wopiSrcURL.Path = path.Join("wopi", "files")
+	wopiSrcURL.Path = path.Join("wopi", endpoint)
 
 	type tokenClaims struct {
 		URL    string `json:"u"`
@@ -67,6 +89,6 @@ func generateProxySrc(fileRef string, proxyUrl string, proxySecret string, wopiS
 	if err != nil {
 		return nil, err
 	}
-	proxyURL.Path = path.Join("wopi", "files", tokenString)
+	proxyURL.Path = path.Join("wopi", endpoint, tokenString)
 	return proxyURL, nil
 }
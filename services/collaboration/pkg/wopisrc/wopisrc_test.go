@@ -61,4 +61,24 @@ var _ = Describe("Wopisrc Test", func() {
 			})
 		})
 	})
+
+	Context("GenerateWopiContainerSrc", func() {
+		BeforeEach(func() {
+			c = &config.Config{
+				Wopi: config.Wopi{
+					WopiSrc: "https://cloud.example.test/wopi/files",
+				},
+			}
+		})
+		It("should generate a WOPI src URL for the container", func() {
+			url, err := wopisrc.GenerateWopiContainerSrc("123456", c)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(url.String()).To(Equal("https://cloud.example.test/wopi/containers/123456"))
+		})
+		It("should generate a WOPI src URL for the ecosystem", func() {
+			url, err := wopisrc.GenerateWopiEcosystemSrc("123456", c)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(url.String()).To(Equal("https://cloud.example.test/wopi/ecosystem/123456"))
+		})
+	})
 })
@@ -122,6 +122,89 @@ func (_c *Permissions_GetPermissionByID_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// ListAvailablePermissions provides a mock function for the type Permissions
+func (_mock *Permissions) ListAvailablePermissions(ctx context.Context, req *v0.ListAvailablePermissionsRequest, opts ...client.CallOption) (*v0.ListAvailablePermissionsResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, req, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, req)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAvailablePermissions")
+	}
+
+	var r0 *v0.ListAvailablePermissionsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v0.ListAvailablePermissionsRequest, ...client.CallOption) (*v0.ListAvailablePermissionsResponse, error)); ok {
+		return returnFunc(ctx, req, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v0.ListAvailablePermissionsRequest, ...client.CallOption) *v0.ListAvailablePermissionsResponse); ok {
+		r0 = returnFunc(ctx, req, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v0.ListAvailablePermissionsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v0.ListAvailablePermissionsRequest, ...client.CallOption) error); ok {
+		r1 = returnFunc(ctx, req, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Permissions_ListAvailablePermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAvailablePermissions'
+type Permissions_ListAvailablePermissions_Call struct {
+	*mock.Call
+}
+
+// ListAvailablePermissions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *v0.ListAvailablePermissionsRequest
+//   - opts ...client.CallOption
+func (_e *Permissions_Expecter) ListAvailablePermissions(ctx interface{}, req interface{}, opts ...interface{}) *Permissions_ListAvailablePermissions_Call {
+	return &Permissions_ListAvailablePermissions_Call{Call: _e.mock.On("ListAvailablePermissions",
+		append([]interface{}{ctx, req}, opts...)...)}
+}
+
+func (_c *Permissions_ListAvailablePermissions_Call) Run(run func(ctx context.Context, req *v0.ListAvailablePermissionsRequest, opts ...client.CallOption)) *Permissions_ListAvailablePermissions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v0.ListAvailablePermissionsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*v0.ListAvailablePermissionsRequest)
+		}
+		var arg2 []client.CallOption
+		var variadicArgs []client.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]client.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *Permissions_ListAvailablePermissions_Call) Return(listAvailablePermissionsResponse *v0.ListAvailablePermissionsResponse, err error) *Permissions_ListAvailablePermissions_Call {
+	_c.Call.Return(listAvailablePermissionsResponse, err)
+	return _c
+}
+
+func (_c *Permissions_ListAvailablePermissions_Call) RunAndReturn(run func(ctx context.Context, req *v0.ListAvailablePermissionsRequest, opts ...client.CallOption) (*v0.ListAvailablePermissionsResponse, error)) *Permissions_ListAvailablePermissions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListPermissions provides a mock function for the type Permissions
 func (_mock *Permissions) ListPermissions(ctx context.Context, req *v0.ListPermissionsRequest, opts ...client.CallOption) (*v0.ListPermissionsResponse, error) {
 	var tmpRet mock.Arguments
@@ -123,6 +123,89 @@ func (_c *RoleService_AssignRoleToUser_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// DeleteRole provides a mock function for the type RoleService
+func (_mock *RoleService) DeleteRole(ctx context.Context, in *v0.DeleteRoleRequest, opts ...client.CallOption) (*emptypb.Empty, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRole")
+	}
+
+	var r0 *emptypb.Empty
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v0.DeleteRoleRequest, ...client.CallOption) (*emptypb.Empty, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v0.DeleteRoleRequest, ...client.CallOption) *emptypb.Empty); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*emptypb.Empty)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v0.DeleteRoleRequest, ...client.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// RoleService_DeleteRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRole'
+type RoleService_DeleteRole_Call struct {
+	*mock.Call
+}
+
+// DeleteRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v0.DeleteRoleRequest
+//   - opts ...client.CallOption
+func (_e *RoleService_Expecter) DeleteRole(ctx interface{}, in interface{}, opts ...interface{}) *RoleService_DeleteRole_Call {
+	return &RoleService_DeleteRole_Call{Call: _e.mock.On("DeleteRole",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *RoleService_DeleteRole_Call) Run(run func(ctx context.Context, in *v0.DeleteRoleRequest, opts ...client.CallOption)) *RoleService_DeleteRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v0.DeleteRoleRequest
+		if args[1] != nil {
+			arg1 = args[1].(*v0.DeleteRoleRequest)
+		}
+		var arg2 []client.CallOption
+		var variadicArgs []client.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]client.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *RoleService_DeleteRole_Call) Return(empty *emptypb.Empty, err error) *RoleService_DeleteRole_Call {
+	_c.Call.Return(empty, err)
+	return _c
+}
+
+func (_c *RoleService_DeleteRole_Call) RunAndReturn(run func(ctx context.Context, in *v0.DeleteRoleRequest, opts ...client.CallOption) (*emptypb.Empty, error)) *RoleService_DeleteRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListRoleAssignments provides a mock function for the type RoleService
 func (_mock *RoleService) ListRoleAssignments(ctx context.Context, in *v0.ListRoleAssignmentsRequest, opts ...client.CallOption) (*v0.ListRoleAssignmentsResponse, error) {
 	var tmpRet mock.Arguments
@@ -454,3 +537,86 @@ func (_c *RoleService_RemoveRoleFromUser_Call) RunAndReturn(run func(ctx context
 	_c.Call.Return(run)
 	return _c
 }
+
+// SaveRole provides a mock function for the type RoleService
+func (_mock *RoleService) SaveRole(ctx context.Context, in *v0.SaveRoleRequest, opts ...client.CallOption) (*v0.SaveRoleResponse, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveRole")
+	}
+
+	var r0 *v0.SaveRoleResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v0.SaveRoleRequest, ...client.CallOption) (*v0.SaveRoleResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v0.SaveRoleRequest, ...client.CallOption) *v0.SaveRoleResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v0.SaveRoleResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v0.SaveRoleRequest, ...client.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// RoleService_SaveRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveRole'
+type RoleService_SaveRole_Call struct {
+	*mock.Call
+}
+
+// SaveRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v0.SaveRoleRequest
+//   - opts ...client.CallOption
+func (_e *RoleService_Expecter) SaveRole(ctx interface{}, in interface{}, opts ...interface{}) *RoleService_SaveRole_Call {
+	return &RoleService_SaveRole_Call{Call: _e.mock.On("SaveRole",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *RoleService_SaveRole_Call) Run(run func(ctx context.Context, in *v0.SaveRoleRequest, opts ...client.CallOption)) *RoleService_SaveRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v0.SaveRoleRequest
+		if args[1] != nil {
+			arg1 = args[1].(*v0.SaveRoleRequest)
+		}
+		var arg2 []client.CallOption
+		var variadicArgs []client.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]client.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *RoleService_SaveRole_Call) Return(saveRoleResponse *v0.SaveRoleResponse, err error) *RoleService_SaveRole_Call {
+	_c.Call.Return(saveRoleResponse, err)
+	return _c
+}
+
+func (_c *RoleService_SaveRole_Call) RunAndReturn(run func(ctx context.Context, in *v0.SaveRoleRequest, opts ...client.CallOption) (*v0.SaveRoleResponse, error)) *RoleService_SaveRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
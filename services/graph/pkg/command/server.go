@@ -20,6 +20,7 @@ import (
 	"github.com/opencloud-eu/opencloud/services/graph/pkg/metrics"
 	"github.com/opencloud-eu/opencloud/services/graph/pkg/server/debug"
 	"github.com/opencloud-eu/opencloud/services/graph/pkg/server/http"
+	svc "github.com/opencloud-eu/opencloud/services/graph/pkg/service/v0"
 )
 
 // Server is the entrypoint for the server command.
@@ -48,7 +49,7 @@ func Server(cfg *config.Config) *cli.Command {
 			mtrcs := metrics.New()
 			mtrcs.BuildInfo.WithLabelValues(version.GetString()).Set(1)
 
-			var kv jetstream.KeyValue
+			var kv, deltakv jetstream.KeyValue
 			// Allow to run without a NATS store (e.g. for the standalone Education provisioning service)
 			if len(cfg.Store.Nodes) > 0 {
 				//Connect to NATS servers
@@ -79,6 +80,20 @@ func Server(cfg *config.Config) *cli.Command {
 						return fmt.Errorf("failed to create bucket (%s): %w", cfg.Store.Database, err)
 					}
 				}
+
+				// the listings and pages of delta queries expire, they are kept in their own bucket
+				deltakv, err = js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{
+					Bucket: cfg.Store.Database + "-delta",
+					TTL:    svc.DeltaStoreTTL(cfg.Spaces.DeltaTokenTTL),
+				})
+				if err != nil {
+					return fmt.Errorf("failed to create bucket (%s-delta): %w", cfg.Store.Database, err)
+				}
+
+				// custom roles created with the graph api are shared by all graph instances
+				if err := svc.WatchCustomRoles(ctx, kv, logger); err != nil {
+					return fmt.Errorf("failed to watch custom roles: %w", err)
+				}
 			}
 
 			gr := runner.NewGroup()
@@ -90,6 +105,7 @@ func Server(cfg *config.Config) *cli.Command {
 					http.Metrics(mtrcs),
 					http.TraceProvider(traceProvider),
 					http.NatsKeyValue(kv),
+					http.DeltaKeyValue(deltakv),
 				)
 				if err != nil {
 					logger.Error().Err(err).Str("transport", "http").Msg("Failed to initialize server")
@@ -72,10 +72,16 @@ func listUnifiedRoles(cfg *config.Config) *cli.Command {
 				const enabled = "enabled"
 				const disabled = "disabled"
 
+				name, ok := unifiedRolesNames[definition.GetId()]
+				if !ok {
+					// custom roles don't have a name, use the display name instead
+					name = definition.GetDisplayName()
+				}
+
 				rows := [][]string{
-					{unifiedRolesNames[definition.GetId()], definition.GetId(), disabled, definition.GetDescription()},
+					{name, definition.GetId(), disabled, definition.GetDescription()},
 				}
-				if slices.Contains(cfg.UnifiedRoles.AvailableRoles, definition.GetId()) {
+				if slices.Contains(cfg.UnifiedRoles.AvailableRoles, definition.GetId()) || unifiedrole.IsCustomRole(definition.GetId()) {
 					rows[0][2] = enabled
 				}
 
@@ -35,6 +35,8 @@ type Config struct {
 
 	Keycloak       Keycloak       `yaml:"keycloak"`
 	ServiceAccount ServiceAccount `yaml:"service_account"`
+	Subscriptions  Subscriptions  `yaml:"subscriptions"`
+	Thumbnails     Thumbnails     `yaml:"thumbnails"`
 
 	Context context.Context `yaml:"-"`
 
@@ -46,15 +48,30 @@ type Config struct {
 }
 
 type Spaces struct {
-	WebDavBase                      string `yaml:"webdav_base" env:"OC_URL;GRAPH_SPACES_WEBDAV_BASE" desc:"The public facing URL of WebDAV." introductionVersion:"1.0.0"`
-	WebDavPath                      string `yaml:"webdav_path" env:"GRAPH_SPACES_WEBDAV_PATH" desc:"The WebDAV sub-path for spaces." introductionVersion:"1.0.0"`
-	DefaultQuota                    string `yaml:"default_quota" env:"GRAPH_SPACES_DEFAULT_QUOTA" desc:"The default quota in bytes." introductionVersion:"1.0.0"`
-	ExtendedSpacePropertiesCacheTTL int    `yaml:"extended_space_properties_cache_ttl" env:"GRAPH_SPACES_EXTENDED_SPACE_PROPERTIES_CACHE_TTL" desc:"Max TTL in seconds for the spaces property cache." introductionVersion:"1.0.0"`
-	UsersCacheTTL                   int    `yaml:"users_cache_ttl" env:"GRAPH_SPACES_USERS_CACHE_TTL" desc:"Max TTL in seconds for the spaces users cache." introductionVersion:"1.0.0"`
-	GroupsCacheTTL                  int    `yaml:"groups_cache_ttl" env:"GRAPH_SPACES_GROUPS_CACHE_TTL" desc:"Max TTL in seconds for the spaces groups cache." introductionVersion:"1.0.0"`
-	StorageUsersAddress             string `yaml:"storage_users_address" env:"GRAPH_SPACES_STORAGE_USERS_ADDRESS" desc:"The address of the storage-users service." introductionVersion:"1.0.0"`
-	DefaultLanguage                 string `yaml:"default_language" env:"OC_DEFAULT_LANGUAGE" desc:"The default language used by services and the WebUI. If not defined, English will be used as default. See the documentation for more details." introductionVersion:"1.0.0"`
-	TranslationPath                 string `yaml:"translation_path" env:"OC_TRANSLATION_PATH;GRAPH_TRANSLATION_PATH" desc:"(optional) Set this to a path with custom translations to overwrite the builtin translations. Note that file and folder naming rules apply, see the documentation for more details." introductionVersion:"1.0.0"`
+	WebDavBase                      string        `yaml:"webdav_base" env:"OC_URL;GRAPH_SPACES_WEBDAV_BASE" desc:"The public facing URL of WebDAV." introductionVersion:"1.0.0"`
+	WebDavPath                      string        `yaml:"webdav_path" env:"GRAPH_SPACES_WEBDAV_PATH" desc:"The WebDAV sub-path for spaces." introductionVersion:"1.0.0"`
+	DefaultQuota                    string        `yaml:"default_quota" env:"GRAPH_SPACES_DEFAULT_QUOTA" desc:"The default quota in bytes." introductionVersion:"1.0.0"`
+	ExtendedSpacePropertiesCacheTTL int           `yaml:"extended_space_properties_cache_ttl" env:"GRAPH_SPACES_EXTENDED_SPACE_PROPERTIES_CACHE_TTL" desc:"Max TTL in seconds for the spaces property cache." introductionVersion:"1.0.0"`
+	UsersCacheTTL                   int           `yaml:"users_cache_ttl" env:"GRAPH_SPACES_USERS_CACHE_TTL" desc:"Max TTL in seconds for the spaces users cache." introductionVersion:"1.0.0"`
+	GroupsCacheTTL                  int           `yaml:"groups_cache_ttl" env:"GRAPH_SPACES_GROUPS_CACHE_TTL" desc:"Max TTL in seconds for the spaces groups cache." introductionVersion:"1.0.0"`
+	StorageUsersAddress             string        `yaml:"storage_users_address" env:"GRAPH_SPACES_STORAGE_USERS_ADDRESS" desc:"The address of the storage-users service." introductionVersion:"1.0.0"`
+	DefaultLanguage                 string        `yaml:"default_language" env:"OC_DEFAULT_LANGUAGE" desc:"The default language used by services and the WebUI. If not defined, English will be used as default. See the documentation for more details." introductionVersion:"1.0.0"`
+	TranslationPath                 string        `yaml:"translation_path" env:"OC_TRANSLATION_PATH;GRAPH_TRANSLATION_PATH" desc:"(optional) Set this to a path with custom translations to overwrite the builtin translations. Note that file and folder naming rules apply, see the documentation for more details." introductionVersion:"1.0.0"`
+	DeltaTokenTTL                   time.Duration `yaml:"delta_token_ttl" env:"GRAPH_SPACES_DELTA_TOKEN_TTL" desc:"The time a delta token of a drive stays valid. Clients with an older token have to enumerate the drive again. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+}
+
+// Subscriptions configures the change notification subscriptions
+type Subscriptions struct {
+	MaxLifetime     time.Duration `yaml:"max_lifetime" env:"GRAPH_SUBSCRIPTIONS_MAX_LIFETIME" desc:"The maximum time a subscription can be valid before it has to be renewed. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	AllowedHosts    []string      `yaml:"allowed_hosts" env:"GRAPH_SUBSCRIPTIONS_ALLOWED_HOSTS" desc:"A list of hosts notifications can be sent to, they can also have internal addresses. If empty, notifications can only be sent to hosts with public IP addresses. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	DeliveryTimeout time.Duration `yaml:"delivery_timeout" env:"GRAPH_SUBSCRIPTIONS_DELIVERY_TIMEOUT" desc:"The time to wait for the answer of a notification url. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	DeliveryRetries int           `yaml:"delivery_retries" env:"GRAPH_SUBSCRIPTIONS_DELIVERY_RETRIES" desc:"The number of times a failed notification is sent again. The time between the attempts doubles with every attempt, starting with one second." introductionVersion:"%%NEXT%%"`
+}
+
+// Thumbnails tells which additional file types the thumbnails service renders previews for
+type Thumbnails struct {
+	DocumentConverterURL string `yaml:"document_converter_url" env:"THUMBNAILS_DOCUMENT_CONVERTER_URL" desc:"The URL of the document converter used by the thumbnails service. If set, thumbnails are returned for office documents. Use the same value as for the thumbnails service." introductionVersion:"%%NEXT%%"`
+	FFmpegPath           string `yaml:"ffmpeg_path" env:"THUMBNAILS_FFMPEG_PATH" desc:"The path to the ffmpeg binary used by the thumbnails service. If set, thumbnails are returned for videos. Use the same value as for the thumbnails service." introductionVersion:"%%NEXT%%"`
 }
 
 type LDAP struct {
@@ -123,6 +140,7 @@ type API struct {
 	AssignDefaultUserRole   bool   `yaml:"graph_assign_default_user_role" env:"GRAPH_ASSIGN_DEFAULT_USER_ROLE" desc:"Whether to assign newly created users the default role 'User'. Set this to 'false' if you want to assign roles manually, or if the role assignment should happen at first login. Set this to 'true' (the default) to assign the role 'User' when creating a new user." introductionVersion:"1.0.0"`
 	IdentitySearchMinLength int    `yaml:"graph_identity_search_min_length" env:"GRAPH_IDENTITY_SEARCH_MIN_LENGTH" desc:"The minimum length the search term needs to have for unprivileged users when searching for users or groups." introductionVersion:"1.0.0"`
 	ShowUserEmailInResults  bool   `yaml:"show_email_in_results" env:"OC_SHOW_USER_EMAIL_IN_RESULTS" desc:"Include user email addresses in responses. If absent or set to false emails will be omitted from results. Please note that admin users can always see all email addresses." introductionVersion:"1.0.0"`
+	BatchRequestsLimit      int    `yaml:"batch_requests_limit" env:"GRAPH_BATCH_REQUESTS_LIMIT" desc:"The maximum number of requests allowed in a single JSON batch request." introductionVersion:"%%NEXT%%"`
 }
 
 // Events combines the configuration options for the event bus.
@@ -62,6 +62,7 @@ func DefaultConfig() *config.Config {
 			UsernameMatch:           "default",
 			AssignDefaultUserRole:   true,
 			IdentitySearchMinLength: 3,
+			BatchRequestsLimit:      20,
 		},
 		Reva: shared.DefaultRevaConfig(),
 		Spaces: config.Spaces{
@@ -75,6 +76,12 @@ func DefaultConfig() *config.Config {
 			GroupsCacheTTL: 60,
 			// 1 minute
 			UsersCacheTTL: 60,
+			DeltaTokenTTL: 7 * 24 * time.Hour,
+		},
+		Subscriptions: config.Subscriptions{
+			MaxLifetime:     3 * 24 * time.Hour,
+			DeliveryTimeout: 10 * time.Second,
+			DeliveryRetries: 5,
 		},
 		Identity: config.Identity{
 			Backend: "ldap",
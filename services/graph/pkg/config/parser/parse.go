@@ -35,6 +35,16 @@ func ParseConfig(cfg *config.Config) error {
 
 	defaults.Sanitize(cfg)
 
+	if cfg.UnifiedRoles.CustomRolesFile != "" {
+		customRoles, err := unifiedrole.LoadCustomRoles(cfg.UnifiedRoles.CustomRolesFile)
+		if err != nil {
+			return err
+		}
+		if err := unifiedrole.RegisterCustomRoles(customRoles...); err != nil {
+			return err
+		}
+	}
+
 	return Validate(cfg)
 }
 
@@ -2,5 +2,6 @@ package config
 
 // UnifiedRoles contains all settings related to unified roles.
 type UnifiedRoles struct {
-	AvailableRoles []string `yaml:"available_roles" env:"GRAPH_AVAILABLE_ROLES" desc:"A comma separated list of roles that are available for assignment." introductionVersion:"1.0.0"`
+	AvailableRoles  []string `yaml:"available_roles" env:"GRAPH_AVAILABLE_ROLES" desc:"A comma separated list of roles that are available for assignment." introductionVersion:"1.0.0"`
+	CustomRolesFile string   `yaml:"custom_roles_file" env:"GRAPH_CUSTOM_ROLES_FILE" desc:"Path to a yaml file with the definitions of custom roles. Custom roles are always available for assignment. See the documentation for more details." introductionVersion:"%%NEXT%%"`
 }
@@ -0,0 +1,21 @@
+package event
+
+import (
+	"encoding/json"
+
+	user "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	types "github.com/cs3org/go-cs3apis/cs3/types/v1beta1"
+)
+
+// PasswordChanged is emitted when a user changes their own password
+type PasswordChanged struct {
+	Executant *user.UserId
+	Timestamp *types.Timestamp
+}
+
+// Unmarshal to fulfill umarshaller interface
+func (PasswordChanged) Unmarshal(v []byte) (interface{}, error) {
+	e := PasswordChanged{}
+	err := json.Unmarshal(v, &e)
+	return e, err
+}
@@ -1,19 +1,82 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/opencloud-eu/opencloud/pkg/log"
 	"github.com/opencloud-eu/opencloud/pkg/roles"
+	settingsmsg "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/settings/v0"
 	"github.com/opencloud-eu/opencloud/services/graph/pkg/errorcode"
 	settings "github.com/opencloud-eu/opencloud/services/settings/pkg/service/v0"
 	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
 )
 
+type passwordResetOnlyKey struct{}
+
 // RequireAdmin middleware is used to require the user in context to be an admin / have account management permissions
 func RequireAdmin(rm *roles.Manager, logger log.Logger) func(next http.Handler) http.Handler {
+	return requirePermission(rm, logger, "requireAdmin", func(ctx context.Context, roleIDs []string) (context.Context, bool) {
+		return ctx, rm.FindPermissionByID(ctx, roleIDs, settings.AccountManagementPermissionID) != nil
+	})
+}
+
+// RequireAdminOrPasswordReset middleware is like RequireAdmin but also lets users with the permission to reset passwords pass.
+// The requests of those users are marked, the handler has to check PasswordResetOnly and only allow changing the password
+// of users who don't hold permissions the user lacks, see HoldsPermissions.
+func RequireAdminOrPasswordReset(rm *roles.Manager, logger log.Logger) func(next http.Handler) http.Handler {
+	return requirePermission(rm, logger, "requireAdminOrPasswordReset", func(ctx context.Context, roleIDs []string) (context.Context, bool) {
+		if rm.FindPermissionByID(ctx, roleIDs, settings.AccountManagementPermissionID) != nil {
+			return ctx, true
+		}
+		if rm.FindPermissionByID(ctx, roleIDs, settings.ResetUserPasswordPermissionID) != nil {
+			return context.WithValue(ctx, passwordResetOnlyKey{}, PermissionConstraints(rm.List(ctx, roleIDs))), true
+		}
+		return ctx, false
+	})
+}
+
+// PasswordResetOnly returns true if the user of the request is only allowed to reset passwords
+func PasswordResetOnly(ctx context.Context) bool {
+	_, resetOnly := ctx.Value(passwordResetOnlyKey{}).(map[string]settingsmsg.Permission_Constraint)
+	return resetOnly
+}
+
+// HoldsPermissions returns true if the user of a request, which is only allowed to reset passwords, holds all the given
+// permissions with the same or a wider constraint
+func HoldsPermissions(ctx context.Context, permissions map[string]settingsmsg.Permission_Constraint) bool {
+	held, ok := ctx.Value(passwordResetOnlyKey{}).(map[string]settingsmsg.Permission_Constraint)
+	if !ok {
+		return false
+	}
+	for id, constraint := range permissions {
+		heldConstraint, ok := held[id]
+		if !ok || heldConstraint < constraint {
+			return false
+		}
+	}
+	return true
+}
+
+// PermissionConstraints returns the permissions granted by the given roles with their widest constraint,
+// the constraints are ordered OWN < SHARED < ALL
+func PermissionConstraints(roles []*settingsmsg.Bundle) map[string]settingsmsg.Permission_Constraint {
+	permissions := map[string]settingsmsg.Permission_Constraint{}
+	for _, role := range roles {
+		for _, setting := range role.GetSettings() {
+			constraint := setting.GetPermissionValue().GetConstraint()
+			if held, ok := permissions[setting.GetId()]; !ok || held < constraint {
+				permissions[setting.GetId()] = constraint
+			}
+		}
+	}
+	return permissions
+}
+
+// requirePermission only lets the request pass if allowed returns true for the roles of the user in context
+func requirePermission(rm *roles.Manager, logger log.Logger, name string, allowed func(ctx context.Context, roleIDs []string) (context.Context, bool)) func(next http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
-		l := logger.With().Str("middleware", "requireAdmin").Logger()
+		l := logger.With().Str("middleware", name).Logger()
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			u, ok := revactx.ContextGetUser(r.Context())
 			if !ok {
@@ -43,8 +106,8 @@ func RequireAdmin(rm *roles.Manager, logger log.Logger) func(next http.Handler)
 			}
 
 			// check if permission is present in roles of the authenticated account
-			if rm.FindPermissionByID(r.Context(), roleIDs, settings.AccountManagementPermissionID) != nil {
-				next.ServeHTTP(w, r)
+			if ctx, ok := allowed(r.Context(), roleIDs); ok {
+				next.ServeHTTP(w, r.WithContext(ctx))
 				return
 			}
 
@@ -0,0 +1,125 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	userv1beta1 "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/pkg/roles"
+	settingsmsg "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/settings/v0"
+	settingssvc "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/settings/v0"
+	"github.com/opencloud-eu/opencloud/services/graph/mocks"
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/middleware"
+	settings "github.com/opencloud-eu/opencloud/services/settings/pkg/service/v0"
+)
+
+func TestRequireAdminOrPasswordReset(t *testing.T) {
+	tests := map[string]struct {
+		permissionID      string
+		status            int
+		passwordResetOnly bool
+	}{
+		"account management": {
+			permissionID: settings.AccountManagementPermissionID,
+			status:       http.StatusOK,
+		},
+		"password reset": {
+			permissionID:      settings.ResetUserPasswordPermissionID,
+			status:            http.StatusOK,
+			passwordResetOnly: true,
+		},
+		"other permission": {
+			permissionID: settings.ListAllSpacesPermissionID,
+			status:       http.StatusForbidden,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			roleService := &mocks.RoleService{}
+			roleService.On("ListRoleAssignments", mock.Anything, mock.Anything).Return(&settingssvc.ListRoleAssignmentsResponse{
+				Assignments: []*settingsmsg.UserRoleAssignment{{AccountUuid: "user", RoleId: "role"}},
+			}, nil)
+			roleService.On("ListRoles", mock.Anything, mock.Anything).Return(&settingssvc.ListBundlesResponse{
+				Bundles: []*settingsmsg.Bundle{{Id: "role", Settings: []*settingsmsg.Setting{{Id: tc.permissionID}}}},
+			}, nil)
+			rm := roles.NewManager(roles.RoleService(roleService))
+
+			var passwordResetOnly bool
+			handler := middleware.RequireAdminOrPasswordReset(&rm, log.NopLogger())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				passwordResetOnly = middleware.PasswordResetOnly(r.Context())
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			req := httptest.NewRequest(http.MethodPatch, "/graph/v1.0/users/other", nil)
+			req = req.WithContext(revactx.ContextSetUser(context.Background(), &userv1beta1.User{Id: &userv1beta1.UserId{OpaqueId: "user"}}))
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			if rr.Code != tc.status {
+				t.Errorf("handler returned wrong status code: got %v want %v", rr.Code, tc.status)
+			}
+			if passwordResetOnly != tc.passwordResetOnly {
+				t.Errorf("password reset only: got %v want %v", passwordResetOnly, tc.passwordResetOnly)
+			}
+		})
+	}
+}
+
+func TestHoldsPermissions(t *testing.T) {
+	own := &settingsmsg.Setting_PermissionValue{PermissionValue: &settingsmsg.Permission{Constraint: settingsmsg.Permission_CONSTRAINT_OWN}}
+	roleService := &mocks.RoleService{}
+	roleService.On("ListRoleAssignments", mock.Anything, mock.Anything).Return(&settingssvc.ListRoleAssignmentsResponse{
+		Assignments: []*settingsmsg.UserRoleAssignment{{AccountUuid: "user", RoleId: "role"}},
+	}, nil)
+	roleService.On("ListRoles", mock.Anything, mock.Anything).Return(&settingssvc.ListBundlesResponse{
+		Bundles: []*settingsmsg.Bundle{{Id: "role", Settings: []*settingsmsg.Setting{
+			{Id: settings.ResetUserPasswordPermissionID},
+			{Id: settings.ListAllSpacesPermissionID, Value: own},
+		}}},
+	}, nil)
+	rm := roles.NewManager(roles.RoleService(roleService))
+
+	var ctx context.Context
+	handler := middleware.RequireAdminOrPasswordReset(&rm, log.NopLogger())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx = r.Context()
+	}))
+	req := httptest.NewRequest(http.MethodPatch, "/graph/v1.0/users/other", nil)
+	req = req.WithContext(revactx.ContextSetUser(context.Background(), &userv1beta1.User{Id: &userv1beta1.UserId{OpaqueId: "user"}}))
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	tests := map[string]struct {
+		permissions map[string]settingsmsg.Permission_Constraint
+		holds       bool
+	}{
+		"no permissions": {
+			holds: true,
+		},
+		"same constraint": {
+			permissions: map[string]settingsmsg.Permission_Constraint{settings.ListAllSpacesPermissionID: settingsmsg.Permission_CONSTRAINT_OWN},
+			holds:       true,
+		},
+		"wider constraint": {
+			permissions: map[string]settingsmsg.Permission_Constraint{settings.ListAllSpacesPermissionID: settingsmsg.Permission_CONSTRAINT_ALL},
+			holds:       false,
+		},
+		"other permission": {
+			permissions: map[string]settingsmsg.Permission_Constraint{settings.AccountManagementPermissionID: settingsmsg.Permission_CONSTRAINT_OWN},
+			holds:       false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if holds := middleware.HoldsPermissions(ctx, tc.permissions); holds != tc.holds {
+				t.Errorf("holds permissions: got %v want %v", holds, tc.holds)
+			}
+		})
+	}
+}
@@ -25,6 +25,7 @@ type Options struct {
 	Namespace     string
 	TraceProvider trace.TracerProvider
 	NatsKeyValue  jetstream.KeyValue
+	DeltaKeyValue jetstream.KeyValue
 }
 
 // newOptions initializes the available default options.
@@ -93,3 +94,10 @@ func NatsKeyValue(val jetstream.KeyValue) Option {
 		o.NatsKeyValue = val
 	}
 }
+
+// DeltaKeyValue provides a function to set the DeltaKeyValue option.
+func DeltaKeyValue(val jetstream.KeyValue) Option {
+	return func(o *Options) {
+		o.DeltaKeyValue = val
+	}
+}
@@ -179,6 +179,7 @@ func Server(opts ...Option) (http.Service, error) {
 		svc.EventHistoryClient(hClient),
 		svc.TraceProvider(options.TraceProvider),
 		svc.WithNatsKeyValue(options.NatsKeyValue),
+		svc.WithDeltaKeyValue(options.DeltaKeyValue),
 	)
 
 	if err != nil {
@@ -97,7 +97,6 @@ func NewDriveItemPermissionsService(logger log.Logger, gatewaySelector pool.Sele
 			gatewaySelector: gatewaySelector,
 			identityCache:   identityCache,
 			config:          config,
-			availableRoles:  unifiedrole.GetRoles(unifiedrole.RoleFilterIDs(config.UnifiedRoles.AvailableRoles...)),
 		},
 	}, nil
 }
@@ -124,7 +123,7 @@ func (s DriveItemPermissionsService) Invite(ctx context.Context, resourceId *sto
 	unifiedRolePermissions := []*libregraph.UnifiedRolePermission{{AllowedResourceActions: invite.LibreGraphPermissionsActions}}
 	for _, roleID := range invite.GetRoles() {
 		// only allow roles that are enabled in the config
-		if !slices.Contains(s.config.UnifiedRoles.AvailableRoles, roleID) {
+		if !slices.Contains(availableRoleIDs(s.config), roleID) {
 			return libregraph.Permission{}, unifiedrole.ErrUnknownRole
 		}
 
@@ -148,8 +147,7 @@ func (s DriveItemPermissionsService) Invite(ctx context.Context, resourceId *sto
 	cs3ResourcePermissions := unifiedrole.PermissionsToCS3ResourcePermissions(unifiedRolePermissions)
 
 	permission := &libregraph.Permission{}
-	availableRoles := unifiedrole.GetRoles(unifiedrole.RoleFilterIDs(s.config.UnifiedRoles.AvailableRoles...))
-	if role := unifiedrole.CS3ResourcePermissionsToRole(availableRoles, cs3ResourcePermissions, condition, false); role != nil {
+	if role := unifiedrole.CS3ResourcePermissionsToRole(availableRoles(s.config), cs3ResourcePermissions, condition, false); role != nil {
 		permission.Roles = []string{role.GetId()}
 	}
 
@@ -383,7 +381,7 @@ func (s DriveItemPermissionsService) ListPermissions(ctx context.Context, itemID
 	if len(queryOptions.SelectedAttrs) == 0 || slices.Contains(queryOptions.SelectedAttrs, "@libre.graph.permissions.roles.allowedValues") {
 		collectionOfPermissions.LibreGraphPermissionsRolesAllowedValues = conversions.ToValueSlice(
 			unifiedrole.GetRolesByPermissions(
-				unifiedrole.GetRoles(unifiedrole.RoleFilterIDs(s.config.UnifiedRoles.AvailableRoles...)),
+				availableRoles(s.config),
 				allowedActions,
 				condition,
 				queryOptions.FilterFederatedRoles,
@@ -670,7 +668,7 @@ func (api DriveItemPermissionsApi) Invite(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	ctx := validate.ContextWithAllowedRoleIDs(r.Context(), api.config.UnifiedRoles.AvailableRoles)
+	ctx := validate.ContextWithAllowedRoleIDs(r.Context(), availableRoleIDs(api.config))
 	if err = validate.StructCtx(ctx, driveItemInvite); err != nil {
 		api.logger.Debug().Err(err).Interface("Body", r.Body).Msg("invalid request body")
 		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, err.Error())
@@ -703,7 +701,7 @@ func (api DriveItemPermissionsApi) SpaceRootInvite(w http.ResponseWriter, r *htt
 		return
 	}
 
-	ctx := validate.ContextWithAllowedRoleIDs(r.Context(), api.config.UnifiedRoles.AvailableRoles)
+	ctx := validate.ContextWithAllowedRoleIDs(r.Context(), availableRoleIDs(api.config))
 	if err = validate.StructCtx(ctx, driveItemInvite); err != nil {
 		api.logger.Debug().Err(err).Interface("Body", r.Body).Msg("invalid request body")
 		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, err.Error())
@@ -766,6 +764,9 @@ func (api DriveItemPermissionsApi) ListPermissions(w http.ResponseWriter, r *htt
 			api.logger.Error().Err(err).Msg("tranlation error")
 		}
 	}
+	for i := range permissions.LibreGraphPermissionsRolesAllowedValues {
+		unifiedrole.TranslateCustomRole(&permissions.LibreGraphPermissionsRolesAllowedValues[i], loc)
+	}
 
 	render.Status(r, http.StatusOK)
 	render.JSON(w, r, permissions)
@@ -817,6 +818,9 @@ func (api DriveItemPermissionsApi) ListSpaceRootPermissions(w http.ResponseWrite
 			api.logger.Error().Err(err).Msg("tranlation error")
 		}
 	}
+	for i := range permissions.LibreGraphPermissionsRolesAllowedValues {
+		unifiedrole.TranslateCustomRole(&permissions.LibreGraphPermissionsRolesAllowedValues[i], loc)
+	}
 
 	render.Status(r, http.StatusOK)
 	render.JSON(w, r, permissions)
@@ -0,0 +1,301 @@
+package svc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/errorcode"
+)
+
+const (
+	batchPath = "/$batch"
+
+	// batchMaxBodySize limits the size of the body of a batch request, the batch is decoded in memory
+	batchMaxBodySize = 4 * 1024 * 1024
+)
+
+// batchRequestHeaders are the headers the requests of a batch can set, all other headers are taken
+// from the batch request so the requests can't change the authentication
+var batchRequestHeaders = []string{"Content-Type", "If-Match", "Prefer"}
+
+type (
+	// batchRequest is the body of a json batch request
+	batchRequest struct {
+		Requests []batchRequestItem `json:"requests"`
+	}
+
+	// batchRequestItem is a single request of a json batch. The url is relative to the api version,
+	// e.g. `/users` for `/graph/v1.0/users`.
+	batchRequestItem struct {
+		ID        string            `json:"id"`
+		Method    string            `json:"method"`
+		URL       string            `json:"url"`
+		Headers   map[string]string `json:"headers,omitempty"`
+		Body      json.RawMessage   `json:"body,omitempty"`
+		DependsOn []string          `json:"dependsOn,omitempty"`
+	}
+
+	// batchResponse is the body of a json batch response
+	batchResponse struct {
+		Responses []batchResponseItem `json:"responses"`
+	}
+
+	// batchResponseItem is the response to a single request of a json batch
+	batchResponseItem struct {
+		ID      string            `json:"id"`
+		Status  int               `json:"status"`
+		Headers map[string]string `json:"headers,omitempty"`
+		Body    json.RawMessage   `json:"body,omitempty"`
+	}
+
+	// batchResponseWriter records the response of a single request of a json batch
+	batchResponseWriter struct {
+		header http.Header
+		status int
+		body   bytes.Buffer
+	}
+)
+
+// Batch runs the requests of a json batch against the graph api and returns their responses.
+// Requests without dependencies run concurrently, requests with dependencies run after all
+// requests they depend on succeeded. All requests use the authentication of the batch request.
+func (g Graph) Batch(w http.ResponseWriter, r *http.Request) {
+	logger := g.logger.SubloggerWithRequestID(r.Context())
+
+	batch := batchRequest{}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, batchMaxBodySize)).Decode(&batch); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			logger.Debug().Err(err).Msg("could not run batch: request body too large")
+			errorcode.InvalidRequest.Render(w, r, http.StatusRequestEntityTooLarge, fmt.Sprintf("the request body is larger than %d bytes", maxBytesErr.Limit))
+			return
+		}
+		logger.Debug().Err(err).Msg("could not run batch: invalid request body")
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, fmt.Sprintf("invalid request body: %s", err.Error()))
+		return
+	}
+	if err := g.validateBatch(batch); err != nil {
+		logger.Debug().Err(err).Msg("could not run batch: invalid batch")
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	prefix := strings.TrimSuffix(r.URL.Path, batchPath)
+	done := make(map[string]chan struct{}, len(batch.Requests))
+	for _, item := range batch.Requests {
+		done[item.ID] = make(chan struct{})
+	}
+
+	workers := g.config.MaxConcurrency
+	if workers <= 0 {
+		workers = 1
+	}
+	sem := make(chan struct{}, workers)
+
+	responses := make([]batchResponseItem, len(batch.Requests))
+	var wg sync.WaitGroup
+	for i, item := range batch.Requests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(done[item.ID])
+
+			for _, dependency := range item.DependsOn {
+				<-done[dependency]
+			}
+			for _, dependency := range item.DependsOn {
+				// the responses of the dependencies are written before their done channel is closed
+				j := slices.IndexFunc(batch.Requests, func(b batchRequestItem) bool { return b.ID == dependency })
+				if status := responses[j].Status; status < 200 || status > 299 {
+					responses[i] = batchFailedDependency(r.Context(), item.ID, dependency)
+					return
+				}
+			}
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			responses[i] = g.runBatchRequest(r, prefix, item)
+		}()
+	}
+	wg.Wait()
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, batchResponse{Responses: responses})
+}
+
+// validateBatch checks the size of the batch, the ids, urls and dependencies of the requests
+func (g Graph) validateBatch(batch batchRequest) error {
+	switch {
+	case len(batch.Requests) == 0:
+		return fmt.Errorf("the batch contains no requests")
+	case len(batch.Requests) > g.config.API.BatchRequestsLimit:
+		return fmt.Errorf("the batch contains more than %d requests", g.config.API.BatchRequestsLimit)
+	}
+
+	dependencies := make(map[string][]string, len(batch.Requests))
+	for _, item := range batch.Requests {
+		if item.ID == "" {
+			return fmt.Errorf("the requests of a batch need an id")
+		}
+		if _, ok := dependencies[item.ID]; ok {
+			return fmt.Errorf("the request id '%s' is used more than once", item.ID)
+		}
+		dependencies[item.ID] = item.DependsOn
+
+		switch item.Method {
+		case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		default:
+			return fmt.Errorf("the method of request '%s' is not supported", item.ID)
+		}
+
+		u, err := url.Parse(item.URL)
+		switch {
+		case err != nil, u.IsAbs(), u.Host != "", !strings.HasPrefix(u.Path, "/"):
+			return fmt.Errorf("the url of request '%s' must be relative to the api version", item.ID)
+		case strings.TrimSuffix(u.Path, "/") == batchPath:
+			return fmt.Errorf("request '%s' must not be a batch", item.ID)
+		}
+
+		for header := range item.Headers {
+			if !slices.Contains(batchRequestHeaders, http.CanonicalHeaderKey(header)) {
+				return fmt.Errorf("the header '%s' of request '%s' is not supported", header, item.ID)
+			}
+		}
+	}
+
+	for id, dependsOn := range dependencies {
+		for _, dependency := range dependsOn {
+			if _, ok := dependencies[dependency]; !ok {
+				return fmt.Errorf("request '%s' depends on the unknown request '%s'", id, dependency)
+			}
+		}
+	}
+
+	// the requests wait for their dependencies, a cycle would never finish
+	visited := make(map[string]bool, len(dependencies))
+	var visit func(id string, path []string) error
+	visit = func(id string, path []string) error {
+		if slices.Contains(path, id) {
+			return fmt.Errorf("the dependencies of request '%s' contain a cycle", id)
+		}
+		if visited[id] {
+			return nil
+		}
+		for _, dependency := range dependencies[id] {
+			if err := visit(dependency, append(path, id)); err != nil {
+				return err
+			}
+		}
+		visited[id] = true
+		return nil
+	}
+	for _, item := range batch.Requests {
+		if err := visit(item.ID, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// runBatchRequest runs a single request of the batch with the headers and the context of the batch request
+func (g Graph) runBatchRequest(r *http.Request, prefix string, item batchRequestItem) batchResponseItem {
+	// reset the route context, otherwise the mux would reuse the routing of the batch request
+	ctx := context.WithValue(r.Context(), chi.RouteCtxKey, (*chi.Context)(nil))
+
+	var body io.Reader = http.NoBody
+	if len(item.Body) > 0 {
+		body = bytes.NewReader(item.Body)
+	}
+	req, err := http.NewRequestWithContext(ctx, item.Method, prefix+item.URL, body)
+	if err != nil {
+		return batchResponseItem{
+			ID:     item.ID,
+			Status: http.StatusBadRequest,
+			Body:   batchErrorBody(r.Context(), errorcode.InvalidRequest, err.Error()),
+		}
+	}
+
+	// reuse the authentication of the batch request
+	req.Header = r.Header.Clone()
+	req.Header.Del("Content-Length")
+	req.Header.Del("Content-Type")
+	if len(item.Body) > 0 {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for k, v := range item.Headers {
+		req.Header.Set(k, v)
+	}
+	req.RemoteAddr = r.RemoteAddr
+
+	rw := &batchResponseWriter{header: http.Header{}}
+	g.ServeHTTP(rw, req)
+
+	res := batchResponseItem{
+		ID:      item.ID,
+		Status:  rw.status,
+		Headers: make(map[string]string, len(rw.header)),
+	}
+	if res.Status == 0 {
+		res.Status = http.StatusOK
+	}
+	for k := range rw.header {
+		res.Headers[k] = rw.header.Get(k)
+	}
+	switch {
+	case rw.body.Len() == 0:
+	case json.Valid(rw.body.Bytes()):
+		res.Body = rw.body.Bytes()
+	default:
+		// bodies which are no json are returned as json string
+		res.Body, _ = json.Marshal(rw.body.String())
+	}
+	return res
+}
+
+// batchFailedDependency returns the response of a request whose dependency failed
+func batchFailedDependency(ctx context.Context, id, dependency string) batchResponseItem {
+	return batchResponseItem{
+		ID:      id,
+		Status:  http.StatusFailedDependency,
+		Headers: map[string]string{"Content-Type": "application/json"},
+		Body:    batchErrorBody(ctx, errorcode.PreconditionFailed, fmt.Sprintf("the request '%s' failed", dependency)),
+	}
+}
+
+func batchErrorBody(ctx context.Context, code errorcode.ErrorCode, msg string) json.RawMessage {
+	body, _ := json.Marshal(code.CreateOdataError(ctx, msg))
+	return body
+}
+
+// Header implements the http.ResponseWriter interface
+func (w *batchResponseWriter) Header() http.Header {
+	return w.header
+}
+
+// Write implements the http.ResponseWriter interface
+func (w *batchResponseWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.body.Write(b)
+}
+
+// WriteHeader implements the http.ResponseWriter interface
+func (w *batchResponseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
@@ -0,0 +1,159 @@
+package svc_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	libregraph "github.com/opencloud-eu/libre-graph-api-go"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
+	cs3mocks "github.com/opencloud-eu/reva/v2/tests/cs3mocks/mocks"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+
+	"github.com/opencloud-eu/opencloud/pkg/shared"
+	settingsmsg "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/settings/v0"
+	settings "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/settings/v0"
+	"github.com/opencloud-eu/opencloud/services/graph/mocks"
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/config/defaults"
+	service "github.com/opencloud-eu/opencloud/services/graph/pkg/service/v0"
+)
+
+type batchResponses struct {
+	Responses []struct {
+		ID     string
+		Status int
+		Body   json.RawMessage
+	}
+}
+
+var _ = Describe("Batch", func() {
+	var (
+		svc         service.Service
+		cfg         *config.Config
+		roleService *mocks.RoleService
+	)
+
+	postBatch := func(body string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/graph/v1.0/$batch", bytes.NewBufferString(body))
+		rr := httptest.NewRecorder()
+		svc.ServeHTTP(rr, r)
+		return rr
+	}
+
+	parseBatch := func(rr *httptest.ResponseRecorder) batchResponses {
+		res := batchResponses{}
+		Expect(json.Unmarshal(rr.Body.Bytes(), &res)).To(Succeed())
+		return res
+	}
+
+	BeforeEach(func() {
+		roleService = &mocks.RoleService{}
+		roleService.On("ListRoles", mock.Anything, mock.Anything, mock.Anything).Return(&settings.ListBundlesResponse{
+			Bundles: []*settingsmsg.Bundle{{Id: "some-appRole-ID", DisplayName: "A role"}},
+		}, nil).Maybe()
+
+		pool.RemoveSelector("GatewaySelector" + "eu.opencloud.api.gateway")
+		gatewayClient := &cs3mocks.GatewayAPIClient{}
+		gatewaySelector := pool.GetSelector[gateway.GatewayAPIClient](
+			"GatewaySelector",
+			"eu.opencloud.api.gateway",
+			func(cc grpc.ClientConnInterface) gateway.GatewayAPIClient {
+				return gatewayClient
+			},
+		)
+
+		cfg = defaults.FullDefaultConfig()
+		cfg.Identity.LDAP.CACert = "" // skip the startup checks, we don't use LDAP at all in this tests
+		cfg.TokenManager.JWTSecret = "loremipsum"
+		cfg.Commons = &shared.Commons{}
+		cfg.GRPCClientTLS = &shared.GRPCClientTLS{}
+		cfg.Application.ID = "some-application-ID"
+
+		var err error
+		svc, err = service.NewService(
+			service.Config(cfg),
+			service.WithGatewaySelector(gatewaySelector),
+			service.WithRoleService(roleService),
+		)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("runs the requests and returns their responses in order", func() {
+		rr := postBatch(`{"requests": [
+			{"id": "1", "method": "GET", "url": "/applications"},
+			{"id": "2", "method": "GET", "url": "/applications/some-application-ID", "dependsOn": ["1"]},
+			{"id": "3", "method": "GET", "url": "/applications/unknown"}
+		]}`)
+		Expect(rr.Code).To(Equal(http.StatusOK))
+
+		res := parseBatch(rr)
+		Expect(res.Responses).To(HaveLen(3))
+		Expect(res.Responses[0].ID).To(Equal("1"))
+		Expect(res.Responses[0].Status).To(Equal(http.StatusOK))
+		Expect(res.Responses[1].ID).To(Equal("2"))
+		Expect(res.Responses[1].Status).To(Equal(http.StatusOK))
+		Expect(res.Responses[2].ID).To(Equal("3"))
+		Expect(res.Responses[2].Status).To(Equal(http.StatusNotFound))
+
+		application := libregraph.Application{}
+		Expect(json.Unmarshal(res.Responses[1].Body, &application)).To(Succeed())
+		Expect(application.GetId()).To(Equal("some-application-ID"))
+		Expect(application.GetAppRoles()).To(HaveLen(1))
+	})
+
+	It("fails requests whose dependencies failed", func() {
+		rr := postBatch(`{"requests": [
+			{"id": "1", "method": "GET", "url": "/applications/unknown"},
+			{"id": "2", "method": "GET", "url": "/applications", "dependsOn": ["1"]},
+			{"id": "3", "method": "GET", "url": "/applications", "dependsOn": ["2"]}
+		]}`)
+		Expect(rr.Code).To(Equal(http.StatusOK))
+
+		res := parseBatch(rr)
+		Expect(res.Responses).To(HaveLen(3))
+		Expect(res.Responses[0].Status).To(Equal(http.StatusNotFound))
+		Expect(res.Responses[1].Status).To(Equal(http.StatusFailedDependency))
+		Expect(res.Responses[2].Status).To(Equal(http.StatusFailedDependency))
+		roleService.AssertNotCalled(GinkgoT(), "ListRoles", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	DescribeTable("rejects invalid batches",
+		func(body string) {
+			Expect(postBatch(body).Code).To(Equal(http.StatusBadRequest))
+		},
+		Entry("invalid json", `{"requests": [`),
+		Entry("no requests", `{"requests": []}`),
+		Entry("missing id", `{"requests": [{"method": "GET", "url": "/applications"}]}`),
+		Entry("duplicate id", `{"requests": [{"id": "1", "method": "GET", "url": "/applications"}, {"id": "1", "method": "GET", "url": "/applications"}]}`),
+		Entry("unsupported method", `{"requests": [{"id": "1", "method": "TRACE", "url": "/applications"}]}`),
+		Entry("absolute url", `{"requests": [{"id": "1", "method": "GET", "url": "https://example.com/applications"}]}`),
+		Entry("nested batch", `{"requests": [{"id": "1", "method": "POST", "url": "/$batch"}]}`),
+		Entry("authorization header", `{"requests": [{"id": "1", "method": "GET", "url": "/applications", "headers": {"Authorization": "Bearer other"}}]}`),
+		Entry("access token header", `{"requests": [{"id": "1", "method": "GET", "url": "/applications", "headers": {"x-access-token": "other"}}]}`),
+		Entry("unknown dependency", `{"requests": [{"id": "1", "method": "GET", "url": "/applications", "dependsOn": ["2"]}]}`),
+		Entry("dependency cycle", `{"requests": [{"id": "1", "method": "GET", "url": "/applications", "dependsOn": ["2"]}, {"id": "2", "method": "GET", "url": "/applications", "dependsOn": ["1"]}]}`),
+	)
+
+	It("rejects batches with too many requests", func() {
+		cfg.API.BatchRequestsLimit = 1
+		rr := postBatch(`{"requests": [
+			{"id": "1", "method": "GET", "url": "/applications"},
+			{"id": "2", "method": "GET", "url": "/applications"}
+		]}`)
+		Expect(rr.Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("rejects batches which are too large", func() {
+		rr := postBatch(`{"requests": [
+			{"id": "1", "method": "POST", "url": "/groups", "body": {"displayName": "` + strings.Repeat("a", 5*1024*1024) + `"}}
+		]}`)
+		Expect(rr.Code).To(Equal(http.StatusRequestEntityTooLarge))
+	})
+})
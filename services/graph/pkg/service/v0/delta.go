@@ -0,0 +1,532 @@
+package svc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	cs3rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	storageprovider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go/jetstream"
+	libregraph "github.com/opencloud-eu/libre-graph-api-go"
+	"github.com/opencloud-eu/reva/v2/pkg/storagespace"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/errorcode"
+)
+
+const (
+	// deltaListingKeyPrefix is the prefix of the keys of the folder listings in the nats key value store
+	deltaListingKeyPrefix = "delta."
+
+	// deltaPageKeyPrefix is the prefix of the keys of the stored pages of delta responses in the nats key value store
+	deltaPageKeyPrefix = "deltapage."
+
+	// deltaRefreshKeyPrefix is the prefix of the keys which contain the time the listings of a drive were refreshed
+	deltaRefreshKeyPrefix = "deltarefresh."
+
+	// deltaTokenLatest can be used instead of a token to skip the initial enumeration of a drive
+	deltaTokenLatest = "latest"
+
+	// deltaDefaultPageSize is the number of items of a page if the client doesn't ask for less or more with $top
+	deltaDefaultPageSize = 200
+	// deltaMaxPageSize is the maximum number of items of a page
+	deltaMaxPageSize = 1000
+	// deltaPageTTL is the time the remaining pages of a delta response can be requested
+	deltaPageTTL = time.Hour
+)
+
+// errDeltaResyncRequired is returned when the folder listings of a delta token are no longer available
+var errDeltaResyncRequired = errors.New("the delta token is no longer valid")
+
+type (
+	// deltaToken is the state of a drive the client has seen. It is handed out base64 encoded
+	// and only references the folder listings in the key value store.
+	deltaToken struct {
+		DriveID string `json:"d"`
+		ETag    string `json:"e"`
+		Issued  int64  `json:"t"`
+	}
+
+	// deltaSkipToken references the next page of a delta response. The pages after the first one
+	// are stored in the key value store when the first page is requested.
+	deltaSkipToken struct {
+		DriveID string `json:"d"`
+		// Pages is the key prefix of the stored pages
+		Pages string `json:"p"`
+		Page  int    `json:"n"`
+		Count int    `json:"c"`
+		// DeltaToken is the token of the delta link returned with the last page
+		DeltaToken string `json:"l"`
+		Issued     int64  `json:"t"`
+	}
+
+	// deltaListing contains the children of a folder with a given etag. The etag of a folder
+	// changes with every change below it, so a listing never changes once it is stored.
+	deltaListing struct {
+		Children []deltaListingEntry `json:"c"`
+	}
+
+	// deltaListingEntry is a child of a folder listing
+	deltaListingEntry struct {
+		ID     string `json:"i"`
+		ETag   string `json:"e"`
+		Name   string `json:"n"`
+		Folder bool   `json:"f,omitempty"`
+	}
+
+	// deltaResponse is the response of a delta query
+	deltaResponse struct {
+		Value     []*libregraph.DriveItem `json:"value"`
+		NextLink  string                  `json:"@odata.nextLink,omitempty"`
+		DeltaLink string                  `json:"@odata.deltaLink,omitempty"`
+	}
+
+	// deltaWalker compares the folders of a drive with the listings of a delta token
+	deltaWalker struct {
+		g             Graph
+		ctx           context.Context
+		gatewayClient gateway.GatewayAPIClient
+		logger        *log.Logger
+
+		items   []*libregraph.DriveItem
+		seen    map[string]struct{}
+		removed map[string]deltaListingEntry
+		// stored contains the keys of the listings which were stored by the walk
+		stored map[string]struct{}
+	}
+)
+
+// GetDriveDelta returns the items of a drive which were created, changed or removed since the state of the delta token.
+// Without a token all items of the drive are returned. The items are paged, every page but the last one contains the
+// link to the next page, the last page contains the token for the next request.
+func (g Graph) GetDriveDelta(w http.ResponseWriter, r *http.Request) {
+	logger := g.logger.SubloggerWithRequestID(r.Context())
+	ctx := r.Context()
+
+	if g.deltakv == nil {
+		errorcode.NotSupported.Render(w, r, http.StatusNotImplemented, "delta queries need a store")
+		return
+	}
+
+	driveID, err := parseIDParam(r, "driveID")
+	if err != nil {
+		errorcode.RenderError(w, r, err)
+		return
+	}
+	formattedDriveID := storagespace.FormatStorageID(driveID.GetStorageId(), driveID.GetSpaceId())
+
+	pageSize := deltaDefaultPageSize
+	if top := r.URL.Query().Get("$top"); top != "" {
+		pageSize, err = strconv.Atoi(top)
+		if err != nil || pageSize < 1 || pageSize > deltaMaxPageSize {
+			errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, "$top must be between 1 and "+strconv.Itoa(deltaMaxPageSize))
+			return
+		}
+	}
+
+	var skip *deltaSkipToken
+	if skipToken := r.URL.Query().Get("$skiptoken"); skipToken != "" {
+		skip = &deltaSkipToken{}
+		err := decodeDeltaToken(skipToken, skip)
+		if err != nil || skip.DriveID != formattedDriveID || !strings.HasPrefix(skip.Pages, deltaDrivePagesKeyPrefix(formattedDriveID)) {
+			logger.Debug().Err(err).Str("driveID", formattedDriveID).Msg("could not get delta: invalid skip token")
+			errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, "invalid skip token")
+			return
+		}
+		if time.Since(time.Unix(skip.Issued, 0)) > deltaPageTTL {
+			errorcode.ResyncRequired.Render(w, r, http.StatusGone, "the skip token has expired")
+			return
+		}
+	}
+
+	var previous *deltaToken
+	token := r.URL.Query().Get("token")
+	if token != "" && token != deltaTokenLatest {
+		previous = &deltaToken{}
+		err = decodeDeltaToken(token, previous)
+		if err != nil || previous.DriveID != formattedDriveID {
+			logger.Debug().Err(err).Str("driveID", formattedDriveID).Msg("could not get delta: invalid token")
+			errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, "invalid delta token")
+			return
+		}
+		if time.Since(time.Unix(previous.Issued, 0)) > g.config.Spaces.DeltaTokenTTL {
+			errorcode.ResyncRequired.Render(w, r, http.StatusGone, "the delta token has expired")
+			return
+		}
+	}
+
+	gatewayClient, err := g.gatewaySelector.Next()
+	if err != nil {
+		errorcode.ServiceNotAvailable.Render(w, r, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// take the time before walking the drive, changes made during the walk are returned again with the next token
+	issued := time.Now()
+	res, err := gatewayClient.Stat(ctx, &storageprovider.StatRequest{
+		Ref: &storageprovider.Reference{ResourceId: &driveID},
+	})
+	switch {
+	case err != nil:
+		errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, err.Error())
+		return
+	case res.GetStatus().GetCode() == cs3rpc.Code_CODE_NOT_FOUND, res.GetStatus().GetCode() == cs3rpc.Code_CODE_PERMISSION_DENIED:
+		errorcode.ItemNotFound.Render(w, r, http.StatusNotFound, res.GetStatus().GetMessage())
+		return
+	case res.GetStatus().GetCode() != cs3rpc.Code_CODE_OK:
+		errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, res.GetStatus().GetMessage())
+		return
+	}
+	root := res.GetInfo()
+
+	if skip != nil {
+		g.renderDeltaPage(w, r, skip)
+		return
+	}
+
+	walker := &deltaWalker{
+		g:             g,
+		ctx:           ctx,
+		gatewayClient: gatewayClient,
+		logger:        &logger,
+		seen:          map[string]struct{}{},
+		removed:       map[string]deltaListingEntry{},
+		stored:        map[string]struct{}{},
+	}
+	var previousETag string
+	if previous != nil {
+		previousETag = previous.ETag
+	}
+	if previousETag != root.GetEtag() {
+		err = walker.walk(root, previousETag)
+	}
+	switch {
+	case errors.Is(err, errDeltaResyncRequired):
+		errorcode.ResyncRequired.Render(w, r, http.StatusGone, err.Error())
+		return
+	case err != nil:
+		logger.Error().Err(err).Str("driveID", formattedDriveID).Msg("could not get delta")
+		errorcode.RenderError(w, r, err)
+		return
+	}
+
+	if err := g.refreshDeltaListings(ctx, formattedDriveID, root, walker.stored); err != nil {
+		logger.Error().Err(err).Str("driveID", formattedDriveID).Msg("could not refresh the delta listings")
+	}
+
+	next, err := encodeDeltaToken(deltaToken{DriveID: formattedDriveID, ETag: root.GetEtag(), Issued: issued.Unix()})
+	if err != nil {
+		errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	items := walker.changes()
+	if token == deltaTokenLatest {
+		items = []*libregraph.DriveItem{}
+	}
+	if len(items) <= pageSize {
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, deltaResponse{Value: items, DeltaLink: g.deltaLink(r, "token", next)})
+		return
+	}
+
+	// the remaining pages are stored, they must not change when the drive changes while the client requests them
+	skip = &deltaSkipToken{
+		DriveID:    formattedDriveID,
+		Pages:      deltaDrivePagesKeyPrefix(formattedDriveID) + strconv.FormatInt(issued.Unix(), 10) + "." + uuid.NewString() + ".",
+		DeltaToken: next,
+		Issued:     issued.Unix(),
+	}
+	for rest := items[pageSize:]; len(rest) > 0; skip.Count++ {
+		page := rest[:min(pageSize, len(rest))]
+		rest = rest[len(page):]
+		if err := g.storeDeltaPage(ctx, skip.Pages+strconv.Itoa(skip.Count), page); err != nil {
+			logger.Error().Err(err).Str("driveID", formattedDriveID).Msg("could not store the delta pages")
+			errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, "could not store the delta pages")
+			return
+		}
+	}
+	nextPage, err := encodeDeltaToken(skip)
+	if err != nil {
+		errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, deltaResponse{Value: items[:pageSize], NextLink: g.deltaLink(r, "$skiptoken", nextPage)})
+}
+
+// renderDeltaPage renders a stored page of a delta response with the link to the next page or,
+// for the last page, the delta link
+func (g Graph) renderDeltaPage(w http.ResponseWriter, r *http.Request, skip *deltaSkipToken) {
+	entry, err := g.deltakv.Get(r.Context(), skip.Pages+strconv.Itoa(skip.Page))
+	switch {
+	case errors.Is(err, jetstream.ErrKeyNotFound):
+		errorcode.ResyncRequired.Render(w, r, http.StatusGone, "the page is no longer available")
+		return
+	case err != nil:
+		errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, err.Error())
+		return
+	}
+	response := deltaResponse{}
+	if err := json.Unmarshal(entry.Value(), &response.Value); err != nil {
+		errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if skip.Page+1 < skip.Count {
+		skip.Page++
+		nextPage, err := encodeDeltaToken(skip)
+		if err != nil {
+			errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, err.Error())
+			return
+		}
+		response.NextLink = g.deltaLink(r, "$skiptoken", nextPage)
+	} else {
+		response.DeltaLink = g.deltaLink(r, "token", skip.DeltaToken)
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, response)
+}
+
+// deltaLink returns the link to the delta query of the request with the given parameter
+func (g Graph) deltaLink(r *http.Request, param, value string) string {
+	return strings.TrimSuffix(g.config.Commons.OpenCloudURL, "/") + r.URL.Path + "?" + param + "=" + url.QueryEscape(value)
+}
+
+// walk lists the folder and compares the children with the listing of the folder at the previous etag.
+// Changed children are added to the items, changed folders are walked as well. Without a previous etag
+// all children are added.
+func (d *deltaWalker) walk(folder *storageprovider.ResourceInfo, previousETag string) error {
+	folderID := storagespace.FormatResourceID(folder.GetId())
+	previous := map[string]deltaListingEntry{}
+	if previousETag != "" {
+		listing, err := d.g.loadDeltaListing(d.ctx, deltaListingKey(folderID, previousETag))
+		if err != nil {
+			return err
+		}
+		for _, child := range listing.Children {
+			previous[child.ID] = child
+		}
+	}
+
+	res, err := d.gatewayClient.ListContainer(d.ctx, &storageprovider.ListContainerRequest{
+		Ref: &storageprovider.Reference{ResourceId: folder.GetId()},
+	})
+	switch {
+	case err != nil:
+		return err
+	case res.GetStatus().GetCode() != cs3rpc.Code_CODE_OK:
+		return errorcode.New(errorcode.GeneralException, res.GetStatus().GetMessage())
+	}
+
+	listing := deltaListing{Children: make([]deltaListingEntry, 0, len(res.GetInfos()))}
+	for _, info := range res.GetInfos() {
+		entry := deltaListingEntry{
+			ID:     storagespace.FormatResourceID(info.GetId()),
+			ETag:   info.GetEtag(),
+			Name:   path.Base(info.GetPath()),
+			Folder: info.GetType() == storageprovider.ResourceType_RESOURCE_TYPE_CONTAINER,
+		}
+		listing.Children = append(listing.Children, entry)
+		d.seen[entry.ID] = struct{}{}
+
+		old, ok := previous[entry.ID]
+		delete(previous, entry.ID)
+		if ok && old == entry {
+			continue
+		}
+
+		item, err := cs3ResourceToDriveItem(d.logger, info)
+		if err != nil {
+			return err
+		}
+		d.items = append(d.items, item)
+
+		if !entry.Folder {
+			continue
+		}
+		// moved folders are walked completely, their previous listing is not known here
+		var oldETag string
+		if ok && old.Folder {
+			oldETag = old.ETag
+		}
+		if oldETag != entry.ETag {
+			if err := d.walk(info, oldETag); err != nil {
+				return err
+			}
+		}
+	}
+
+	// the remaining children were removed or moved to another folder
+	for id, entry := range previous {
+		d.removed[id] = entry
+	}
+
+	key := deltaListingKey(folderID, folder.GetEtag())
+	stored, err := d.g.storeDeltaListing(d.ctx, key, listing)
+	if stored {
+		d.stored[key] = struct{}{}
+	}
+	return err
+}
+
+// changes returns the changed items followed by the removed items
+func (d *deltaWalker) changes() []*libregraph.DriveItem {
+	items := d.items
+	for id, entry := range d.removed {
+		if _, ok := d.seen[id]; ok {
+			continue
+		}
+		items = append(items, &libregraph.DriveItem{
+			Id:      libregraph.PtrString(id),
+			Name:    libregraph.PtrString(entry.Name),
+			Deleted: &libregraph.Deleted{State: libregraph.PtrString("deleted")},
+		})
+	}
+	if items == nil {
+		return []*libregraph.DriveItem{}
+	}
+	return items
+}
+
+// loadDeltaListing reads the listing with the given key from the nats key value store
+func (g Graph) loadDeltaListing(ctx context.Context, key string) (deltaListing, error) {
+	listing := deltaListing{}
+	entry, err := g.deltakv.Get(ctx, key)
+	switch {
+	case errors.Is(err, jetstream.ErrKeyNotFound):
+		return listing, errDeltaResyncRequired
+	case err != nil:
+		return listing, err
+	}
+	return listing, json.Unmarshal(entry.Value(), &listing)
+}
+
+// storeDeltaListing stores a listing in the nats key value store. A listing never changes, it returns false
+// if the listing was already stored.
+func (g Graph) storeDeltaListing(ctx context.Context, key string, listing deltaListing) (bool, error) {
+	if _, err := g.deltakv.Get(ctx, key); err == nil {
+		return false, nil
+	}
+
+	data, err := json.Marshal(listing)
+	if err != nil {
+		return false, err
+	}
+	if _, err := g.deltakv.Put(ctx, key, data); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// refreshDeltaListings stores the listings which can be reached from the root of a drive again, so they don't
+// expire while tokens reference them. This is done once per token ttl and drive, the listings which can no
+// longer be reached, like the listings of deleted folders and drives, expire with the bucket, see DeltaStoreTTL.
+func (g Graph) refreshDeltaListings(ctx context.Context, driveID string, root *storageprovider.ResourceInfo, stored map[string]struct{}) error {
+	refreshKey := deltaRefreshKeyPrefix + deltaKeyHash(driveID)
+	if entry, err := g.deltakv.Get(ctx, refreshKey); err == nil {
+		refreshed, err := strconv.ParseInt(string(entry.Value()), 10, 64)
+		if err == nil && time.Since(time.Unix(refreshed, 0)) < g.config.Spaces.DeltaTokenTTL {
+			return nil
+		}
+	}
+
+	refreshed := time.Now()
+	keys := []string{deltaListingKey(storagespace.FormatResourceID(root.GetId()), root.GetEtag())}
+	for len(keys) > 0 {
+		key := keys[len(keys)-1]
+		keys = keys[:len(keys)-1]
+
+		entry, err := g.deltakv.Get(ctx, key)
+		switch {
+		case errors.Is(err, jetstream.ErrKeyNotFound):
+			continue
+		case err != nil:
+			return err
+		}
+		listing := deltaListing{}
+		if err := json.Unmarshal(entry.Value(), &listing); err != nil {
+			return err
+		}
+		if _, ok := stored[key]; !ok {
+			if _, err := g.deltakv.Put(ctx, key, entry.Value()); err != nil {
+				return err
+			}
+		}
+		for _, child := range listing.Children {
+			if child.Folder {
+				keys = append(keys, deltaListingKey(child.ID, child.ETag))
+			}
+		}
+	}
+
+	_, err := g.deltakv.Put(ctx, refreshKey, []byte(strconv.FormatInt(refreshed.Unix(), 10)))
+	return err
+}
+
+// storeDeltaPage stores a page of a delta response in the nats key value store
+func (g Graph) storeDeltaPage(ctx context.Context, key string, items []*libregraph.DriveItem) error {
+	data, err := json.Marshal(items)
+	if err != nil {
+		return err
+	}
+	_, err = g.deltakv.Put(ctx, key, data)
+	return err
+}
+
+// DeltaStoreTTL returns the time the entries of the bucket of the delta queries are kept. The listings which
+// are referenced by valid tokens are refreshed once per token ttl, so they are kept for twice the token ttl.
+func DeltaStoreTTL(tokenTTL time.Duration) time.Duration {
+	return max(2*tokenTTL, deltaPageTTL)
+}
+
+// deltaDrivePagesKeyPrefix returns the prefix of the keys of the stored pages of a drive
+func deltaDrivePagesKeyPrefix(driveID string) string {
+	return deltaPageKeyPrefix + deltaKeyHash(driveID) + "."
+}
+
+// deltaListingKey returns the key of the listing of a folder at the given etag. Resource ids and etags
+// contain characters which are not allowed in keys, they are hashed.
+func deltaListingKey(id, etag string) string {
+	return deltaListingKeyPrefix + deltaKeyHash(id) + "." + deltaKeyHash(etag)
+}
+
+// deltaKeyHash hashes a part of a key in the nats key value store, ids and etags contain characters
+// which are not allowed in keys
+func deltaKeyHash(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:16])
+}
+
+// encodeDeltaToken encodes a delta or skip token
+func encodeDeltaToken(t interface{}) (string, error) {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeDeltaToken decodes a delta or skip token
+func decodeDeltaToken(s string, t interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, t)
+}
@@ -0,0 +1,340 @@
+package svc_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"time"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	userpb "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/go-chi/chi/v5"
+	"github.com/nats-io/nats.go/jetstream"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	libregraph "github.com/opencloud-eu/libre-graph-api-go"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+
+	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/status"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
+	"github.com/opencloud-eu/reva/v2/pkg/utils"
+	cs3mocks "github.com/opencloud-eu/reva/v2/tests/cs3mocks/mocks"
+
+	"github.com/opencloud-eu/opencloud/pkg/shared"
+	"github.com/opencloud-eu/opencloud/services/graph/mocks"
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/config/defaults"
+	service "github.com/opencloud-eu/opencloud/services/graph/pkg/service/v0"
+)
+
+type deltaList struct {
+	Value     []*libregraph.DriveItem
+	NextLink  string `json:"@odata.nextLink"`
+	DeltaLink string `json:"@odata.deltaLink"`
+}
+
+var _ = Describe("Delta", func() {
+	var (
+		svc              service.Service
+		ctx              context.Context
+		cfg              *config.Config
+		gatewayClient    *cs3mocks.GatewayAPIClient
+		gatewaySelector  pool.Selectable[gateway.GatewayAPIClient]
+		natsKeyValueMock *mocks.KeyValue
+		store            map[string][]byte
+		puts             map[string]int
+
+		// the current state of the drive, folder id -> children
+		root     *provider.ResourceInfo
+		children map[string][]*provider.ResourceInfo
+
+		currentUser = &userpb.User{
+			Id: &userpb.UserId{
+				OpaqueId: "user",
+			},
+		}
+	)
+
+	resourceInfo := func(id, name, etag string, folder bool) *provider.ResourceInfo {
+		info := &provider.ResourceInfo{
+			Id:    &provider.ResourceId{StorageId: "storageid", SpaceId: "spaceid", OpaqueId: id},
+			Path:  name,
+			Etag:  etag,
+			Type:  provider.ResourceType_RESOURCE_TYPE_FILE,
+			Mtime: utils.TimeToTS(time.Now()),
+		}
+		if folder {
+			info.Type = provider.ResourceType_RESOURCE_TYPE_CONTAINER
+		}
+		return info
+	}
+
+	getDeltaQuery := func(query url.Values) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/graph/v1.0/drives/storageid$spaceid/root/delta?"+query.Encode(), nil)
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("driveID", "storageid$spaceid")
+		r = r.WithContext(context.WithValue(revactx.ContextSetUser(ctx, currentUser), chi.RouteCtxKey, rctx))
+		rr := httptest.NewRecorder()
+		svc.GetDriveDelta(rr, r)
+		return rr
+	}
+
+	getDelta := func(token string) *httptest.ResponseRecorder {
+		query := url.Values{}
+		if token != "" {
+			query.Set("token", token)
+		}
+		return getDeltaQuery(query)
+	}
+
+	parseDelta := func(rr *httptest.ResponseRecorder) (deltaList, string) {
+		res := deltaList{}
+		Expect(json.Unmarshal(rr.Body.Bytes(), &res)).To(Succeed())
+		link, err := url.Parse(res.DeltaLink)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(link.Path).To(Equal("/graph/v1.0/drives/storageid$spaceid/root/delta"))
+		return res, link.Query().Get("token")
+	}
+
+	BeforeEach(func() {
+		pool.RemoveSelector("GatewaySelector" + "eu.opencloud.api.gateway")
+		gatewayClient = &cs3mocks.GatewayAPIClient{}
+		gatewaySelector = pool.GetSelector[gateway.GatewayAPIClient](
+			"GatewaySelector",
+			"eu.opencloud.api.gateway",
+			func(cc grpc.ClientConnInterface) gateway.GatewayAPIClient {
+				return gatewayClient
+			},
+		)
+
+		store = map[string][]byte{}
+		puts = map[string]int{}
+		natsKeyValueMock = &mocks.KeyValue{}
+		natsKeyValueMock.EXPECT().Get(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, key string) (jetstream.KeyValueEntry, error) {
+			value, ok := store[key]
+			if !ok {
+				return nil, jetstream.ErrKeyNotFound
+			}
+			kve := &mocks.KeyValueEntry{}
+			kve.On("Value").Return(value)
+			return kve, nil
+		}).Maybe()
+		natsKeyValueMock.EXPECT().Put(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, key string, val []byte) (uint64, error) {
+			store[key] = val
+			puts[key]++
+			return 1, nil
+		}).Maybe()
+
+		root = resourceInfo("spaceid", ".", "root-1", true)
+		children = map[string][]*provider.ResourceInfo{
+			"spaceid": {
+				resourceInfo("file1", "file1.txt", "file1-1", false),
+				resourceInfo("folder1", "folder1", "folder1-1", true),
+			},
+			"folder1": {
+				resourceInfo("file2", "file2.txt", "file2-1", false),
+			},
+		}
+		gatewayClient.On("Stat", mock.Anything, mock.Anything).Return(func(_ context.Context, _ *provider.StatRequest, _ ...grpc.CallOption) (*provider.StatResponse, error) {
+			return &provider.StatResponse{Status: status.NewOK(ctx), Info: root}, nil
+		}).Maybe()
+		gatewayClient.On("ListContainer", mock.Anything, mock.Anything).Return(func(_ context.Context, req *provider.ListContainerRequest, _ ...grpc.CallOption) (*provider.ListContainerResponse, error) {
+			return &provider.ListContainerResponse{Status: status.NewOK(ctx), Infos: children[req.GetRef().GetResourceId().GetOpaqueId()]}, nil
+		}).Maybe()
+
+		ctx = context.Background()
+
+		cfg = defaults.FullDefaultConfig()
+		cfg.Identity.LDAP.CACert = "" // skip the startup checks, we don't use LDAP at all in this tests
+		cfg.TokenManager.JWTSecret = "loremipsum"
+		cfg.Commons = &shared.Commons{}
+		cfg.GRPCClientTLS = &shared.GRPCClientTLS{}
+
+		var err error
+		svc, err = service.NewService(
+			service.Config(cfg),
+			service.WithGatewaySelector(gatewaySelector),
+			service.WithDeltaKeyValue(natsKeyValueMock),
+		)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("returns all items without a token", func() {
+		rr := getDelta("")
+		Expect(rr.Code).To(Equal(http.StatusOK))
+
+		res, token := parseDelta(rr)
+		Expect(token).ToNot(BeEmpty())
+		Expect(res.Value).To(HaveLen(3))
+		Expect(res.Value[0].GetName()).To(Equal("file1.txt"))
+		Expect(res.Value[1].GetName()).To(Equal("folder1"))
+		Expect(res.Value[2].GetName()).To(Equal("file2.txt"))
+	})
+
+	It("returns no items for the latest token", func() {
+		rr := getDelta("latest")
+		Expect(rr.Code).To(Equal(http.StatusOK))
+
+		res, token := parseDelta(rr)
+		Expect(token).ToNot(BeEmpty())
+		Expect(res.Value).To(BeEmpty())
+	})
+
+	It("returns no items when the drive did not change", func() {
+		_, token := parseDelta(getDelta(""))
+
+		rr := getDelta(token)
+		Expect(rr.Code).To(Equal(http.StatusOK))
+		res, _ := parseDelta(rr)
+		Expect(res.Value).To(BeEmpty())
+	})
+
+	It("returns changed and removed items", func() {
+		_, token := parseDelta(getDelta(""))
+
+		root = resourceInfo("spaceid", ".", "root-2", true)
+		children["spaceid"] = []*provider.ResourceInfo{
+			resourceInfo("folder1", "folder1", "folder1-2", true),
+		}
+		children["folder1"] = []*provider.ResourceInfo{
+			resourceInfo("file2", "file2.txt", "file2-2", false),
+		}
+
+		rr := getDelta(token)
+		Expect(rr.Code).To(Equal(http.StatusOK))
+		res, _ := parseDelta(rr)
+		Expect(res.Value).To(HaveLen(3))
+		Expect(res.Value[0].GetName()).To(Equal("folder1"))
+		Expect(res.Value[1].GetName()).To(Equal("file2.txt"))
+		Expect(res.Value[2].GetId()).To(Equal("storageid$spaceid!file1"))
+		Expect(res.Value[2].Deleted.GetState()).To(Equal("deleted"))
+	})
+
+	It("does not report moved items as removed", func() {
+		_, token := parseDelta(getDelta(""))
+
+		root = resourceInfo("spaceid", ".", "root-2", true)
+		children["spaceid"] = []*provider.ResourceInfo{
+			resourceInfo("folder1", "folder1", "folder1-2", true),
+		}
+		children["folder1"] = []*provider.ResourceInfo{
+			resourceInfo("file1", "file1.txt", "file1-1", false),
+			resourceInfo("file2", "file2.txt", "file2-1", false),
+		}
+
+		rr := getDelta(token)
+		Expect(rr.Code).To(Equal(http.StatusOK))
+		res, _ := parseDelta(rr)
+		Expect(res.Value).To(HaveLen(2))
+		Expect(res.Value[0].GetName()).To(Equal("folder1"))
+		Expect(res.Value[1].GetName()).To(Equal("file1.txt"))
+		Expect(res.Value[1].HasDeleted()).To(BeFalse())
+	})
+
+	It("requires a resync when the listings are gone", func() {
+		_, token := parseDelta(getDelta(""))
+		clear(store)
+
+		root = resourceInfo("spaceid", ".", "root-2", true)
+		Expect(getDelta(token).Code).To(Equal(http.StatusGone))
+	})
+
+	It("refreshes the listings once per token ttl", func() {
+		_, token := parseDelta(getDelta(""))
+		listings := 0
+		for key, n := range puts {
+			if strings.HasPrefix(key, "delta.") {
+				listings++
+				Expect(n).To(Equal(1))
+			}
+		}
+		Expect(listings).To(Equal(2))
+
+		_, token = parseDelta(getDelta(token))
+		for key, n := range puts {
+			if strings.HasPrefix(key, "delta.") {
+				Expect(n).To(Equal(1))
+			}
+		}
+
+		// the listings of the unchanged folders are stored again after the token ttl
+		for key := range store {
+			if strings.HasPrefix(key, "deltarefresh.") {
+				store[key] = []byte("0")
+			}
+		}
+		Expect(getDelta(token).Code).To(Equal(http.StatusOK))
+		for key, n := range puts {
+			if strings.HasPrefix(key, "delta.") {
+				Expect(n).To(Equal(2))
+			}
+		}
+	})
+
+	It("requires a resync when the token expired", func() {
+		cfg.Spaces.DeltaTokenTTL = -time.Second
+		_, token := parseDelta(getDelta(""))
+
+		Expect(getDelta(token).Code).To(Equal(http.StatusGone))
+	})
+
+	It("pages the items", func() {
+		rr := getDeltaQuery(url.Values{"$top": {"2"}})
+		Expect(rr.Code).To(Equal(http.StatusOK))
+		res := deltaList{}
+		Expect(json.Unmarshal(rr.Body.Bytes(), &res)).To(Succeed())
+		Expect(res.Value).To(HaveLen(2))
+		Expect(res.Value[0].GetName()).To(Equal("file1.txt"))
+		Expect(res.Value[1].GetName()).To(Equal("folder1"))
+		Expect(res.DeltaLink).To(BeEmpty())
+
+		// the next page doesn't change when the drive changes in the meantime
+		root = resourceInfo("spaceid", ".", "root-2", true)
+		children["spaceid"] = nil
+
+		link, err := url.Parse(res.NextLink)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(link.Path).To(Equal("/graph/v1.0/drives/storageid$spaceid/root/delta"))
+		rr = getDeltaQuery(link.Query())
+		Expect(rr.Code).To(Equal(http.StatusOK))
+		res, token := parseDelta(rr)
+		Expect(res.NextLink).To(BeEmpty())
+		Expect(res.Value).To(HaveLen(1))
+		Expect(res.Value[0].GetName()).To(Equal("file2.txt"))
+
+		// the delta link of the last page returns the changes made while paging
+		res, _ = parseDelta(getDelta(token))
+		Expect(res.Value).To(HaveLen(2))
+		Expect(res.Value[0].GetId()).ToNot(BeEmpty())
+		Expect(res.Value[0].Deleted.GetState()).To(Equal("deleted"))
+	})
+
+	It("requires a resync when the pages are gone", func() {
+		res := deltaList{}
+		Expect(json.Unmarshal(getDeltaQuery(url.Values{"$top": {"1"}}).Body.Bytes(), &res)).To(Succeed())
+		clear(store)
+
+		link, err := url.Parse(res.NextLink)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(getDeltaQuery(link.Query()).Code).To(Equal(http.StatusGone))
+	})
+
+	It("rejects invalid page sizes", func() {
+		Expect(getDeltaQuery(url.Values{"$top": {"0"}}).Code).To(Equal(http.StatusBadRequest))
+		Expect(getDeltaQuery(url.Values{"$top": {"invalid"}}).Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("rejects skip tokens of other drives", func() {
+		Expect(getDeltaQuery(url.Values{"$skiptoken": {"eyJkIjoib3RoZXIifQ"}}).Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("rejects invalid tokens", func() {
+		Expect(getDelta("invalid").Code).To(Equal(http.StatusBadRequest))
+	})
+})
@@ -1,5 +1,31 @@
 package svc
 
+import (
+	"context"
+	"time"
+
+	"github.com/opencloud-eu/reva/v2/pkg/events"
+)
+
 var (
 	CS3ReceivedShareToLibreGraphPermissions = cs3ReceivedShareToLibreGraphPermissions
 )
+
+var (
+	SubscriptionSignature = subscriptionSignature
+	IsPublicAddress       = isPublicAddress
+)
+
+func NotifySubscriptions(g Graph, ctx context.Context, e events.Event) {
+	g.newSubscriptionNotifier().notifySubscriptions(ctx, e)
+}
+
+func RunSubscriptionNotifier(g Graph, ctx context.Context, evChannel <-chan events.Event) {
+	g.newSubscriptionNotifier().run(ctx, evChannel)
+}
+
+func SetSubscriptionRetryDelay(delay time.Duration) (restore func()) {
+	previous := subscriptionRetryDelay
+	subscriptionRetryDelay = delay
+	return func() { subscriptionRetryDelay = previous }
+}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/opencloud-eu/reva/v2/pkg/events"
 	"github.com/opencloud-eu/reva/v2/pkg/storagespace"
+	"github.com/opencloud-eu/reva/v2/pkg/token"
 
 	"github.com/opencloud-eu/opencloud/pkg/keycloak"
 	ehsvc "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/eventhistory/v0"
@@ -50,6 +51,8 @@ type RoleService interface {
 	ListRoleAssignmentsFiltered(ctx context.Context, in *settingssvc.ListRoleAssignmentsFilteredRequest, opts ...client.CallOption) (*settingssvc.ListRoleAssignmentsResponse, error)
 	AssignRoleToUser(ctx context.Context, in *settingssvc.AssignRoleToUserRequest, opts ...client.CallOption) (*settingssvc.AssignRoleToUserResponse, error)
 	RemoveRoleFromUser(ctx context.Context, in *settingssvc.RemoveRoleFromUserRequest, opts ...client.CallOption) (*emptypb.Empty, error)
+	SaveRole(ctx context.Context, in *settingssvc.SaveRoleRequest, opts ...client.CallOption) (*settingssvc.SaveRoleResponse, error)
+	DeleteRole(ctx context.Context, in *settingssvc.DeleteRoleRequest, opts ...client.CallOption) (*emptypb.Empty, error)
 }
 
 // Graph defines implements the business logic for Service.
@@ -69,6 +72,9 @@ type Graph struct {
 	historyClient            ehsvc.EventHistoryService
 	traceProvider            trace.TracerProvider
 	natskv                   jetstream.KeyValue
+	deltakv                  jetstream.KeyValue
+	notificationClient       *http.Client
+	subscriptionTokenManager token.Manager
 }
 
 // ServeHTTP implements the Service interface.
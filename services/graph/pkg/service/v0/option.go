@@ -45,6 +45,7 @@ type Options struct {
 	EventHistoryClient       ehsvc.EventHistoryService
 	TraceProvider            trace.TracerProvider
 	NatsKeyValue             jetstream.KeyValue
+	DeltaKeyValue            jetstream.KeyValue
 }
 
 // newOptions initializes the available default options.
@@ -121,6 +122,13 @@ func WithNatsKeyValue(val jetstream.KeyValue) Option {
 	}
 }
 
+// WithDeltaKeyValue provides a function to set the DeltaKeyValue option.
+func WithDeltaKeyValue(val jetstream.KeyValue) Option {
+	return func(o *Options) {
+		o.DeltaKeyValue = val
+	}
+}
+
 // WithRoleService provides a function to set the RoleService option.
 func WithRoleService(val RoleService) Option {
 	return func(o *Options) {
@@ -11,8 +11,10 @@ import (
 	libregraph "github.com/opencloud-eu/libre-graph-api-go"
 
 	"github.com/opencloud-eu/opencloud/services/graph/pkg/errorcode"
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/event"
 	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
 	"github.com/opencloud-eu/reva/v2/pkg/events"
+	"github.com/opencloud-eu/reva/v2/pkg/utils"
 )
 
 // ChangeOwnPassword implements the Service interface. It allows the user to change
@@ -106,6 +108,10 @@ func (g Graph) ChangeOwnPassword(w http.ResponseWriter, r *http.Request) {
 			},
 		},
 	)
+	g.publishEvent(ctx, event.PasswordChanged{
+		Executant: u.GetId(),
+		Timestamp: utils.TSNow(),
+	})
 
 	render.Status(r, http.StatusNoContent)
 	render.NoContent(w, r)
@@ -15,6 +15,7 @@ import (
 	. "github.com/onsi/gomega"
 	libregraph "github.com/opencloud-eu/libre-graph-api-go"
 	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
 	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/status"
 	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
 	cs3mocks "github.com/opencloud-eu/reva/v2/tests/cs3mocks/mocks"
@@ -26,6 +27,7 @@ import (
 	"github.com/opencloud-eu/opencloud/services/graph/mocks"
 	"github.com/opencloud-eu/opencloud/services/graph/pkg/config"
 	"github.com/opencloud-eu/opencloud/services/graph/pkg/config/defaults"
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/event"
 	"github.com/opencloud-eu/opencloud/services/graph/pkg/identity"
 	identitymocks "github.com/opencloud-eu/opencloud/services/graph/pkg/identity/mocks"
 	service "github.com/opencloud-eu/opencloud/services/graph/pkg/service/v0"
@@ -139,6 +141,28 @@ var _ = Describe("Users changing their own password", func() {
 		Entry("fails when current password is wrong", "currentpassword", "newpassword", "deny", http.StatusBadRequest),
 		Entry("succeeds when current password is correct", "currentpassword", "newpassword", "", http.StatusNoContent),
 	)
+
+	It("publishes a password changed event", func() {
+		gatewayClient.On("Authenticate", mock.Anything, mock.Anything).Return(&gateway.AuthenticateResponse{
+			Status: status.NewOK(ctx),
+			Token:  "authtoken",
+		}, nil)
+		cpw := libregraph.NewPasswordChangeWithDefaults()
+		cpw.SetCurrentPassword("currentpassword")
+		cpw.SetNewPassword("newpassword")
+		body, _ := json.Marshal(cpw)
+		r := httptest.NewRequest(http.MethodPost, "/graph/v1.0/me/changePassword", bytes.NewBuffer(body)).WithContext(ctx)
+		rr := httptest.NewRecorder()
+		svc.ChangeOwnPassword(rr, r)
+		Expect(rr.Code).To(Equal(http.StatusNoContent))
+
+		eventsPublisher.AssertCalled(GinkgoT(), "Publish", mock.Anything, mock.MatchedBy(func(ev event.PasswordChanged) bool {
+			return ev.Executant.GetOpaqueId() == "user" && ev.Timestamp != nil
+		}), mock.Anything)
+		eventsPublisher.AssertCalled(GinkgoT(), "Publish", mock.Anything, mock.MatchedBy(func(ev events.UserFeatureChanged) bool {
+			return ev.UserID == "user" && len(ev.Features) == 1 && ev.Features[0].Name == "password"
+		}), mock.Anything)
+	})
 })
 
 func mockedLDAPClient() *identitymocks.Client {
@@ -1,20 +1,60 @@
 package svc
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go/jetstream"
+	libregraph "github.com/opencloud-eu/libre-graph-api-go"
 
+	"github.com/opencloud-eu/opencloud/pkg/l10n"
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/config"
 	"github.com/opencloud-eu/opencloud/services/graph/pkg/errorcode"
 	"github.com/opencloud-eu/opencloud/services/graph/pkg/unifiedrole"
 )
 
+// customRoleKeyPrefix is the prefix of the keys of the custom roles in the nats key value store
+const customRoleKeyPrefix = "unifiedrole."
+
+// customRoleDefinition is the request body to create or update a custom role
+type customRoleDefinition struct {
+	libregraph.UnifiedRoleDefinition
+	Translations map[string]unifiedrole.CustomRoleTranslation `json:"@libre.graph.translations,omitempty"`
+}
+
+// availableRoles returns the roles which are enabled in the config and all custom roles
+func availableRoles(cfg *config.Config) []*libregraph.UnifiedRoleDefinition {
+	return unifiedrole.GetRoles(unifiedrole.RoleFilterEnabled(cfg.UnifiedRoles.AvailableRoles...))
+}
+
+// availableRoleIDs returns the ids of the roles which are enabled in the config and of all custom roles
+func availableRoleIDs(cfg *config.Config) []string {
+	roles := availableRoles(cfg)
+	ids := make([]string, 0, len(roles))
+	for _, role := range roles {
+		ids = append(ids, role.GetId())
+	}
+	return ids
+}
+
 // GetRoleDefinitions a list of permission roles than can be used when sharing with users or groups
 func (g Graph) GetRoleDefinitions(w http.ResponseWriter, r *http.Request) {
+	roles := availableRoles(g.config)
+	loc := r.Header.Get(l10n.HeaderAcceptLanguage)
+	for _, role := range roles {
+		unifiedrole.TranslateCustomRole(role, loc)
+	}
+
 	render.Status(r, http.StatusOK)
-	render.JSON(w, r, g.availableRoles)
+	render.JSON(w, r, roles)
 }
 
 // GetRoleDefinition a permission role than can be used when sharing with users or groups
@@ -32,6 +72,222 @@ func (g Graph) GetRoleDefinition(w http.ResponseWriter, r *http.Request) {
 		errorcode.ItemNotFound.Render(w, r, http.StatusNotFound, err.Error())
 		return
 	}
+	unifiedrole.TranslateCustomRole(role, r.Header.Get(l10n.HeaderAcceptLanguage))
 	render.Status(r, http.StatusOK)
 	render.JSON(w, r, role)
 }
+
+// CreateRoleDefinition creates a custom role
+func (g Graph) CreateRoleDefinition(w http.ResponseWriter, r *http.Request) {
+	logger := g.logger.SubloggerWithRequestID(r.Context())
+	if !g.customRolesEnabled(w, r) {
+		return
+	}
+
+	definition := customRoleDefinition{}
+	if err := StrictJSONUnmarshal(r.Body, &definition); err != nil {
+		logger.Debug().Err(err).Msg("could not create role: invalid request body")
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if definition.GetId() == "" {
+		definition.SetId(uuid.NewString())
+	}
+	if _, err := unifiedrole.GetRole(unifiedrole.RoleFilterIDs(definition.GetId())); err == nil {
+		logger.Debug().Str("roleID", definition.GetId()).Msg("could not create role: role already exists")
+		errorcode.NameAlreadyExists.Render(w, r, http.StatusConflict, "a role with this id already exists")
+		return
+	}
+
+	role := unifiedrole.CustomRoleFromDefinition(definition.UnifiedRoleDefinition, definition.Translations)
+	if err := unifiedrole.RegisterCustomRoles(role); err != nil {
+		logger.Debug().Err(err).Str("roleID", role.ID).Msg("could not create role: invalid role")
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := g.storeCustomRole(r.Context(), role); err != nil {
+		logger.Error().Err(err).Str("roleID", role.ID).Msg("could not create role: storing the role failed")
+		unifiedrole.UnregisterCustomRole(role.ID)
+		errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, "could not store the role")
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, role.Definition())
+}
+
+// UpdateRoleDefinition changes a custom role, the properties which are not part of the request stay unchanged
+func (g Graph) UpdateRoleDefinition(w http.ResponseWriter, r *http.Request) {
+	logger := g.logger.SubloggerWithRequestID(r.Context())
+	if !g.customRolesEnabled(w, r) {
+		return
+	}
+
+	role, ok := g.getEditableCustomRole(w, r)
+	if !ok {
+		return
+	}
+
+	definition := customRoleDefinition{}
+	if err := StrictJSONUnmarshal(r.Body, &definition); err != nil {
+		logger.Debug().Err(err).Msg("could not update role: invalid request body")
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if definition.HasId() && definition.GetId() != role.ID {
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, "the id of a role can't be changed")
+		return
+	}
+
+	updated := role
+	if definition.HasDisplayName() {
+		updated.DisplayName = definition.GetDisplayName()
+	}
+	if definition.HasDescription() {
+		updated.Description = definition.GetDescription()
+	}
+	if definition.HasLibreGraphWeight() {
+		updated.Weight = definition.GetLibreGraphWeight()
+	}
+	if definition.RolePermissions != nil {
+		updated.Permissions = unifiedrole.CustomRoleFromDefinition(definition.UnifiedRoleDefinition, nil).Permissions
+	}
+	if definition.Translations != nil {
+		updated.Translations = definition.Translations
+	}
+
+	if err := unifiedrole.RegisterCustomRoles(updated); err != nil {
+		logger.Debug().Err(err).Str("roleID", role.ID).Msg("could not update role: invalid role")
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := g.storeCustomRole(r.Context(), updated); err != nil {
+		logger.Error().Err(err).Str("roleID", role.ID).Msg("could not update role: storing the role failed")
+		_ = unifiedrole.RegisterCustomRoles(role)
+		errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, "could not store the role")
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, updated.Definition())
+}
+
+// DeleteRoleDefinition deletes a custom role. Existing shares keep their permissions.
+func (g Graph) DeleteRoleDefinition(w http.ResponseWriter, r *http.Request) {
+	logger := g.logger.SubloggerWithRequestID(r.Context())
+	if !g.customRolesEnabled(w, r) {
+		return
+	}
+
+	role, ok := g.getEditableCustomRole(w, r)
+	if !ok {
+		return
+	}
+
+	if err := g.deleteCustomRole(r.Context(), role.ID); err != nil {
+		logger.Error().Err(err).Str("roleID", role.ID).Msg("could not delete role")
+		errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, "could not delete the role")
+		return
+	}
+	unifiedrole.UnregisterCustomRole(role.ID)
+
+	render.Status(r, http.StatusNoContent)
+	render.NoContent(w, r)
+}
+
+// customRolesEnabled renders an error if there is no store for the custom roles
+func (g Graph) customRolesEnabled(w http.ResponseWriter, r *http.Request) bool {
+	if g.natskv == nil {
+		errorcode.NotSupported.Render(w, r, http.StatusNotImplemented, "custom roles need a store")
+		return false
+	}
+	return true
+}
+
+// getEditableCustomRole returns the custom role from the url, it renders an error if the role doesn't exist
+// or if it can't be changed
+func (g Graph) getEditableCustomRole(w http.ResponseWriter, r *http.Request) (unifiedrole.CustomRole, bool) {
+	logger := g.logger.SubloggerWithRequestID(r.Context())
+	roleID, err := url.PathUnescape(chi.URLParam(r, "roleID"))
+	if err != nil {
+		logger.Debug().Err(err).Str("roleID", chi.URLParam(r, "roleID")).Msg("could not get roleID: unescaping is failed")
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, "unescaping role id failed")
+		return unifiedrole.CustomRole{}, false
+	}
+
+	role, ok := unifiedrole.GetCustomRole(roleID)
+	switch {
+	case !ok && len(unifiedrole.GetRoles(unifiedrole.RoleFilterIDs(roleID))) != 0:
+		errorcode.NotAllowed.Render(w, r, http.StatusForbidden, "built-in roles can't be changed")
+		return unifiedrole.CustomRole{}, false
+	case !ok:
+		errorcode.ItemNotFound.Render(w, r, http.StatusNotFound, unifiedrole.ErrUnknownRole.Error())
+		return unifiedrole.CustomRole{}, false
+	case role.ReadOnly:
+		errorcode.NotAllowed.Render(w, r, http.StatusForbidden, "roles from the custom roles file can't be changed")
+		return unifiedrole.CustomRole{}, false
+	}
+	return role, true
+}
+
+// storeCustomRole stores a custom role in the nats key value store, the other graph instances pick it up from there
+func (g Graph) storeCustomRole(ctx context.Context, role unifiedrole.CustomRole) error {
+	data, err := json.Marshal(role)
+	if err != nil {
+		return err
+	}
+	_, err = g.natskv.Put(ctx, customRoleKeyPrefix+role.ID, data)
+	return err
+}
+
+// deleteCustomRole deletes a custom role from the nats key value store
+func (g Graph) deleteCustomRole(ctx context.Context, id string) error {
+	if err := g.natskv.Delete(ctx, customRoleKeyPrefix+id); err != nil && !errors.Is(err, jetstream.ErrKeyNotFound) {
+		return err
+	}
+	return nil
+}
+
+// WatchCustomRoles registers the custom roles from the nats key value store and keeps them up to date
+// with the changes made by all graph instances
+func WatchCustomRoles(ctx context.Context, kv jetstream.KeyValue, logger log.Logger) error {
+	watcher, err := kv.Watch(ctx, customRoleKeyPrefix+"*")
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		defer func() { _ = watcher.Stop() }()
+		for entry := range watcher.Updates() {
+			// a nil entry marks the end of the initial values
+			if entry == nil {
+				continue
+			}
+
+			id := strings.TrimPrefix(entry.Key(), customRoleKeyPrefix)
+			if existing, ok := unifiedrole.GetCustomRole(id); ok && existing.ReadOnly {
+				logger.Warn().Str("roleID", id).Msg("ignoring stored role, a role with the same id is defined in the custom roles file")
+				continue
+			}
+
+			switch entry.Operation() {
+			case jetstream.KeyValueDelete, jetstream.KeyValuePurge:
+				unifiedrole.UnregisterCustomRole(id)
+			default:
+				role := unifiedrole.CustomRole{}
+				if err := json.Unmarshal(entry.Value(), &role); err != nil {
+					logger.Error().Err(err).Str("roleID", id).Msg("could not unmarshal stored role")
+					continue
+				}
+				if err := unifiedrole.RegisterCustomRoles(role); err != nil {
+					logger.Error().Err(err).Str("roleID", id).Msg("could not register stored role")
+				}
+			}
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package svc_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/go-chi/chi/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	libregraph "github.com/opencloud-eu/libre-graph-api-go"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/opencloud-eu/opencloud/pkg/shared"
+	"github.com/opencloud-eu/opencloud/services/graph/mocks"
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/config/defaults"
+	service "github.com/opencloud-eu/opencloud/services/graph/pkg/service/v0"
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/unifiedrole"
+)
+
+var _ = Describe("RoleManagement", func() {
+	const roleID = "5d2c8a6e-8d3e-4d4a-9d0e-3a6f4f1e7c11"
+
+	var (
+		svc              service.Graph
+		natsKeyValueMock *mocks.KeyValue
+		rr               *httptest.ResponseRecorder
+	)
+
+	request := func(method, body string) *http.Request {
+		r := httptest.NewRequest(method, "/graph/v1beta1/roleManagement/permissions/roleDefinitions", bytes.NewBufferString(body))
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("roleID", roleID)
+		return r.WithContext(context.WithValue(context.Background(), chi.RouteCtxKey, rctx))
+	}
+
+	BeforeEach(func() {
+		natsKeyValueMock = &mocks.KeyValue{}
+		rr = httptest.NewRecorder()
+
+		cfg := defaults.FullDefaultConfig()
+		cfg.Identity.LDAP.CACert = ""
+		cfg.TokenManager.JWTSecret = "loremipsum"
+		cfg.Commons = &shared.Commons{}
+		cfg.GRPCClientTLS = &shared.GRPCClientTLS{}
+		cfg.Application.ID = "some-application-ID"
+
+		var err error
+		svc, err = service.NewService(
+			service.Config(cfg),
+			service.PermissionService(&mocks.Permissions{}),
+			service.WithNatsKeyValue(natsKeyValueMock),
+		)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		unifiedrole.UnregisterCustomRole(roleID)
+	})
+
+	It("creates, translates and deletes a custom role", func() {
+		natsKeyValueMock.On("Put", mock.Anything, "unifiedrole."+roleID, mock.Anything).Return(uint64(1), nil)
+		natsKeyValueMock.On("Delete", mock.Anything, "unifiedrole."+roleID).Return(nil)
+
+		svc.CreateRoleDefinition(rr, request(http.MethodPost, `{
+			"id": "`+roleID+`",
+			"displayName": "Can comment",
+			"rolePermissions": [{
+				"condition": "exists @Resource.File",
+				"allowedResourceActions": ["libre.graph/driveItem/basic/read", "libre.graph/driveItem/path/read", "libre.graph/driveItem/versions/read"]
+			}],
+			"@libre.graph.translations": {"de": {"displayName": "Kann kommentieren"}}
+		}`))
+		Expect(rr.Code).To(Equal(http.StatusCreated))
+
+		rr = httptest.NewRecorder()
+		r := request(http.MethodGet, "")
+		r.Header.Set("Accept-Language", "de")
+		svc.GetRoleDefinitions(rr, r)
+		Expect(rr.Code).To(Equal(http.StatusOK))
+		var roles []libregraph.UnifiedRoleDefinition
+		Expect(json.Unmarshal(rr.Body.Bytes(), &roles)).To(Succeed())
+		Expect(roles).To(ContainElement(HaveField("DisplayName", HaveValue(Equal("Kann kommentieren")))))
+
+		rr = httptest.NewRecorder()
+		svc.DeleteRoleDefinition(rr, request(http.MethodDelete, ""))
+		Expect(rr.Code).To(Equal(http.StatusNoContent))
+		Expect(unifiedrole.IsCustomRole(roleID)).To(BeFalse())
+		natsKeyValueMock.AssertExpectations(GinkgoT())
+	})
+
+	It("rejects roles with unknown actions", func() {
+		svc.CreateRoleDefinition(rr, request(http.MethodPost, `{
+			"displayName": "Can comment",
+			"rolePermissions": [{"condition": "exists @Resource.File", "allowedResourceActions": ["libre.graph/driveItem/comments/create"]}]
+		}`))
+		Expect(rr.Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("doesn't change built-in roles", func() {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("roleID", unifiedrole.UnifiedRoleViewerID)
+		r := httptest.NewRequest(http.MethodDelete, "/graph/v1beta1/roleManagement/permissions/roleDefinitions", nil)
+		svc.DeleteRoleDefinition(rr, r.WithContext(context.WithValue(context.Background(), chi.RouteCtxKey, rctx)))
+		Expect(rr.Code).To(Equal(http.StatusForbidden))
+	})
+
+	It("doesn't create roles without a store", func() {
+		cfg := defaults.FullDefaultConfig()
+		cfg.Identity.LDAP.CACert = ""
+		cfg.TokenManager.JWTSecret = "loremipsum"
+		cfg.Commons = &shared.Commons{}
+		cfg.GRPCClientTLS = &shared.GRPCClientTLS{}
+		cfg.Application.ID = "some-application-ID"
+		svc, err := service.NewService(
+			service.Config(cfg),
+			service.PermissionService(&mocks.Permissions{}),
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		svc.CreateRoleDefinition(rr, request(http.MethodPost, `{
+			"id": "`+roleID+`",
+			"displayName": "Can comment",
+			"rolePermissions": [{"condition": "exists @Resource.File", "allowedResourceActions": ["libre.graph/driveItem/basic/read"]}]
+		}`))
+		Expect(rr.Code).To(Equal(http.StatusNotImplemented))
+		Expect(unifiedrole.IsCustomRole(roleID)).To(BeFalse())
+	})
+})
@@ -22,6 +22,7 @@ import (
 	"github.com/opencloud-eu/reva/v2/pkg/events"
 	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
 	"github.com/opencloud-eu/reva/v2/pkg/store"
+	"github.com/opencloud-eu/reva/v2/pkg/token/manager/jwt"
 	"github.com/opencloud-eu/reva/v2/pkg/utils"
 	"github.com/opencloud-eu/reva/v2/pkg/utils/ldap"
 
@@ -34,7 +35,6 @@ import (
 	settingssvc "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/settings/v0"
 	"github.com/opencloud-eu/opencloud/services/graph/pkg/identity"
 	graphm "github.com/opencloud-eu/opencloud/services/graph/pkg/middleware"
-	"github.com/opencloud-eu/opencloud/services/graph/pkg/unifiedrole"
 )
 
 const (
@@ -47,6 +47,14 @@ const (
 type Service interface { //nolint:interfacebloat
 	ServeHTTP(w http.ResponseWriter, r *http.Request)
 
+	Batch(w http.ResponseWriter, r *http.Request)
+
+	ListSubscriptions(w http.ResponseWriter, r *http.Request)
+	GetSubscription(w http.ResponseWriter, r *http.Request)
+	CreateSubscription(w http.ResponseWriter, r *http.Request)
+	UpdateSubscription(w http.ResponseWriter, r *http.Request)
+	DeleteSubscription(w http.ResponseWriter, r *http.Request)
+
 	ListApplications(w http.ResponseWriter, r *http.Request)
 	GetApplication(w http.ResponseWriter, r *http.Request)
 
@@ -117,6 +125,7 @@ type Service interface { //nolint:interfacebloat
 	GetRootDriveChildren(w http.ResponseWriter, r *http.Request)
 	GetDriveItem(w http.ResponseWriter, r *http.Request)
 	GetDriveItemChildren(w http.ResponseWriter, r *http.Request)
+	GetDriveDelta(w http.ResponseWriter, r *http.Request)
 
 	CreateUploadSession(w http.ResponseWriter, r *http.Request)
 
@@ -159,7 +168,6 @@ func NewService(opts ...Option) (Graph, error) { //nolint:maintidx
 		identityCache:   identityCache,
 		gatewaySelector: options.GatewaySelector,
 		config:          options.Config,
-		availableRoles:  unifiedrole.GetRoles(unifiedrole.RoleFilterIDs(options.Config.UnifiedRoles.AvailableRoles...)),
 	}
 
 	drivesDriveItemService, err := NewDrivesDriveItemService(options.Logger, options.GatewaySelector)
@@ -187,6 +195,14 @@ func NewService(opts ...Option) (Graph, error) { //nolint:maintidx
 		return Graph{}, err
 	}
 
+	subscriptionTokenManager, err := jwt.New(map[string]interface{}{
+		"secret":  options.Config.TokenManager.JWTSecret,
+		"expires": int64(subscriptionTokenLifetime.Seconds()),
+	})
+	if err != nil {
+		return Graph{}, err
+	}
+
 	svc := Graph{
 		BaseGraphService:         baseGraphService,
 		mux:                      m,
@@ -200,12 +216,19 @@ func NewService(opts ...Option) (Graph, error) { //nolint:maintidx
 		traceProvider:            options.TraceProvider,
 		valueService:             options.ValueService,
 		natskv:                   options.NatsKeyValue,
+		deltakv:                  options.DeltaKeyValue,
+		notificationClient:       newNotificationClient(options.Config.Subscriptions),
+		subscriptionTokenManager: subscriptionTokenManager,
 	}
 
 	if err := setIdentityBackends(options, &svc); err != nil {
 		return svc, err
 	}
 
+	if err := svc.StartSubscriptionNotifications(options.Context, options.Logger); err != nil {
+		return svc, err
+	}
+
 	if options.PermissionService == nil {
 		grpcClient, err := grpc.NewClient(append(grpc.GetClientOptions(options.Config.GRPCClientTLS), grpc.WithTraceProvider(options.TraceProvider))...)
 		if err != nil {
@@ -237,11 +260,13 @@ func NewService(opts ...Option) (Graph, error) { //nolint:maintidx
 		roleManager = &m
 	}
 
-	var requireAdmin func(http.Handler) http.Handler
+	var requireAdmin, requireAdminOrPasswordReset func(http.Handler) http.Handler
 	if options.RequireAdminMiddleware == nil {
 		requireAdmin = graphm.RequireAdmin(roleManager, options.Logger)
+		requireAdminOrPasswordReset = graphm.RequireAdminOrPasswordReset(roleManager, options.Logger)
 	} else {
 		requireAdmin = options.RequireAdminMiddleware
+		requireAdminOrPasswordReset = options.RequireAdminMiddleware
 	}
 
 	m.Route(options.Config.HTTP.Root, func(r chi.Router) {
@@ -290,10 +315,25 @@ func NewService(opts ...Option) (Graph, error) { //nolint:maintidx
 			})
 			r.Route("/roleManagement/permissions/roleDefinitions", func(r chi.Router) {
 				r.Get("/", svc.GetRoleDefinitions)
-				r.Get("/{roleID}", svc.GetRoleDefinition)
+				r.With(requireAdmin).Post("/", svc.CreateRoleDefinition)
+				r.Route("/{roleID}", func(r chi.Router) {
+					r.Get("/", svc.GetRoleDefinition)
+					r.With(requireAdmin).Patch("/", svc.UpdateRoleDefinition)
+					r.With(requireAdmin).Delete("/", svc.DeleteRoleDefinition)
+				})
 			})
 		})
 		r.Route("/v1.0", func(r chi.Router) {
+			r.Post("/$batch", svc.Batch)
+			r.Route("/subscriptions", func(r chi.Router) {
+				r.Get("/", svc.ListSubscriptions)
+				r.Post("/", svc.CreateSubscription)
+				r.Route("/{subscriptionID}", func(r chi.Router) {
+					r.Get("/", svc.GetSubscription)
+					r.Patch("/", svc.UpdateSubscription)
+					r.Delete("/", svc.DeleteSubscription)
+				})
+			})
 			r.Route("/extensions/org.libregraph", func(r chi.Router) {
 				r.Get("/tags", svc.GetTags)
 				r.Put("/tags", svc.AssignTags)
@@ -330,7 +370,7 @@ func NewService(opts ...Option) (Graph, error) { //nolint:maintidx
 						r.Get("/", usersUserProfilePhotoApi.GetProfilePhoto(GetSlugValue("userID")))
 					})
 					r.With(requireAdmin).Delete("/", svc.DeleteUser)
-					r.With(requireAdmin).Patch("/", svc.PatchUser)
+					r.With(requireAdminOrPasswordReset).Patch("/", svc.PatchUser)
 					if svc.roleService != nil {
 						r.With(requireAdmin).Route("/appRoleAssignments", func(r chi.Router) {
 							r.Get("/", svc.ListAppRoleAssignments)
@@ -361,6 +401,7 @@ func NewService(opts ...Option) (Graph, error) { //nolint:maintidx
 					r.Patch("/", svc.UpdateDrive)
 					r.Get("/", svc.GetSingleDrive)
 					r.Delete("/", svc.DeleteDrive)
+					r.Get("/root/delta", svc.GetDriveDelta)
 					r.Route("/items/{driveItemID}", func(r chi.Router) {
 						r.Get("/", svc.GetDriveItem)
 						r.Get("/children", svc.GetDriveItemChildren)
@@ -43,14 +43,14 @@ func (g Graph) GetSharedByMe(w http.ResponseWriter, r *http.Request) {
 	expand := r.URL.Query().Get("$expand")
 	expandThumbnails := strings.Contains(expand, "thumbnails")
 	if expandThumbnails {
+		previews := thumbnail.NewPreviews(g.config.Thumbnails.DocumentConverterURL, g.config.Thumbnails.FFmpegPath)
 		for k, item := range driveItems {
 			mt := item.GetFile().MimeType
 			if mt == nil {
 				continue
 			}
 
-			_, match := thumbnail.SupportedMimeTypes[*mt]
-			if match {
+			if previews.IsMimeTypeSupported(*mt) {
 				baseUrl := fmt.Sprintf("%s/dav/spaces/%s?scalingup=0&preview=1&processor=thumbnail",
 					g.config.Commons.OpenCloudURL,
 					item.GetId())
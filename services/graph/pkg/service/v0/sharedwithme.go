@@ -46,7 +46,7 @@ func (g Graph) listSharedWithMe(ctx context.Context, expandThumbnails bool) ([]l
 		g.logger.Error().Err(err).Msg("listing shares failed")
 		return nil, err
 	}
-	driveItems, err := cs3ReceivedSharesToDriveItems(ctx, g.logger, gatewayClient, g.identityCache, listReceivedSharesResponse.GetShares(), g.availableRoles)
+	driveItems, err := cs3ReceivedSharesToDriveItems(ctx, g.logger, gatewayClient, g.identityCache, listReceivedSharesResponse.GetShares(), availableRoles(g.config))
 	if err != nil {
 		g.logger.Error().Err(err).Msg("could not convert received shares to drive items")
 		return nil, err
@@ -58,7 +58,7 @@ func (g Graph) listSharedWithMe(ctx context.Context, expandThumbnails bool) ([]l
 			g.logger.Error().Err(err).Msg("listing shares failed")
 			return nil, err
 		}
-		ocmDriveItems, err := cs3ReceivedOCMSharesToDriveItems(ctx, g.logger, gatewayClient, g.identityCache, listReceivedOCMSharesResponse.GetShares(), g.availableRoles)
+		ocmDriveItems, err := cs3ReceivedOCMSharesToDriveItems(ctx, g.logger, gatewayClient, g.identityCache, listReceivedOCMSharesResponse.GetShares(), availableRoles(g.config))
 		if err != nil {
 			g.logger.Error().Err(err).Msg("could not convert received ocm shares to drive items")
 			return nil, err
@@ -67,14 +67,14 @@ func (g Graph) listSharedWithMe(ctx context.Context, expandThumbnails bool) ([]l
 	}
 
 	if expandThumbnails {
+		previews := thumbnail.NewPreviews(g.config.Thumbnails.DocumentConverterURL, g.config.Thumbnails.FFmpegPath)
 		for k, item := range driveItems {
 			mt := item.GetFile().MimeType
 			if mt == nil {
 				continue
 			}
 
-			_, match := thumbnail.SupportedMimeTypes[*mt]
-			if match {
+			if previews.IsMimeTypeSupported(*mt) {
 				baseUrl := fmt.Sprintf("%s/dav/spaces/%s?scalingup=0&preview=1&processor=thumbnail",
 					g.config.Commons.OpenCloudURL,
 					item.RemoteItem.GetId())
@@ -0,0 +1,947 @@
+package svc
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	userpb "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	cs3rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	storageprovider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/opencloud-eu/reva/v2/pkg/auth/scope"
+	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
+	"github.com/opencloud-eu/reva/v2/pkg/storagespace"
+	"github.com/opencloud-eu/reva/v2/pkg/utils"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/errorcode"
+)
+
+const (
+	// subscriptionKeyPrefix is the prefix of the keys of the subscriptions in the nats key value store
+	subscriptionKeyPrefix = "subscription."
+
+	subscriptionChangeCreated = "created"
+	subscriptionChangeUpdated = "updated"
+	subscriptionChangeDeleted = "deleted"
+
+	// headerSubscriptionSignature contains the hex encoded HMAC-SHA256 of the timestamp and the body of a notification
+	headerSubscriptionSignature = "X-OpenCloud-Signature"
+	// headerSubscriptionTimestamp contains the unix time a notification was signed at
+	headerSubscriptionTimestamp = "X-OpenCloud-Signature-Timestamp"
+
+	// subscriptionMaxAncestors limits the number of parents which are looked up for a changed item
+	subscriptionMaxAncestors = 100
+
+	// subscriptionTokenLifetime is the lifetime of the tokens which are minted to check the access of the
+	// creator of a subscription before a notification is sent
+	subscriptionTokenLifetime = time.Minute
+)
+
+var (
+	// subscriptionRetryDelay is the delay before a failed notification is sent again, it doubles with every attempt
+	subscriptionRetryDelay = time.Second
+
+	// _sharedAddressSpace is used for carrier-grade NAT, the addresses are not reachable from the internet
+	_sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+	errNonPublicAddress = errors.New("notifications can only be sent to public addresses")
+)
+
+var _subscriptionEvents = []events.Unmarshaller{
+	events.ContainerCreated{},
+	events.UploadReady{},
+	events.FileTouched{},
+	events.ItemMoved{},
+	events.ItemTrashed{},
+	events.ItemRestored{},
+	events.FileVersionRestored{},
+	events.UserDeleted{},
+	events.UserFeatureChanged{},
+}
+
+type (
+	// subscription registers a notification url for the changes of a drive, a drive item or a user
+	subscription struct {
+		ID                 string    `json:"id"`
+		Resource           string    `json:"resource"`
+		ChangeType         string    `json:"changeType"`
+		NotificationURL    string    `json:"notificationUrl"`
+		ClientState        string    `json:"clientState,omitempty"`
+		ExpirationDateTime time.Time `json:"expirationDateTime"`
+		CreatorID          string    `json:"creatorId,omitempty"`
+		// SigningSecret is stored with the subscription but only returned when the subscription is created
+		SigningSecret string `json:"signingSecret,omitempty"`
+	}
+
+	// subscriptionUpdate is the request body to renew a subscription
+	subscriptionUpdate struct {
+		ExpirationDateTime *time.Time `json:"expirationDateTime"`
+	}
+
+	// subscriptionResource is the parsed resource of a subscription
+	subscriptionResource struct {
+		driveID *storageprovider.ResourceId
+		itemID  *storageprovider.ResourceId
+		userID  string
+	}
+
+	// subscriptionChange is a change of a resource which is reported to the matching subscriptions
+	subscriptionChange struct {
+		changeType string
+		userID     string
+		ref        *storageprovider.Reference
+		id         *storageprovider.ResourceId
+		parentID   *storageprovider.ResourceId
+	}
+
+	// subscriptionNotifications is the body of a notification request
+	subscriptionNotifications struct {
+		Value []subscriptionNotification `json:"value"`
+	}
+
+	// subscriptionNotification tells a subscriber about a change of the subscribed resource
+	subscriptionNotification struct {
+		SubscriptionID                 string                        `json:"subscriptionId"`
+		SubscriptionExpirationDateTime time.Time                     `json:"subscriptionExpirationDateTime"`
+		ClientState                    string                        `json:"clientState,omitempty"`
+		ChangeType                     string                        `json:"changeType"`
+		Resource                       string                        `json:"resource"`
+		ResourceData                   *subscriptionNotificationData `json:"resourceData,omitempty"`
+	}
+
+	// subscriptionNotificationData identifies the changed item
+	subscriptionNotificationData struct {
+		ID string `json:"id"`
+	}
+
+	// subscriptionDelivery is a notification which is sent to the notification url of a subscription
+	subscriptionDelivery struct {
+		subscription subscription
+		body         []byte
+		attempt      int
+	}
+
+	// subscriptionNotifier sends the notifications with a limited number of workers. Failed deliveries are
+	// queued again after a delay, they don't keep a worker busy while they wait.
+	subscriptionNotifier struct {
+		g       Graph
+		workers chan struct{}
+		retries chan subscriptionDelivery
+	}
+)
+
+// ListSubscriptions lists the active subscriptions of the current user
+func (g Graph) ListSubscriptions(w http.ResponseWriter, r *http.Request) {
+	logger := g.logger.SubloggerWithRequestID(r.Context())
+	if !g.subscriptionsEnabled(w, r) {
+		return
+	}
+
+	subscriptions, err := g.loadSubscriptions(r.Context(), subscriptionKeyPrefix+">")
+	if err != nil {
+		logger.Error().Err(err).Msg("could not list subscriptions")
+		errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, "could not list the subscriptions")
+		return
+	}
+
+	currentUser := revactx.ContextMustGetUser(r.Context())
+	value := make([]subscription, 0, len(subscriptions))
+	for _, s := range subscriptions {
+		if s.CreatorID == currentUser.GetId().GetOpaqueId() {
+			value = append(value, s.public())
+		}
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &ListResponse{Value: value})
+}
+
+// GetSubscription returns a subscription of the current user
+func (g Graph) GetSubscription(w http.ResponseWriter, r *http.Request) {
+	if !g.subscriptionsEnabled(w, r) {
+		return
+	}
+	s, ok := g.getOwnSubscription(w, r)
+	if !ok {
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, s.public())
+}
+
+// CreateSubscription registers a notification url for the changes of a resource. The notification url has to
+// answer a validation request with the validation token before the subscription is created.
+func (g Graph) CreateSubscription(w http.ResponseWriter, r *http.Request) {
+	logger := g.logger.SubloggerWithRequestID(r.Context())
+	if !g.subscriptionsEnabled(w, r) {
+		return
+	}
+
+	s := subscription{}
+	if err := json.NewDecoder(r.Body).Decode(&s); err != nil {
+		logger.Debug().Err(err).Msg("could not create subscription: invalid request body")
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resource, err := parseSubscriptionResource(s.Resource)
+	if err != nil {
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err := validateSubscriptionChangeType(s.ChangeType); err != nil {
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err := g.validateSubscriptionExpiration(s.ExpirationDateTime); err != nil {
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err := g.validateNotificationURL(s.NotificationURL); err != nil {
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	currentUser := revactx.ContextMustGetUser(r.Context())
+	if err := g.checkSubscriptionResourceAccess(r.Context(), resource, currentUser.GetId().GetOpaqueId()); err != nil {
+		logger.Debug().Err(err).Str("resource", s.Resource).Msg("could not create subscription: no access to the resource")
+		errorcode.RenderError(w, r, err)
+		return
+	}
+
+	if err := g.validateNotificationEndpoint(r.Context(), s.NotificationURL); err != nil {
+		logger.Debug().Err(err).Str("notificationUrl", s.NotificationURL).Msg("could not create subscription: validation failed")
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, "the notification url did not answer the validation request")
+		return
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, err.Error())
+		return
+	}
+	s.ID = uuid.NewString()
+	s.CreatorID = currentUser.GetId().GetOpaqueId()
+	s.SigningSecret = hex.EncodeToString(secret)
+
+	if err := g.storeSubscription(r.Context(), resource, s); err != nil {
+		logger.Error().Err(err).Msg("could not create subscription: storing the subscription failed")
+		errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, "could not store the subscription")
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, s)
+}
+
+// UpdateSubscription renews a subscription of the current user
+func (g Graph) UpdateSubscription(w http.ResponseWriter, r *http.Request) {
+	logger := g.logger.SubloggerWithRequestID(r.Context())
+	if !g.subscriptionsEnabled(w, r) {
+		return
+	}
+	s, ok := g.getOwnSubscription(w, r)
+	if !ok {
+		return
+	}
+
+	update := subscriptionUpdate{}
+	if err := StrictJSONUnmarshal(r.Body, &update); err != nil || update.ExpirationDateTime == nil {
+		logger.Debug().Err(err).Msg("could not update subscription: invalid request body")
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, "invalid request body, only the expirationDateTime can be changed")
+		return
+	}
+	if err := g.validateSubscriptionExpiration(*update.ExpirationDateTime); err != nil {
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resource, err := parseSubscriptionResource(s.Resource)
+	if err != nil {
+		errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, err.Error())
+		return
+	}
+	// the access could have been revoked since the subscription was created
+	if err := g.checkSubscriptionResourceAccess(r.Context(), resource, s.CreatorID); err != nil {
+		logger.Debug().Err(err).Str("subscriptionID", s.ID).Msg("could not update subscription: no access to the resource")
+		if subscriptionAccessRevoked(err) {
+			if err := g.deleteSubscription(r.Context(), s); err != nil {
+				logger.Error().Err(err).Str("subscriptionID", s.ID).Msg("could not delete subscription")
+			}
+		}
+		errorcode.RenderError(w, r, err)
+		return
+	}
+	s.ExpirationDateTime = *update.ExpirationDateTime
+	if err := g.storeSubscription(r.Context(), resource, s); err != nil {
+		logger.Error().Err(err).Str("subscriptionID", s.ID).Msg("could not update subscription: storing the subscription failed")
+		errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, "could not store the subscription")
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, s.public())
+}
+
+// DeleteSubscription deletes a subscription of the current user
+func (g Graph) DeleteSubscription(w http.ResponseWriter, r *http.Request) {
+	logger := g.logger.SubloggerWithRequestID(r.Context())
+	if !g.subscriptionsEnabled(w, r) {
+		return
+	}
+	s, ok := g.getOwnSubscription(w, r)
+	if !ok {
+		return
+	}
+
+	if err := g.deleteSubscription(r.Context(), s); err != nil {
+		logger.Error().Err(err).Str("subscriptionID", s.ID).Msg("could not delete subscription")
+		errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, "could not delete the subscription")
+		return
+	}
+
+	render.Status(r, http.StatusNoContent)
+	render.NoContent(w, r)
+}
+
+// subscriptionsEnabled renders an error if there is no store for the subscriptions
+func (g Graph) subscriptionsEnabled(w http.ResponseWriter, r *http.Request) bool {
+	if g.natskv == nil {
+		errorcode.NotSupported.Render(w, r, http.StatusNotImplemented, "subscriptions need a store")
+		return false
+	}
+	return true
+}
+
+// getOwnSubscription returns the subscription from the url, it renders an error if the subscription doesn't
+// exist, is expired or belongs to another user
+func (g Graph) getOwnSubscription(w http.ResponseWriter, r *http.Request) (subscription, bool) {
+	logger := g.logger.SubloggerWithRequestID(r.Context())
+	subscriptionID := chi.URLParam(r, "subscriptionID")
+	if _, err := uuid.Parse(subscriptionID); err != nil {
+		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, "invalid subscription id")
+		return subscription{}, false
+	}
+
+	subscriptions, err := g.loadSubscriptions(r.Context(), subscriptionKeyPrefix+"*."+subscriptionID)
+	if err != nil {
+		logger.Error().Err(err).Str("subscriptionID", subscriptionID).Msg("could not get subscription")
+		errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, "could not get the subscription")
+		return subscription{}, false
+	}
+
+	currentUser := revactx.ContextMustGetUser(r.Context())
+	if len(subscriptions) != 1 || subscriptions[0].CreatorID != currentUser.GetId().GetOpaqueId() {
+		errorcode.ItemNotFound.Render(w, r, http.StatusNotFound, "subscription not found")
+		return subscription{}, false
+	}
+	return subscriptions[0], true
+}
+
+// checkSubscriptionResourceAccess checks that the user can see the resource of a subscription. Users can only
+// subscribe to the changes of their own user.
+func (g Graph) checkSubscriptionResourceAccess(ctx context.Context, resource subscriptionResource, userID string) error {
+	if resource.userID != "" {
+		if resource.userID != userID {
+			return errorcode.New(errorcode.AccessDenied, "subscriptions for other users are not allowed")
+		}
+		return nil
+	}
+
+	gatewayClient, err := g.gatewaySelector.Next()
+	if err != nil {
+		return errorcode.New(errorcode.ServiceNotAvailable, err.Error())
+	}
+	id := resource.driveID
+	if resource.itemID != nil {
+		id = resource.itemID
+	}
+	res, err := gatewayClient.Stat(ctx, &storageprovider.StatRequest{Ref: &storageprovider.Reference{ResourceId: id}})
+	switch {
+	case err != nil:
+		return errorcode.New(errorcode.GeneralException, err.Error())
+	case res.GetStatus().GetCode() == cs3rpc.Code_CODE_NOT_FOUND, res.GetStatus().GetCode() == cs3rpc.Code_CODE_PERMISSION_DENIED:
+		return errorcode.New(errorcode.ItemNotFound, "resource not found")
+	case res.GetStatus().GetCode() != cs3rpc.Code_CODE_OK:
+		return errorcode.New(errorcode.GeneralException, res.GetStatus().GetMessage())
+	}
+	return nil
+}
+
+// checkSubscriptionCreatorAccess checks that the creator of a subscription can still see the resource. The
+// resource is looked up with a short-lived token of the creator, which is minted by the graph service, so the
+// current groups and shares of the creator are taken into account.
+func (g Graph) checkSubscriptionCreatorAccess(ctx context.Context, resource subscriptionResource, creatorID string) error {
+	gatewayClient, err := g.gatewaySelector.Next()
+	if err != nil {
+		return err
+	}
+	serviceCtx, err := utils.GetServiceUserContextWithContext(ctx, gatewayClient, g.config.ServiceAccount.ServiceAccountID, g.config.ServiceAccount.ServiceAccountSecret)
+	if err != nil {
+		return err
+	}
+	res, err := gatewayClient.GetUser(serviceCtx, &userpb.GetUserRequest{UserId: &userpb.UserId{OpaqueId: creatorID}})
+	switch {
+	case err != nil:
+		return err
+	case res.GetStatus().GetCode() == cs3rpc.Code_CODE_NOT_FOUND:
+		return errorcode.New(errorcode.ItemNotFound, "the creator of the subscription does not exist")
+	case res.GetStatus().GetCode() != cs3rpc.Code_CODE_OK:
+		return errorcode.New(errorcode.GeneralException, res.GetStatus().GetMessage())
+	}
+
+	tokenScope, err := scope.AddOwnerScope(nil)
+	if err != nil {
+		return err
+	}
+	token, err := g.subscriptionTokenManager.MintToken(ctx, res.GetUser(), tokenScope)
+	if err != nil {
+		return err
+	}
+	creatorCtx := revactx.ContextSetToken(revactx.ContextSetUser(ctx, res.GetUser()), token)
+	creatorCtx = metadata.AppendToOutgoingContext(creatorCtx, revactx.TokenHeader, token)
+	return g.checkSubscriptionResourceAccess(creatorCtx, resource, creatorID)
+}
+
+// subscriptionAccessRevoked returns true if the error of checkSubscriptionResourceAccess tells that the resource
+// is no longer accessible, other errors can be temporary
+func subscriptionAccessRevoked(err error) bool {
+	e, ok := errorcode.ToError(err)
+	return ok && (e.GetCode() == errorcode.ItemNotFound || e.GetCode() == errorcode.AccessDenied)
+}
+
+// validateSubscriptionExpiration checks that the expiration is in the future and not after the maximum lifetime
+func (g Graph) validateSubscriptionExpiration(expiration time.Time) error {
+	now := time.Now()
+	switch {
+	case !expiration.After(now):
+		return errors.New("the expirationDateTime must be in the future")
+	case expiration.After(now.Add(g.config.Subscriptions.MaxLifetime)):
+		return fmt.Errorf("the expirationDateTime must not be more than %s in the future", g.config.Subscriptions.MaxLifetime)
+	}
+	return nil
+}
+
+// validateNotificationURL checks the scheme and the host of a notification url
+func (g Graph) validateNotificationURL(notificationURL string) error {
+	u, err := url.Parse(notificationURL)
+	switch {
+	case err != nil, u.Scheme != "https" && u.Scheme != "http", u.Hostname() == "":
+		return errors.New("the notificationUrl must be a http or https url")
+	case len(g.config.Subscriptions.AllowedHosts) > 0 && !slices.Contains(g.config.Subscriptions.AllowedHosts, u.Hostname()):
+		return fmt.Errorf("the host '%s' is not allowed as notificationUrl", u.Hostname())
+	}
+	return nil
+}
+
+// validateNotificationEndpoint sends a validation token to the notification url which has to be returned
+// in the response body
+func (g Graph) validateNotificationEndpoint(ctx context.Context, notificationURL string) error {
+	u, err := url.Parse(notificationURL)
+	if err != nil {
+		return err
+	}
+	token := uuid.NewString()
+	q := u.Query()
+	q.Set("validationToken", token)
+	u.RawQuery = q.Encode()
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), g.config.Subscriptions.DeliveryTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), http.NoBody)
+	if err != nil {
+		return err
+	}
+	res, err := g.notificationClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, 1024))
+	switch {
+	case err != nil:
+		return err
+	case res.StatusCode != http.StatusOK:
+		return fmt.Errorf("unexpected status code %d", res.StatusCode)
+	case strings.TrimSpace(string(body)) != token:
+		return errors.New("the response does not contain the validation token")
+	}
+	return nil
+}
+
+// StartSubscriptionNotifications sends the notifications of the subscriptions for the changes on the event bus
+func (g Graph) StartSubscriptionNotifications(ctx context.Context, l log.Logger) error {
+	if g.eventsConsumer == nil || g.natskv == nil {
+		return nil
+	}
+
+	evChannel, err := events.Consume(g.eventsConsumer, "graph-subscriptions", _subscriptionEvents...)
+	if err != nil {
+		l.Error().Err(err).Msg("cannot consume from nats")
+		return err
+	}
+
+	go g.newSubscriptionNotifier().run(ctx, evChannel)
+	return nil
+}
+
+// newSubscriptionNotifier returns a notifier with as many workers as the configured concurrency
+func (g Graph) newSubscriptionNotifier() subscriptionNotifier {
+	workers := g.config.MaxConcurrency
+	if workers <= 0 {
+		workers = 1
+	}
+	return subscriptionNotifier{
+		g:       g,
+		workers: make(chan struct{}, workers),
+		retries: make(chan subscriptionDelivery),
+	}
+}
+
+// run handles the events and the retries of failed deliveries until the context is done
+func (n subscriptionNotifier) run(ctx context.Context, evChannel <-chan events.Event) {
+	for {
+		select {
+		case e := <-evChannel:
+			n.work(ctx, func() { n.notifySubscriptions(ctx, e) })
+		case d := <-n.retries:
+			n.work(ctx, func() { n.deliver(ctx, d) })
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// work runs f as soon as a worker is free
+func (n subscriptionNotifier) work(ctx context.Context, f func()) {
+	select {
+	case n.workers <- struct{}{}:
+	case <-ctx.Done():
+		return
+	}
+	go func() {
+		defer func() { <-n.workers }()
+		f()
+	}()
+}
+
+// notifySubscriptions sends a notification to the subscriptions matching the change of an event
+func (n subscriptionNotifier) notifySubscriptions(ctx context.Context, e events.Event) {
+	change, ok := subscriptionChangeFromEvent(e)
+	if !ok {
+		return
+	}
+
+	scope := change.scope()
+	if scope == "" {
+		return
+	}
+	subscriptions, err := n.g.loadSubscriptions(ctx, subscriptionKeyPrefix+deltaKeyHash(scope)+".*")
+	if err != nil {
+		n.g.logger.Error().Err(err).Str("event", e.Type).Msg("could not load subscriptions")
+		return
+	}
+	subscriptions = slices.DeleteFunc(subscriptions, func(s subscription) bool {
+		return !slices.Contains(strings.Split(s.ChangeType, ","), change.changeType)
+	})
+	if len(subscriptions) == 0 {
+		return
+	}
+
+	var (
+		itemID    string
+		ancestors []string
+	)
+	if change.userID == "" {
+		itemID, ancestors = n.g.resolveSubscriptionChange(change)
+	}
+
+	for _, s := range subscriptions {
+		resource, err := parseSubscriptionResource(s.Resource)
+		if err != nil {
+			continue
+		}
+		if resource.itemID != nil && !slices.Contains(ancestors, storagespace.FormatResourceID(resource.itemID)) {
+			continue
+		}
+		if resource.userID == "" {
+			err := n.g.checkSubscriptionCreatorAccess(ctx, resource, s.CreatorID)
+			switch {
+			case subscriptionAccessRevoked(err):
+				// the creator can no longer see the resource, or the subscribed item itself was deleted
+				n.g.logger.Debug().Err(err).Str("subscriptionID", s.ID).Msg("deleting subscription, the resource is no longer accessible")
+				if err := n.g.deleteSubscription(ctx, s); err != nil {
+					n.g.logger.Error().Err(err).Str("subscriptionID", s.ID).Msg("could not delete subscription")
+				}
+				if change.changeType != subscriptionChangeDeleted || itemID != storagespace.FormatResourceID(resource.itemID) {
+					continue
+				}
+			case err != nil:
+				n.g.logger.Error().Err(err).Str("subscriptionID", s.ID).Msg("could not check the access of the subscription creator")
+				continue
+			}
+		}
+
+		notification := subscriptionNotification{
+			SubscriptionID:                 s.ID,
+			SubscriptionExpirationDateTime: s.ExpirationDateTime,
+			ClientState:                    s.ClientState,
+			ChangeType:                     change.changeType,
+			Resource:                       s.Resource,
+		}
+		if itemID != "" {
+			notification.ResourceData = &subscriptionNotificationData{ID: itemID}
+		}
+		body, err := json.Marshal(subscriptionNotifications{Value: []subscriptionNotification{notification}})
+		if err != nil {
+			n.g.logger.Error().Err(err).Str("subscriptionID", s.ID).Msg("could not encode notification")
+			continue
+		}
+		n.deliver(ctx, subscriptionDelivery{subscription: s, body: body})
+	}
+}
+
+// deliver posts a notification to the notification url of a subscription. Failed deliveries are queued
+// again after a delay until they ran out of retries.
+func (n subscriptionNotifier) deliver(ctx context.Context, d subscriptionDelivery) {
+	logger := n.g.logger.With().Str("subscriptionID", d.subscription.ID).Int("attempt", d.attempt).Logger()
+	err := n.g.postNotification(ctx, d.subscription, d.body)
+	switch {
+	case err == nil:
+		return
+	case d.attempt >= n.g.config.Subscriptions.DeliveryRetries:
+		logger.Error().Err(err).Msg("could not deliver notification")
+		return
+	}
+
+	delay := subscriptionRetryDelay << d.attempt
+	logger.Debug().Err(err).Dur("delay", delay).Msg("could not deliver notification, retrying")
+	d.attempt++
+	time.AfterFunc(delay, func() {
+		select {
+		case n.retries <- d:
+		case <-ctx.Done():
+		}
+	})
+}
+
+// resolveSubscriptionChange returns the id of the changed item and the ids of the item and all its parents
+func (g Graph) resolveSubscriptionChange(change subscriptionChange) (string, []string) {
+	gatewayClient, err := g.gatewaySelector.Next()
+	if err != nil {
+		g.logger.Error().Err(err).Msg("could not resolve change: no gateway client")
+		return "", nil
+	}
+	ctx, err := utils.GetServiceUserContext(g.config.ServiceAccount.ServiceAccountID, gatewayClient, g.config.ServiceAccount.ServiceAccountSecret)
+	if err != nil {
+		g.logger.Error().Err(err).Msg("could not resolve change: no service user context")
+		return "", nil
+	}
+
+	stat := func(ref *storageprovider.Reference) *storageprovider.ResourceInfo {
+		res, err := gatewayClient.Stat(ctx, &storageprovider.StatRequest{Ref: ref})
+		if err != nil || res.GetStatus().GetCode() != cs3rpc.Code_CODE_OK {
+			return nil
+		}
+		return res.GetInfo()
+	}
+
+	id, parentID := change.id, change.parentID
+	if id == nil || parentID == nil {
+		// deleted items can't be found anymore, their parent is taken from the reference
+		if info := stat(change.ref); info != nil {
+			id, parentID = info.GetId(), info.GetParentId()
+		} else if p := change.ref.GetPath(); parentID == nil && p != "" && p != "." {
+			parentID = change.ref.GetResourceId()
+		}
+	}
+
+	var itemID string
+	var ancestors []string
+	if id != nil {
+		itemID = storagespace.FormatResourceID(id)
+		ancestors = append(ancestors, itemID)
+	}
+	for i := 0; parentID != nil && i < subscriptionMaxAncestors; i++ {
+		ancestors = append(ancestors, storagespace.FormatResourceID(parentID))
+		if parentID.GetOpaqueId() == parentID.GetSpaceId() {
+			break
+		}
+		info := stat(&storageprovider.Reference{ResourceId: parentID})
+		if info == nil {
+			break
+		}
+		parentID = info.GetParentId()
+	}
+	return itemID, ancestors
+}
+
+func (g Graph) postNotification(ctx context.Context, s subscription, body []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, g.config.Subscriptions.DeliveryTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.NotificationURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(headerSubscriptionTimestamp, timestamp)
+	req.Header.Set(headerSubscriptionSignature, subscriptionSignature(s.SigningSecret, timestamp, body))
+
+	res, err := g.notificationClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+	return nil
+}
+
+// newNotificationClient returns the http client for the requests to the notification urls. Redirects are not
+// followed. Unless the hosts are restricted by the configuration, only public addresses can be connected to.
+// The addresses are checked after the host names are resolved, so a host name can't point to an internal service.
+func newNotificationClient(cfg config.Subscriptions) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if len(cfg.AllowedHosts) == 0 {
+		dialer.Control = rejectNonPublicAddress
+		// with a proxy the address of the proxy would be checked instead of the notification url
+		transport.Proxy = nil
+	}
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// rejectNonPublicAddress is called by the dialer with the resolved address before it connects
+func rejectNonPublicAddress(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+	if !isPublicAddress(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", errNonPublicAddress, addrPort.Addr())
+	}
+	return nil
+}
+
+// isPublicAddress checks that an address is reachable from the internet. Loopback, private, link local,
+// multicast and unspecified addresses are not.
+func isPublicAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsGlobalUnicast() && !addr.IsPrivate() && !_sharedAddressSpace.Contains(addr)
+}
+
+// subscriptionSignature returns the HMAC-SHA256 of the timestamp and the body, separated by a dot
+func subscriptionSignature(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// loadSubscriptions returns the subscriptions matching the key filter, expired subscriptions are deleted
+func (g Graph) loadSubscriptions(ctx context.Context, filter string) ([]subscription, error) {
+	lister, err := g.natskv.ListKeysFiltered(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = lister.Stop() }()
+
+	var subscriptions []subscription
+	for key := range lister.Keys() {
+		entry, err := g.natskv.Get(ctx, key)
+		switch {
+		case errors.Is(err, jetstream.ErrKeyNotFound):
+			continue
+		case err != nil:
+			return nil, err
+		}
+
+		s := subscription{}
+		if err := json.Unmarshal(entry.Value(), &s); err != nil {
+			g.logger.Error().Err(err).Str("key", key).Msg("could not read subscription")
+			continue
+		}
+		if time.Now().After(s.ExpirationDateTime) {
+			if err := g.natskv.Delete(ctx, key); err != nil && !errors.Is(err, jetstream.ErrKeyNotFound) {
+				g.logger.Debug().Err(err).Str("key", key).Msg("could not delete expired subscription")
+			}
+			continue
+		}
+		subscriptions = append(subscriptions, s)
+	}
+	return subscriptions, nil
+}
+
+// storeSubscription stores the subscription in the nats key value store
+func (g Graph) storeSubscription(ctx context.Context, resource subscriptionResource, s subscription) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	_, err = g.natskv.Put(ctx, subscriptionKey(resource.scope(), s.ID), data)
+	return err
+}
+
+// deleteSubscription deletes the subscription from the nats key value store
+func (g Graph) deleteSubscription(ctx context.Context, s subscription) error {
+	resource, err := parseSubscriptionResource(s.Resource)
+	if err != nil {
+		return err
+	}
+	err = g.natskv.Delete(ctx, subscriptionKey(resource.scope(), s.ID))
+	if errors.Is(err, jetstream.ErrKeyNotFound) {
+		return nil
+	}
+	return err
+}
+
+// subscriptionKey returns the key of a subscription. The key contains the drive or user of the resource,
+// so the subscriptions for a change can be listed without reading all subscriptions.
+func subscriptionKey(scope, id string) string {
+	return subscriptionKeyPrefix + deltaKeyHash(scope) + "." + id
+}
+
+// public returns the subscription without the signing secret
+func (s subscription) public() subscription {
+	s.SigningSecret = ""
+	return s
+}
+
+// parseSubscriptionResource parses the resource of a subscription, supported are `drives/{driveID}`,
+// `drives/{driveID}/root`, `drives/{driveID}/items/{itemID}` and `users/{userID}`
+func parseSubscriptionResource(resource string) (subscriptionResource, error) {
+	parts := strings.Split(strings.Trim(resource, "/"), "/")
+	switch {
+	case len(parts) == 2 && parts[0] == "users" && parts[1] != "":
+		return subscriptionResource{userID: parts[1]}, nil
+	case len(parts) < 2 || parts[0] != "drives":
+		return subscriptionResource{}, fmt.Errorf("unsupported resource '%s'", resource)
+	}
+
+	driveID, err := storagespace.ParseID(parts[1])
+	if err != nil || driveID.GetSpaceId() == "" {
+		return subscriptionResource{}, fmt.Errorf("invalid drive id in resource '%s'", resource)
+	}
+	driveID.OpaqueId = ""
+
+	switch {
+	case len(parts) == 2, len(parts) == 3 && parts[2] == "root":
+		return subscriptionResource{driveID: &driveID}, nil
+	case len(parts) == 4 && parts[2] == "items":
+		itemID, err := storagespace.ParseID(parts[3])
+		if err != nil || itemID.GetStorageId() != driveID.GetStorageId() || itemID.GetSpaceId() != driveID.GetSpaceId() || itemID.GetOpaqueId() == "" {
+			return subscriptionResource{}, fmt.Errorf("invalid item id in resource '%s'", resource)
+		}
+		return subscriptionResource{driveID: &driveID, itemID: &itemID}, nil
+	}
+	return subscriptionResource{}, fmt.Errorf("unsupported resource '%s'", resource)
+}
+
+// scope returns the drive or the user of the resource
+func (r subscriptionResource) scope() string {
+	if r.userID != "" {
+		return "users/" + r.userID
+	}
+	return storagespace.FormatStorageID(r.driveID.GetStorageId(), r.driveID.GetSpaceId())
+}
+
+// validateSubscriptionChangeType checks the comma separated list of change types
+func validateSubscriptionChangeType(changeType string) error {
+	if changeType == "" {
+		return errors.New("the changeType is required")
+	}
+	for _, t := range strings.Split(changeType, ",") {
+		switch t {
+		case subscriptionChangeCreated, subscriptionChangeUpdated, subscriptionChangeDeleted:
+		default:
+			return fmt.Errorf("unsupported changeType '%s'", t)
+		}
+	}
+	return nil
+}
+
+// subscriptionChangeFromEvent returns the change of an event
+func subscriptionChangeFromEvent(e events.Event) (subscriptionChange, bool) {
+	switch ev := e.Event.(type) {
+	case events.ContainerCreated:
+		return subscriptionChange{changeType: subscriptionChangeCreated, ref: ev.Ref, parentID: ev.ParentID}, true
+	case events.FileTouched:
+		return subscriptionChange{changeType: subscriptionChangeCreated, ref: ev.Ref, parentID: ev.ParentID}, true
+	case events.UploadReady:
+		if ev.Failed {
+			return subscriptionChange{}, false
+		}
+		changeType := subscriptionChangeCreated
+		if ev.IsVersion {
+			changeType = subscriptionChangeUpdated
+		}
+		return subscriptionChange{changeType: changeType, ref: ev.FileRef, parentID: ev.ParentID}, true
+	case events.ItemMoved:
+		return subscriptionChange{changeType: subscriptionChangeUpdated, ref: ev.Ref}, true
+	case events.FileVersionRestored:
+		return subscriptionChange{changeType: subscriptionChangeUpdated, ref: ev.Ref}, true
+	case events.ItemRestored:
+		return subscriptionChange{changeType: subscriptionChangeCreated, ref: ev.Ref, id: ev.ID}, true
+	case events.ItemTrashed:
+		return subscriptionChange{changeType: subscriptionChangeDeleted, ref: ev.Ref, id: ev.ID}, true
+	case events.UserDeleted:
+		return subscriptionChange{changeType: subscriptionChangeDeleted, userID: ev.UserID}, true
+	case events.UserFeatureChanged:
+		return subscriptionChange{changeType: subscriptionChangeUpdated, userID: ev.UserID}, true
+	}
+	return subscriptionChange{}, false
+}
+
+// scope returns the drive or the user of the change
+func (c subscriptionChange) scope() string {
+	if c.userID != "" {
+		return "users/" + c.userID
+	}
+	for _, id := range []*storageprovider.ResourceId{c.id, c.parentID, c.ref.GetResourceId()} {
+		if id.GetSpaceId() != "" {
+			return storagespace.FormatStorageID(id.GetStorageId(), id.GetSpaceId())
+		}
+	}
+	return ""
+}
@@ -0,0 +1,478 @@
+package svc_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	userpb "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/go-chi/chi/v5"
+	"github.com/nats-io/nats.go/jetstream"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+
+	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/status"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
+	cs3mocks "github.com/opencloud-eu/reva/v2/tests/cs3mocks/mocks"
+
+	"github.com/opencloud-eu/opencloud/pkg/shared"
+	"github.com/opencloud-eu/opencloud/services/graph/mocks"
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/config/defaults"
+	service "github.com/opencloud-eu/opencloud/services/graph/pkg/service/v0"
+)
+
+type subscriptionResponse struct {
+	ID                 string
+	Resource           string
+	ChangeType         string
+	NotificationURL    string
+	ExpirationDateTime time.Time
+	SigningSecret      string
+}
+
+type subscriptionList struct {
+	Value []subscriptionResponse
+}
+
+type receivedNotification struct {
+	signature string
+	timestamp string
+	body      []byte
+}
+
+// natsSubjectMatches matches a key against a nats subject filter with the `*` and `>` wildcards
+func natsSubjectMatches(filter, key string) bool {
+	filterTokens := strings.Split(filter, ".")
+	keyTokens := strings.Split(key, ".")
+	for i, token := range filterTokens {
+		switch {
+		case token == ">":
+			return len(keyTokens) > i
+		case i >= len(keyTokens):
+			return false
+		case token != "*" && token != keyTokens[i]:
+			return false
+		}
+	}
+	return len(filterTokens) == len(keyTokens)
+}
+
+var _ = Describe("Subscriptions", func() {
+	var (
+		svc              service.Graph
+		ctx              context.Context
+		cfg              *config.Config
+		gatewayClient    *cs3mocks.GatewayAPIClient
+		natsKeyValueMock *mocks.KeyValue
+		store            map[string][]byte
+		storeMutex       sync.Mutex
+
+		receiver          *httptest.Server
+		notifications     chan receivedNotification
+		validate          func(token string) string
+		failNotifications atomic.Int32
+		newService        func()
+
+		currentUser = &userpb.User{
+			Id: &userpb.UserId{
+				OpaqueId: "user",
+			},
+		}
+	)
+
+	do := func(handler http.HandlerFunc, method, target, body, subscriptionID string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, target, bytes.NewBufferString(body))
+		rctx := chi.NewRouteContext()
+		if subscriptionID != "" {
+			rctx.URLParams.Add("subscriptionID", subscriptionID)
+		}
+		r = r.WithContext(context.WithValue(revactx.ContextSetUser(ctx, currentUser), chi.RouteCtxKey, rctx))
+		rr := httptest.NewRecorder()
+		handler(rr, r)
+		return rr
+	}
+
+	createSubscription := func(resource string) *httptest.ResponseRecorder {
+		body := fmt.Sprintf(`{"resource": %q, "changeType": "created,deleted", "notificationUrl": %q, "clientState": "secret state", "expirationDateTime": %q}`,
+			resource, receiver.URL+"/notify", time.Now().Add(time.Hour).Format(time.RFC3339))
+		return do(svc.CreateSubscription, http.MethodPost, "/graph/v1.0/subscriptions", body, "")
+	}
+
+	parseSubscription := func(rr *httptest.ResponseRecorder) subscriptionResponse {
+		s := subscriptionResponse{}
+		Expect(json.Unmarshal(rr.Body.Bytes(), &s)).To(Succeed())
+		return s
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		notifications = make(chan receivedNotification, 10)
+		validate = func(token string) string { return token }
+		failNotifications.Store(0)
+		receiver = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/redirect" {
+				http.Redirect(w, r, "/notify?"+r.URL.RawQuery, http.StatusTemporaryRedirect)
+				return
+			}
+			if token := r.URL.Query().Get("validationToken"); token != "" {
+				_, _ = io.WriteString(w, validate(token))
+				return
+			}
+			if failNotifications.Add(-1) >= 0 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			body, _ := io.ReadAll(r.Body)
+			notifications <- receivedNotification{
+				signature: r.Header.Get("X-OpenCloud-Signature"),
+				timestamp: r.Header.Get("X-OpenCloud-Signature-Timestamp"),
+				body:      body,
+			}
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		DeferCleanup(receiver.Close)
+
+		pool.RemoveSelector("GatewaySelector" + "eu.opencloud.api.gateway")
+		gatewayClient = &cs3mocks.GatewayAPIClient{}
+		gatewaySelector := pool.GetSelector[gateway.GatewayAPIClient](
+			"GatewaySelector",
+			"eu.opencloud.api.gateway",
+			func(cc grpc.ClientConnInterface) gateway.GatewayAPIClient {
+				return gatewayClient
+			},
+		)
+
+		store = map[string][]byte{}
+		natsKeyValueMock = &mocks.KeyValue{}
+		natsKeyValueMock.EXPECT().Get(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, key string) (jetstream.KeyValueEntry, error) {
+			storeMutex.Lock()
+			defer storeMutex.Unlock()
+			value, ok := store[key]
+			if !ok {
+				return nil, jetstream.ErrKeyNotFound
+			}
+			kve := &mocks.KeyValueEntry{}
+			kve.On("Value").Return(value)
+			return kve, nil
+		}).Maybe()
+		natsKeyValueMock.EXPECT().Put(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, key string, val []byte) (uint64, error) {
+			storeMutex.Lock()
+			defer storeMutex.Unlock()
+			store[key] = val
+			return 1, nil
+		}).Maybe()
+		natsKeyValueMock.EXPECT().Delete(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, key string, _ ...jetstream.KVDeleteOpt) error {
+			storeMutex.Lock()
+			defer storeMutex.Unlock()
+			delete(store, key)
+			return nil
+		}).Maybe()
+		natsKeyValueMock.On("ListKeysFiltered", mock.Anything, mock.Anything).Return(func(_ context.Context, filters ...string) (jetstream.KeyLister, error) {
+			storeMutex.Lock()
+			defer storeMutex.Unlock()
+			keys := make(chan string, len(store))
+			for key := range store {
+				if natsSubjectMatches(filters[0], key) {
+					keys <- key
+				}
+			}
+			close(keys)
+			lister := &mocks.KeyLister{}
+			lister.On("Keys").Return((<-chan string)(keys))
+			lister.On("Stop").Return(nil)
+			return lister, nil
+		}).Maybe()
+
+		gatewayClient.On("Stat", mock.Anything, mock.MatchedBy(func(req *provider.StatRequest) bool {
+			return req.GetRef().GetResourceId().GetSpaceId() == "spaceid" && req.GetRef().GetPath() == ""
+		})).Return(&provider.StatResponse{Status: status.NewOK(ctx), Info: &provider.ResourceInfo{}}, nil).Maybe()
+		gatewayClient.On("Stat", mock.Anything, mock.Anything).Return(&provider.StatResponse{Status: status.NewNotFound(ctx, "not found")}, nil).Maybe()
+
+		cfg = defaults.FullDefaultConfig()
+		cfg.Identity.LDAP.CACert = "" // skip the startup checks, we don't use LDAP at all in this tests
+		cfg.TokenManager.JWTSecret = "loremipsum"
+		cfg.Commons = &shared.Commons{}
+		cfg.GRPCClientTLS = &shared.GRPCClientTLS{}
+		cfg.Subscriptions.DeliveryRetries = 0
+		// the receiver listens on the loopback interface, which is only allowed for configured hosts
+		cfg.Subscriptions.AllowedHosts = []string{"127.0.0.1"}
+
+		newService = func() {
+			var err error
+			svc, err = service.NewService(
+				service.Config(cfg),
+				service.WithGatewaySelector(gatewaySelector),
+				service.WithNatsKeyValue(natsKeyValueMock),
+			)
+			Expect(err).ToNot(HaveOccurred())
+		}
+		newService()
+	})
+
+	Describe("CreateSubscription", func() {
+		It("creates a subscription for a drive", func() {
+			rr := createSubscription("drives/storageid$spaceid")
+			Expect(rr.Code).To(Equal(http.StatusCreated))
+
+			s := parseSubscription(rr)
+			Expect(s.ID).ToNot(BeEmpty())
+			Expect(s.SigningSecret).ToNot(BeEmpty())
+			Expect(store).To(HaveLen(1))
+		})
+
+		It("rejects notification urls which fail the validation", func() {
+			validate = func(string) string { return "wrong" }
+			Expect(createSubscription("drives/storageid$spaceid").Code).To(Equal(http.StatusBadRequest))
+			Expect(store).To(BeEmpty())
+		})
+
+		It("rejects drives the user can't access", func() {
+			Expect(createSubscription("drives/storageid$otherspace").Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("rejects other users", func() {
+			Expect(createSubscription("users/otheruser").Code).To(Equal(http.StatusForbidden))
+			Expect(createSubscription("users/user").Code).To(Equal(http.StatusCreated))
+		})
+
+		It("rejects hosts which are not allowed", func() {
+			cfg.Subscriptions.AllowedHosts = []string{"example.com"}
+			Expect(createSubscription("drives/storageid$spaceid").Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("rejects internal addresses if the hosts are not restricted", func() {
+			cfg.Subscriptions.AllowedHosts = nil
+			newService()
+			Expect(createSubscription("drives/storageid$spaceid").Code).To(Equal(http.StatusBadRequest))
+			Expect(store).To(BeEmpty())
+		})
+
+		It("doesn't follow redirects", func() {
+			body := fmt.Sprintf(`{"resource": "drives/storageid$spaceid", "changeType": "created", "notificationUrl": %q, "expirationDateTime": %q}`,
+				receiver.URL+"/redirect", time.Now().Add(time.Hour).Format(time.RFC3339))
+			Expect(do(svc.CreateSubscription, http.MethodPost, "/graph/v1.0/subscriptions", body, "").Code).To(Equal(http.StatusBadRequest))
+			Expect(store).To(BeEmpty())
+		})
+
+		DescribeTable("rejects invalid subscriptions",
+			func(body string) {
+				Expect(do(svc.CreateSubscription, http.MethodPost, "/graph/v1.0/subscriptions", body, "").Code).To(Equal(http.StatusBadRequest))
+			},
+			Entry("invalid json", `{`),
+			Entry("unsupported resource", `{"resource": "groups/group1", "changeType": "updated", "notificationUrl": "https://example.com", "expirationDateTime": "2999-01-01T00:00:00Z"}`),
+			Entry("unsupported change type", `{"resource": "drives/storageid$spaceid", "changeType": "renamed", "notificationUrl": "https://example.com", "expirationDateTime": "2999-01-01T00:00:00Z"}`),
+			Entry("expiration too far in the future", `{"resource": "drives/storageid$spaceid", "changeType": "updated", "notificationUrl": "https://example.com", "expirationDateTime": "2999-01-01T00:00:00Z"}`),
+			Entry("expired", `{"resource": "drives/storageid$spaceid", "changeType": "updated", "notificationUrl": "https://example.com", "expirationDateTime": "2000-01-01T00:00:00Z"}`),
+			Entry("invalid notification url", `{"resource": "drives/storageid$spaceid", "changeType": "updated", "notificationUrl": "ftp://example.com", "expirationDateTime": "2999-01-01T00:00:00Z"}`),
+		)
+	})
+
+	Describe("managing subscriptions", func() {
+		var created subscriptionResponse
+
+		BeforeEach(func() {
+			rr := createSubscription("drives/storageid$spaceid")
+			Expect(rr.Code).To(Equal(http.StatusCreated))
+			created = parseSubscription(rr)
+		})
+
+		It("lists and gets the subscription without the secret", func() {
+			rr := do(svc.ListSubscriptions, http.MethodGet, "/graph/v1.0/subscriptions", "", "")
+			Expect(rr.Code).To(Equal(http.StatusOK))
+			list := subscriptionList{}
+			Expect(json.Unmarshal(rr.Body.Bytes(), &list)).To(Succeed())
+			Expect(list.Value).To(HaveLen(1))
+			Expect(list.Value[0].ID).To(Equal(created.ID))
+			Expect(list.Value[0].SigningSecret).To(BeEmpty())
+
+			rr = do(svc.GetSubscription, http.MethodGet, "/graph/v1.0/subscriptions/"+created.ID, "", created.ID)
+			Expect(rr.Code).To(Equal(http.StatusOK))
+			Expect(parseSubscription(rr).SigningSecret).To(BeEmpty())
+		})
+
+		It("hides the subscription from other users", func() {
+			currentUser = &userpb.User{Id: &userpb.UserId{OpaqueId: "otheruser"}}
+			DeferCleanup(func() { currentUser = &userpb.User{Id: &userpb.UserId{OpaqueId: "user"}} })
+
+			Expect(do(svc.GetSubscription, http.MethodGet, "/graph/v1.0/subscriptions/"+created.ID, "", created.ID).Code).To(Equal(http.StatusNotFound))
+			Expect(do(svc.DeleteSubscription, http.MethodDelete, "/graph/v1.0/subscriptions/"+created.ID, "", created.ID).Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("renews the subscription", func() {
+			expiration := time.Now().Add(2 * time.Hour).Truncate(time.Second).UTC()
+			body := fmt.Sprintf(`{"expirationDateTime": %q}`, expiration.Format(time.RFC3339))
+			rr := do(svc.UpdateSubscription, http.MethodPatch, "/graph/v1.0/subscriptions/"+created.ID, body, created.ID)
+			Expect(rr.Code).To(Equal(http.StatusOK))
+			Expect(parseSubscription(rr).ExpirationDateTime).To(BeTemporally("==", expiration))
+
+			body = `{"expirationDateTime": "2999-01-01T00:00:00Z"}`
+			Expect(do(svc.UpdateSubscription, http.MethodPatch, "/graph/v1.0/subscriptions/"+created.ID, body, created.ID).Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("deletes the subscription when it is renewed without access to the resource", func() {
+			gatewayClient.ExpectedCalls = nil
+			gatewayClient.On("Stat", mock.Anything, mock.Anything).Return(&provider.StatResponse{Status: status.NewPermissionDenied(ctx, nil, "denied")}, nil)
+
+			body := fmt.Sprintf(`{"expirationDateTime": %q}`, time.Now().Add(2*time.Hour).Format(time.RFC3339))
+			rr := do(svc.UpdateSubscription, http.MethodPatch, "/graph/v1.0/subscriptions/"+created.ID, body, created.ID)
+			Expect(rr.Code).To(Equal(http.StatusNotFound))
+			Expect(store).To(BeEmpty())
+		})
+
+		It("deletes the subscription", func() {
+			rr := do(svc.DeleteSubscription, http.MethodDelete, "/graph/v1.0/subscriptions/"+created.ID, "", created.ID)
+			Expect(rr.Code).To(Equal(http.StatusNoContent))
+			Expect(store).To(BeEmpty())
+		})
+	})
+
+	Describe("notifications", func() {
+		var created subscriptionResponse
+
+		BeforeEach(func() {
+			rr := createSubscription("drives/storageid$spaceid/items/storageid$spaceid!folder")
+			Expect(rr.Code).To(Equal(http.StatusCreated))
+			created = parseSubscription(rr)
+
+			gatewayClient.On("Authenticate", mock.Anything, mock.Anything).Return(&gateway.AuthenticateResponse{
+				Status: status.NewOK(ctx),
+				Token:  "token",
+			}, nil).Maybe()
+			gatewayClient.On("GetUser", mock.Anything, mock.Anything).Return(&userpb.GetUserResponse{
+				Status: status.NewOK(ctx),
+				User:   currentUser,
+			}, nil).Maybe()
+		})
+
+		// denyFolder lets the stat requests for the subscribed folder fail
+		denyFolder := func(st *rpc.Status) {
+			calls := gatewayClient.ExpectedCalls
+			gatewayClient.ExpectedCalls = nil
+			gatewayClient.On("Stat", mock.Anything, mock.MatchedBy(func(req *provider.StatRequest) bool {
+				return req.GetRef().GetResourceId().GetOpaqueId() == "folder"
+			})).Return(&provider.StatResponse{Status: st}, nil)
+			gatewayClient.ExpectedCalls = append(gatewayClient.ExpectedCalls, calls...)
+		}
+
+		It("sends signed notifications for changes below the item", func() {
+			service.NotifySubscriptions(svc, ctx, events.Event{Event: events.ItemTrashed{
+				ID:  &provider.ResourceId{StorageId: "storageid", SpaceId: "spaceid", OpaqueId: "file"},
+				Ref: &provider.Reference{ResourceId: &provider.ResourceId{StorageId: "storageid", SpaceId: "spaceid", OpaqueId: "folder"}, Path: "./file.txt"},
+			}})
+
+			var n receivedNotification
+			Eventually(notifications).Should(Receive(&n))
+			Expect(n.signature).To(Equal(service.SubscriptionSignature(created.SigningSecret, n.timestamp, n.body)))
+
+			body := struct {
+				Value []map[string]any
+			}{}
+			Expect(json.Unmarshal(n.body, &body)).To(Succeed())
+			Expect(body.Value).To(HaveLen(1))
+			Expect(body.Value[0]["subscriptionId"]).To(Equal(created.ID))
+			Expect(body.Value[0]["changeType"]).To(Equal("deleted"))
+			Expect(body.Value[0]["clientState"]).To(Equal("secret state"))
+			Expect(body.Value[0]["resourceData"]).To(Equal(map[string]any{"id": "storageid$spaceid!file"}))
+		})
+
+		It("retries failed deliveries without blocking a worker", func() {
+			cfg.MaxConcurrency = 1
+			cfg.Subscriptions.DeliveryRetries = 1
+			DeferCleanup(service.SetSubscriptionRetryDelay(200 * time.Millisecond))
+			failNotifications.Store(1)
+
+			notifierCtx, cancel := context.WithCancel(ctx)
+			DeferCleanup(cancel)
+			evChannel := make(chan events.Event)
+			go service.RunSubscriptionNotifier(svc, notifierCtx, evChannel)
+
+			for _, id := range []string{"first", "second"} {
+				evChannel <- events.Event{Event: events.ItemTrashed{
+					ID:  &provider.ResourceId{StorageId: "storageid", SpaceId: "spaceid", OpaqueId: id},
+					Ref: &provider.Reference{ResourceId: &provider.ResourceId{StorageId: "storageid", SpaceId: "spaceid", OpaqueId: "folder"}, Path: "./" + id + ".txt"},
+				}}
+			}
+
+			// the failed notification of the first change waits for its retry without delaying the second change
+			for _, id := range []string{"second", "first"} {
+				var n receivedNotification
+				Eventually(notifications, 5*time.Second).Should(Receive(&n))
+				Expect(string(n.body)).To(ContainSubstring(`"id":"storageid$spaceid!` + id + `"`))
+			}
+		})
+
+		It("deletes the subscription when the creator lost the access to the item", func() {
+			denyFolder(status.NewPermissionDenied(ctx, nil, "denied"))
+
+			service.NotifySubscriptions(svc, ctx, events.Event{Event: events.ItemTrashed{
+				ID:  &provider.ResourceId{StorageId: "storageid", SpaceId: "spaceid", OpaqueId: "file"},
+				Ref: &provider.Reference{ResourceId: &provider.ResourceId{StorageId: "storageid", SpaceId: "spaceid", OpaqueId: "folder"}, Path: "./file.txt"},
+			}})
+			Consistently(notifications, 100*time.Millisecond).ShouldNot(Receive())
+			Expect(store).To(BeEmpty())
+		})
+
+		It("sends the deletion of the subscribed item and deletes the subscription", func() {
+			denyFolder(status.NewNotFound(ctx, "not found"))
+
+			service.NotifySubscriptions(svc, ctx, events.Event{Event: events.ItemTrashed{
+				ID:  &provider.ResourceId{StorageId: "storageid", SpaceId: "spaceid", OpaqueId: "folder"},
+				Ref: &provider.Reference{ResourceId: &provider.ResourceId{StorageId: "storageid", SpaceId: "spaceid", OpaqueId: "spaceid"}, Path: "./folder"},
+			}})
+			var n receivedNotification
+			Eventually(notifications).Should(Receive(&n))
+			Expect(string(n.body)).To(ContainSubstring(`"id":"storageid$spaceid!folder"`))
+			Expect(store).To(BeEmpty())
+		})
+
+		It("ignores changes outside of the item", func() {
+			service.NotifySubscriptions(svc, ctx, events.Event{Event: events.ItemTrashed{
+				ID:  &provider.ResourceId{StorageId: "storageid", SpaceId: "spaceid", OpaqueId: "file"},
+				Ref: &provider.Reference{ResourceId: &provider.ResourceId{StorageId: "storageid", SpaceId: "spaceid", OpaqueId: "spaceid"}, Path: "./file.txt"},
+			}})
+			Consistently(notifications, 100*time.Millisecond).ShouldNot(Receive())
+		})
+
+		It("ignores change types which were not subscribed", func() {
+			service.NotifySubscriptions(svc, ctx, events.Event{Event: events.FileVersionRestored{
+				Ref: &provider.Reference{ResourceId: &provider.ResourceId{StorageId: "storageid", SpaceId: "spaceid", OpaqueId: "folder"}},
+			}})
+			Consistently(notifications, 100*time.Millisecond).ShouldNot(Receive())
+		})
+	})
+
+	DescribeTable("isPublicAddress",
+		func(address string, public bool) {
+			Expect(service.IsPublicAddress(netip.MustParseAddr(address))).To(Equal(public))
+		},
+		Entry("loopback", "127.0.0.1", false),
+		Entry("IPv6 loopback", "::1", false),
+		Entry("IPv4 mapped loopback", "::ffff:127.0.0.1", false),
+		Entry("private", "10.0.0.1", false),
+		Entry("IPv6 unique local", "fd00::1", false),
+		Entry("link local", "169.254.169.254", false),
+		Entry("shared address space", "100.64.0.1", false),
+		Entry("unspecified", "0.0.0.0", false),
+		Entry("multicast", "224.0.0.1", false),
+		Entry("public", "203.0.113.10", true),
+		Entry("IPv6 public", "2001:4860:4860::8888", true),
+	)
+})
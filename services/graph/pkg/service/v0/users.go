@@ -34,6 +34,7 @@ import (
 	settingssvc "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/settings/v0"
 	"github.com/opencloud-eu/opencloud/services/graph/pkg/errorcode"
 	"github.com/opencloud-eu/opencloud/services/graph/pkg/identity"
+	graphm "github.com/opencloud-eu/opencloud/services/graph/pkg/middleware"
 	"github.com/opencloud-eu/opencloud/services/graph/pkg/odata"
 	"github.com/opencloud-eu/opencloud/services/graph/pkg/userstate"
 	ocsettingssvc "github.com/opencloud-eu/opencloud/services/settings/pkg/service/v0"
@@ -864,6 +865,11 @@ func (g Graph) PatchUser(w http.ResponseWriter, r *http.Request) {
 		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, "user is not allowed to change other users language")
 		return
 	}
+	if graphm.PasswordResetOnly(r.Context()) && !reflect.DeepEqual(*changes, libregraph.UserUpdate{PasswordProfile: changes.PasswordProfile}) {
+		logger.Debug().Str("id", nameOrID).Msg("could not update user: user is only allowed to reset the password")
+		errorcode.AccessDenied.Render(w, r, http.StatusForbidden, "user is only allowed to reset the password")
+		return
+	}
 
 	g.patchUser(w, r, nameOrID, changes)
 }
@@ -888,6 +894,20 @@ func (g Graph) patchUser(w http.ResponseWriter, r *http.Request, nameOrID string
 		return
 	}
 
+	if graphm.PasswordResetOnly(r.Context()) {
+		permissions, err := g.userPermissions(r.Context(), oldUserValues.GetId())
+		if err != nil {
+			logger.Error().Err(err).Str("id", oldUserValues.GetId()).Msg("could not update user: could not get the permissions of the user")
+			errorcode.GeneralException.Render(w, r, http.StatusInternalServerError, "could not get the permissions of the user")
+			return
+		}
+		if !graphm.HoldsPermissions(r.Context(), permissions) {
+			logger.Debug().Str("id", oldUserValues.GetId()).Msg("could not update user: user is not allowed to reset the password of users with more permissions")
+			errorcode.AccessDenied.Render(w, r, http.StatusForbidden, "user is not allowed to reset the password of users with more permissions")
+			return
+		}
+	}
+
 	if nameOrID == "" {
 		logger.Debug().Msg("could not update user: missing user id")
 		errorcode.InvalidRequest.Render(w, r, http.StatusBadRequest, "missing user id")
@@ -1021,6 +1041,30 @@ func (g Graph) patchUser(w http.ResponseWriter, r *http.Request, nameOrID string
 	g.patchUserResponse(w, r, u, features)
 }
 
+// userPermissions returns the permissions granted to the user by the assigned roles with their widest constraint
+func (g Graph) userPermissions(ctx context.Context, userID string) (map[string]settingsmsg.Permission_Constraint, error) {
+	if g.roleService == nil {
+		return nil, errors.New("no role service configured")
+	}
+	assignments, err := g.roleService.ListRoleAssignments(ctx, &settingssvc.ListRoleAssignmentsRequest{AccountUuid: userID})
+	if err != nil {
+		return nil, err
+	}
+	if len(assignments.GetAssignments()) == 0 {
+		return nil, nil
+	}
+
+	roleIDs := make([]string, 0, len(assignments.GetAssignments()))
+	for _, a := range assignments.GetAssignments() {
+		roleIDs = append(roleIDs, a.GetRoleId())
+	}
+	roles, err := g.roleService.ListRoles(ctx, &settingssvc.ListBundlesRequest{BundleIds: roleIDs})
+	if err != nil {
+		return nil, err
+	}
+	return graphm.PermissionConstraints(roles.GetBundles()), nil
+}
+
 func (g *Graph) patchUserResponse(w http.ResponseWriter, r *http.Request, user *libregraph.User, features []events.UserFeature) {
 	e := events.UserFeatureChanged{
 		UserID:    *user.Id,
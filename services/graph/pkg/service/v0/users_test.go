@@ -30,6 +30,8 @@ import (
 
 	"github.com/opencloud-eu/opencloud/services/graph/pkg/userstate"
 
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/pkg/roles"
 	"github.com/opencloud-eu/opencloud/pkg/shared"
 	settingsmsg "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/settings/v0"
 	settings "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/settings/v0"
@@ -38,7 +40,9 @@ import (
 	"github.com/opencloud-eu/opencloud/services/graph/pkg/config"
 	"github.com/opencloud-eu/opencloud/services/graph/pkg/config/defaults"
 	identitymocks "github.com/opencloud-eu/opencloud/services/graph/pkg/identity/mocks"
+	graphm "github.com/opencloud-eu/opencloud/services/graph/pkg/middleware"
 	service "github.com/opencloud-eu/opencloud/services/graph/pkg/service/v0"
+	ocsettingssvc "github.com/opencloud-eu/opencloud/services/settings/pkg/service/v0"
 )
 
 type userList struct {
@@ -1306,6 +1310,66 @@ var _ = Describe("Users", func() {
 				Expect(unmarshaledUser.GetMail()).To(Equal("mail@mail.test"))
 				Expect(unmarshaledUser.GetDisplayName()).To(Equal("New Display Name"))
 			})
+
+			Describe("as a user who is only allowed to reset passwords", func() {
+				var (
+					targetRoleID  string
+					resetPassword func() *httptest.ResponseRecorder
+				)
+
+				BeforeEach(func() {
+					// the current user is a helpdesk user, the role of the user to update is set by the tests
+					roleService.On("ListRoleAssignments", mock.Anything, mock.Anything).Return(func(_ context.Context, in *settings.ListRoleAssignmentsRequest, _ ...client.CallOption) (*settings.ListRoleAssignmentsResponse, error) {
+						roleID := "helpdesk"
+						if in.GetAccountUuid() == user.GetId() {
+							roleID = targetRoleID
+						}
+						return &settings.ListRoleAssignmentsResponse{Assignments: []*settingsmsg.UserRoleAssignment{
+							{AccountUuid: in.GetAccountUuid(), RoleId: roleID},
+						}}, nil
+					})
+					roleService.On("ListRoles", mock.Anything, mock.Anything).Return(func(_ context.Context, in *settings.ListBundlesRequest, _ ...client.CallOption) (*settings.ListBundlesResponse, error) {
+						bundles := map[string]*settingsmsg.Bundle{
+							"helpdesk": {Id: "helpdesk", Settings: []*settingsmsg.Setting{{Id: ocsettingssvc.ResetUserPasswordPermissionID}}},
+							"admin":    {Id: "admin", Settings: []*settingsmsg.Setting{{Id: ocsettingssvc.AccountManagementPermissionID}, {Id: ocsettingssvc.ResetUserPasswordPermissionID}}},
+							"user":     {Id: "user"},
+						}
+						res := &settings.ListBundlesResponse{}
+						for _, id := range in.GetBundleIds() {
+							res.Bundles = append(res.Bundles, bundles[id])
+						}
+						return res, nil
+					})
+					rm := roles.NewManager(roles.RoleService(roleService))
+
+					resetPassword = func() *httptest.ResponseRecorder {
+						userUpdate.SetPasswordProfile(libregraph.PasswordProfile{Password: libregraph.PtrString("new password")})
+						data, err := json.Marshal(userUpdate)
+						Expect(err).ToNot(HaveOccurred())
+
+						r := httptest.NewRequest(http.MethodPatch, "/graph/v1.0/users", bytes.NewBuffer(data))
+						rctx := chi.NewRouteContext()
+						rctx.URLParams.Add("userID", user.GetId())
+						r = r.WithContext(context.WithValue(revactx.ContextSetUser(ctx, currentUser), chi.RouteCtxKey, rctx))
+						graphm.RequireAdminOrPasswordReset(&rm, log.NopLogger())(http.HandlerFunc(svc.PatchUser)).ServeHTTP(rr, r)
+						return rr
+					}
+				})
+
+				It("can't reset the password of an admin", func() {
+					targetRoleID = "admin"
+
+					Expect(resetPassword().Code).To(Equal(http.StatusForbidden))
+					identityBackend.AssertNotCalled(GinkgoT(), "UpdateUser", mock.Anything, mock.Anything, mock.Anything)
+				})
+
+				It("resets the password of a user without more permissions", func() {
+					targetRoleID = "user"
+					identityBackend.On("UpdateUser", mock.Anything, user.GetId(), mock.Anything).Return(expectedUser, nil)
+
+					Expect(resetPassword().Code).To(Equal(http.StatusOK))
+				})
+			})
 		})
 	})
 	When("OCM is enabled", func() {
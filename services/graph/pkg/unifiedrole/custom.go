@@ -0,0 +1,312 @@
+package unifiedrole
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	libregraph "github.com/opencloud-eu/libre-graph-api-go"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+type (
+	// CustomRole is a unified role which is defined by an administrator
+	CustomRole struct {
+		ID           string                           `yaml:"id" json:"id"`
+		DisplayName  string                           `yaml:"display_name" json:"displayName"`
+		Description  string                           `yaml:"description" json:"description"`
+		Weight       int32                            `yaml:"weight" json:"weight"`
+		Permissions  []CustomRolePermission           `yaml:"permissions" json:"permissions"`
+		Translations map[string]CustomRoleTranslation `yaml:"translations" json:"translations,omitempty"`
+
+		// ReadOnly is set for the roles which are loaded from a file, they can't be changed with the graph api
+		ReadOnly bool `yaml:"-" json:"-"`
+	}
+
+	// CustomRolePermission defines the actions which are allowed by a custom role for resources matching the condition
+	CustomRolePermission struct {
+		Condition              string   `yaml:"condition" json:"condition"`
+		AllowedResourceActions []string `yaml:"allowed_resource_actions" json:"allowedResourceActions"`
+	}
+
+	// CustomRoleTranslation contains the display name and the description of a custom role in another language
+	CustomRoleTranslation struct {
+		DisplayName string `yaml:"display_name" json:"displayName"`
+		Description string `yaml:"description" json:"description,omitempty"`
+	}
+
+	// customRolesFile is the structure of the custom roles file
+	customRolesFile struct {
+		Roles []CustomRole `yaml:"roles"`
+	}
+)
+
+var (
+	// customRoleConditions maps the short condition names which can be used for custom roles to the conditions
+	customRoleConditions = map[string]string{
+		"drive":            UnifiedRoleConditionDrive,
+		"folder":           UnifiedRoleConditionFolder,
+		"file":             UnifiedRoleConditionFile,
+		"federated-folder": UnifiedRoleConditionFolderFederatedUser,
+		"federated-file":   UnifiedRoleConditionFileFederatedUser,
+	}
+
+	// resourceActions contains all actions which can be allowed by a role
+	resourceActions = []string{
+		DriveItemPermissionsCreate,
+		DriveItemChildrenCreate,
+		DriveItemStandardDelete,
+		DriveItemPathRead,
+		DriveItemQuotaRead,
+		DriveItemContentRead,
+		DriveItemUploadCreate,
+		DriveItemPermissionsRead,
+		DriveItemChildrenRead,
+		DriveItemVersionsRead,
+		DriveItemDeletedRead,
+		DriveItemPathUpdate,
+		DriveItemPermissionsDelete,
+		DriveItemDeletedDelete,
+		DriveItemVersionsUpdate,
+		DriveItemDeletedUpdate,
+		DriveItemBasicRead,
+		DriveItemPermissionsUpdate,
+	}
+
+	// customRoles contains the registered custom roles, they are added after the built-in roles
+	customRoles = struct {
+		sync.RWMutex
+		roles []CustomRole
+	}{}
+)
+
+// LoadCustomRoles reads the custom roles from a yaml file
+func LoadCustomRoles(path string) ([]CustomRole, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var f customRolesFile
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("could not parse the custom roles file %s: %w", path, err)
+	}
+
+	for i := range f.Roles {
+		f.Roles[i].ReadOnly = true
+	}
+	return f.Roles, nil
+}
+
+// CustomRoleFromDefinition creates a custom role from a role definition
+func CustomRoleFromDefinition(definition libregraph.UnifiedRoleDefinition, translations map[string]CustomRoleTranslation) CustomRole {
+	role := CustomRole{
+		ID:           definition.GetId(),
+		DisplayName:  definition.GetDisplayName(),
+		Description:  definition.GetDescription(),
+		Weight:       definition.GetLibreGraphWeight(),
+		Translations: translations,
+	}
+	for _, permission := range definition.GetRolePermissions() {
+		role.Permissions = append(role.Permissions, CustomRolePermission{
+			Condition:              permission.GetCondition(),
+			AllowedResourceActions: permission.GetAllowedResourceActions(),
+		})
+	}
+	return role
+}
+
+// Definition returns the role definition of the custom role
+func (r CustomRole) Definition() *libregraph.UnifiedRoleDefinition {
+	definition := &libregraph.UnifiedRoleDefinition{
+		Id:          proto.String(r.ID),
+		DisplayName: proto.String(r.DisplayName),
+	}
+	if r.Description != "" {
+		definition.Description = proto.String(r.Description)
+	}
+	if r.Weight != 0 {
+		definition.LibreGraphWeight = proto.Int32(r.Weight)
+	}
+	for _, permission := range r.Permissions {
+		condition := permission.Condition
+		if c, ok := customRoleConditions[condition]; ok {
+			condition = c
+		}
+		definition.RolePermissions = append(definition.RolePermissions, libregraph.UnifiedRolePermission{
+			AllowedResourceActions: slices.Clone(permission.AllowedResourceActions),
+			Condition:              proto.String(condition),
+		})
+	}
+	return definition
+}
+
+// Validate checks if the custom role is complete and only uses known conditions and actions
+func (r CustomRole) Validate() error {
+	if _, err := uuid.Parse(r.ID); err != nil {
+		return fmt.Errorf("%w: the id '%s' is not a uuid", ErrInvalidCustomRole, r.ID)
+	}
+	if len(filterRoles(buildInRoles, RoleFilterIDs(r.ID))) != 0 {
+		return fmt.Errorf("%w: the id '%s' belongs to a built-in role", ErrInvalidCustomRole, r.ID)
+	}
+	if strings.TrimSpace(r.DisplayName) == "" {
+		return fmt.Errorf("%w: the role '%s' has no display name", ErrInvalidCustomRole, r.ID)
+	}
+	if len(r.Permissions) == 0 {
+		return fmt.Errorf("%w: the role '%s' has no permissions", ErrInvalidCustomRole, r.ID)
+	}
+
+	definition := r.Definition()
+	conditions := make([]string, 0, len(definition.GetRolePermissions()))
+	for _, permission := range definition.GetRolePermissions() {
+		if !slices.Contains(conditionValues(), permission.GetCondition()) {
+			return fmt.Errorf("%w: the role '%s' has the unknown condition '%s'", ErrInvalidCustomRole, r.ID, permission.GetCondition())
+		}
+		if slices.Contains(conditions, permission.GetCondition()) {
+			return fmt.Errorf("%w: the role '%s' has the condition '%s' more than once", ErrInvalidCustomRole, r.ID, permission.GetCondition())
+		}
+		conditions = append(conditions, permission.GetCondition())
+
+		if len(permission.GetAllowedResourceActions()) == 0 {
+			return fmt.Errorf("%w: the role '%s' allows no actions for the condition '%s'", ErrInvalidCustomRole, r.ID, permission.GetCondition())
+		}
+		for _, action := range permission.GetAllowedResourceActions() {
+			if !slices.Contains(resourceActions, action) {
+				return fmt.Errorf("%w: the role '%s' has the unknown action '%s'", ErrInvalidCustomRole, r.ID, action)
+			}
+		}
+	}
+
+	return nil
+}
+
+// conditionValues returns the conditions which can be used by custom roles
+func conditionValues() []string {
+	values := make([]string, 0, len(customRoleConditions))
+	for _, condition := range customRoleConditions {
+		values = append(values, condition)
+	}
+	return values
+}
+
+// RegisterCustomRoles adds the custom roles or replaces the custom roles with the same id.
+// Shares only store the permissions, therefore a role must not allow the same actions
+// for a condition as another role, the share could not be mapped back to the role otherwise.
+func RegisterCustomRoles(roles ...CustomRole) error {
+	customRoles.Lock()
+	defer customRoles.Unlock()
+
+	registered := slices.Clone(customRoles.roles)
+	for _, role := range roles {
+		if err := role.Validate(); err != nil {
+			return err
+		}
+
+		registered = slices.DeleteFunc(registered, func(r CustomRole) bool {
+			return r.ID == role.ID
+		})
+
+		others := slices.Clone(buildInRoles)
+		for _, r := range registered {
+			others = append(others, r.Definition())
+		}
+		if conflict := conflictingRole(role.Definition(), others); conflict != nil {
+			return fmt.Errorf("%w: the role '%s' allows the same actions as the role '%s'", ErrInvalidCustomRole, role.ID, conflict.GetId())
+		}
+
+		registered = append(registered, role)
+	}
+
+	customRoles.roles = registered
+	return nil
+}
+
+// conflictingRole returns the first role which allows the same actions for a condition as the given role
+func conflictingRole(role *libregraph.UnifiedRoleDefinition, roles []*libregraph.UnifiedRoleDefinition) *libregraph.UnifiedRoleDefinition {
+	for _, permission := range role.GetRolePermissions() {
+		actionSet := map[string]struct{}{}
+		for _, action := range permission.GetAllowedResourceActions() {
+			actionSet[action] = struct{}{}
+		}
+
+		for _, other := range roles {
+			for _, otherPermission := range other.GetRolePermissions() {
+				if otherPermission.GetCondition() == permission.GetCondition() && resourceActionsEqual(actionSet, otherPermission.GetAllowedResourceActions()) {
+					return other
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// UnregisterCustomRole removes the custom role with the given id, it returns false if no such role is registered
+func UnregisterCustomRole(id string) bool {
+	customRoles.Lock()
+	defer customRoles.Unlock()
+
+	n := len(customRoles.roles)
+	customRoles.roles = slices.DeleteFunc(slices.Clone(customRoles.roles), func(r CustomRole) bool {
+		return r.ID == id
+	})
+	return len(customRoles.roles) != n
+}
+
+// GetCustomRole returns the registered custom role with the given id
+func GetCustomRole(id string) (CustomRole, bool) {
+	customRoles.RLock()
+	defer customRoles.RUnlock()
+
+	for _, role := range customRoles.roles {
+		if role.ID == id {
+			return role, true
+		}
+	}
+	return CustomRole{}, false
+}
+
+// IsCustomRole checks if the role with the given id is a custom role
+func IsCustomRole(id string) bool {
+	_, ok := GetCustomRole(id)
+	return ok
+}
+
+// TranslateCustomRole replaces the display name and the description of a custom role definition
+// with the translation for the locale, the locale can be a language tag like 'de-DE' or a language like 'de'.
+func TranslateCustomRole(definition *libregraph.UnifiedRoleDefinition, locale string) {
+	role, ok := GetCustomRole(definition.GetId())
+	if !ok || len(role.Translations) == 0 {
+		return
+	}
+
+	translation, ok := role.Translations[locale]
+	if !ok {
+		language, _, _ := strings.Cut(strings.ReplaceAll(locale, "_", "-"), "-")
+		if translation, ok = role.Translations[language]; !ok {
+			return
+		}
+	}
+
+	if translation.DisplayName != "" {
+		definition.DisplayName = proto.String(translation.DisplayName)
+	}
+	if translation.Description != "" {
+		definition.Description = proto.String(translation.Description)
+	}
+}
+
+// allRoles returns the built-in roles followed by the custom roles
+func allRoles() []*libregraph.UnifiedRoleDefinition {
+	customRoles.RLock()
+	defer customRoles.RUnlock()
+
+	roles := slices.Clone(buildInRoles)
+	for _, role := range customRoles.roles {
+		roles = append(roles, role.Definition())
+	}
+	return roles
+}
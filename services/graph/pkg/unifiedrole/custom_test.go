@@ -0,0 +1,176 @@
+package unifiedrole_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	libregraph "github.com/opencloud-eu/libre-graph-api-go"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/opencloud-eu/opencloud/services/graph/pkg/unifiedrole"
+)
+
+const customRoleID = "0a3a3b25-44fb-4c3e-a63f-8ec1bd8a8fbd"
+
+func customRole() unifiedrole.CustomRole {
+	return unifiedrole.CustomRole{
+		ID:          customRoleID,
+		DisplayName: "Can comment",
+		Description: "View and comment.",
+		Weight:      15,
+		Permissions: []unifiedrole.CustomRolePermission{
+			{
+				Condition: "file",
+				AllowedResourceActions: []string{
+					unifiedrole.DriveItemBasicRead,
+					unifiedrole.DriveItemPathRead,
+					unifiedrole.DriveItemVersionsRead,
+				},
+			},
+		},
+		Translations: map[string]unifiedrole.CustomRoleTranslation{
+			"de": {DisplayName: "Kann kommentieren"},
+		},
+	}
+}
+
+func TestRegisterCustomRoles(t *testing.T) {
+	tests := map[string]struct {
+		role        func(r unifiedrole.CustomRole) unifiedrole.CustomRole
+		expectError bool
+	}{
+		"pass": {
+			role: func(r unifiedrole.CustomRole) unifiedrole.CustomRole { return r },
+		},
+		"pass full condition": {
+			role: func(r unifiedrole.CustomRole) unifiedrole.CustomRole {
+				r.Permissions[0].Condition = unifiedrole.UnifiedRoleConditionFile
+				return r
+			},
+		},
+		"fail invalid id": {
+			role: func(r unifiedrole.CustomRole) unifiedrole.CustomRole {
+				r.ID = "commenter"
+				return r
+			},
+			expectError: true,
+		},
+		"fail built-in id": {
+			role: func(r unifiedrole.CustomRole) unifiedrole.CustomRole {
+				r.ID = unifiedrole.UnifiedRoleViewerID
+				return r
+			},
+			expectError: true,
+		},
+		"fail unknown condition": {
+			role: func(r unifiedrole.CustomRole) unifiedrole.CustomRole {
+				r.Permissions[0].Condition = "space"
+				return r
+			},
+			expectError: true,
+		},
+		"fail unknown action": {
+			role: func(r unifiedrole.CustomRole) unifiedrole.CustomRole {
+				r.Permissions[0].AllowedResourceActions = append(r.Permissions[0].AllowedResourceActions, "libre.graph/driveItem/comments/create")
+				return r
+			},
+			expectError: true,
+		},
+		"fail same actions as a built-in role": {
+			role: func(r unifiedrole.CustomRole) unifiedrole.CustomRole {
+				r.Permissions[0].AllowedResourceActions = getRoleActions(unifiedrole.RoleSecureViewer)
+				return r
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Cleanup(func() { unifiedrole.UnregisterCustomRole(customRoleID) })
+
+			role := tc.role(customRole())
+			err := unifiedrole.RegisterCustomRoles(role)
+			if tc.expectError {
+				g.Expect(err).To(MatchError(unifiedrole.ErrInvalidCustomRole))
+				g.Expect(unifiedrole.IsCustomRole(role.ID)).To(BeFalse())
+				return
+			}
+
+			g.Expect(err).NotTo(HaveOccurred())
+			definition, err := unifiedrole.GetRole(unifiedrole.RoleFilterIDs(customRoleID))
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(definition.GetDisplayName()).To(Equal("Can comment"))
+			g.Expect(definition.GetRolePermissions()[0].GetCondition()).To(Equal(unifiedrole.UnifiedRoleConditionFile))
+		})
+	}
+}
+
+func TestCustomRolesHonoredByConversions(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(unifiedrole.RegisterCustomRoles(customRole())).To(Succeed())
+	t.Cleanup(func() { unifiedrole.UnregisterCustomRole(customRoleID) })
+
+	roles := unifiedrole.GetRoles(unifiedrole.RoleFilterEnabled(unifiedrole.UnifiedRoleViewerID))
+	g.Expect(roles).To(HaveLen(2))
+
+	actions := customRole().Permissions[0].AllowedResourceActions
+	byPermissions := unifiedrole.GetRolesByPermissions(roles, actions, unifiedrole.UnifiedRoleConditionFile, false, false)
+	g.Expect(byPermissions).To(HaveLen(1))
+	g.Expect(byPermissions[0].GetId()).To(Equal(customRoleID))
+
+	p := unifiedrole.PermissionsToCS3ResourcePermissions([]*libregraph.UnifiedRolePermission{{AllowedResourceActions: actions}})
+	g.Expect(p.GetInitiateFileDownload()).To(BeFalse())
+	role := unifiedrole.CS3ResourcePermissionsToRole(roles, p, unifiedrole.UnifiedRoleConditionFile, false)
+	g.Expect(role.GetId()).To(Equal(customRoleID))
+
+	g.Expect(unifiedrole.UnregisterCustomRole(customRoleID)).To(BeTrue())
+	g.Expect(unifiedrole.GetRoles(unifiedrole.RoleFilterIDs(customRoleID))).To(BeEmpty())
+}
+
+func TestTranslateCustomRole(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(unifiedrole.RegisterCustomRoles(customRole())).To(Succeed())
+	t.Cleanup(func() { unifiedrole.UnregisterCustomRole(customRoleID) })
+
+	for locale, displayName := range map[string]string{"de": "Kann kommentieren", "de-DE": "Kann kommentieren", "fr": "Can comment"} {
+		definition := customRole().Definition()
+		unifiedrole.TranslateCustomRole(definition, locale)
+		g.Expect(definition.GetDisplayName()).To(Equal(displayName), locale)
+		g.Expect(definition.GetDescription()).To(Equal("View and comment."), locale)
+	}
+
+	viewer := &libregraph.UnifiedRoleDefinition{Id: proto.String(unifiedrole.UnifiedRoleViewerID), DisplayName: proto.String("Can view")}
+	unifiedrole.TranslateCustomRole(viewer, "de")
+	g.Expect(viewer.GetDisplayName()).To(Equal("Can view"))
+}
+
+func TestLoadCustomRoles(t *testing.T) {
+	g := NewWithT(t)
+	path := filepath.Join(t.TempDir(), "roles.yaml")
+	g.Expect(os.WriteFile(path, []byte(`
+roles:
+  - id: `+customRoleID+`
+    display_name: Can comment
+    weight: 15
+    permissions:
+      - condition: file
+        allowed_resource_actions:
+          - libre.graph/driveItem/basic/read
+          - libre.graph/driveItem/path/read
+          - libre.graph/driveItem/versions/read
+    translations:
+      de:
+        display_name: Kann kommentieren
+`), 0600)).To(Succeed())
+
+	roles, err := unifiedrole.LoadCustomRoles(path)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(roles).To(HaveLen(1))
+	g.Expect(roles[0].ReadOnly).To(BeTrue())
+	g.Expect(roles[0].Validate()).To(Succeed())
+	g.Expect(roles[0].Translations["de"].DisplayName).To(Equal("Kann kommentieren"))
+}
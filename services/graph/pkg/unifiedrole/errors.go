@@ -7,4 +7,6 @@ import (
 var (
 	// ErrUnknownRole is returned when an unknown unified role is requested.
 	ErrUnknownRole = errors.New("unknown role, check if the role is enabled")
+	// ErrInvalidCustomRole is returned when a custom role can't be registered.
+	ErrInvalidCustomRole = errors.New("invalid custom role")
 )
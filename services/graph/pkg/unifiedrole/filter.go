@@ -33,6 +33,14 @@ func RoleFilterIDs(ids ...string) RoleFilter {
 	}
 }
 
+// RoleFilterEnabled returns a role filter that matches the built-in roles with the provided ids
+// and all custom roles, custom roles are always enabled
+func RoleFilterEnabled(ids ...string) RoleFilter {
+	return func(r *libregraph.UnifiedRoleDefinition) bool {
+		return slices.Contains(ids, r.GetId()) || IsCustomRole(r.GetId())
+	}
+}
+
 // filterRoles filters the provided roles by the provided filter
 func filterRoles(roles []*libregraph.UnifiedRoleDefinition, f RoleFilter) []*libregraph.UnifiedRoleDefinition {
 	return slices.DeleteFunc(
@@ -469,12 +469,12 @@ var (
 
 // GetRoles returns a role filter that matches the provided resources
 func GetRoles(f RoleFilter) []*libregraph.UnifiedRoleDefinition {
-	return filterRoles(buildInRoles, f)
+	return filterRoles(allRoles(), f)
 }
 
 // GetRole returns a role filter that matches the provided resources
 func GetRole(f RoleFilter) (*libregraph.UnifiedRoleDefinition, error) {
-	roles := filterRoles(buildInRoles, f)
+	roles := filterRoles(allRoles(), f)
 	if len(roles) == 0 {
 		return nil, ErrUnknownRole
 	}
@@ -17,6 +17,15 @@ import (
 	"github.com/opencloud-eu/opencloud/services/notifications/pkg/config"
 )
 
+// Names of the available communication channels. They match the option keys of
+// the notification channels user setting.
+const (
+	ChannelMail    = "mail"
+	ChannelWebhook = "webhook"
+	ChannelMatrix  = "matrix"
+	ChannelPush    = "push"
+)
+
 // Channel defines the methods of a communication channel.
 type Channel interface {
 	// SendMessage sends a message to users.
@@ -25,6 +34,10 @@ type Channel interface {
 
 // Message represent the already rendered message including the user id opaqueID
 type Message struct {
+	UserID string
+	// Destination is the per user target of the Matrix and push channels, i.e.
+	// the room or the topic or token the user configured in the settings.
+	Destination  string
 	Sender       string
 	Recipient    []string
 	Subject      string
@@ -33,6 +46,31 @@ type Message struct {
 	AttachInline map[string][]byte
 }
 
+// NewChannels instantiates all communication channels which are configured.
+// The mail channel is always part of the result.
+func NewChannels(cfg config.Config, logger log.Logger) (map[string]Channel, error) {
+	m, err := NewMailChannel(cfg, logger)
+	if err != nil {
+		return nil, err
+	}
+	chs := map[string]Channel{ChannelMail: m}
+
+	if cfg.Notifications.Webhook.URL != "" {
+		chs[ChannelWebhook] = NewWebhookChannel(cfg, logger)
+	}
+	if cfg.Notifications.Matrix.Homeserver != "" {
+		chs[ChannelMatrix] = NewMatrixChannel(cfg, logger)
+	}
+	if cfg.Notifications.Push.URL != "" {
+		p, err := NewPushChannel(cfg, logger)
+		if err != nil {
+			return nil, err
+		}
+		chs[ChannelPush] = p
+	}
+	return chs, nil
+}
+
 // NewMailChannel instantiates a new mail communication channel.
 func NewMailChannel(cfg config.Config, logger log.Logger) (Channel, error) {
 	a, err := stdmail.ParseAddress(cfg.Notifications.SMTP.Sender)
@@ -0,0 +1,167 @@
+package channels
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/notifications/pkg/config"
+)
+
+type recordedRequest struct {
+	method string
+	path   string
+	header http.Header
+	body   []byte
+}
+
+func newRecordingServer(t *testing.T, status int) (*httptest.Server, *recordedRequest) {
+	t.Helper()
+	rec := &recordedRequest{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec.method = r.Method
+		rec.path = r.URL.Path
+		rec.header = r.Header.Clone()
+		rec.body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, rec
+}
+
+var testMessage = &Message{
+	UserID:    "einstein-id",
+	Sender:    "Marie",
+	Recipient: []string{"einstein@example.org"},
+	Subject:   "Marie shared 'relativity' with you",
+	TextBody:  "Click here to view it",
+}
+
+func withDestination(destination string) *Message {
+	m := *testMessage
+	m.Destination = destination
+	return &m
+}
+
+func TestWebhookSendMessage(t *testing.T) {
+	srv, rec := newRecordingServer(t, http.StatusNoContent)
+
+	cfg := config.Config{}
+	cfg.Notifications.Webhook = config.Webhook{URL: srv.URL + "/hook", Secret: "s3cr3t", Timeout: time.Second}
+
+	if err := NewWebhookChannel(cfg, log.NopLogger()).SendMessage(context.Background(), testMessage); err != nil {
+		t.Fatalf("SendMessage() error = %v", err)
+	}
+	if rec.method != http.MethodPost || rec.path != "/hook" {
+		t.Errorf("unexpected request %s %s", rec.method, rec.path)
+	}
+
+	var payload WebhookPayload
+	if err := json.Unmarshal(rec.body, &payload); err != nil {
+		t.Fatalf("invalid payload: %v", err)
+	}
+	if payload.UserID != testMessage.UserID || payload.Subject != testMessage.Subject {
+		t.Errorf("unexpected payload %+v", payload)
+	}
+
+	want := "sha256=" + SignWebhookPayload("s3cr3t", rec.header.Get(WebhookTimestampHeader), rec.body)
+	if got := rec.header.Get(WebhookSignatureHeader); got != want {
+		t.Errorf("signature = %s, want %s", got, want)
+	}
+}
+
+func TestWebhookSendMessageError(t *testing.T) {
+	srv, _ := newRecordingServer(t, http.StatusInternalServerError)
+
+	cfg := config.Config{}
+	cfg.Notifications.Webhook = config.Webhook{URL: srv.URL, Timeout: time.Second}
+
+	if err := NewWebhookChannel(cfg, log.NopLogger()).SendMessage(context.Background(), testMessage); err == nil {
+		t.Error("expected an error for a non 2xx response")
+	}
+}
+
+func TestMatrixSendMessage(t *testing.T) {
+	srv, rec := newRecordingServer(t, http.StatusOK)
+
+	cfg := config.Config{}
+	cfg.Notifications.Matrix = config.Matrix{Homeserver: srv.URL, AccessToken: "token", Timeout: time.Second}
+
+	if err := NewMatrixChannel(cfg, log.NopLogger()).SendMessage(context.Background(), withDestination("!einstein-id:example.org")); err != nil {
+		t.Fatalf("SendMessage() error = %v", err)
+	}
+	if rec.method != http.MethodPut || !strings.HasPrefix(rec.path, "/_matrix/client/v3/rooms/!einstein-id:example.org/send/m.room.message/") {
+		t.Errorf("unexpected request %s %s", rec.method, rec.path)
+	}
+	if got := rec.header.Get("Authorization"); got != "Bearer token" {
+		t.Errorf("Authorization = %s", got)
+	}
+	if !strings.Contains(string(rec.body), `"msgtype":"m.text"`) {
+		t.Errorf("unexpected body %s", rec.body)
+	}
+}
+
+func TestPushSendMessage(t *testing.T) {
+	tests := []struct {
+		name       string
+		pushType   string
+		wantPath   string
+		wantHeader string
+		wantValue  string
+	}{
+		{name: "ntfy", pushType: "ntfy", wantPath: "/einstein-topic", wantHeader: "Authorization", wantValue: "Bearer token"},
+		{name: "gotify", pushType: "gotify", wantPath: "/message", wantHeader: "X-Gotify-Key", wantValue: "einstein-topic"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, rec := newRecordingServer(t, http.StatusOK)
+
+			cfg := config.Config{}
+			cfg.Notifications.Push = config.Push{Type: tt.pushType, URL: srv.URL, Token: "token", Timeout: time.Second}
+
+			ch, err := NewPushChannel(cfg, log.NopLogger())
+			if err != nil {
+				t.Fatalf("NewPushChannel() error = %v", err)
+			}
+			if err := ch.SendMessage(context.Background(), withDestination("einstein-topic")); err != nil {
+				t.Fatalf("SendMessage() error = %v", err)
+			}
+			if rec.path != tt.wantPath {
+				t.Errorf("path = %s, want %s", rec.path, tt.wantPath)
+			}
+			if got := rec.header.Get(tt.wantHeader); got != tt.wantValue {
+				t.Errorf("%s = %s, want %s", tt.wantHeader, got, tt.wantValue)
+			}
+		})
+	}
+}
+
+func TestSendMessageWithoutDestination(t *testing.T) {
+	cfg := config.Config{}
+	cfg.Notifications.Matrix = config.Matrix{Homeserver: "http://127.0.0.1:0", Timeout: time.Second}
+	cfg.Notifications.Push = config.Push{Type: "ntfy", URL: "http://127.0.0.1:0", Timeout: time.Second}
+
+	push, err := NewPushChannel(cfg, log.NopLogger())
+	if err != nil {
+		t.Fatalf("NewPushChannel() error = %v", err)
+	}
+	for name, ch := range map[string]Channel{"matrix": NewMatrixChannel(cfg, log.NopLogger()), "push": push} {
+		if err := ch.SendMessage(context.Background(), testMessage); err == nil {
+			t.Errorf("%s: expected an error without destination", name)
+		}
+	}
+}
+
+func TestNewPushChannelUnknownType(t *testing.T) {
+	cfg := config.Config{}
+	cfg.Notifications.Push = config.Push{Type: "pigeon", URL: "http://localhost"}
+	if _, err := NewPushChannel(cfg, log.NopLogger()); err == nil {
+		t.Error("expected an error for an unknown push type")
+	}
+}
@@ -0,0 +1,73 @@
+package channels
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/notifications/pkg/config"
+)
+
+// NewMatrixChannel instantiates a new Matrix communication channel.
+func NewMatrixChannel(cfg config.Config, logger log.Logger) Channel {
+	return Matrix{
+		conf:   cfg.Notifications.Matrix,
+		client: newHTTPClient(cfg.Notifications.Matrix.Insecure, cfg.Notifications.Matrix.Timeout),
+		logger: logger,
+	}
+}
+
+// Matrix is the communication channel posting messages into a Matrix room.
+type Matrix struct {
+	conf   config.Matrix
+	client *http.Client
+	logger log.Logger
+}
+
+type matrixRoomMessage struct {
+	MsgType string `json:"msgtype"`
+	Body    string `json:"body"`
+}
+
+// SendMessage sends the message as m.text event to the room of the user.
+func (m Matrix) SendMessage(ctx context.Context, message *Message) error {
+	if message.Destination == "" {
+		return errors.New("no matrix room set")
+	}
+
+	body, err := json.Marshal(matrixRoomMessage{
+		MsgType: "m.text",
+		Body:    strings.TrimSpace(message.Subject + "\n\n" + message.TextBody),
+	})
+	if err != nil {
+		return err
+	}
+
+	txnID := make([]byte, 16)
+	if _, err := rand.Read(txnID); err != nil {
+		return err
+	}
+
+	endpoint, err := url.JoinPath(m.conf.Homeserver,
+		"_matrix/client/v3/rooms", message.Destination,
+		"send/m.room.message", hex.EncodeToString(txnID))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+m.conf.AccessToken)
+
+	return do(m.client, req)
+}
@@ -0,0 +1,102 @@
+package channels
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/notifications/pkg/config"
+)
+
+// NewPushChannel instantiates a new push communication channel for ntfy or Gotify servers.
+func NewPushChannel(cfg config.Config, logger log.Logger) (Channel, error) {
+	switch strings.ToLower(cfg.Notifications.Push.Type) {
+	case "ntfy", "gotify":
+	default:
+		return nil, fmt.Errorf("unknown push server type '%s'", cfg.Notifications.Push.Type)
+	}
+	return Push{
+		conf:   cfg.Notifications.Push,
+		client: newHTTPClient(cfg.Notifications.Push.Insecure, cfg.Notifications.Push.Timeout),
+		logger: logger,
+	}, nil
+}
+
+// Push is the communication channel for ntfy and Gotify push servers.
+type Push struct {
+	conf   config.Push
+	client *http.Client
+	logger log.Logger
+}
+
+type gotifyMessage struct {
+	Title    string `json:"title"`
+	Message  string `json:"message"`
+	Priority int    `json:"priority"`
+}
+
+// SendMessage publishes the message to the push server.
+func (p Push) SendMessage(ctx context.Context, message *Message) error {
+	if message.Destination == "" {
+		return errors.New("no push topic or token set")
+	}
+
+	var (
+		req *http.Request
+		err error
+	)
+	switch strings.ToLower(p.conf.Type) {
+	case "gotify":
+		req, err = p.gotifyRequest(ctx, message)
+	default:
+		req, err = p.ntfyRequest(ctx, message)
+	}
+	if err != nil {
+		return err
+	}
+	return do(p.client, req)
+}
+
+func (p Push) ntfyRequest(ctx context.Context, message *Message) (*http.Request, error) {
+	endpoint, err := url.JoinPath(p.conf.URL, message.Destination)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(message.TextBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Title", message.Subject)
+	if p.conf.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+p.conf.Token)
+	}
+	return req, nil
+}
+
+func (p Push) gotifyRequest(ctx context.Context, message *Message) (*http.Request, error) {
+	endpoint, err := url.JoinPath(p.conf.URL, "message")
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(gotifyMessage{
+		Title:    message.Subject,
+		Message:  message.TextBody,
+		Priority: 5,
+	})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Gotify-Key", message.Destination)
+	return req, nil
+}
@@ -0,0 +1,88 @@
+package channels
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/notifications/pkg/config"
+)
+
+const (
+	// WebhookSignatureHeader carries the hex encoded HMAC-SHA256 of the timestamp and the payload
+	WebhookSignatureHeader = "X-OpenCloud-Signature"
+	// WebhookTimestampHeader carries the unix timestamp the payload was signed at
+	WebhookTimestampHeader = "X-OpenCloud-Timestamp"
+)
+
+// WebhookPayload is the JSON document posted to the webhook endpoint.
+type WebhookPayload struct {
+	UserID     string   `json:"userId,omitempty"`
+	Recipients []string `json:"recipients,omitempty"`
+	Sender     string   `json:"sender,omitempty"`
+	Subject    string   `json:"subject"`
+	Text       string   `json:"text"`
+	HTML       string   `json:"html,omitempty"`
+}
+
+// NewWebhookChannel instantiates a new webhook communication channel.
+func NewWebhookChannel(cfg config.Config, logger log.Logger) Channel {
+	return Webhook{
+		conf:   cfg.Notifications.Webhook,
+		client: newHTTPClient(cfg.Notifications.Webhook.Insecure, cfg.Notifications.Webhook.Timeout),
+		logger: logger,
+	}
+}
+
+// Webhook is the communication channel for generic HTTP webhooks.
+type Webhook struct {
+	conf   config.Webhook
+	client *http.Client
+	logger log.Logger
+}
+
+// SendMessage posts the message as JSON to the configured endpoint.
+func (w Webhook) SendMessage(ctx context.Context, message *Message) error {
+	body, err := json.Marshal(WebhookPayload{
+		UserID:     message.UserID,
+		Recipients: message.Recipient,
+		Sender:     message.Sender,
+		Subject:    message.Subject,
+		Text:       message.TextBody,
+		HTML:       message.HTMLBody,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.conf.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if w.conf.Secret != "" {
+		ts := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(WebhookTimestampHeader, ts)
+		req.Header.Set(WebhookSignatureHeader, "sha256="+SignWebhookPayload(w.conf.Secret, ts, body))
+	}
+
+	return do(w.client, req)
+}
+
+// SignWebhookPayload computes the signature of a webhook payload. Receivers
+// can use it to verify the X-OpenCloud-Signature header.
+func SignWebhookPayload(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -105,7 +105,7 @@ func Server(cfg *config.Config) *cli.Command {
 			if err != nil {
 				return err
 			}
-			channel, err := channels.NewMailChannel(*cfg, logger)
+			chs, err := channels.NewChannels(*cfg, logger)
 			if err != nil {
 				return err
 			}
@@ -135,7 +135,7 @@ func Server(cfg *config.Config) *cli.Command {
 				store.Authentication(cfg.Store.AuthUsername, cfg.Store.AuthPassword),
 			)
 
-			svc := service.NewEventsNotifier(evts, channel, logger, gatewaySelector, valueService,
+			svc := service.NewEventsNotifier(evts, chs, logger, gatewaySelector, valueService,
 				cfg.ServiceAccount.ServiceAccountID, cfg.ServiceAccount.ServiceAccountSecret,
 				cfg.Notifications.EmailTemplatePath, cfg.Notifications.DefaultLanguage, cfg.WebUIURL,
 				cfg.Notifications.TranslationPath, cfg.Notifications.SMTP.Sender, notificationStore, historyClient, registeredEvents)
@@ -31,6 +31,9 @@ type Config struct {
 // Notifications defines the config options for the notifications service.
 type Notifications struct {
 	SMTP              SMTP                  `yaml:"SMTP"`
+	Webhook           Webhook               `yaml:"webhook"`
+	Matrix            Matrix                `yaml:"matrix"`
+	Push              Push                  `yaml:"push"`
 	Events            Events                `yaml:"events"`
 	EmailTemplatePath string                `yaml:"email_template_path" env:"OC_EMAIL_TEMPLATE_PATH;NOTIFICATIONS_EMAIL_TEMPLATE_PATH" desc:"Path to Email notification templates overriding embedded ones." introductionVersion:"1.0.0"`
 	TranslationPath   string                `yaml:"translation_path" env:"OC_TRANSLATION_PATH;NOTIFICATIONS_TRANSLATION_PATH" desc:"(optional) Set this to a path with custom translations to overwrite the builtin translations. Note that file and folder naming rules apply, see the documentation for more details." introductionVersion:"1.0.0"`
@@ -51,6 +54,31 @@ type SMTP struct {
 	Encryption     string `yaml:"smtp_encryption" env:"NOTIFICATIONS_SMTP_ENCRYPTION" desc:"Encryption method for the SMTP communication. Possible values are 'starttls', 'ssltls' and 'none'." introductionVersion:"1.0.0"`
 }
 
+// Webhook combines the configuration options for the webhook channel.
+type Webhook struct {
+	URL      string        `yaml:"url" env:"NOTIFICATIONS_WEBHOOK_URL" desc:"URL of the HTTP endpoint notifications are posted to as JSON. The ID of the notified user is part of the payload. Leave empty to disable the webhook channel." introductionVersion:"%%NEXT%%"`
+	Secret   string        `yaml:"secret" env:"NOTIFICATIONS_WEBHOOK_SECRET" desc:"Secret used to sign the webhook payload. The HMAC-SHA256 signature is sent in the 'X-OpenCloud-Signature' header. If empty, payloads are not signed." introductionVersion:"%%NEXT%%"`
+	Insecure bool          `yaml:"insecure" env:"NOTIFICATIONS_WEBHOOK_INSECURE" desc:"Allow insecure connections to the webhook endpoint." introductionVersion:"%%NEXT%%"`
+	Timeout  time.Duration `yaml:"timeout" env:"NOTIFICATIONS_WEBHOOK_TIMEOUT" desc:"Timeout for a single webhook request. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+}
+
+// Matrix combines the configuration options for the Matrix channel.
+type Matrix struct {
+	Homeserver  string        `yaml:"homeserver" env:"NOTIFICATIONS_MATRIX_HOMESERVER" desc:"URL of the Matrix homeserver, e.g. 'https://matrix.example.com'. Leave empty to disable the Matrix channel." introductionVersion:"%%NEXT%%"`
+	AccessToken string        `yaml:"access_token" env:"NOTIFICATIONS_MATRIX_ACCESS_TOKEN" desc:"Access token of the Matrix account used to post notifications. Users invite this account to the room they set in their notification settings." introductionVersion:"%%NEXT%%"`
+	Insecure    bool          `yaml:"insecure" env:"NOTIFICATIONS_MATRIX_INSECURE" desc:"Allow insecure connections to the Matrix homeserver." introductionVersion:"%%NEXT%%"`
+	Timeout     time.Duration `yaml:"timeout" env:"NOTIFICATIONS_MATRIX_TIMEOUT" desc:"Timeout for a single request to the Matrix homeserver. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+}
+
+// Push combines the configuration options for the push channel.
+type Push struct {
+	Type     string        `yaml:"type" env:"NOTIFICATIONS_PUSH_TYPE" desc:"Type of the push server. Supported values are 'ntfy' and 'gotify'." introductionVersion:"%%NEXT%%"`
+	URL      string        `yaml:"url" env:"NOTIFICATIONS_PUSH_URL" desc:"Base URL of the push server. Users set the ntfy topic or their Gotify application token in their notification settings. Leave empty to disable the push channel." introductionVersion:"%%NEXT%%"`
+	Token    string        `yaml:"token" env:"NOTIFICATIONS_PUSH_TOKEN" desc:"Access token sent as bearer token to the ntfy server. Not used for 'gotify', the messages are sent with the application token of the user." introductionVersion:"%%NEXT%%"`
+	Insecure bool          `yaml:"insecure" env:"NOTIFICATIONS_PUSH_INSECURE" desc:"Allow insecure connections to the push server." introductionVersion:"%%NEXT%%"`
+	Timeout  time.Duration `yaml:"timeout" env:"NOTIFICATIONS_PUSH_TIMEOUT" desc:"Timeout for a single request to the push server. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+}
+
 // Events combines the configuration options for the event bus.
 type Events struct {
 	Endpoint             string `yaml:"endpoint" env:"OC_EVENTS_ENDPOINT;NOTIFICATIONS_EVENTS_ENDPOINT" desc:"The address of the event system. The event system is the message queuing service. It is used as message broker for the microservice architecture." introductionVersion:"1.0.0"`
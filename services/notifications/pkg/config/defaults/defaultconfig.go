@@ -35,6 +35,16 @@ func DefaultConfig() *config.Config {
 			SMTP: config.SMTP{
 				Encryption: "none",
 			},
+			Webhook: config.Webhook{
+				Timeout: 10 * time.Second,
+			},
+			Matrix: config.Matrix{
+				Timeout: 10 * time.Second,
+			},
+			Push: config.Push{
+				Type:    "ntfy",
+				Timeout: 10 * time.Second,
+			},
 			Events: config.Events{
 				Endpoint:  "127.0.0.1:9233",
 				Cluster:   "opencloud-cluster",
@@ -140,6 +140,7 @@ func (s eventsNotifier) createGroupedMail(ctx context.Context, logger zerolog.Lo
 		logger.Error().Err(err).Msg("could not render template")
 		return
 	}
+	rendered.UserID = userEvents.User.GetId().GetOpaqueId()
 	rendered.Sender = s.defaultEmailSender
 	rendered.Recipient = []string{userEvents.User.GetMail()}
 	s.send(ctx, []*channels.Message{rendered})
@@ -49,7 +49,7 @@ type Service interface {
 // NewEventsNotifier provides a new eventsNotifier
 func NewEventsNotifier(
 	events <-chan events.Event,
-	channel channels.Channel,
+	chs map[string]channels.Channel,
 	logger log.Logger,
 	gatewaySelector pool.Selectable[gateway.GatewayAPIClient],
 	valueService settingssvc.ValueService,
@@ -60,7 +60,7 @@ func NewEventsNotifier(
 
 	return eventsNotifier{
 		logger:               logger,
-		channel:              channel,
+		channels:             chs,
 		events:               events,
 		gatewaySelector:      gatewaySelector,
 		valueService:         valueService,
@@ -82,7 +82,7 @@ func NewEventsNotifier(
 
 type eventsNotifier struct {
 	logger               log.Logger
-	channel              channels.Channel
+	channels             map[string]channels.Channel
 	events               <-chan events.Event
 	gatewaySelector      pool.Selectable[gateway.GatewayAPIClient]
 	valueService         settingssvc.ValueService
@@ -168,6 +168,7 @@ func (s eventsNotifier) render(ctx context.Context, template email.MessageTempla
 		if err != nil {
 			return nil, err
 		}
+		rendered.UserID = usr.GetId().GetOpaqueId()
 		rendered.Sender = sender
 		rendered.Recipient = []string{usr.GetMail()}
 		messageList[i] = rendered
@@ -175,13 +176,90 @@ func (s eventsNotifier) render(ctx context.Context, template email.MessageTempla
 	return messageList, nil
 }
 
-func (s eventsNotifier) send(ctx context.Context, emails []*channels.Message) {
-	for _, r := range emails {
-		err := s.channel.SendMessage(ctx, r)
-		if err != nil {
-			s.logger.Error().Err(err).Str("event", "SendEmail").Msg("failed to send a message")
+// send fans every message out to all channels the recipient opted into.
+func (s eventsNotifier) send(ctx context.Context, messages []*channels.Message) {
+	for _, r := range messages {
+		for _, name := range s.userChannels(ctx, r.UserID) {
+			ch, ok := s.channels[name]
+			if !ok {
+				continue
+			}
+			msg := r
+			if settingID, ok := destinationSettings[name]; ok {
+				destination, err := getStringValue(ctx, s.valueService, r.UserID, settingID)
+				if err != nil || destination == "" {
+					s.logger.Debug().Err(err).Str("channel", name).Str("userId", r.UserID).Msg("no destination set, skipping channel")
+					continue
+				}
+				m := *r
+				m.Destination = destination
+				msg = &m
+			}
+			if err := ch.SendMessage(ctx, msg); err != nil {
+				s.logger.Error().Err(err).Str("channel", name).Msg("failed to send a message")
+			}
+		}
+	}
+}
+
+// userChannels returns the names of the channels a user wants to be notified on.
+// Messages not addressed to a known user, e.g. invitations, always go out by mail.
+func (s eventsNotifier) userChannels(ctx context.Context, userID string) []string {
+	if userID == "" {
+		return []string{channels.ChannelMail}
+	}
+	names, err := getNotificationChannels(ctx, s.valueService, userID)
+	if err != nil {
+		s.logger.Debug().Err(err).Str("userId", userID).Msg("cannot get user notification channels, falling back to mail")
+		return []string{channels.ChannelMail}
+	}
+	return names
+}
+
+// destinationSettings maps the channels which deliver to a per user destination
+// to the setting holding it.
+var destinationSettings = map[string]string{
+	channels.ChannelMatrix: defaults.SettingUUIDProfileNotificationMatrixRoom,
+	channels.ChannelPush:   defaults.SettingUUIDProfileNotificationPushTarget,
+}
+
+func getStringValue(ctx context.Context, vc settingssvc.ValueService, userID, settingID string) (string, error) {
+	resp, err := vc.GetValueByUniqueIdentifiers(
+		metadata.Set(ctx, middleware.AccountID, userID),
+		&settingssvc.GetValueByUniqueIdentifiersRequest{
+			AccountUuid: userID,
+			SettingId:   settingID,
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(resp.GetValue().GetValue().GetStringValue()), nil
+}
+
+func getNotificationChannels(ctx context.Context, vc settingssvc.ValueService, userID string) ([]string, error) {
+	resp, err := vc.GetValueByUniqueIdentifiers(
+		metadata.Set(ctx, middleware.AccountID, userID),
+		&settingssvc.GetValueByUniqueIdentifiersRequest{
+			AccountUuid: userID,
+			SettingId:   defaults.SettingUUIDProfileNotificationChannels,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	val := resp.GetValue().GetValue().GetCollectionValue().GetValues()
+	if len(val) == 0 {
+		return nil, errors.New("no notification channels setting found")
+	}
+	names := make([]string, 0, len(val))
+	for _, option := range val {
+		if option.GetBoolValue() {
+			names = append(names, option.GetKey())
 		}
 	}
+	return names, nil
 }
 
 func (s eventsNotifier) ensureGranteeList(ctx context.Context, executant, u *user.UserId, g *group.GroupId) []*user.User {
@@ -93,7 +93,7 @@ var _ = Describe("Notifications", func() {
 			cfg := defaults.FullDefaultConfig()
 			cfg.GRPCClientTLS = &shared.GRPCClientTLS{}
 			ch := make(chan events.Event)
-			evts := service.NewEventsNotifier(ch, tc, log.NewLogger(), gatewaySelector, vs, "",
+			evts := service.NewEventsNotifier(ch, map[string]channels.Channel{channels.ChannelMail: tc}, log.NewLogger(), gatewaySelector, vs, "",
 				"", "", "", "", "", "",
 				store.Create(), nil, nil)
 			go evts.Run()
@@ -306,7 +306,7 @@ var _ = Describe("Notifications X-Site Scripting", func() {
 			cfg := defaults.FullDefaultConfig()
 			cfg.GRPCClientTLS = &shared.GRPCClientTLS{}
 			ch := make(chan events.Event)
-			evts := service.NewEventsNotifier(ch, tc, log.NewLogger(), gatewaySelector, vs, "",
+			evts := service.NewEventsNotifier(ch, map[string]channels.Channel{channels.ChannelMail: tc}, log.NewLogger(), gatewaySelector, vs, "",
 				"", "", "", "", "", "",
 				store.Create(), nil, nil)
 			go evts.Run()
@@ -7,6 +7,7 @@ import (
 
 	"github.com/opencloud-eu/opencloud/pkg/broker"
 	"github.com/opencloud-eu/opencloud/pkg/config/configlog"
+	"github.com/opencloud-eu/opencloud/pkg/favorite"
 	"github.com/opencloud-eu/opencloud/pkg/registry"
 	"github.com/opencloud-eu/opencloud/pkg/runner"
 	ohttp "github.com/opencloud-eu/opencloud/pkg/service/http"
@@ -18,7 +19,9 @@ import (
 	"github.com/opencloud-eu/opencloud/services/ocdav/pkg/server/debug"
 	"github.com/opencloud-eu/reva/v2/pkg/micro/ocdav"
 	"github.com/opencloud-eu/reva/v2/pkg/sharedconf"
+	"github.com/opencloud-eu/reva/v2/pkg/store"
 	"github.com/urfave/cli/v2"
+	microstore "go-micro.dev/v4/store"
 )
 
 // Server is the entry point for the server command.
@@ -57,6 +60,14 @@ func Server(cfg *config.Config) *cli.Command {
 			if err := sharedconf.Decode(sc); err != nil {
 				logger.Error().Err(err).Msg("error decoding shared config for ocdav")
 			}
+			favoritesStore := store.Create(
+				store.Store(cfg.Store.Store),
+				microstore.Nodes(cfg.Store.Nodes...),
+				microstore.Database(cfg.Store.Database),
+				microstore.Table(cfg.Store.Table),
+				store.Authentication(cfg.Store.AuthUsername, cfg.Store.AuthPassword),
+			)
+
 			opts := []ocdav.Option{
 				ocdav.Name(cfg.HTTP.Namespace + "." + cfg.Service.Name),
 				ocdav.Version(version.GetString()),
@@ -86,7 +97,7 @@ func Server(cfg *config.Config) *cli.Command {
 				ocdav.Edition(cfg.Status.Edition),
 				ocdav.MachineAuthAPIKey(cfg.MachineAuthAPIKey),
 				ocdav.Broker(broker.NoOp{}),
-				// ocdav.FavoriteManager() // FIXME needs a proper persistence implementation https://github.com/owncloud/ocis/issues/1228
+				ocdav.FavoriteManager(favorite.NewManager(favoritesStore)),
 				// ocdav.LockSystem(), // will default to the CS3 lock system
 				// ocdav.TLSConfig() // tls config for the http server
 				ocdav.MetricsEnabled(true),
@@ -36,6 +36,8 @@ type Config struct {
 	Context context.Context `yaml:"-"`
 	Status  Status          `yaml:"-"`
 
+	Store Store `yaml:"store"`
+
 	AllowPropfindDepthInfinity bool `yaml:"allow_propfind_depth_infinity" env:"OCDAV_ALLOW_PROPFIND_DEPTH_INFINITY" desc:"Allow the use of depth infinity in PROPFINDS. When enabled, a propfind will traverse through all subfolders. If many subfolders are expected, depth infinity can cause heavy server load and/or delayed response times." introductionVersion:"1.0.0"`
 }
 
@@ -77,6 +77,11 @@ func DefaultConfig() *config.Config {
 		Service: config.Service{
 			Name: "ocdav",
 		},
+		Store: config.Store{
+			Store:    "nats-js-kv",
+			Nodes:    []string{"127.0.0.1:9233"},
+			Database: "favorites",
+		},
 		Reva:              shared.DefaultRevaConfig(),
 		WebdavNamespace:   "/users/{{.Id.OpaqueId}}",
 		FilesNamespace:    "/users/{{.Id.OpaqueId}}",
@@ -59,6 +59,12 @@ func Server(cfg *config.Config) *cli.Command {
 				return err
 			}
 
+			connName := generators.GenerateConnectionName(cfg.Service.Name, generators.NTypeBus)
+			bus, err := stream.NatsFromConfig(connName, false, stream.NatsConfig(cfg.Events))
+			if err != nil {
+				return err
+			}
+
 			gr := runner.NewGroup()
 			{
 				grpcClient, err := grpc.NewClient(
@@ -90,7 +96,7 @@ func Server(cfg *config.Config) *cli.Command {
 					return err
 				}
 
-				grpcSvc, err := svcGRPC.New(e)
+				grpcSvc, err := svcGRPC.New(e, bus, logger)
 				if err != nil {
 					return err
 				}
@@ -106,13 +112,6 @@ func Server(cfg *config.Config) *cli.Command {
 			}
 
 			{
-
-				connName := generators.GenerateConnectionName(cfg.Service.Name, generators.NTypeBus)
-				bus, err := stream.NatsFromConfig(connName, false, stream.NatsConfig(cfg.Events))
-				if err != nil {
-					return err
-				}
-
 				eventSvc, err := svcEvent.New(ctx, bus, logger, traceProvider, e, cfg.Postprocessing.Query)
 				if err != nil {
 					return err
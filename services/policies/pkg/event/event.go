@@ -0,0 +1,53 @@
+package event
+
+import (
+	"encoding/json"
+
+	user "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	types "github.com/cs3org/go-cs3apis/cs3/types/v1beta1"
+	"github.com/opencloud-eu/reva/v2/pkg/utils"
+
+	"github.com/opencloud-eu/opencloud/services/policies/pkg/engine"
+)
+
+// PolicyDenied is emitted when a policy denies a request or an upload
+type PolicyDenied struct {
+	Executant *user.UserId
+	// Stage is the stage of the evaluation, 'http' for requests and 'pp' for uploads in postprocessing
+	Stage      string
+	Query      string
+	Method     string
+	Path       string
+	ResourceID *provider.ResourceId
+	Filename   string
+	Timestamp  *types.Timestamp
+}
+
+// Unmarshal to fulfill umarshaller interface
+func (PolicyDenied) Unmarshal(v []byte) (interface{}, error) {
+	e := PolicyDenied{}
+	err := json.Unmarshal(v, &e)
+	return e, err
+}
+
+// NewPolicyDenied returns the PolicyDenied event for an environment the query denied
+func NewPolicyDenied(query string, env *engine.Environment) PolicyDenied {
+	ev := PolicyDenied{
+		Executant: env.User.GetId(),
+		Stage:     string(env.Stage),
+		Query:     query,
+		Method:    env.Request.Method,
+		Path:      env.Request.Path,
+		Filename:  env.Resource.Name,
+		Timestamp: utils.TSNow(),
+	}
+	if env.Resource.ID.GetOpaqueId() != "" {
+		ev.ResourceID = &provider.ResourceId{
+			StorageId: env.Resource.ID.GetStorageId(),
+			SpaceId:   env.Resource.ID.GetSpaceId(),
+			OpaqueId:  env.Resource.ID.GetOpaqueId(),
+		}
+	}
+	return ev
+}
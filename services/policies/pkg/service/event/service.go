@@ -6,6 +6,7 @@ import (
 
 	"github.com/opencloud-eu/opencloud/pkg/log"
 	"github.com/opencloud-eu/opencloud/services/policies/pkg/engine"
+	"github.com/opencloud-eu/opencloud/services/policies/pkg/event"
 	"github.com/opencloud-eu/reva/v2/pkg/events"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -119,6 +120,9 @@ func (s Service) processEvent(e events.Event) error {
 
 			if !result {
 				outcome = events.PPOutcomeDelete
+				if err := events.Publish(ctx, s.stream, event.NewPolicyDenied(s.query, &env)); err != nil {
+					s.log.Error().Err(err).Msg("could not publish policy denied event")
+				}
 			}
 		}
 
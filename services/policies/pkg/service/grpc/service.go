@@ -3,19 +3,28 @@ package grpcSVC
 import (
 	"context"
 
+	"github.com/opencloud-eu/reva/v2/pkg/events"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
 	v0 "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/policies/v0"
 	"github.com/opencloud-eu/opencloud/services/policies/pkg/engine"
+	"github.com/opencloud-eu/opencloud/services/policies/pkg/event"
 )
 
 // Service defines the service handlers.
 type Service struct {
-	engine engine.Engine
+	engine    engine.Engine
+	publisher events.Publisher
+	log       log.Logger
 }
 
-// New returns a service implementation for Service.
-func New(engine engine.Engine) (Service, error) {
+// New returns a service implementation for Service. The publisher is optional,
+// without it no events are published for denied requests.
+func New(engine engine.Engine, publisher events.Publisher, logger log.Logger) (Service, error) {
 	svc := Service{
-		engine: engine,
+		engine:    engine,
+		publisher: publisher,
+		log:       logger,
 	}
 
 	return svc, nil
@@ -31,5 +40,11 @@ func (s Service) Evaluate(ctx context.Context, request *v0.EvaluateRequest, resp
 	result, err := s.engine.Evaluate(ctx, request.Query, env)
 	response.Result = result
 
+	if err == nil && !result && s.publisher != nil {
+		if err := events.Publish(ctx, s.publisher, event.NewPolicyDenied(request.Query, &env)); err != nil {
+			s.log.Error().Err(err).Msg("could not publish policy denied event")
+		}
+	}
+
 	return err
 }
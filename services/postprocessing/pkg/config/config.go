@@ -27,11 +27,20 @@ type Postprocessing struct {
 	Events  Events `yaml:"events"`
 	Workers int    `yaml:"workers" env:"POSTPROCESSING_WORKERS" desc:"The number of concurrent go routines that fetch events from the event queue." introductionVersion:"1.0.0"`
 
-	Steps           []string      `yaml:"steps" env:"POSTPROCESSING_STEPS" desc:"A list of postprocessing steps processed in order of their appearance. Currently supported values by the system are: 'virusscan', 'policies' and 'delay'. Custom steps are allowed. See the documentation for instructions. See the Environment Variable Types description for more details." introductionVersion:"1.0.0"`
+	Steps           []string      `yaml:"steps" env:"POSTPROCESSING_STEPS" desc:"A list of postprocessing steps processed in order of their appearance. Currently supported values by the system are: 'virusscan', 'policies' and 'delay'. Custom steps are allowed. Steps joined with a '+' like 'virusscan+ocr' are processed in parallel. See the documentation for instructions. See the Environment Variable Types description for more details." introductionVersion:"1.0.0"`
 	Delayprocessing time.Duration `yaml:"delayprocessing" env:"POSTPROCESSING_DELAY" desc:"After uploading a file but before making it available for download, a delay step can be added. Intended for developing purposes only. If a duration is set but the keyword 'delay' is not explicitely added to 'POSTPROCESSING_STEPS', the delay step will be processed as last step. In such a case, a log entry will be written on service startup to remind the admin about that situation. See the Environment Variable Types description for more details." introductionVersion:"1.0.0"`
 
 	RetryBackoffDuration time.Duration `yaml:"retry_backoff_duration" env:"POSTPROCESSING_RETRY_BACKOFF_DURATION" desc:"The base for the exponential backoff duration before retrying a failed postprocessing step. See the Environment Variable Types description for more details." introductionVersion:"1.0.0"`
 	MaxRetries           int           `yaml:"max_retries" env:"POSTPROCESSING_MAX_RETRIES" desc:"The maximum number of retries for a failed postprocessing step." introductionVersion:"1.0.0"`
+	StepTimeout          time.Duration `yaml:"step_timeout" env:"POSTPROCESSING_STEP_TIMEOUT" desc:"The time a postprocessing step may take before it is considered failed and retried. Set to '0' to wait without limit. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+
+	StepSettings map[string]StepSettings `yaml:"step_settings"`
+}
+
+// StepSettings overrides the timeout and the retry budget of a single postprocessing step.
+type StepSettings struct {
+	Timeout    time.Duration `yaml:"timeout"`
+	MaxRetries int           `yaml:"max_retries"`
 }
 
 // Events combines the configuration options for the event bus.
@@ -52,8 +52,10 @@ func Validate(cfg *config.Config) error {
 
 func contains(all []string, candidate events.Postprocessingstep) bool {
 	for _, s := range all {
-		if s == string(candidate) {
-			return true
+		for _, step := range strings.Split(s, "+") {
+			if strings.TrimSpace(step) == string(candidate) {
+				return true
+			}
 		}
 	}
 	return false
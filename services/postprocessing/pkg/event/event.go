@@ -0,0 +1,43 @@
+// Package event contains the events the postprocessing service understands in
+// addition to the postprocessing events defined by reva.
+package event
+
+import (
+	"encoding/json"
+	"time"
+
+	types "github.com/cs3org/go-cs3apis/cs3/types/v1beta1"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
+)
+
+// RegisterPostprocessingStep is emitted by an external worker to register a custom postprocessing step.
+// Registered steps which are not part of the configured steps are appended as an additional stage, or
+// run in parallel to ParallelTo if set.
+type RegisterPostprocessingStep struct {
+	Step       events.Postprocessingstep
+	ParallelTo events.Postprocessingstep // optional, run in the same stage as this step
+	Timeout    time.Duration             // optional, 0 uses the configured default
+	MaxRetries int                       // optional, 0 uses the configured default, a negative value disables retries
+	Timestamp  *types.Timestamp
+}
+
+// Unmarshal to fulfill umarshaller interface
+func (RegisterPostprocessingStep) Unmarshal(v []byte) (interface{}, error) {
+	e := RegisterPostprocessingStep{}
+	err := json.Unmarshal(v, &e)
+	return e, err
+}
+
+// UnregisterPostprocessingStep is emitted by an external worker to remove a previously registered step.
+// Uploads already in postprocessing are not affected.
+type UnregisterPostprocessingStep struct {
+	Step      events.Postprocessingstep
+	Timestamp *types.Timestamp
+}
+
+// Unmarshal to fulfill umarshaller interface
+func (UnregisterPostprocessingStep) Unmarshal(v []byte) (interface{}, error) {
+	e := UnregisterPostprocessingStep{}
+	err := json.Unmarshal(v, &e)
+	return e, err
+}
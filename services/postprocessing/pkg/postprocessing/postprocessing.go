@@ -2,6 +2,7 @@ package postprocessing
 
 import (
 	"math"
+	"slices"
 	"time"
 
 	user "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
@@ -19,12 +20,18 @@ type Postprocessing struct {
 	Filename          string
 	Filesize          uint64
 	ResourceID        *provider.ResourceId
-	Steps             []events.Postprocessingstep
-	Status            Status
-	Failures          int
-	InitiatorID       string
-	Finished          bool
-	StartTime         time.Time
+	// Steps is the flat list of steps. It is only read to migrate postprocessings stored before stages were introduced.
+	Steps        []events.Postprocessingstep
+	Stages       []Stage
+	StepSettings map[events.Postprocessingstep]StepSettings
+	Status       Status
+	StepFailures map[events.Postprocessingstep]int
+	StepStarted  map[events.Postprocessingstep]time.Time
+	// Deadlines are the times the current attempts of the steps time out. They are stored so the timeouts survive a restart.
+	Deadlines map[events.Postprocessingstep]time.Time
+	InitiatorID  string
+	Finished     bool
+	StartTime    time.Time
 
 	config config.Postprocessing
 }
@@ -33,6 +40,10 @@ type Postprocessing struct {
 type Status struct {
 	CurrentStep events.Postprocessingstep
 	Outcome     events.PostprocessingOutcome
+	// Stage is the index of the stage currently processed
+	Stage int
+	// Pending are the steps of the current stage which have not finished yet
+	Pending []events.Postprocessingstep
 }
 
 // New returns a new postprocessing instance
@@ -43,64 +54,145 @@ func New(config config.Postprocessing) *Postprocessing {
 }
 
 // Init is the first step of the postprocessing
-func (pp *Postprocessing) Init(_ events.BytesReceived) interface{} {
-	if len(pp.Steps) == 0 {
-		return pp.finished(events.PPOutcomeContinue)
+func (pp *Postprocessing) Init(_ events.BytesReceived) []interface{} {
+	pp.migrate()
+	if len(pp.Stages) == 0 {
+		return []interface{}{pp.finished(events.PPOutcomeContinue)}
 	}
 
-	return pp.step(pp.Steps[0])
+	return pp.startStage(0)
 }
 
-// NextStep returns the next postprocessing step
-func (pp *Postprocessing) NextStep(ev events.PostprocessingStepFinished) interface{} {
+// NextStep returns the events to publish after a postprocessing step finished.
+// Events for steps which are not pending, e.g. late answers of a timed out step, are ignored.
+func (pp *Postprocessing) NextStep(ev events.PostprocessingStepFinished) []interface{} {
+	pp.migrate()
+	if !pp.IsPending(ev.FinishedStep) {
+		return nil
+	}
+
 	switch ev.Outcome {
 	case events.PPOutcomeContinue:
-		return pp.next(ev.FinishedStep)
+		pp.Status.Pending = slices.DeleteFunc(pp.Status.Pending, func(s events.Postprocessingstep) bool {
+			return s == ev.FinishedStep
+		})
+		if len(pp.Status.Pending) > 0 {
+			// wait for the other steps of this stage
+			pp.Status.CurrentStep = pp.Status.Pending[0]
+			return nil
+		}
+		if pp.Status.Stage+1 < len(pp.Stages) {
+			return pp.startStage(pp.Status.Stage + 1)
+		}
+		return []interface{}{pp.finished(events.PPOutcomeContinue)}
 	case events.PPOutcomeRetry:
-		pp.Failures++
-		if pp.Failures > pp.config.MaxRetries {
-			return pp.finished(events.PPOutcomeAbort)
+		if pp.StepFailures == nil {
+			pp.StepFailures = make(map[events.Postprocessingstep]int)
 		}
-		return pp.retry()
+		pp.StepFailures[ev.FinishedStep]++
+		if pp.StepFailures[ev.FinishedStep] > pp.MaxRetries(ev.FinishedStep) {
+			return []interface{}{pp.finished(events.PPOutcomeAbort)}
+		}
+		return []interface{}{pp.retry(ev.FinishedStep)}
 	default:
-		return pp.finished(ev.Outcome)
+		return []interface{}{pp.finished(ev.Outcome)}
 	}
 }
 
-// CurrentStep returns the current postprocessing step
-func (pp *Postprocessing) CurrentStep() interface{} {
+// CurrentStep returns the events needed to resume the postprocessing
+func (pp *Postprocessing) CurrentStep() []interface{} {
+	pp.migrate()
 	if pp.Status.CurrentStep == events.PPStepFinished {
-		return pp.finished(pp.Status.Outcome)
+		return []interface{}{pp.finished(pp.Status.Outcome)}
+	}
+
+	next := make([]interface{}, 0, len(pp.Status.Pending))
+	for _, s := range pp.Status.Pending {
+		next = append(next, pp.step(s))
 	}
-	return pp.step(pp.Status.CurrentStep)
+	return next
 }
 
-// Delay will sleep the configured time then continue
+// Delay will sleep the configured time then finish the delay step
 func (pp *Postprocessing) Delay(f func(next interface{})) {
-	next := pp.next(events.PPStepDelay)
+	next := events.PostprocessingStepFinished{
+		UploadID:      pp.ID,
+		ExecutingUser: pp.User,
+		Filename:      pp.Filename,
+		FinishedStep:  events.PPStepDelay,
+		Outcome:       events.PPOutcomeContinue,
+	}
 	go func() {
 		time.Sleep(pp.config.Delayprocessing)
 		f(next)
 	}()
 }
 
-// BackoffDuration calculates the duration for exponential backoff based on the number of failures.
-func (pp *Postprocessing) BackoffDuration() time.Duration {
-	return pp.config.RetryBackoffDuration * time.Duration(math.Pow(2, float64(pp.Failures-1)))
+// BackoffDuration calculates the duration for exponential backoff based on the number of failures of a step.
+func (pp *Postprocessing) BackoffDuration(step events.Postprocessingstep) time.Duration {
+	return pp.config.RetryBackoffDuration * time.Duration(math.Pow(2, float64(pp.StepFailures[step]-1)))
+}
+
+// Timeout returns the time the given step may take, 0 means no limit.
+func (pp *Postprocessing) Timeout(step events.Postprocessingstep) time.Duration {
+	if s, ok := pp.StepSettings[step]; ok && s.Timeout > 0 {
+		return s.Timeout
+	}
+	return pp.config.StepTimeout
+}
+
+// MaxRetries returns the retry budget of the given step.
+func (pp *Postprocessing) MaxRetries(step events.Postprocessingstep) int {
+	if s, ok := pp.StepSettings[step]; ok && s.MaxRetries != 0 {
+		return max(s.MaxRetries, 0)
+	}
+	return pp.config.MaxRetries
+}
+
+// IsPending tells if the given step is running or waiting for a retry.
+func (pp *Postprocessing) IsPending(step events.Postprocessingstep) bool {
+	return slices.Contains(pp.Status.Pending, step)
+}
+
+// Started returns the time the current attempt of the given step was started.
+func (pp *Postprocessing) Started(step events.Postprocessingstep) time.Time {
+	return pp.StepStarted[step]
+}
+
+// Deadline returns the time the current attempt of the given step times out, the zero time means no limit.
+func (pp *Postprocessing) Deadline(step events.Postprocessingstep) time.Time {
+	return pp.Deadlines[step]
 }
 
-func (pp *Postprocessing) next(current events.Postprocessingstep) interface{} {
-	l := len(pp.Steps)
-	for i, s := range pp.Steps {
-		if s == current && i+1 < l {
-			return pp.step(pp.Steps[i+1])
+// migrate converts postprocessings which were stored before stages were introduced.
+func (pp *Postprocessing) migrate() {
+	if len(pp.Stages) == 0 && len(pp.Steps) > 0 {
+		for _, s := range pp.Steps {
+			pp.Stages = append(pp.Stages, Stage{s})
+			if s == pp.Status.CurrentStep {
+				pp.Status.Stage = len(pp.Stages) - 1
+			}
 		}
+		pp.Steps = nil
+	}
+	if pp.Status.Pending == nil && pp.Status.CurrentStep != "" && pp.Status.CurrentStep != events.PPStepFinished {
+		pp.Status.Pending = []events.Postprocessingstep{pp.Status.CurrentStep}
 	}
-	return pp.finished(events.PPOutcomeContinue)
+}
+
+func (pp *Postprocessing) startStage(i int) []interface{} {
+	pp.Status.Stage = i
+	pp.Status.Pending = slices.Clone(pp.Stages[i])
+	next := make([]interface{}, 0, len(pp.Stages[i]))
+	for _, s := range pp.Stages[i] {
+		next = append(next, pp.step(s))
+	}
+	return next
 }
 
 func (pp *Postprocessing) step(next events.Postprocessingstep) events.StartPostprocessingStep {
 	pp.Status.CurrentStep = next
+	pp.markStarted(next, time.Now())
 	return events.StartPostprocessingStep{
 		UploadID:          pp.ID,
 		URL:               pp.URL,
@@ -113,8 +205,26 @@ func (pp *Postprocessing) step(next events.Postprocessingstep) events.StartPostp
 	}
 }
 
+func (pp *Postprocessing) markStarted(step events.Postprocessingstep, t time.Time) {
+	if pp.StepStarted == nil {
+		pp.StepStarted = make(map[events.Postprocessingstep]time.Time)
+	}
+	pp.StepStarted[step] = t
+
+	if pp.Deadlines == nil {
+		pp.Deadlines = make(map[events.Postprocessingstep]time.Time)
+	}
+	timeout := pp.Timeout(step)
+	if timeout <= 0 || step == events.PPStepDelay {
+		delete(pp.Deadlines, step)
+		return
+	}
+	pp.Deadlines[step] = t.Add(timeout)
+}
+
 func (pp *Postprocessing) finished(outcome events.PostprocessingOutcome) events.PostprocessingFinished {
 	pp.Status.CurrentStep = events.PPStepFinished
+	pp.Status.Pending = []events.Postprocessingstep{}
 	pp.Status.Outcome = outcome
 	return events.PostprocessingFinished{
 		UploadID:          pp.ID,
@@ -125,13 +235,16 @@ func (pp *Postprocessing) finished(outcome events.PostprocessingOutcome) events.
 	}
 }
 
-func (pp *Postprocessing) retry() events.PostprocessingRetry {
+func (pp *Postprocessing) retry(step events.Postprocessingstep) events.PostprocessingRetry {
 	pp.Status.Outcome = events.PPOutcomeRetry
+	backoff := pp.BackoffDuration(step)
+	// the next attempt starts once the backoff is over
+	pp.markStarted(step, time.Now().Add(backoff))
 	return events.PostprocessingRetry{
 		UploadID:        pp.ID,
 		ExecutingUser:   pp.User,
 		Filename:        pp.Filename,
-		Failures:        pp.Failures,
-		BackoffDuration: pp.BackoffDuration(),
+		Failures:        pp.StepFailures[step],
+		BackoffDuration: backoff,
 	}
 }
@@ -0,0 +1,174 @@
+package postprocessing
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/opencloud-eu/opencloud/services/postprocessing/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/postprocessing/pkg/event"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
+)
+
+func TestNewPipeline(t *testing.T) {
+	c := config.Postprocessing{
+		Steps: []string{"virusscan", "dlp + ocr", "delay"},
+		StepSettings: map[string]config.StepSettings{
+			"dlp": {Timeout: time.Minute, MaxRetries: 2},
+		},
+	}
+	registered := []event.RegisterPostprocessingStep{
+		{Step: "checksum", ParallelTo: "virusscan", Timeout: time.Second},
+		{Step: "dlp", Timeout: time.Hour},
+		{Step: "thumbnail"},
+	}
+
+	p := NewPipeline(c, registered)
+
+	wantStages := []Stage{{"virusscan", "checksum"}, {"dlp", "ocr"}, {"delay"}, {"thumbnail"}}
+	if !reflect.DeepEqual(p.Stages, wantStages) {
+		t.Errorf("stages = %v, want %v", p.Stages, wantStages)
+	}
+	if got := p.StepSettings["dlp"]; got.Timeout != time.Minute || got.MaxRetries != 2 {
+		t.Errorf("configured step settings must win over registrations, got %+v", got)
+	}
+	if got := p.StepSettings["checksum"]; got.Timeout != time.Second {
+		t.Errorf("registered step settings not applied, got %+v", got)
+	}
+}
+
+func newTestPP(stages ...Stage) *Postprocessing {
+	pp := New(config.Postprocessing{MaxRetries: 1, RetryBackoffDuration: time.Second, StepTimeout: time.Minute})
+	pp.ID = "upload"
+	pp.Stages = stages
+	pp.StepSettings = map[events.Postprocessingstep]StepSettings{"ocr": {Timeout: time.Hour, MaxRetries: -1}}
+	return pp
+}
+
+func finished(step events.Postprocessingstep, outcome events.PostprocessingOutcome) events.PostprocessingStepFinished {
+	return events.PostprocessingStepFinished{UploadID: "upload", FinishedStep: step, Outcome: outcome}
+}
+
+func startedSteps(next []interface{}) []events.Postprocessingstep {
+	var steps []events.Postprocessingstep
+	for _, n := range next {
+		if ev, ok := n.(events.StartPostprocessingStep); ok {
+			steps = append(steps, ev.StepToStart)
+		}
+	}
+	return steps
+}
+
+func TestParallelStage(t *testing.T) {
+	pp := newTestPP(Stage{"virusscan", "ocr"}, Stage{"policies"})
+
+	if got := startedSteps(pp.Init(events.BytesReceived{})); !reflect.DeepEqual(got, []events.Postprocessingstep{"virusscan", "ocr"}) {
+		t.Fatalf("Init started %v", got)
+	}
+	if next := pp.NextStep(finished("ocr", events.PPOutcomeContinue)); next != nil {
+		t.Fatalf("stage must wait for virusscan, got %v", next)
+	}
+	if next := pp.NextStep(finished("ocr", events.PPOutcomeContinue)); next != nil {
+		t.Fatalf("duplicate answers must be ignored, got %v", next)
+	}
+	if got := startedSteps(pp.NextStep(finished("virusscan", events.PPOutcomeContinue))); !reflect.DeepEqual(got, []events.Postprocessingstep{"policies"}) {
+		t.Fatalf("expected the next stage to start, got %v", got)
+	}
+
+	next := pp.NextStep(finished("policies", events.PPOutcomeContinue))
+	if len(next) != 1 {
+		t.Fatalf("expected one event, got %v", next)
+	}
+	if ev, ok := next[0].(events.PostprocessingFinished); !ok || ev.Outcome != events.PPOutcomeContinue {
+		t.Errorf("expected postprocessing to finish, got %v", next[0])
+	}
+}
+
+func TestRetryBudget(t *testing.T) {
+	pp := newTestPP(Stage{"virusscan", "ocr"})
+	pp.Init(events.BytesReceived{})
+
+	if next := pp.NextStep(finished("virusscan", events.PPOutcomeRetry)); len(next) != 1 {
+		t.Fatalf("expected a retry, got %v", next)
+	} else if _, ok := next[0].(events.PostprocessingRetry); !ok {
+		t.Fatalf("expected a retry, got %v", next[0])
+	}
+	if !pp.IsPending("virusscan") {
+		t.Error("retried step must stay pending")
+	}
+
+	// ocr has retries disabled
+	next := pp.NextStep(finished("ocr", events.PPOutcomeRetry))
+	if ev, ok := next[0].(events.PostprocessingFinished); !ok || ev.Outcome != events.PPOutcomeAbort {
+		t.Fatalf("expected abort, got %v", next)
+	}
+	if next := pp.NextStep(finished("virusscan", events.PPOutcomeContinue)); next != nil {
+		t.Errorf("answers after the postprocessing finished must be ignored, got %v", next)
+	}
+}
+
+func TestTimeoutAndRetries(t *testing.T) {
+	pp := newTestPP()
+	if got := pp.Timeout("virusscan"); got != time.Minute {
+		t.Errorf("Timeout(virusscan) = %v", got)
+	}
+	if got := pp.Timeout("ocr"); got != time.Hour {
+		t.Errorf("Timeout(ocr) = %v", got)
+	}
+	if got := pp.MaxRetries("virusscan"); got != 1 {
+		t.Errorf("MaxRetries(virusscan) = %v", got)
+	}
+	if got := pp.MaxRetries("ocr"); got != 0 {
+		t.Errorf("MaxRetries(ocr) = %v", got)
+	}
+}
+
+func TestMigrateStoredSteps(t *testing.T) {
+	// postprocessing as stored before stages were introduced
+	stored := `{"ID":"upload","Steps":["virusscan","policies"],"Status":{"CurrentStep":"policies"}}`
+	pp := New(config.Postprocessing{})
+	if err := json.Unmarshal([]byte(stored), pp); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := startedSteps(pp.CurrentStep()); !reflect.DeepEqual(got, []events.Postprocessingstep{"policies"}) {
+		t.Fatalf("CurrentStep() started %v", got)
+	}
+	next := pp.NextStep(finished("policies", events.PPOutcomeContinue))
+	if _, ok := next[0].(events.PostprocessingFinished); !ok {
+		t.Errorf("expected postprocessing to finish, got %v", next)
+	}
+}
+
+func TestDeadlines(t *testing.T) {
+	pp := newTestPP(Stage{"virusscan", "delay"})
+	pp.StepSettings["virusscan"] = StepSettings{Timeout: time.Minute}
+	pp.Init(events.BytesReceived{})
+
+	if got := pp.Deadline("virusscan"); !got.Equal(pp.Started("virusscan").Add(time.Minute)) {
+		t.Errorf("Deadline(virusscan) = %v, started %v", got, pp.Started("virusscan"))
+	}
+	if got := pp.Deadline("delay"); !got.IsZero() {
+		t.Errorf("the delay step must not time out, got %v", got)
+	}
+
+	// the deadline of a retried step includes the backoff
+	pp.NextStep(finished("virusscan", events.PPOutcomeRetry))
+	if got := pp.Deadline("virusscan"); !got.Equal(pp.Started("virusscan").Add(time.Minute)) || !pp.Started("virusscan").After(time.Now()) {
+		t.Errorf("Deadline(virusscan) = %v, started %v", got, pp.Started("virusscan"))
+	}
+
+	// the deadlines are stored with the postprocessing
+	b, err := json.Marshal(pp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored := New(config.Postprocessing{})
+	if err := json.Unmarshal(b, stored); err != nil {
+		t.Fatal(err)
+	}
+	if !stored.Deadline("virusscan").Equal(pp.Deadline("virusscan")) {
+		t.Errorf("stored deadline = %v, want %v", stored.Deadline("virusscan"), pp.Deadline("virusscan"))
+	}
+}
@@ -0,0 +1,80 @@
+package postprocessing
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/opencloud-eu/opencloud/services/postprocessing/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/postprocessing/pkg/event"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
+)
+
+// Stage is a group of postprocessing steps which are processed in parallel
+type Stage []events.Postprocessingstep
+
+// StepSettings holds the timeout and the retry budget of a single step
+type StepSettings struct {
+	Timeout    time.Duration
+	MaxRetries int
+}
+
+// Pipeline describes all stages an upload passes through
+type Pipeline struct {
+	Stages       []Stage
+	StepSettings map[events.Postprocessingstep]StepSettings
+}
+
+// NewPipeline builds the pipeline from the configured steps and the steps registered by external workers.
+// Configured entries like 'a+b' form a stage of parallel steps. Registered steps which are not configured
+// are added to the stage of their ParallelTo step or appended as a stage of their own.
+func NewPipeline(c config.Postprocessing, registered []event.RegisterPostprocessingStep) Pipeline {
+	p := Pipeline{
+		StepSettings: make(map[events.Postprocessingstep]StepSettings),
+	}
+
+	for _, entry := range c.Steps {
+		var stage Stage
+		for _, s := range strings.Split(entry, "+") {
+			if s = strings.TrimSpace(s); s != "" {
+				stage = append(stage, events.Postprocessingstep(s))
+			}
+		}
+		if len(stage) > 0 {
+			p.Stages = append(p.Stages, stage)
+		}
+	}
+
+	for name, s := range c.StepSettings {
+		p.StepSettings[events.Postprocessingstep(name)] = StepSettings{Timeout: s.Timeout, MaxRetries: s.MaxRetries}
+	}
+
+	for _, r := range registered {
+		if r.Step == "" {
+			continue
+		}
+		if _, ok := c.StepSettings[string(r.Step)]; !ok {
+			p.StepSettings[r.Step] = StepSettings{Timeout: r.Timeout, MaxRetries: r.MaxRetries}
+		}
+		if p.index(r.Step) >= 0 {
+			continue
+		}
+		if i := p.index(r.ParallelTo); r.ParallelTo != "" && i >= 0 {
+			p.Stages[i] = append(p.Stages[i], r.Step)
+			continue
+		}
+		p.Stages = append(p.Stages, Stage{r.Step})
+	}
+
+	return p
+}
+
+// index returns the index of the stage containing the step or -1
+func (p Pipeline) index(step events.Postprocessingstep) int {
+	for i, stage := range p.Stages {
+		if slices.Contains(stage, step) {
+			return i
+		}
+	}
+	return -1
+}
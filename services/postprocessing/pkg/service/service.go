@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"hash/fnv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -13,6 +15,7 @@ import (
 	"github.com/opencloud-eu/opencloud/pkg/log"
 	"github.com/opencloud-eu/opencloud/pkg/version"
 	"github.com/opencloud-eu/opencloud/services/postprocessing/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/postprocessing/pkg/event"
 	"github.com/opencloud-eu/opencloud/services/postprocessing/pkg/metrics"
 	"github.com/opencloud-eu/opencloud/services/postprocessing/pkg/postprocessing"
 	ctxpkg "github.com/opencloud-eu/reva/v2/pkg/ctx"
@@ -30,13 +33,15 @@ type PostprocessingService struct {
 	log     log.Logger
 	events  <-chan raw.Event
 	pub     events.Publisher
-	steps   []events.Postprocessingstep
 	store   store.Store
 	c       config.Postprocessing
 	tp      trace.TracerProvider
 	metrics *metrics.Metrics
 	stopCh  chan struct{}
 	stopped atomic.Bool
+	// locks serialize the updates of an upload within this instance, parallel steps might finish at the same time.
+	// An update lost to another instance is recovered by the stored deadline of the step.
+	locks [64]sync.Mutex
 }
 
 var (
@@ -48,6 +53,9 @@ var (
 	ErrNotFound = errors.New("postprocessing not found")
 )
 
+// registeredStepPrefix is the store key prefix of steps registered by external workers
+const registeredStepPrefix = "registered-step/"
+
 // NewPostprocessingService returns a new instance of a postprocessing service
 func NewPostprocessingService(ctx context.Context, logger log.Logger, sto store.Store, tp trace.TracerProvider, cfg *config.Config) (*PostprocessingService, error) {
 	connName := generators.GenerateConnectionName(cfg.Service.Name, generators.NTypeBus)
@@ -81,7 +89,9 @@ func NewPostprocessingService(ctx context.Context, logger log.Logger, sto store.
 		events.StartPostprocessingStep{},
 		events.UploadReady{},
 		events.PostprocessingStepFinished{},
-		events.ResumePostprocessing{})
+		events.ResumePostprocessing{},
+		event.RegisterPostprocessingStep{},
+		event.UnregisterPostprocessingStep{})
 	if err != nil {
 		return nil, err
 	}
@@ -95,7 +105,6 @@ func NewPostprocessingService(ctx context.Context, logger log.Logger, sto store.
 		log:     logger,
 		events:  evs,
 		pub:     pub,
-		steps:   getSteps(cfg.Postprocessing),
 		store:   sto,
 		c:       cfg.Postprocessing,
 		tp:      tp,
@@ -106,6 +115,8 @@ func NewPostprocessingService(ctx context.Context, logger log.Logger, sto store.
 
 // Run to fulfil Runner interface
 func (pps *PostprocessingService) Run() error {
+	pps.rearm()
+
 	wg := sync.WaitGroup{}
 
 	for range pps.c.Workers {
@@ -173,9 +184,10 @@ func (pps *PostprocessingService) processEvent(e raw.Event) error {
 	pps.log.Debug().Str("Type", e.Type).Str("ID", e.ID).Msg("processing event received")
 
 	var (
-		next interface{}
-		pp   *postprocessing.Postprocessing
-		err  error
+		next  []interface{}
+		pp    *postprocessing.Postprocessing
+		retry events.Postprocessingstep
+		err   error
 	)
 
 	ctx := e.GetTraceContext(pps.ctx)
@@ -193,24 +205,27 @@ func (pps *PostprocessingService) processEvent(e raw.Event) error {
 
 	switch ev := e.Event.Event.(type) {
 	case events.BytesReceived:
-		pp = &postprocessing.Postprocessing{
-			ID:                ev.UploadID,
-			URL:               ev.URL,
-			User:              ev.ExecutingUser,
-			Filename:          ev.Filename,
-			Filesize:          ev.Filesize,
-			ResourceID:        ev.ResourceID,
-			Steps:             pps.steps,
-			InitiatorID:       e.InitiatorID,
-			ImpersonatingUser: ev.ImpersonatingUser,
-			StartTime:         time.Now(),
-		}
+		pipeline := postprocessing.NewPipeline(pps.c, pps.registeredSteps())
+		pp = postprocessing.New(pps.c)
+		pp.ID = ev.UploadID
+		pp.URL = ev.URL
+		pp.User = ev.ExecutingUser
+		pp.Filename = ev.Filename
+		pp.Filesize = ev.Filesize
+		pp.ResourceID = ev.ResourceID
+		pp.Stages = pipeline.Stages
+		pp.StepSettings = pipeline.StepSettings
+		pp.InitiatorID = e.InitiatorID
+		pp.ImpersonatingUser = ev.ImpersonatingUser
+		pp.StartTime = time.Now()
 		next = pp.Init(ev)
 	case events.PostprocessingStepFinished:
 		if ev.UploadID == "" {
 			// no current upload - this was an on demand scan
 			return nil
 		}
+		unlock := pps.lock(ev.UploadID)
+		defer unlock()
 		pp, err = pps.getPP(pps.store, ev.UploadID)
 		if err != nil {
 			pps.log.Error().Str("uploadID", ev.UploadID).Err(err).Msg("cannot get upload")
@@ -218,27 +233,10 @@ func (pps *PostprocessingService) processEvent(e raw.Event) error {
 		}
 		next = pp.NextStep(ev)
 
-		switch pp.Status.Outcome {
-		case events.PPOutcomeRetry:
-			// schedule retry
-			backoff := pp.BackoffDuration()
-			go func() {
-				time.Sleep(backoff)
-				retryEvent := events.StartPostprocessingStep{
-					UploadID:          pp.ID,
-					URL:               pp.URL,
-					ExecutingUser:     pp.User,
-					Filename:          pp.Filename,
-					Filesize:          pp.Filesize,
-					ResourceID:        pp.ResourceID,
-					StepToStart:       pp.Status.CurrentStep,
-					ImpersonatingUser: pp.ImpersonatingUser,
-				}
-				err := events.Publish(ctx, pps.pub, retryEvent)
-				if err != nil {
-					pps.log.Error().Str("uploadID", ev.UploadID).Err(err).Msg("cannot publish RestartPostprocessing event")
-				}
-			}()
+		for _, n := range next {
+			if _, ok := n.(events.PostprocessingRetry); ok {
+				retry = ev.FinishedStep
+			}
 		}
 	case events.StartPostprocessingStep:
 		if ev.StepToStart != events.PPStepDelay {
@@ -254,6 +252,8 @@ func (pps *PostprocessingService) processEvent(e raw.Event) error {
 				pps.log.Error().Err(err).Msg("cannot publish event")
 			}
 		})
+		// nothing changed, no need to store the upload
+		return nil
 	case events.UploadReady:
 		// the upload failed - let's keep it around for a while - but mark it as finished
 		pp, err = pps.getPP(pps.store, ev.UploadID)
@@ -282,6 +282,15 @@ func (pps *PostprocessingService) processEvent(e raw.Event) error {
 		}
 	case events.ResumePostprocessing:
 		return pps.handleResumePPEvent(ctx, ev)
+	case event.RegisterPostprocessingStep:
+		return pps.registerStep(ev)
+	case event.UnregisterPostprocessingStep:
+		if err := pps.store.Delete(registeredStepPrefix + string(ev.Step)); err != nil && err != store.ErrNotFound {
+			pps.log.Error().Str("step", string(ev.Step)).Err(err).Msg("cannot unregister step")
+			return fmt.Errorf("%w: cannot unregister step", ErrEvent)
+		}
+		pps.log.Info().Str("step", string(ev.Step)).Msg("postprocessing step unregistered")
+		return nil
 	}
 
 	if pp != nil {
@@ -292,17 +301,159 @@ func (pps *PostprocessingService) processEvent(e raw.Event) error {
 			pps.log.Error().Str("uploadID", pp.ID).Err(err).Msg("cannot store upload")
 			return fmt.Errorf("%w: cannot store upload", ErrEvent)
 		}
+		if retry != "" {
+			pps.scheduleRetry(ctx, pp, retry)
+		}
+	}
+
+	if err := pps.publish(ctx, pp, next); err != nil {
+		pps.log.Error().Err(err).Msg("unable to publish event")
+		return fmt.Errorf("%w: unable to publish event", ErrFatal) // we can't publish -> we are screwed
+	}
+	return nil
+}
+
+// publish publishes the given events and watches the timeouts of the started steps
+func (pps *PostprocessingService) publish(ctx context.Context, pp *postprocessing.Postprocessing, next []interface{}) error {
+	for _, n := range next {
+		if err := events.Publish(ctx, pps.pub, n); err != nil {
+			return err
+		}
+		if ev, ok := n.(events.StartPostprocessingStep); ok && pp != nil {
+			pps.watchTimeout(ctx, pp, ev.StepToStart)
+		}
+	}
+	return nil
+}
+
+// rearm restarts the delays, retries and timeouts of the unfinished postprocessings in the store, their timers are lost when the service restarts
+func (pps *PostprocessingService) rearm() {
+	for _, pp := range pps.storedPPs() {
+		if pp.Finished || pp.Status.CurrentStep == events.PPStepFinished {
+			continue
+		}
+		ctx := ctxpkg.ContextSetInitiator(pps.ctx, pp.InitiatorID)
+		for _, step := range pp.Status.Pending {
+			switch {
+			case step == events.PPStepDelay:
+				pp.Delay(func(next interface{}) {
+					if err := events.Publish(ctx, pps.pub, next); err != nil {
+						pps.log.Error().Err(err).Msg("cannot publish event")
+					}
+				})
+			case pp.Started(step).After(time.Now()):
+				// the step waits for its next attempt
+				pps.scheduleRetry(ctx, pp, step)
+			default:
+				pps.watchTimeout(ctx, pp, step)
+			}
+		}
 	}
+}
+
+// scheduleRetry restarts the step once the backoff is over unless it finished in the meantime
+func (pps *PostprocessingService) scheduleRetry(ctx context.Context, pp *postprocessing.Postprocessing, step events.Postprocessingstep) {
+	pps.watchTimeout(ctx, pp, step)
+	start := pp.Started(step)
+	time.AfterFunc(time.Until(start), func() {
+		current, err := pps.getPP(pps.store, pp.ID)
+		if err != nil || !current.IsPending(step) || !current.Started(step).Equal(start) {
+			return
+		}
+		retryEvent := events.StartPostprocessingStep{
+			UploadID:          pp.ID,
+			URL:               pp.URL,
+			ExecutingUser:     pp.User,
+			Filename:          pp.Filename,
+			Filesize:          pp.Filesize,
+			ResourceID:        pp.ResourceID,
+			StepToStart:       step,
+			ImpersonatingUser: pp.ImpersonatingUser,
+		}
+		err = events.Publish(ctx, pps.pub, retryEvent)
+		if err != nil {
+			pps.log.Error().Str("uploadID", pp.ID).Err(err).Msg("cannot publish RestartPostprocessing event")
+		}
+	})
+}
 
-	if next != nil {
-		if err := events.Publish(ctx, pps.pub, next); err != nil {
-			pps.log.Error().Err(err).Msg("unable to publish event")
-			return fmt.Errorf("%w: unable to publish event", ErrFatal) // we can't publish -> we are screwed
+// watchTimeout fails the current attempt of a step with a retry outcome once its stored deadline has passed
+func (pps *PostprocessingService) watchTimeout(ctx context.Context, pp *postprocessing.Postprocessing, step events.Postprocessingstep) {
+	deadline := pp.Deadline(step)
+	if deadline.IsZero() {
+		return
+	}
+	time.AfterFunc(time.Until(deadline), func() {
+		current, err := pps.getPP(pps.store, pp.ID)
+		if err != nil || !current.IsPending(step) || !current.Deadline(step).Equal(deadline) {
+			// finished, removed or already in another attempt
+			return
+		}
+		pps.log.Info().Str("uploadID", pp.ID).Str("step", string(step)).Time("deadline", deadline).Msg("postprocessing step timed out")
+		if err := events.Publish(ctx, pps.pub, events.PostprocessingStepFinished{
+			UploadID:      pp.ID,
+			ExecutingUser: pp.User,
+			Filename:      pp.Filename,
+			FinishedStep:  step,
+			Outcome:       events.PPOutcomeRetry,
+			Timestamp:     utils.TSNow(),
+		}); err != nil {
+			pps.log.Error().Str("uploadID", pp.ID).Err(err).Msg("cannot publish timeout event")
 		}
+	})
+}
+
+// lock locks the updates of the given upload and returns the unlock function
+func (pps *PostprocessingService) lock(uploadID string) func() {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(uploadID))
+	mu := &pps.locks[h.Sum32()%uint32(len(pps.locks))]
+	mu.Lock()
+	return mu.Unlock
+}
+
+func (pps *PostprocessingService) registerStep(ev event.RegisterPostprocessingStep) error {
+	if strings.TrimSpace(string(ev.Step)) == "" || ev.Step == events.PPStepFinished {
+		pps.log.Error().Str("step", string(ev.Step)).Msg("invalid step name")
+		return nil
+	}
+	b, err := json.Marshal(ev)
+	if err != nil {
+		return fmt.Errorf("%w: cannot marshal step registration", ErrEvent)
 	}
+	if err := pps.store.Write(&store.Record{
+		Key:   registeredStepPrefix + string(ev.Step),
+		Value: b,
+	}); err != nil {
+		pps.log.Error().Str("step", string(ev.Step)).Err(err).Msg("cannot register step")
+		return fmt.Errorf("%w: cannot register step", ErrEvent)
+	}
+	pps.log.Info().Str("step", string(ev.Step)).Msg("postprocessing step registered")
 	return nil
 }
 
+// registeredSteps returns the steps registered by external workers
+func (pps *PostprocessingService) registeredSteps() []event.RegisterPostprocessingStep {
+	recs, err := pps.store.Read(registeredStepPrefix, store.ReadPrefix())
+	if err != nil {
+		if err != store.ErrNotFound {
+			pps.log.Error().Err(err).Msg("cannot read registered steps")
+		}
+		return nil
+	}
+
+	steps := make([]event.RegisterPostprocessingStep, 0, len(recs))
+	for _, rec := range recs {
+		var s event.RegisterPostprocessingStep
+		if err := json.Unmarshal(rec.Value, &s); err != nil {
+			pps.log.Error().Str("key", rec.Key).Err(err).Msg("cannot unmarshal registered step")
+			continue
+		}
+		steps = append(steps, s)
+	}
+	return steps
+}
+
 func (pps *PostprocessingService) getPP(sto store.Store, uploadID string) (*postprocessing.Postprocessing, error) {
 	recs, err := sto.Read(uploadID)
 	if err != nil {
@@ -329,18 +480,6 @@ func (pps *PostprocessingService) getPP(sto store.Store, uploadID string) (*post
 	return pp, nil
 }
 
-func getSteps(c config.Postprocessing) []events.Postprocessingstep {
-	// NOTE: improved version only allows configuring order of postprocessing steps
-	// But we aim for a system where postprocessing steps can be configured per space, ideally by the spaceadmin itself
-	// We need to iterate over configuring PP service when we see fit
-	steps := make([]events.Postprocessingstep, 0, len(c.Steps))
-	for _, s := range c.Steps {
-		steps = append(steps, events.Postprocessingstep(s))
-	}
-
-	return steps
-}
-
 func storePP(sto store.Store, pp *postprocessing.Postprocessing) error {
 	b, err := json.Marshal(pp)
 	if err != nil {
@@ -368,6 +507,9 @@ func (pps *PostprocessingService) handleResumePPEvent(ctx context.Context, ev ev
 }
 
 func (pps *PostprocessingService) resumePP(ctx context.Context, uploadID string) error {
+	unlock := pps.lock(uploadID)
+	defer unlock()
+
 	pp, err := pps.getPP(pps.store, uploadID)
 	if err != nil {
 		if err == ErrNotFound {
@@ -387,11 +529,26 @@ func (pps *PostprocessingService) resumePP(ctx context.Context, uploadID string)
 		return nil
 	}
 
-	return events.Publish(ctx, pps.pub, pp.CurrentStep())
+	next := pp.CurrentStep()
+	if err := storePP(pps.store, pp); err != nil {
+		return fmt.Errorf("cannot store upload: %w", err)
+	}
+	return pps.publish(ctx, pp, next)
 }
 
 func (pps *PostprocessingService) findUploadsByStep(step events.Postprocessingstep) []string {
 	var ids []string
+	for _, pp := range pps.storedPPs() {
+		if pp.Status.CurrentStep == step || pp.IsPending(step) {
+			ids = append(ids, pp.ID)
+		}
+	}
+	return ids
+}
+
+// storedPPs returns all postprocessings in the store
+func (pps *PostprocessingService) storedPPs() []*postprocessing.Postprocessing {
+	var uploads []*postprocessing.Postprocessing
 
 	keys, err := pps.store.List()
 	if err != nil {
@@ -399,30 +556,18 @@ func (pps *PostprocessingService) findUploadsByStep(step events.Postprocessingst
 	}
 
 	for _, k := range keys {
-		rec, err := pps.store.Read(k)
-		if err != nil {
-			pps.log.Error().Err(err).Msg("cannot read upload")
-			continue
-		}
-
-		if len(rec) != 1 {
-			pps.log.Error().Err(err).Msg("expected only one result")
+		if strings.HasPrefix(k, registeredStepPrefix) {
 			continue
 		}
-
-		pp := &postprocessing.Postprocessing{}
-		err = json.Unmarshal(rec[0].Value, pp)
+		pp, err := pps.getPP(pps.store, k)
 		if err != nil {
-			pps.log.Error().Err(err).Msg("cannot unmarshal upload")
+			pps.log.Error().Str("uploadID", k).Err(err).Msg("cannot read upload")
 			continue
 		}
-
-		if pp.Status.CurrentStep == step {
-			ids = append(ids, pp.ID)
-		}
+		uploads = append(uploads, pp)
 	}
 
-	return ids
+	return uploads
 }
 
 func monitorMetrics(stream raw.Stream, name string, m *metrics.Metrics, logger log.Logger) {
@@ -0,0 +1,165 @@
+package command
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/olekukonko/tablewriter/tw"
+	"github.com/opencloud-eu/opencloud/pkg/config/configlog"
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/config/parser"
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/lockout"
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/logging"
+	"github.com/opencloud-eu/reva/v2/pkg/store"
+	"github.com/urfave/cli/v2"
+	microstore "go-micro.dev/v4/store"
+)
+
+// Lockouts is the entrypoint for the lockouts command.
+func Lockouts(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:  "lockouts",
+		Usage: "manage the lockouts of the brute force protection",
+		Before: func(c *cli.Context) error {
+			return configlog.ReturnFatal(parser.ParseConfig(cfg))
+		},
+		Subcommands: []*cli.Command{
+			ListLockouts(cfg),
+			ClearLockout(cfg),
+		},
+	}
+}
+
+// ListLockouts prints a list of the locked out usernames, public links and IP addresses
+func ListLockouts(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:  "list",
+		Usage: "print a list of the locked out usernames, public links and IP addresses",
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  "all",
+				Usage: "also list the subjects with failed attempts which are not locked out",
+			},
+			&cli.BoolFlag{
+				Name:  "json",
+				Usage: "output as json",
+			},
+		},
+		Action: func(c *cli.Context) error {
+			entries, err := lockoutTracker(cfg).List()
+			if err != nil {
+				return cli.Exit(fmt.Sprintf("could not list the lockouts: %s", err), 1)
+			}
+
+			now := time.Now()
+			if !c.Bool("all") {
+				entries = slices.DeleteFunc(entries, func(e lockout.Entry) bool {
+					return !e.Locked(now)
+				})
+			}
+
+			if c.Bool("json") {
+				b, err := json.Marshal(entries)
+				if err != nil {
+					return cli.Exit(fmt.Sprintf("could not marshal the lockouts: %s", err), 1)
+				}
+				fmt.Println(string(b))
+				return nil
+			}
+
+			table := tablewriter.NewTable(os.Stdout, tablewriter.WithHeaderAutoFormat(tw.Off))
+			table.Header([]string{"Kind", "Subject", "Failures", "Last Failure", "Locked Until"})
+			for _, e := range entries {
+				lockedUntil := ""
+				if e.Locked(now) {
+					lockedUntil = e.LockedUntil.Format(time.RFC3339)
+				}
+				if err := table.Append([]string{
+					string(e.Kind),
+					e.Subject,
+					strconv.Itoa(e.Failures),
+					e.LastFailure.Format(time.RFC3339),
+					lockedUntil,
+				}); err != nil {
+					return err
+				}
+			}
+			return table.Render()
+		},
+	}
+}
+
+// ClearLockout removes the failed attempts and the lockout of usernames, public links or IP addresses
+func ClearLockout(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:      "clear",
+		Usage:     "remove the failed attempts and the lockout of a username, public link or IP address",
+		ArgsUsage: "<subject>",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "kind",
+				Value: string(lockout.KindUser),
+				Usage: fmt.Sprintf("the kind of the subject, one of %v", lockout.Kinds),
+			},
+			&cli.BoolFlag{
+				Name:  "all",
+				Usage: "clear all failed attempts and lockouts",
+			},
+		},
+		Action: func(c *cli.Context) error {
+			tracker := lockoutTracker(cfg)
+
+			if c.Bool("all") {
+				entries, err := tracker.List()
+				if err != nil {
+					return cli.Exit(fmt.Sprintf("could not list the lockouts: %s", err), 1)
+				}
+				for _, e := range entries {
+					if err := tracker.Reset(lockout.Subject{Kind: e.Kind, Name: e.Subject}); err != nil {
+						return cli.Exit(fmt.Sprintf("could not clear the %s '%s': %s", e.Kind, e.Subject, err), 1)
+					}
+				}
+				fmt.Printf("cleared: %d\n", len(entries))
+				return nil
+			}
+
+			kind := lockout.Kind(c.String("kind"))
+			if !slices.Contains(lockout.Kinds, kind) {
+				return cli.Exit(fmt.Sprintf("invalid kind '%s', possible values are: %v", kind, lockout.Kinds), 1)
+			}
+			if c.NArg() != 1 {
+				return cli.Exit("exactly one subject must be given", 1)
+			}
+
+			err := tracker.Clear(lockout.Subject{Kind: kind, Name: c.Args().First()})
+			switch {
+			case errors.Is(err, lockout.ErrNotFound):
+				return cli.Exit(fmt.Sprintf("no failed attempts found for the %s '%s'", kind, c.Args().First()), 1)
+			case err != nil:
+				return cli.Exit(fmt.Sprintf("could not clear the %s '%s': %s", kind, c.Args().First(), err), 1)
+			}
+			fmt.Printf("cleared the %s '%s'\n", kind, c.Args().First())
+			return nil
+		},
+	}
+}
+
+// lockoutTracker returns a tracker reading the store of the proxy
+func lockoutTracker(cfg *config.Config) *lockout.Tracker {
+	st := store.Create(
+		store.Store(cfg.BruteForce.Store.Store),
+		store.TTL(lockout.StoreTTL(cfg.BruteForce)),
+		microstore.Nodes(cfg.BruteForce.Store.Nodes...),
+		microstore.Database("proxy"),
+		microstore.Table("lockouts"),
+		store.DisablePersistence(true),
+		store.Authentication(cfg.BruteForce.Store.AuthUsername, cfg.BruteForce.Store.AuthPassword),
+	)
+	return lockout.NewTracker(st, cfg.BruteForce, nil, logging.Configure(cfg.Service.Name, cfg.Log))
+}
@@ -25,6 +25,7 @@ import (
 	settingssvc "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/settings/v0"
 	"github.com/opencloud-eu/opencloud/services/proxy/pkg/config"
 	"github.com/opencloud-eu/opencloud/services/proxy/pkg/config/parser"
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/lockout"
 	"github.com/opencloud-eu/opencloud/services/proxy/pkg/logging"
 	"github.com/opencloud-eu/opencloud/services/proxy/pkg/metrics"
 	"github.com/opencloud-eu/opencloud/services/proxy/pkg/middleware"
@@ -76,6 +77,26 @@ func Server(cfg *config.Config) *cli.Command {
 				store.Authentication(cfg.PreSignedURL.SigningKeys.AuthUsername, cfg.PreSignedURL.SigningKeys.AuthPassword),
 			)
 
+			rateLimitStore := store.Create(
+				store.Store(cfg.RateLimiting.Store.Store),
+				store.TTL(cfg.RateLimiting.Store.TTL),
+				microstore.Nodes(cfg.RateLimiting.Store.Nodes...),
+				microstore.Database("proxy"),
+				microstore.Table("rate-limits"),
+				store.DisablePersistence(true),
+				store.Authentication(cfg.RateLimiting.Store.AuthUsername, cfg.RateLimiting.Store.AuthPassword),
+			)
+
+			bruteForceStore := store.Create(
+				store.Store(cfg.BruteForce.Store.Store),
+				store.TTL(lockout.StoreTTL(cfg.BruteForce)),
+				microstore.Nodes(cfg.BruteForce.Store.Nodes...),
+				microstore.Database("proxy"),
+				microstore.Table("lockouts"),
+				store.DisablePersistence(true),
+				store.Authentication(cfg.BruteForce.Store.AuthUsername, cfg.BruteForce.Store.AuthPassword),
+			)
+
 			logger := logging.Configure(cfg.Service.Name, cfg.Log)
 			traceProvider, err := tracing.GetTraceProvider(c.Context, cfg.Commons.TracesExporter, cfg.Service.Name)
 			if err != nil {
@@ -186,7 +207,7 @@ func Server(cfg *config.Config) *cli.Command {
 
 			gr := runner.NewGroup()
 			{
-				middlewares := loadMiddlewares(logger, cfg, userInfoCache, signingKeyStore, traceProvider, *m, userProvider, publisher, gatewaySelector, serviceSelector)
+				middlewares := loadMiddlewares(logger, cfg, userInfoCache, signingKeyStore, rateLimitStore, bruteForceStore, traceProvider, *m, userProvider, publisher, gatewaySelector, serviceSelector)
 
 				server, err := proxyHTTP.Server(
 					proxyHTTP.Handler(lh.Handler()),
@@ -236,7 +257,7 @@ func Server(cfg *config.Config) *cli.Command {
 }
 
 func loadMiddlewares(logger log.Logger, cfg *config.Config,
-	userInfoCache, signingKeyStore microstore.Store,
+	userInfoCache, signingKeyStore, rateLimitStore, bruteForceStore microstore.Store,
 	traceProvider trace.TracerProvider, metrics metrics.Metrics,
 	userProvider backend.UserBackend, publisher events.Publisher,
 	gatewaySelector pool.Selectable[gateway.GatewayAPIClient], serviceSelector selector.Selector) alice.Chain {
@@ -275,6 +296,11 @@ func loadMiddlewares(logger log.Logger, cfg *config.Config,
 		Timeout: time.Second * 10,
 	}
 
+	var lockoutTracker *lockout.Tracker
+	if cfg.BruteForce.Enabled {
+		lockoutTracker = lockout.NewTracker(bruteForceStore, cfg.BruteForce, publisher, logger)
+	}
+
 	var authenticators []middleware.Authenticator
 	if cfg.EnableBasicAuth {
 		logger.Warn().Msg("basic auth enabled, use only for testing or development")
@@ -309,6 +335,7 @@ func loadMiddlewares(logger log.Logger, cfg *config.Config,
 	authenticators = append(authenticators, middleware.PublicShareAuthenticator{
 		Logger:              logger,
 		RevaGatewaySelector: gatewaySelector,
+		Lockout:             lockoutTracker,
 	})
 
 	signURLVerifier, err := signedurl.NewJWTSignedURL(signedurl.WithSecret(cfg.Commons.URLSigningSecret))
@@ -331,8 +358,13 @@ func loadMiddlewares(logger log.Logger, cfg *config.Config,
 		logger.Fatal().Err(err).Msg("Failed to load CSP configuration.")
 	}
 
+	trustedProxies, err := middleware.ParseTrustedProxies(cfg.TrustedProxies)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("Failed to parse the trusted proxies.")
+	}
+
 	return alice.New(
-		chimiddleware.RealIP,
+		middleware.RealIP(logger, trustedProxies),
 		chimiddleware.RequestID,
 		// first make sure we log all requests and redirect to https if necessary
 		otelhttp.NewMiddleware("proxy",
@@ -356,6 +388,8 @@ func loadMiddlewares(logger log.Logger, cfg *config.Config,
 			middleware.OIDCIss(cfg.OIDC.Issuer),
 			middleware.EnableBasicAuth(cfg.EnableBasicAuth || cfg.AuthMiddleware.AllowAppAuth),
 			middleware.TraceProvider(traceProvider),
+			middleware.EventsPublisher(publisher),
+			middleware.Lockout(lockoutTracker),
 		),
 		middleware.AccountResolver(
 			middleware.Logger(logger),
@@ -369,6 +403,10 @@ func loadMiddlewares(logger log.Logger, cfg *config.Config,
 			middleware.MultiTenantEnabled(cfg.Commons.MultiTenantEnabled),
 			middleware.EventsPublisher(publisher),
 		),
+		middleware.RateLimiter(
+			middleware.Logger(logger),
+			middleware.RateLimitStore(rateLimitStore),
+		),
 		middleware.SelectorCookie(
 			middleware.Logger(logger),
 			middleware.TraceProvider(traceProvider),
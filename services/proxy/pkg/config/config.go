@@ -31,6 +31,9 @@ type Config struct {
 	RoleAssignment                RoleAssignment      `yaml:"role_assignment"`
 	PolicySelector                *PolicySelector     `yaml:"policy_selector"`
 	PreSignedURL                  PreSignedURL        `yaml:"pre_signed_url"`
+	RateLimiting                  RateLimiting        `yaml:"rate_limiting"`
+	BruteForce                    BruteForce          `yaml:"brute_force_protection"`
+	TrustedProxies                []string            `yaml:"trusted_proxies" env:"PROXY_TRUSTED_PROXIES" desc:"A list of IP addresses and CIDR ranges of the reverse proxies in front of the proxy service. The client IP address is only taken from the X-Forwarded-For and X-Real-IP headers of requests sent by these proxies. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
 	AccountBackend                string              `yaml:"account_backend" env:"PROXY_ACCOUNT_BACKEND_TYPE" desc:"Account backend the PROXY service should use. Currently only 'cs3' is possible here." introductionVersion:"1.0.0"`
 	UserOIDCClaim                 string              `yaml:"user_oidc_claim" env:"PROXY_USER_OIDC_CLAIM" desc:"The name of an OpenID Connect claim that is used for resolving users with the account backend. The value of the claim must hold a per user unique, stable and non re-assignable identifier. The availability of claims depends on your Identity Provider. There are common claims available for most Identity providers like 'email' or 'preferred_username' but you can also add your own claim." introductionVersion:"1.0.0"`
 	UserCS3Claim                  string              `yaml:"user_cs3_claim" env:"PROXY_USER_CS3_CLAIM" desc:"The name of a CS3 user attribute (claim) that should be mapped to the 'user_oidc_claim'. Supported values are 'username', 'mail' and 'userid'." introductionVersion:"1.0.0"`
@@ -70,8 +73,57 @@ type Route struct {
 	AdditionalHeaders map[string]string `yaml:"additional_headers,omitempty"`
 	RemoteUserHeader  string            `yaml:"remote_user_header,omitempty"`
 	SkipXAccessToken  bool              `yaml:"skip_x_access_token"`
+	// RateLimit optionally limits the requests clients can send to this route
+	RateLimit *RateLimit `yaml:"rate_limit,omitempty"`
 }
 
+// RateLimit defines the request quota of a route. Requests exceeding the
+// quota are rejected with a 429 status.
+type RateLimit struct {
+	// Requests is the number of requests a client can send per window.
+	// 0 disables the limit.
+	Requests int `yaml:"requests"`
+	// Window is the duration of the window, one minute if not set
+	Window time.Duration `yaml:"window,omitempty"`
+	// Concurrent is the number of requests a client can have in progress
+	// at the same time. 0 disables the limit. Unlike the requests per
+	// window, this is enforced by every proxy instance on its own.
+	Concurrent int `yaml:"concurrent,omitempty"`
+	// Key tells the clients apart, see the RateLimitKey constants.
+	// If not set, the most specific key of the request is used.
+	Key RateLimitKey `yaml:"key,omitempty"`
+}
+
+// DefaultRateLimitWindow is the window of rate limits without a window
+const DefaultRateLimitWindow = time.Minute
+
+// EffectiveWindow returns the window of the rate limit or the default window
+func (rl *RateLimit) EffectiveWindow() time.Duration {
+	if rl.Window == 0 {
+		return DefaultRateLimitWindow
+	}
+	return rl.Window
+}
+
+// RateLimitKey defines how clients are told apart by the rate limiter
+type RateLimitKey string
+
+const (
+	// RateLimitKeyIP limits the requests per client IP address
+	RateLimitKeyIP RateLimitKey = "ip"
+	// RateLimitKeyUser limits the requests per authenticated user
+	RateLimitKeyUser RateLimitKey = "user"
+	// RateLimitKeyPublicLink limits the requests per verified public link token
+	RateLimitKeyPublicLink RateLimitKey = "public_link"
+	// RateLimitKeyAppToken limits the requests per verified app token or basic auth password
+	RateLimitKeyAppToken RateLimitKey = "app_token"
+)
+
+var (
+	// RateLimitKeys is an array of the available rate limit keys
+	RateLimitKeys = []RateLimitKey{RateLimitKeyIP, RateLimitKeyUser, RateLimitKeyPublicLink, RateLimitKeyAppToken}
+)
+
 // RouteType defines the type of route
 type RouteType string
 
@@ -195,6 +247,46 @@ type SigningKeys struct {
 	AuthPassword       string        `yaml:"password" env:"OC_CACHE_AUTH_PASSWORD;PROXY_PRESIGNEDURL_SIGNING_KEYS_STORE_AUTH_PASSWORD" desc:"The password to authenticate with the store. Only applies when store type 'nats-js-kv' is configured." introductionVersion:"1.0.0"`
 }
 
+// RateLimiting configures the rate limiter. The limits themselves are
+// configured per route.
+type RateLimiting struct {
+	Store *RateLimitStore `yaml:"store"`
+}
+
+// RateLimitStore is the store holding the request counters. Proxy instances
+// sharing the store enforce the same limits.
+type RateLimitStore struct {
+	Store        string        `yaml:"store" env:"OC_CACHE_STORE;PROXY_RATE_LIMIT_STORE" desc:"The type of the store holding the request counters of the rate limiter. Supported values are: 'memory', 'redis-sentinel' and 'nats-js-kv'. Use a shared store to enforce the limits across all proxy instances. See the text description for details." introductionVersion:"%%NEXT%%"`
+	Nodes        []string      `yaml:"addresses" env:"OC_CACHE_STORE_NODES;PROXY_RATE_LIMIT_STORE_NODES" desc:"A list of nodes to access the configured store. This has no effect when 'memory' store is configured. Note that the behaviour how nodes are used is dependent on the library of the configured store. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	TTL          time.Duration `yaml:"ttl" env:"PROXY_RATE_LIMIT_STORE_TTL" desc:"Time to live for the request counters in the store. Stores like 'nats-js-kv' drop the counters only after this time, so it must be at least as long as the longest rate limit window. Defaults to '1h'. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	AuthUsername string        `yaml:"username" env:"OC_CACHE_AUTH_USERNAME;PROXY_RATE_LIMIT_STORE_AUTH_USERNAME" desc:"The username to authenticate with the store. Only applies when store type 'nats-js-kv' is configured." introductionVersion:"%%NEXT%%"`
+	AuthPassword string        `yaml:"password" env:"OC_CACHE_AUTH_PASSWORD;PROXY_RATE_LIMIT_STORE_AUTH_PASSWORD" desc:"The password to authenticate with the store. Only applies when store type 'nats-js-kv' is configured." introductionVersion:"%%NEXT%%"`
+}
+
+// BruteForce configures the protection against brute force attacks on basic
+// auth, app tokens and public link passwords.
+type BruteForce struct {
+	Enabled          bool          `yaml:"enabled" env:"PROXY_BRUTE_FORCE_PROTECTION_ENABLED" desc:"Track failed sign in attempts with basic auth, app tokens and public link passwords and slow down or lock out the attackers. See the text description for details." introductionVersion:"%%NEXT%%"`
+	MaxFailures      int           `yaml:"max_failures" env:"PROXY_BRUTE_FORCE_PROTECTION_MAX_FAILURES" desc:"The number of failed attempts after which a username or a public link is locked out." introductionVersion:"%%NEXT%%"`
+	MaxFailuresPerIP int           `yaml:"max_failures_per_ip" env:"PROXY_BRUTE_FORCE_PROTECTION_MAX_FAILURES_PER_IP" desc:"The number of failed attempts after which a client IP address is locked out. Clients behind a NAT share their IP address, so this should be higher than the limit per username." introductionVersion:"%%NEXT%%"`
+	BackoffBase      time.Duration `yaml:"backoff_base" env:"PROXY_BRUTE_FORCE_PROTECTION_BACKOFF_BASE" desc:"The delay after the first failed attempt. The delay doubles with every further failed attempt. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	BackoffBasePerIP time.Duration `yaml:"backoff_base_per_ip" env:"PROXY_BRUTE_FORCE_PROTECTION_BACKOFF_BASE_PER_IP" desc:"The delay of a client IP address after as many failed attempts as 'max_failures'. The delay doubles with every further failed attempt. Clients behind a NAT share their IP address, so this should be shorter than the delay of a username. Set to '0' to not delay IP addresses. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	BackoffMax       time.Duration `yaml:"backoff_max" env:"PROXY_BRUTE_FORCE_PROTECTION_BACKOFF_MAX" desc:"The maximum delay after a failed attempt. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	LockoutDuration  time.Duration `yaml:"lockout_duration" env:"PROXY_BRUTE_FORCE_PROTECTION_LOCKOUT_DURATION" desc:"The time a username, public link or IP address stays locked out. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	FailureWindow    time.Duration `yaml:"failure_window" env:"PROXY_BRUTE_FORCE_PROTECTION_FAILURE_WINDOW" desc:"The failed attempts are forgotten when there was no further failed attempt for this duration. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+
+	Store *BruteForceStore `yaml:"store"`
+}
+
+// BruteForceStore is the store holding the failed sign in attempts. Proxy
+// instances sharing the store share the lockouts.
+type BruteForceStore struct {
+	Store        string   `yaml:"store" env:"OC_CACHE_STORE;PROXY_BRUTE_FORCE_PROTECTION_STORE" desc:"The type of the store holding the failed sign in attempts. Supported values are: 'memory', 'redis-sentinel' and 'nats-js-kv'. Use a shared store to share the lockouts between all proxy instances and the 'lockouts' command. See the text description for details." introductionVersion:"%%NEXT%%"`
+	Nodes        []string `yaml:"addresses" env:"OC_CACHE_STORE_NODES;PROXY_BRUTE_FORCE_PROTECTION_STORE_NODES" desc:"A list of nodes to access the configured store. This has no effect when 'memory' store is configured. Note that the behaviour how nodes are used is dependent on the library of the configured store. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+	AuthUsername string   `yaml:"username" env:"OC_CACHE_AUTH_USERNAME;PROXY_BRUTE_FORCE_PROTECTION_STORE_AUTH_USERNAME" desc:"The username to authenticate with the store. Only applies when store type 'nats-js-kv' is configured." introductionVersion:"%%NEXT%%"`
+	AuthPassword string   `yaml:"password" env:"OC_CACHE_AUTH_PASSWORD;PROXY_BRUTE_FORCE_PROTECTION_STORE_AUTH_PASSWORD" desc:"The password to authenticate with the store. Only applies when store type 'nats-js-kv' is configured." introductionVersion:"%%NEXT%%"`
+}
+
 // ClaimsSelectorConf is the config for the claims-selector
 type ClaimsSelectorConf struct {
 	DefaultPolicy         string `yaml:"default_policy"`
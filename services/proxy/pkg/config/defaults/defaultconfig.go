@@ -82,6 +82,26 @@ func DefaultConfig() *config.Config {
 				DisablePersistence: true,
 			},
 		},
+		RateLimiting: config.RateLimiting{
+			Store: &config.RateLimitStore{
+				Store: "memory",
+				Nodes: []string{"127.0.0.1:9233"},
+				TTL:   time.Hour,
+			},
+		},
+		BruteForce: config.BruteForce{
+			MaxFailures:      10,
+			MaxFailuresPerIP: 100,
+			BackoffBase:      time.Second,
+			BackoffBasePerIP: 100 * time.Millisecond,
+			BackoffMax:       time.Minute,
+			LockoutDuration:  15 * time.Minute,
+			FailureWindow:    time.Hour,
+			Store: &config.BruteForceStore{
+				Store: "memory",
+				Nodes: []string{"127.0.0.1:9233"},
+			},
+		},
 		AccountBackend:        "cs3",
 		UserOIDCClaim:         "preferred_username",
 		UserCS3Claim:          "username",
@@ -285,6 +305,10 @@ func DefaultPolicies() []config.Policy {
 					Endpoint: "/auth-app/tokens",
 					Service:  "eu.opencloud.web.auth-app",
 				},
+				{
+					Endpoint: "/api/v0/collaboration",
+					Service:  "eu.opencloud.web.collaboration",
+				},
 				{
 					Endpoint:         "/wopi",
 					Service:          "eu.opencloud.web.collaboration",
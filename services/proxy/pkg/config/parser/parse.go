@@ -3,11 +3,13 @@ package parser
 import (
 	"errors"
 	"fmt"
+	"slices"
 
 	occfg "github.com/opencloud-eu/opencloud/pkg/config"
 	"github.com/opencloud-eu/opencloud/pkg/shared"
 	"github.com/opencloud-eu/opencloud/services/proxy/pkg/config"
 	"github.com/opencloud-eu/opencloud/services/proxy/pkg/config/defaults"
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/middleware"
 
 	"github.com/opencloud-eu/opencloud/pkg/config/envdecode"
 )
@@ -65,5 +67,70 @@ func Validate(cfg *config.Config) error {
 		return shared.MissingURLSigningSecret(cfg.Service.Name)
 	}
 
+	if err := validateRateLimits(cfg); err != nil {
+		return err
+	}
+
+	if _, err := middleware.ParseTrustedProxies(cfg.TrustedProxies); err != nil {
+		return fmt.Errorf("Invalid value for 'trusted_proxies' in service %s: %w", cfg.Service.Name, err)
+	}
+
+	return validateBruteForce(cfg)
+}
+
+func validateBruteForce(cfg *config.Config) error {
+	bf := cfg.BruteForce
+	if !bf.Enabled {
+		return nil
+	}
+	if bf.MaxFailures < 1 || bf.MaxFailuresPerIP < 1 {
+		return fmt.Errorf(
+			"Invalid value for 'max_failures' or 'max_failures_per_ip' of the brute force protection in service %s. The values must be at least 1.",
+			cfg.Service.Name,
+		)
+	}
+	if bf.BackoffBase < 0 || bf.BackoffBasePerIP < 0 || bf.BackoffMax < 0 || bf.LockoutDuration < 0 || bf.FailureWindow <= 0 {
+		return fmt.Errorf(
+			"Invalid durations of the brute force protection in service %s. The durations must not be negative and the 'failure_window' must be set.",
+			cfg.Service.Name,
+		)
+	}
+	return nil
+}
+
+func validateRateLimits(cfg *config.Config) error {
+	ttl := cfg.RateLimiting.Store.TTL
+	if ttl <= 0 {
+		return fmt.Errorf(
+			"Invalid value for the 'ttl' of the rate limit store in service %s. The value must be positive.",
+			cfg.Service.Name,
+		)
+	}
+	for _, policy := range cfg.Policies {
+		for _, route := range policy.Routes {
+			rl := route.RateLimit
+			if rl == nil {
+				continue
+			}
+			if rl.Requests < 0 || rl.Concurrent < 0 || rl.Window < 0 {
+				return fmt.Errorf(
+					"Invalid rate limit for the route '%s' of the policy '%s' in service %s. The limits must not be negative.",
+					route.Endpoint, policy.Name, cfg.Service.Name,
+				)
+			}
+			if rl.Key != "" && !slices.Contains(config.RateLimitKeys, rl.Key) {
+				return fmt.Errorf(
+					"Invalid value '%s' for the rate limit 'key' of the route '%s' of the policy '%s' in service %s. Possible values are: %v.",
+					rl.Key, route.Endpoint, policy.Name, cfg.Service.Name, config.RateLimitKeys,
+				)
+			}
+			if rl.EffectiveWindow() > ttl {
+				return fmt.Errorf(
+					"Invalid rate limit for the route '%s' of the policy '%s' in service %s. The window %s is longer than the 'ttl' %s of the rate limit store.",
+					route.Endpoint, policy.Name, cfg.Service.Name, rl.EffectiveWindow(), ttl,
+				)
+			}
+		}
+	}
 	return nil
 }
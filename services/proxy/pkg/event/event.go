@@ -0,0 +1,46 @@
+package event
+
+import (
+	"encoding/json"
+
+	types "github.com/cs3org/go-cs3apis/cs3/types/v1beta1"
+)
+
+// SignInFailed is emitted when the credentials of a request are rejected
+type SignInFailed struct {
+	// Username is the username of basic auth credentials, it is empty for tokens
+	Username   string
+	Method     string
+	RemoteAddr string
+	UserAgent  string
+	URL        string
+	Timestamp  *types.Timestamp
+}
+
+// Unmarshal to fulfill umarshaller interface
+func (SignInFailed) Unmarshal(v []byte) (interface{}, error) {
+	e := SignInFailed{}
+	err := json.Unmarshal(v, &e)
+	return e, err
+}
+
+// LockedOut is emitted when a username, a public link or a client IP address
+// is locked out after too many failed sign in attempts
+type LockedOut struct {
+	// Kind is either "user", "public_link" or "ip"
+	Kind        string
+	Subject     string
+	Failures    int
+	LockedUntil *types.Timestamp
+	RemoteAddr  string
+	UserAgent   string
+	URL         string
+	Timestamp   *types.Timestamp
+}
+
+// Unmarshal to fulfill umarshaller interface
+func (LockedOut) Unmarshal(v []byte) (interface{}, error) {
+	e := LockedOut{}
+	err := json.Unmarshal(v, &e)
+	return e, err
+}
@@ -0,0 +1,330 @@
+package lockout
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/event"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
+	"github.com/opencloud-eu/reva/v2/pkg/utils"
+	"go-micro.dev/v4/store"
+)
+
+const _keyPrefix = "lockout/"
+
+// ErrNotFound is returned when no failed attempts are tracked for a subject
+var ErrNotFound = errors.New("no failed attempts found")
+
+// Kind is the kind of subject failed attempts are tracked for
+type Kind string
+
+const (
+	// KindUser tracks the failed attempts per username of basic auth and app token credentials
+	KindUser Kind = "user"
+	// KindPublicLink tracks the failed password attempts per public link token
+	KindPublicLink Kind = "public_link"
+	// KindIP tracks the failed attempts per client IP address
+	KindIP Kind = "ip"
+)
+
+var (
+	// Kinds is an array of the available subject kinds
+	Kinds = []Kind{KindUser, KindPublicLink, KindIP}
+)
+
+// Subject identifies what the failed attempts are tracked for
+type Subject struct {
+	Kind Kind
+	Name string
+}
+
+// Entry holds the failed attempts of a subject
+type Entry struct {
+	Kind        Kind      `json:"kind"`
+	Subject     string    `json:"subject"`
+	Failures    int       `json:"failures"`
+	LastFailure time.Time `json:"lastFailure"`
+	LockedUntil time.Time `json:"lockedUntil"`
+}
+
+// Locked tells if the subject is locked out at the given time
+func (e Entry) Locked(now time.Time) bool {
+	return now.Before(e.LockedUntil)
+}
+
+// LogSubject returns the subject as it may be logged and published. Public
+// link tokens grant access to the link, so only a part of their hash is
+// returned.
+func (e Entry) LogSubject() string {
+	if e.Kind != KindPublicLink {
+		return e.Subject
+	}
+	sum := sha256.Sum256([]byte(e.Subject))
+	return "sha256:" + hex.EncodeToString(sum[:])[:16]
+}
+
+// Tracker tracks failed sign in attempts in a store. Every failed attempt of
+// a user or public link delays its next attempt, the delay doubles with every
+// failure. IP addresses are only delayed after as many failures as a user may
+// have, starting with a shorter delay. After too many failures the subject is
+// locked out for a while. Proxy instances sharing the store share the delays
+// and lockouts.
+//
+// The entries aren't updated atomically, concurrent attempts might be
+// counted as one.
+type Tracker struct {
+	store     store.Store
+	cfg       config.BruteForce
+	publisher events.Publisher
+	logger    log.Logger
+	now       func() time.Time
+}
+
+// NewTracker creates a new tracker. The publisher is optional, if set a
+// LockedOut event is published for every lockout.
+func NewTracker(st store.Store, cfg config.BruteForce, publisher events.Publisher, logger log.Logger) *Tracker {
+	return &Tracker{
+		store:     st,
+		cfg:       cfg,
+		publisher: publisher,
+		logger:    logger,
+		now:       time.Now,
+	}
+}
+
+// StoreTTL returns the time the store has to keep the entries. Stores like
+// nats-js-kv ignore the expiry of single records and drop them after the ttl
+// of the store.
+func StoreTTL(cfg config.BruteForce) time.Duration {
+	return max(cfg.FailureWindow, cfg.LockoutDuration)
+}
+
+// Delay returns the time until the subjects may try again. A zero delay
+// means no subject is delayed or locked out.
+func (t *Tracker) Delay(subjects ...Subject) (time.Duration, error) {
+	now := t.now()
+	var delay time.Duration
+	for _, s := range subjects {
+		e, err := t.Get(s)
+		switch {
+		case errors.Is(err, ErrNotFound):
+			continue
+		case err != nil:
+			return 0, err
+		}
+		if d := t.blockedUntil(e).Sub(now); d > delay {
+			delay = d
+		}
+	}
+	return delay, nil
+}
+
+// Fail records a failed attempt of the request for the subjects. Subjects
+// exceeding the number of allowed failures are locked out.
+func (t *Tracker) Fail(r *http.Request, subjects ...Subject) error {
+	now := t.now()
+	var errs []error
+	for _, s := range subjects {
+		e, err := t.Get(s)
+		switch {
+		case errors.Is(err, ErrNotFound):
+			e = Entry{Kind: s.Kind, Subject: s.Name}
+		case err != nil:
+			errs = append(errs, err)
+			continue
+		}
+
+		if now.Sub(e.LastFailure) > t.cfg.FailureWindow {
+			// the store might not have expired the entry yet
+			e.Failures = 0
+		}
+		e.Failures++
+		e.LastFailure = now
+
+		lockedOut := false
+		if e.Failures >= t.maxFailures(s.Kind) && !e.Locked(now) {
+			e.LockedUntil = now.Add(t.cfg.LockoutDuration)
+			lockedOut = true
+		}
+
+		if err := t.write(e); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if lockedOut {
+			t.logger.Warn().
+				Str("kind", string(e.Kind)).
+				Str("subject", e.LogSubject()).
+				Int("failures", e.Failures).
+				Time("lockedUntil", e.LockedUntil).
+				Msg("too many failed sign in attempts, locked out")
+			t.publishLockedOut(r, e)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Reset forgets the failed attempts of the subjects, e.g. after a successful
+// sign in
+func (t *Tracker) Reset(subjects ...Subject) error {
+	var errs []error
+	for _, s := range subjects {
+		if err := t.store.Delete(key(s)); err != nil && !errors.Is(err, store.ErrNotFound) {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Get returns the failed attempts of a subject
+func (t *Tracker) Get(s Subject) (Entry, error) {
+	records, err := t.store.Read(key(s))
+	switch {
+	case errors.Is(err, store.ErrNotFound):
+		return Entry{}, ErrNotFound
+	case err != nil:
+		return Entry{}, err
+	case len(records) == 0:
+		return Entry{}, ErrNotFound
+	}
+
+	e := Entry{}
+	if err := json.Unmarshal(records[0].Value, &e); err != nil {
+		return Entry{}, err
+	}
+	return e, nil
+}
+
+// List returns the failed attempts of all subjects, the most recent failure
+// first
+func (t *Tracker) List() ([]Entry, error) {
+	keys, err := t.store.List(store.ListPrefix(_keyPrefix))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]Entry, 0, len(keys))
+	for _, k := range keys {
+		records, err := t.store.Read(k)
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			// expired in the meantime
+			continue
+		case err != nil:
+			return nil, err
+		}
+		for _, r := range records {
+			e := Entry{}
+			if err := json.Unmarshal(r.Value, &e); err != nil {
+				return nil, err
+			}
+			entries = append(entries, e)
+		}
+	}
+
+	slices.SortFunc(entries, func(a, b Entry) int {
+		return b.LastFailure.Compare(a.LastFailure)
+	})
+	return entries, nil
+}
+
+// Clear removes the failed attempts and the lockout of a subject
+func (t *Tracker) Clear(s Subject) error {
+	if _, err := t.Get(s); err != nil {
+		return err
+	}
+	return t.Reset(s)
+}
+
+func (t *Tracker) write(e Entry) error {
+	value, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	expiry := e.LastFailure.Add(t.cfg.FailureWindow)
+	if e.LockedUntil.After(expiry) {
+		expiry = e.LockedUntil
+	}
+	return t.store.Write(&store.Record{
+		Key:    key(Subject{Kind: e.Kind, Name: e.Subject}),
+		Value:  value,
+		Expiry: expiry.Sub(t.now()),
+	})
+}
+
+// blockedUntil returns the time the subject of the entry may try again.
+// Clients behind a NAT share their IP address, every failure of one of them
+// slows down all of them. So IP addresses are only delayed after as many
+// failures as a single user may have, and with their own base delay.
+func (t *Tracker) blockedUntil(e Entry) time.Time {
+	delay := t.backoff(t.cfg.BackoffBase, e.Failures)
+	if e.Kind == KindIP {
+		delay = t.backoff(t.cfg.BackoffBasePerIP, e.Failures-t.cfg.MaxFailures)
+	}
+	until := e.LastFailure.Add(delay)
+	if e.LockedUntil.After(until) {
+		until = e.LockedUntil
+	}
+	return until
+}
+
+// backoff returns the delay after the given number of failures
+func (t *Tracker) backoff(base time.Duration, failures int) time.Duration {
+	if failures < 1 || base <= 0 {
+		return 0
+	}
+	limit := max(t.cfg.BackoffMax, base)
+	delay := base
+	for i := 1; i < failures && delay < limit; i++ {
+		delay *= 2
+	}
+	return min(delay, limit)
+}
+
+func (t *Tracker) maxFailures(kind Kind) int {
+	if kind == KindIP {
+		return t.cfg.MaxFailuresPerIP
+	}
+	return t.cfg.MaxFailures
+}
+
+func (t *Tracker) publishLockedOut(r *http.Request, e Entry) {
+	if t.publisher == nil {
+		return
+	}
+
+	remoteAddr, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		remoteAddr = r.RemoteAddr
+	}
+	ev := event.LockedOut{
+		Kind:        string(e.Kind),
+		Subject:     e.LogSubject(),
+		Failures:    e.Failures,
+		LockedUntil: utils.TimeToTS(e.LockedUntil),
+		RemoteAddr:  remoteAddr,
+		UserAgent:   r.UserAgent(),
+		URL:         r.URL.Path,
+		Timestamp:   utils.TimeToTS(e.LastFailure),
+	}
+	if err := events.Publish(r.Context(), t.publisher, ev); err != nil {
+		t.logger.Error().Err(err).Msg("could not publish locked out event")
+	}
+}
+
+// key returns the store key of a subject. The name is hashed, it might
+// contain any characters.
+func key(s Subject) string {
+	sum := sha256.Sum256([]byte(string(s.Kind) + "\x00" + s.Name))
+	return _keyPrefix + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,222 @@
+package lockout
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/event"
+	eventsmocks "github.com/opencloud-eu/reva/v2/pkg/events/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go-micro.dev/v4/store"
+)
+
+var (
+	_user = Subject{Kind: KindUser, Name: "einstein"}
+	_ip   = Subject{Kind: KindIP, Name: "192.0.2.1"}
+)
+
+func newTracker(publisher *eventsmocks.Stream) (*Tracker, *time.Time) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	cfg := config.BruteForce{
+		Enabled:          true,
+		MaxFailures:      3,
+		MaxFailuresPerIP: 5,
+		BackoffBase:      time.Second,
+		BackoffBasePerIP: 500 * time.Millisecond,
+		BackoffMax:       4 * time.Second,
+		LockoutDuration:  15 * time.Minute,
+		FailureWindow:    time.Hour,
+	}
+	t := NewTracker(store.NewMemoryStore(), cfg, publisher, log.NewLogger())
+	t.now = func() time.Time { return now }
+	return t, &now
+}
+
+func request() *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/dav/spaces/", http.NoBody)
+	req.RemoteAddr = "192.0.2.1:1234"
+	return req
+}
+
+func TestBackoff(t *testing.T) {
+	tracker, _ := newTracker(nil)
+
+	for failures, expected := range []time.Duration{0, time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second, 4 * time.Second} {
+		require.Equal(t, expected, tracker.backoff(time.Second, failures), "failures: %d", failures)
+	}
+}
+
+func TestDelay(t *testing.T) {
+	tracker, now := newTracker(nil)
+
+	delay, err := tracker.Delay(_user, _ip)
+	require.NoError(t, err)
+	require.Zero(t, delay)
+
+	require.NoError(t, tracker.Fail(request(), _user, _ip))
+	require.NoError(t, tracker.Fail(request(), _user, _ip))
+
+	delay, err = tracker.Delay(_user, _ip)
+	require.NoError(t, err)
+	require.Equal(t, 2*time.Second, delay)
+
+	// an IP address isn't delayed before it failed as often as a user may
+	delay, err = tracker.Delay(_ip)
+	require.NoError(t, err)
+	require.Zero(t, delay)
+
+	*now = now.Add(2 * time.Second)
+	delay, err = tracker.Delay(_user, _ip)
+	require.NoError(t, err)
+	require.Zero(t, delay)
+}
+
+func TestLockout(t *testing.T) {
+	publisher := &eventsmocks.Stream{}
+	publisher.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	tracker, now := newTracker(publisher)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, tracker.Fail(request(), _user, _ip))
+	}
+
+	e, err := tracker.Get(_user)
+	require.NoError(t, err)
+	require.Equal(t, 3, e.Failures)
+	require.True(t, e.Locked(*now))
+	require.Equal(t, now.Add(15*time.Minute), e.LockedUntil)
+
+	// the IP address has a higher limit
+	e, err = tracker.Get(_ip)
+	require.NoError(t, err)
+	require.False(t, e.Locked(*now))
+
+	delay, err := tracker.Delay(_ip)
+	require.NoError(t, err)
+	require.Zero(t, delay)
+	delay, err = tracker.Delay(_user, _ip)
+	require.NoError(t, err)
+	require.Equal(t, 15*time.Minute, delay)
+
+	publisher.AssertNumberOfCalls(t, "Publish", 1)
+	ev := publisher.Calls[0].Arguments.Get(1).(event.LockedOut)
+	require.Equal(t, "user", ev.Kind)
+	require.Equal(t, "einstein", ev.Subject)
+	require.Equal(t, 3, ev.Failures)
+	require.Equal(t, "192.0.2.1", ev.RemoteAddr)
+	require.Equal(t, "/dav/spaces/", ev.URL)
+
+	// the next failure after the lockout locks the subject out again
+	*now = now.Add(16 * time.Minute)
+	delay, err = tracker.Delay(_user)
+	require.NoError(t, err)
+	require.Zero(t, delay)
+	require.NoError(t, tracker.Fail(request(), _user))
+	e, err = tracker.Get(_user)
+	require.NoError(t, err)
+	require.True(t, e.Locked(*now))
+	publisher.AssertNumberOfCalls(t, "Publish", 2)
+}
+
+func TestDelayIP(t *testing.T) {
+	tracker, _ := newTracker(nil)
+
+	for i, expected := range []time.Duration{0, 0, 0, 500 * time.Millisecond} {
+		require.NoError(t, tracker.Fail(request(), _ip))
+		delay, err := tracker.Delay(_ip)
+		require.NoError(t, err)
+		require.Equal(t, expected, delay, "failures: %d", i+1)
+	}
+}
+
+func TestLockoutIP(t *testing.T) {
+	publisher := &eventsmocks.Stream{}
+	publisher.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	tracker, now := newTracker(publisher)
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, tracker.Fail(request(), _ip))
+	}
+
+	delay, err := tracker.Delay(_ip)
+	require.NoError(t, err)
+	require.Equal(t, 15*time.Minute, delay)
+
+	*now = now.Add(15 * time.Minute)
+	delay, err = tracker.Delay(_ip)
+	require.NoError(t, err)
+	require.Zero(t, delay)
+}
+
+func TestLockoutPublicLink(t *testing.T) {
+	publisher := &eventsmocks.Stream{}
+	publisher.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	tracker, _ := newTracker(publisher)
+
+	link := Subject{Kind: KindPublicLink, Name: "sharetoken"}
+	for i := 0; i < 3; i++ {
+		require.NoError(t, tracker.Fail(request(), link))
+	}
+
+	// the token of the link must not be published
+	publisher.AssertNumberOfCalls(t, "Publish", 1)
+	ev := publisher.Calls[0].Arguments.Get(1).(event.LockedOut)
+	require.Equal(t, "public_link", ev.Kind)
+	require.NotContains(t, ev.Subject, "sharetoken")
+	require.Regexp(t, "^sha256:[0-9a-f]{16}$", ev.Subject)
+
+	e, err := tracker.Get(link)
+	require.NoError(t, err)
+	require.Equal(t, ev.Subject, e.LogSubject())
+}
+
+func TestFailureWindow(t *testing.T) {
+	tracker, now := newTracker(nil)
+
+	require.NoError(t, tracker.Fail(request(), _user))
+	require.NoError(t, tracker.Fail(request(), _user))
+
+	*now = now.Add(2 * time.Hour)
+	require.NoError(t, tracker.Fail(request(), _user))
+
+	e, err := tracker.Get(_user)
+	require.NoError(t, err)
+	require.Equal(t, 1, e.Failures)
+}
+
+func TestResetAndClear(t *testing.T) {
+	tracker, _ := newTracker(nil)
+
+	require.NoError(t, tracker.Fail(request(), _user, _ip))
+	require.NoError(t, tracker.Reset(_user))
+
+	_, err := tracker.Get(_user)
+	require.ErrorIs(t, err, ErrNotFound)
+	_, err = tracker.Get(_ip)
+	require.NoError(t, err)
+
+	require.NoError(t, tracker.Clear(_ip))
+	require.ErrorIs(t, tracker.Clear(_ip), ErrNotFound)
+}
+
+func TestList(t *testing.T) {
+	tracker, now := newTracker(nil)
+
+	require.NoError(t, tracker.Fail(request(), _user))
+	*now = now.Add(time.Minute)
+	link := Subject{Kind: KindPublicLink, Name: "sharetoken"}
+	require.NoError(t, tracker.Fail(request(), link))
+
+	entries, err := tracker.List()
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, KindPublicLink, entries[0].Kind)
+	require.Equal(t, "sharetoken", entries[0].Subject)
+	require.Equal(t, KindUser, entries[1].Kind)
+	require.Equal(t, "einstein", entries[1].Subject)
+}
@@ -3,12 +3,16 @@ package middleware
 import (
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"regexp"
 	"strings"
 
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/event"
 	"github.com/opencloud-eu/opencloud/services/proxy/pkg/router"
 	"github.com/opencloud-eu/opencloud/services/proxy/pkg/webdav"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
+	"github.com/opencloud-eu/reva/v2/pkg/utils"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/text/cases"
@@ -75,8 +79,20 @@ func Authentication(auths []Authenticator, opts ...Option) func(next http.Handle
 				return
 			}
 
+			subjects := lockoutSubjects(r)
+			if rejectLockedOut(options.Lockout, options.Logger, w, subjects) {
+				span.End()
+				return
+			}
+
 			for _, a := range auths {
 				if req, ok := a.Authenticate(r); ok {
+					// the failed public link attempts are tracked by the PublicShareAuthenticator
+					if options.Lockout != nil && isUserAttempt(subjects) {
+						if err := options.Lockout.Reset(subjects[0]); err != nil {
+							options.Logger.Error().Err(err).Msg("could not reset the failed sign in attempts")
+						}
+					}
 					span.End()
 					next.ServeHTTP(w, req)
 					return
@@ -84,6 +100,13 @@ func Authentication(auths []Authenticator, opts ...Option) func(next http.Handle
 			}
 
 			if !isPublicPath(r.URL.Path) {
+				publishSignInFailed(options, r)
+				if options.Lockout != nil && isUserAttempt(subjects) {
+					if err := options.Lockout.Fail(r, subjects...); err != nil {
+						options.Logger.Error().Err(err).Msg("could not record the failed sign in attempt")
+					}
+				}
+
 				// Failed basic authentication attempts receive the Www-Authenticate header in the response
 				var touch bool
 				caser := cases.Title(language.Und)
@@ -136,6 +159,32 @@ func isOIDCTokenAuth(req *http.Request) bool {
 	return req.URL.Path == "/konnect/v1/token"
 }
 
+// publishSignInFailed publishes a SignInFailed event for requests with rejected credentials. Requests
+// without credentials are not sign in attempts.
+func publishSignInFailed(o Options, r *http.Request) {
+	method, _, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || o.EventsPublisher == nil {
+		return
+	}
+
+	username, _, _ := r.BasicAuth()
+	remoteAddr, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		remoteAddr = r.RemoteAddr
+	}
+	ev := event.SignInFailed{
+		Username:   username,
+		Method:     strings.ToLower(method),
+		RemoteAddr: remoteAddr,
+		UserAgent:  r.UserAgent(),
+		URL:        r.URL.Path,
+		Timestamp:  utils.TSNow(),
+	}
+	if err := events.Publish(r.Context(), o.EventsPublisher, ev); err != nil {
+		o.Logger.Error().Err(err).Msg("could not publish sign in failed event")
+	}
+}
+
 func isPublicPath(p string) bool {
 	for _, pp := range _publicPaths {
 		if strings.HasPrefix(p, pp) {
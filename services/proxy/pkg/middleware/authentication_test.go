@@ -15,9 +15,11 @@ import (
 	"github.com/opencloud-eu/opencloud/pkg/log"
 	"github.com/opencloud-eu/opencloud/pkg/oidc"
 	oidcmocks "github.com/opencloud-eu/opencloud/pkg/oidc/mocks"
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/event"
 	"github.com/opencloud-eu/opencloud/services/proxy/pkg/router"
 	"github.com/opencloud-eu/opencloud/services/proxy/pkg/user/backend"
 	"github.com/opencloud-eu/opencloud/services/proxy/pkg/user/backend/mocks"
+	eventsmocks "github.com/opencloud-eu/reva/v2/pkg/events/mocks"
 	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
 	"github.com/stretchr/testify/mock"
 	"go-micro.dev/v4/store"
@@ -200,4 +202,47 @@ var _ = Describe("Authenticating requests", Label("Authentication"), func() {
 			Expect(rr).To(HaveHTTPStatus(http.StatusOK))
 		})
 	})
+
+	When("the credentials are rejected", func() {
+		It("publishes a sign in failed event", func() {
+			req := httptest.NewRequest("PROPFIND", "http://example.com/dav/spaces/", http.NoBody)
+			req = req.WithContext(router.SetRoutingInfo(context.Background(), router.RoutingInfo{}))
+			req.SetBasicAuth("testuser", "wrongpassword")
+			req.RemoteAddr = "192.0.2.1:1234"
+
+			publisher := &eventsmocks.Stream{}
+			publisher.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			handler := Authentication(authenticators,
+				EnableBasicAuth(true),
+				EventsPublisher(publisher),
+			)
+			rr := httptest.NewRecorder()
+			handler(http.NotFoundHandler()).ServeHTTP(rr, req)
+			Expect(rr).To(HaveHTTPStatus(http.StatusUnauthorized))
+
+			publisher.AssertCalled(GinkgoT(), "Publish", mock.Anything, event.SignInFailed{
+				Username:   "testuser",
+				Method:     "basic",
+				RemoteAddr: "192.0.2.1",
+				UserAgent:  req.UserAgent(),
+				URL:        "/dav/spaces/",
+				Timestamp:  publisher.Calls[0].Arguments.Get(1).(event.SignInFailed).Timestamp,
+			}, mock.Anything)
+		})
+
+		It("does not publish an event for requests without credentials", func() {
+			req := httptest.NewRequest("PROPFIND", "http://example.com/dav/spaces/", http.NoBody)
+			req = req.WithContext(router.SetRoutingInfo(context.Background(), router.RoutingInfo{}))
+
+			publisher := &eventsmocks.Stream{}
+			handler := Authentication(authenticators,
+				EnableBasicAuth(true),
+				EventsPublisher(publisher),
+			)
+			rr := httptest.NewRecorder()
+			handler(http.NotFoundHandler()).ServeHTTP(rr, req)
+			Expect(rr).To(HaveHTTPStatus(http.StatusUnauthorized))
+			publisher.AssertNotCalled(GinkgoT(), "Publish", mock.Anything, mock.Anything, mock.Anything)
+		})
+	})
 })
@@ -5,6 +5,7 @@ import (
 
 	"github.com/opencloud-eu/opencloud/pkg/log"
 	"github.com/opencloud-eu/opencloud/pkg/oidc"
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/config"
 	"github.com/opencloud-eu/opencloud/services/proxy/pkg/user/backend"
 )
 
@@ -58,5 +59,6 @@ func (m BasicAuthenticator) Authenticate(r *http.Request) (*http.Request, bool)
 		Str("authenticator", "basic").
 		Str("path", r.URL.Path).
 		Msg("successfully authenticated request")
-	return r.WithContext(oidc.NewContext(r.Context(), claims)), true
+	ctx := contextWithVerifiedCredentials(r.Context(), config.RateLimitKeyAppToken, login+":"+password)
+	return r.WithContext(oidc.NewContext(ctx, claims)), true
 }
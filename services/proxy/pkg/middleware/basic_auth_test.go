@@ -48,6 +48,7 @@ var _ = Describe("Authenticating requests", Label("BasicAuthenticator"), func()
 
 			Expect(valid).To(Equal(true))
 			Expect(req2).ToNot(BeNil())
+			Expect(rateLimitClient(req2, "")).To(Equal("app_token:testuser:testpassword"))
 		})
 		It("adds claims to the request context", func() {
 			req := httptest.NewRequest(http.MethodGet, "http://example.com/example/path", http.NoBody)
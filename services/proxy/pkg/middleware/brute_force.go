@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/lockout"
+)
+
+// lockoutSubjects returns the subjects the failed attempts of a request are
+// tracked for. Only passwords sent via basic auth are tracked, the tokens of
+// the other authentication methods can't be guessed.
+func lockoutSubjects(r *http.Request) []lockout.Subject {
+	username, _, ok := r.BasicAuth()
+	if !ok {
+		return nil
+	}
+
+	ip := lockout.Subject{Kind: lockout.KindIP, Name: clientIP(r)}
+	if isPublicPath(r.URL.Path) || isPublicShareArchive(r) || isPublicShareAppOpen(r) {
+		if token := publicLinkToken(r); token != "" {
+			return []lockout.Subject{{Kind: lockout.KindPublicLink, Name: token}, ip}
+		}
+		return nil
+	}
+	return []lockout.Subject{{Kind: lockout.KindUser, Name: username}, ip}
+}
+
+// rejectLockedOut rejects the request with a 429 status if one of its
+// subjects has to wait before the next attempt. If the failed attempts
+// can't be read, the request is let through.
+func rejectLockedOut(tracker *lockout.Tracker, logger log.Logger, w http.ResponseWriter, subjects []lockout.Subject) bool {
+	if tracker == nil || len(subjects) == 0 {
+		return false
+	}
+
+	delay, err := tracker.Delay(subjects...)
+	if err != nil {
+		logger.Error().Err(err).Msg("could not read the failed sign in attempts, skipping the brute force protection")
+		return false
+	}
+	if delay <= 0 {
+		return false
+	}
+
+	logger.Debug().Str("kind", string(subjects[0].Kind)).Dur("delay", delay).Msg("sign in attempt rejected, too many failed attempts")
+	rejectRateLimited(w, delay)
+	return true
+}
+
+// isUserAttempt tells if the subjects are those of a basic auth or app token
+// sign in attempt
+func isUserAttempt(subjects []lockout.Subject) bool {
+	return len(subjects) > 0 && subjects[0].Kind == lockout.KindUser
+}
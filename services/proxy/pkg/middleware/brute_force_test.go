@@ -0,0 +1,163 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"time"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	userv1beta1 "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	rpcv1beta1 "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/lockout"
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/router"
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/user/backend"
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/user/backend/mocks"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
+	"github.com/stretchr/testify/mock"
+	"go-micro.dev/v4/store"
+	"google.golang.org/grpc"
+)
+
+var _ = Describe("Brute force protection", Label("BruteForce"), func() {
+	var (
+		tracker *lockout.Tracker
+		handler http.Handler
+	)
+
+	ub := mocks.UserBackend{}
+	ub.On("Authenticate", mock.Anything, "testuser", "testpassword").Return(
+		&userv1beta1.User{
+			Id:       &userv1beta1.UserId{Idp: "IdpId", OpaqueId: "OpaqueId"},
+			Username: "testuser",
+		},
+		"",
+		nil,
+	)
+	ub.On("Authenticate", mock.Anything, mock.Anything, mock.Anything).Return(nil, "", backend.ErrAccountNotFound)
+
+	BeforeEach(func() {
+		pool.RemoveSelector("GatewaySelector" + "eu.opencloud.api.gateway")
+
+		logger := log.NewLogger()
+		tracker = lockout.NewTracker(store.NewMemoryStore(), config.BruteForce{
+			Enabled:          true,
+			MaxFailures:      2,
+			MaxFailuresPerIP: 10,
+			LockoutDuration:  time.Hour,
+			FailureWindow:    time.Hour,
+		}, nil, logger)
+
+		authenticators := []Authenticator{
+			BasicAuthenticator{
+				Logger:       logger,
+				UserProvider: &ub,
+			},
+			PublicShareAuthenticator{
+				Logger:  logger,
+				Lockout: tracker,
+				RevaGatewaySelector: pool.GetSelector[gateway.GatewayAPIClient](
+					"GatewaySelector",
+					"eu.opencloud.api.gateway",
+					func(cc grpc.ClientConnInterface) gateway.GatewayAPIClient {
+						return mockGatewayClient{
+							AuthenticateFunc: func(authType, clientID, clientSecret string) (string, rpcv1beta1.Code) {
+								if clientID == "sharetoken" && clientSecret == "password|examples3cr3t" {
+									return "exampletoken", rpcv1beta1.Code_CODE_OK
+								}
+								return "", rpcv1beta1.Code_CODE_UNAUTHENTICATED
+							},
+						}
+					},
+				),
+			},
+		}
+
+		handler = Authentication(authenticators,
+			EnableBasicAuth(true),
+			Logger(logger),
+			Lockout(tracker),
+		)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+	})
+
+	request := func(target, username, password string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("PROPFIND", target, http.NoBody)
+		req = req.WithContext(router.SetRoutingInfo(context.Background(), router.RoutingInfo{}))
+		req.SetBasicAuth(username, password)
+		req.RemoteAddr = "192.0.2.1:1234"
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	It("locks out a user after too many failed attempts", func() {
+		Expect(request("http://example.com/dav/spaces/", "testuser", "wrong")).To(HaveHTTPStatus(http.StatusUnauthorized))
+		Expect(request("http://example.com/dav/spaces/", "testuser", "wrong")).To(HaveHTTPStatus(http.StatusUnauthorized))
+
+		rr := request("http://example.com/dav/spaces/", "testuser", "testpassword")
+		Expect(rr).To(HaveHTTPStatus(http.StatusTooManyRequests))
+		retryAfter, err := strconv.Atoi(rr.Header().Get("Retry-After"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retryAfter).To(BeNumerically(">", 3500))
+
+		// other users from the same IP address are not locked out
+		Expect(request("http://example.com/dav/spaces/", "otheruser", "wrong")).To(HaveHTTPStatus(http.StatusUnauthorized))
+
+		Expect(tracker.Clear(lockout.Subject{Kind: lockout.KindUser, Name: "testuser"})).To(Succeed())
+		Expect(request("http://example.com/dav/spaces/", "testuser", "testpassword")).To(HaveHTTPStatus(http.StatusOK))
+	})
+
+	It("forgets the failed attempts after a successful sign in", func() {
+		Expect(request("http://example.com/dav/spaces/", "testuser", "wrong")).To(HaveHTTPStatus(http.StatusUnauthorized))
+		Expect(request("http://example.com/dav/spaces/", "testuser", "testpassword")).To(HaveHTTPStatus(http.StatusOK))
+
+		_, err := tracker.Get(lockout.Subject{Kind: lockout.KindUser, Name: "testuser"})
+		Expect(err).To(MatchError(lockout.ErrNotFound))
+		e, err := tracker.Get(lockout.Subject{Kind: lockout.KindIP, Name: "192.0.2.1"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(e.Failures).To(Equal(1))
+	})
+
+	It("locks out a public link after too many failed password attempts", func() {
+		target := "http://example.com/dav/public-files/?public-token=sharetoken"
+		Expect(request(target, "public", "wrong")).To(HaveHTTPStatus(http.StatusOK))
+		Expect(request(target, "public", "wrong")).To(HaveHTTPStatus(http.StatusOK))
+		Expect(request(target, "public", "examples3cr3t")).To(HaveHTTPStatus(http.StatusTooManyRequests))
+
+		e, err := tracker.Get(lockout.Subject{Kind: lockout.KindPublicLink, Name: "sharetoken"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(e.Failures).To(Equal(2))
+	})
+
+	DescribeTable("lockoutSubjects returns the subjects of a request",
+		func(target string, basicAuth bool, expected []lockout.Subject) {
+			req := httptest.NewRequest(http.MethodGet, target, http.NoBody)
+			req.RemoteAddr = "192.0.2.1:1234"
+			if basicAuth {
+				req.SetBasicAuth("einstein", "secret")
+			}
+			Expect(lockoutSubjects(req)).To(Equal(expected))
+		},
+		Entry("without credentials", "http://example.com/dav/spaces/", false, nil),
+		Entry("with basic auth", "http://example.com/dav/spaces/", true, []lockout.Subject{
+			{Kind: lockout.KindUser, Name: "einstein"},
+			{Kind: lockout.KindIP, Name: "192.0.2.1"},
+		}),
+		Entry("with a public link", "http://example.com/dav/public-files/?public-token=sharetoken", true, []lockout.Subject{
+			{Kind: lockout.KindPublicLink, Name: "sharetoken"},
+			{Kind: lockout.KindIP, Name: "192.0.2.1"},
+		}),
+		Entry("with a public link in the path", "http://example.com/remote.php/dav/public-files/sharetoken/file.txt", true, []lockout.Subject{
+			{Kind: lockout.KindPublicLink, Name: "sharetoken"},
+			{Kind: lockout.KindIP, Name: "192.0.2.1"},
+		}),
+		Entry("on a public path without a public link", "http://example.com/ocs/v1.php/cloud/capabilities", true, nil),
+	)
+})
@@ -10,6 +10,7 @@ import (
 	policiessvc "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/policies/v0"
 	settingssvc "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/settings/v0"
 	"github.com/opencloud-eu/opencloud/services/proxy/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/lockout"
 	"github.com/opencloud-eu/opencloud/services/proxy/pkg/user/backend"
 	"github.com/opencloud-eu/opencloud/services/proxy/pkg/userroles"
 	"github.com/opencloud-eu/reva/v2/pkg/events"
@@ -74,6 +75,10 @@ type Options struct {
 	// MultiTenantEnabled causes the account resolve middleware to reject users that don't have a tenant id assigned
 	MultiTenantEnabled bool
 	EventsPublisher    events.Publisher
+	// RateLimitStore holds the request counters of the rate limiter
+	RateLimitStore store.Store
+	// Lockout tracks the failed sign in attempts, brute force protection is disabled if nil
+	Lockout *lockout.Tracker
 }
 
 // newOptions initializes the available default options.
@@ -254,3 +259,17 @@ func EventsPublisher(ep events.Publisher) Option {
 		o.EventsPublisher = ep
 	}
 }
+
+// RateLimitStore provides a function to set the rate limit store option.
+func RateLimitStore(val store.Store) Option {
+	return func(o *Options) {
+		o.RateLimitStore = val
+	}
+}
+
+// Lockout provides a function to set the lockout option.
+func Lockout(val *lockout.Tracker) Option {
+	return func(o *Options) {
+		o.Lockout = val
+	}
+}
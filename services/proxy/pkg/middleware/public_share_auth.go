@@ -5,7 +5,10 @@ import (
 	"strings"
 
 	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
 	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/lockout"
 	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
 	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
 )
@@ -25,6 +28,8 @@ const (
 type PublicShareAuthenticator struct {
 	Logger              log.Logger
 	RevaGatewaySelector pool.Selectable[gateway.GatewayAPIClient]
+	// Lockout tracks the failed password attempts, optional
+	Lockout *lockout.Tracker
 }
 
 // The archiver is able to create archives from public shares in which case it needs to use the
@@ -119,7 +124,16 @@ func (a PublicShareAuthenticator) Authenticate(r *http.Request) (*http.Request,
 		return nil, false
 	}
 
+	// the requests with a wrong password are rejected by the services, so
+	// the failed attempts are only tracked here
+	if _, _, ok := r.BasicAuth(); ok && a.Lockout != nil {
+		a.trackAttempt(r, authResp.GetStatus().GetCode())
+	}
+
 	r.Header.Add(headerRevaAccessToken, authResp.Token)
+	if authResp.GetStatus().GetCode() == rpc.Code_CODE_OK {
+		r = r.WithContext(contextWithVerifiedCredentials(r.Context(), config.RateLimitKeyPublicLink, shareToken))
+	}
 
 	a.Logger.Debug().
 		Str("authenticator", "public_share").
@@ -127,3 +141,26 @@ func (a PublicShareAuthenticator) Authenticate(r *http.Request) (*http.Request,
 		Msg("successfully authenticated request")
 	return r, true
 }
+
+// trackAttempt records a failed password attempt or forgets the failed
+// attempts of the public link after a successful one
+func (a PublicShareAuthenticator) trackAttempt(r *http.Request, code rpc.Code) {
+	subjects := lockoutSubjects(r)
+	if len(subjects) == 0 {
+		return
+	}
+
+	var err error
+	switch code {
+	case rpc.Code_CODE_OK:
+		err = a.Lockout.Reset(subjects[0])
+	case rpc.Code_CODE_UNAUTHENTICATED, rpc.Code_CODE_PERMISSION_DENIED, rpc.Code_CODE_NOT_FOUND:
+		err = a.Lockout.Fail(r, subjects...)
+	}
+	if err != nil {
+		a.Logger.Error().
+			Err(err).
+			Str("authenticator", "public_share").
+			Msg("could not track the password attempt")
+	}
+}
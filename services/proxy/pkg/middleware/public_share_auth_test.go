@@ -59,6 +59,7 @@ var _ = Describe("Authenticating requests", Label("PublicShareAuthenticator"), f
 
 				h := req2.Header
 				Expect(h.Get(headerRevaAccessToken)).To(Equal("exampletoken"))
+				Expect(rateLimitClient(req2, "")).To(Equal("public_link:sharetoken"))
 			})
 		})
 		Context("using signature authentication", func() {
@@ -75,6 +76,19 @@ var _ = Describe("Authenticating requests", Label("PublicShareAuthenticator"), f
 			})
 		})
 	})
+	When("the request contains a wrong password", func() {
+		It("doesn't verify the public link token", func() {
+			req := httptest.NewRequest(http.MethodGet, "http://example.com/dav/public-files/?public-token=sharetoken", http.NoBody)
+			req.SetBasicAuth("public", "wrong")
+			req.RemoteAddr = "192.0.2.1:1234"
+
+			req2, _ := authenticator.Authenticate(req)
+
+			Expect(req2).ToNot(BeNil())
+			Expect(req2.Header.Get(headerRevaAccessToken)).To(BeEmpty())
+			Expect(rateLimitClient(req2, "")).To(Equal("ip:192.0.2.1"))
+		})
+	})
 	When("the reguest is for the archiver", func() {
 		Context("using a public-token", func() {
 			It("should successfully authenticate", func() {
@@ -0,0 +1,209 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/router"
+	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
+	"go-micro.dev/v4/store"
+)
+
+const (
+	_rateLimitKeyPrefix = "ratelimit/"
+)
+
+type verifiedCredentialsCtxKey struct{}
+
+// verifiedCredentials are the credentials of a request which were accepted by an authenticator
+type verifiedCredentials struct {
+	kind config.RateLimitKey
+	id   string
+}
+
+// contextWithVerifiedCredentials stores the credentials an authenticator accepted, the rate limiter tells the
+// clients apart by them
+func contextWithVerifiedCredentials(ctx context.Context, kind config.RateLimitKey, id string) context.Context {
+	return context.WithValue(ctx, verifiedCredentialsCtxKey{}, verifiedCredentials{kind: kind, id: id})
+}
+
+// RateLimiter provides a middleware which limits the requests a client can
+// send to the routes having a rate limit. Requests exceeding the limit are
+// rejected with a 429 status and a Retry-After header.
+//
+// The requests per window are counted in the rate limit store, so proxy
+// instances sharing the store enforce one budget. The counters aren't
+// updated atomically, concurrent requests of a client might slightly exceed
+// the limit. If the store fails, the requests are let through.
+func RateLimiter(optionSetters ...Option) func(next http.Handler) http.Handler {
+	options := newOptions(optionSetters...)
+
+	return func(next http.Handler) http.Handler {
+		return &rateLimiter{
+			next:     next,
+			logger:   options.Logger,
+			store:    options.RateLimitStore,
+			inFlight: make(map[string]int),
+		}
+	}
+}
+
+type rateLimiter struct {
+	next   http.Handler
+	logger log.Logger
+	store  store.Store
+
+	mu       sync.Mutex
+	inFlight map[string]int
+}
+
+func (m *rateLimiter) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	ri := router.ContextRoutingInfo(req.Context())
+	rl := ri.RateLimit()
+	if rl == nil || (rl.Requests == 0 && rl.Concurrent == 0) {
+		m.next.ServeHTTP(w, req)
+		return
+	}
+
+	// hash the key, it might contain credentials
+	sum := sha256.Sum256([]byte(ri.Name() + "\x00" + rateLimitClient(req, rl.Key)))
+	key := hex.EncodeToString(sum[:])
+
+	if rl.Requests > 0 {
+		retryAfter, ok, err := m.count(key, rl)
+		switch {
+		case err != nil:
+			m.logger.Error().Err(err).Str("route", ri.Name()).Msg("could not count the request, skipping the rate limit")
+		case !ok:
+			m.logger.Debug().Str("route", ri.Name()).Msg("rate limit exceeded")
+			rejectRateLimited(w, retryAfter)
+			return
+		}
+	}
+
+	if rl.Concurrent > 0 {
+		if !m.acquire(key, rl.Concurrent) {
+			m.logger.Debug().Str("route", ri.Name()).Msg("concurrency limit exceeded")
+			rejectRateLimited(w, time.Second)
+			return
+		}
+		defer m.release(key)
+	}
+
+	m.next.ServeHTTP(w, req)
+}
+
+// count counts the request in the current window. If the limit of the window
+// is exceeded, the time until the next window starts is returned.
+func (m *rateLimiter) count(key string, rl *config.RateLimit) (time.Duration, bool, error) {
+	window := rl.EffectiveWindow()
+	now := time.Now()
+	start := now.Truncate(window)
+	remaining := start.Add(window).Sub(now)
+	windowKey := _rateLimitKeyPrefix + key + "/" + strconv.FormatInt(start.Unix(), 10)
+
+	count := 0
+	records, err := m.store.Read(windowKey)
+	switch {
+	case errors.Is(err, store.ErrNotFound):
+	case err != nil:
+		return 0, false, err
+	case len(records) > 0:
+		count, _ = strconv.Atoi(string(records[0].Value))
+	}
+
+	if count >= rl.Requests {
+		return remaining, false, nil
+	}
+
+	err = m.store.Write(&store.Record{
+		Key:    windowKey,
+		Value:  []byte(strconv.Itoa(count + 1)),
+		Expiry: remaining,
+	})
+	return 0, true, err
+}
+
+func (m *rateLimiter) acquire(key string, limit int) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.inFlight[key] >= limit {
+		return false
+	}
+	m.inFlight[key]++
+	return true
+}
+
+func (m *rateLimiter) release(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.inFlight[key]--
+	if m.inFlight[key] <= 0 {
+		delete(m.inFlight, key)
+	}
+}
+
+func rejectRateLimited(w http.ResponseWriter, retryAfter time.Duration) {
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	w.Header().Set("Retry-After", strconv.Itoa(seconds))
+	w.WriteHeader(http.StatusTooManyRequests)
+}
+
+// rateLimitClient returns the key identifying the client of the request.
+// Only credentials which were verified by the authentication are used, a
+// client can't get a new budget by sending made up credentials. Without a
+// configured key, the verified public link token or app token and the user
+// are tried, in this order. If the request has none of them, the client IP
+// address is used.
+func rateLimitClient(req *http.Request, key config.RateLimitKey) string {
+	if c, ok := req.Context().Value(verifiedCredentialsCtxKey{}).(verifiedCredentials); ok && (key == "" || key == c.kind) {
+		return string(c.kind) + ":" + c.id
+	}
+	if key == "" || key == config.RateLimitKeyUser {
+		if user, ok := revactx.ContextGetUser(req.Context()); ok && user.GetId().GetOpaqueId() != "" {
+			return string(config.RateLimitKeyUser) + ":" + user.GetId().GetOpaqueId()
+		}
+	}
+	return string(config.RateLimitKeyIP) + ":" + clientIP(req)
+}
+
+// publicLinkToken returns the token of the public link the request accesses
+func publicLinkToken(req *http.Request) string {
+	if token := req.Header.Get(headerShareToken); token != "" {
+		return token
+	}
+	if token := req.URL.Query().Get(headerShareToken); token != "" {
+		return token
+	}
+	for _, prefix := range []string{"/dav/public-files/", "/remote.php/dav/public-files/"} {
+		if rest, ok := strings.CutPrefix(req.URL.Path, prefix); ok {
+			token, _, _ := strings.Cut(rest, "/")
+			return token
+		}
+	}
+	return ""
+}
+
+// clientIP returns the IP address of the client. The remote address has
+// already been replaced with the real client IP by the RealIP middleware.
+func clientIP(req *http.Request) string {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+	return host
+}
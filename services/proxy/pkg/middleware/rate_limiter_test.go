@@ -0,0 +1,183 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"time"
+
+	userv1beta1 "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/proxy/pkg/router"
+	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
+	"go-micro.dev/v4/store"
+)
+
+var _ = Describe("Rate limiter", Label("RateLimiter"), func() {
+	var (
+		st      store.Store
+		limit   *config.RateLimit
+		block   chan struct{}
+		handler func() http.Handler
+	)
+
+	BeforeEach(func() {
+		st = store.NewMemoryStore()
+		limit = &config.RateLimit{Requests: 2, Window: time.Hour}
+		block = nil
+
+		handler = func() http.Handler {
+			policies := []config.Policy{{
+				Name: "default",
+				Routes: []config.Route{
+					{Endpoint: "/", Backend: "http://localhost:9100"},
+					{Endpoint: "/graph", Backend: "http://localhost:9120", RateLimit: limit},
+				},
+			}}
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Query().Has("block") {
+					<-block
+				}
+				w.WriteHeader(http.StatusOK)
+			})
+			return router.Middleware(nil, nil, policies, log.NewLogger())(
+				RateLimiter(Logger(log.NewLogger()), RateLimitStore(st))(next),
+			)
+		}
+	})
+
+	request := func(h http.Handler, path string, modify ...func(*http.Request) *http.Request) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com"+path, http.NoBody)
+		req.RemoteAddr = "192.0.2.1:1234"
+		for _, m := range modify {
+			req = m(req)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	withUser := func(id string) func(*http.Request) *http.Request {
+		return func(req *http.Request) *http.Request {
+			ctx := revactx.ContextSetUser(context.Background(), &userv1beta1.User{Id: &userv1beta1.UserId{OpaqueId: id}})
+			return req.WithContext(ctx)
+		}
+	}
+
+	It("rejects requests exceeding the limit", func() {
+		h := handler()
+		Expect(request(h, "/graph/v1.0/me").Code).To(Equal(http.StatusOK))
+		Expect(request(h, "/graph/v1.0/me").Code).To(Equal(http.StatusOK))
+
+		rec := request(h, "/graph/v1.0/me")
+		Expect(rec.Code).To(Equal(http.StatusTooManyRequests))
+		retryAfter, err := strconv.Atoi(rec.Header().Get("Retry-After"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retryAfter).To(BeNumerically(">", 0))
+		Expect(retryAfter).To(BeNumerically("<=", 3600))
+	})
+
+	It("doesn't limit routes without a rate limit", func() {
+		h := handler()
+		for i := 0; i < 5; i++ {
+			Expect(request(h, "/index.html").Code).To(Equal(http.StatusOK))
+		}
+	})
+
+	It("counts the requests per user", func() {
+		h := handler()
+		Expect(request(h, "/graph/v1.0/me", withUser("einstein")).Code).To(Equal(http.StatusOK))
+		Expect(request(h, "/graph/v1.0/me", withUser("einstein")).Code).To(Equal(http.StatusOK))
+		Expect(request(h, "/graph/v1.0/me", withUser("einstein")).Code).To(Equal(http.StatusTooManyRequests))
+		Expect(request(h, "/graph/v1.0/me", withUser("marie")).Code).To(Equal(http.StatusOK))
+	})
+
+	It("counts the requests per IP address if configured", func() {
+		limit.Key = config.RateLimitKeyIP
+		h := handler()
+		Expect(request(h, "/graph/v1.0/me", withUser("einstein")).Code).To(Equal(http.StatusOK))
+		Expect(request(h, "/graph/v1.0/me", withUser("marie")).Code).To(Equal(http.StatusOK))
+		Expect(request(h, "/graph/v1.0/me", withUser("richard")).Code).To(Equal(http.StatusTooManyRequests))
+	})
+
+	It("doesn't give requests with made up credentials a new budget", func() {
+		h := handler()
+		withRandomCredentials := func(i int) func(*http.Request) *http.Request {
+			return func(req *http.Request) *http.Request {
+				req.SetBasicAuth("user"+strconv.Itoa(i), "password"+strconv.Itoa(i))
+				req.Header.Set(headerShareToken, "token"+strconv.Itoa(i))
+				return req
+			}
+		}
+		Expect(request(h, "/graph/v1.0/me", withRandomCredentials(1)).Code).To(Equal(http.StatusOK))
+		Expect(request(h, "/graph/v1.0/me", withRandomCredentials(2)).Code).To(Equal(http.StatusOK))
+		Expect(request(h, "/graph/v1.0/me", withRandomCredentials(3)).Code).To(Equal(http.StatusTooManyRequests))
+	})
+
+	It("shares the budget between instances using the same store", func() {
+		Expect(request(handler(), "/graph/v1.0/me").Code).To(Equal(http.StatusOK))
+		Expect(request(handler(), "/graph/v1.0/me").Code).To(Equal(http.StatusOK))
+		Expect(request(handler(), "/graph/v1.0/me").Code).To(Equal(http.StatusTooManyRequests))
+	})
+
+	It("limits the concurrent requests", func() {
+		limit.Requests = 0
+		limit.Concurrent = 1
+		block = make(chan struct{})
+		h := handler()
+
+		done := make(chan int)
+		go func() {
+			done <- request(h, "/graph/v1.0/me?block").Code
+		}()
+
+		Eventually(func() int {
+			return request(h, "/graph/v1.0/me").Code
+		}).Should(Equal(http.StatusTooManyRequests))
+
+		close(block)
+		Eventually(done).Should(Receive(Equal(http.StatusOK)))
+		Expect(request(h, "/graph/v1.0/me").Code).To(Equal(http.StatusOK))
+	})
+})
+
+var _ = Describe("Rate limit client", Label("RateLimiter"), func() {
+	withCredentials := func(req *http.Request, kind config.RateLimitKey, id string) *http.Request {
+		return req.WithContext(contextWithVerifiedCredentials(req.Context(), kind, id))
+	}
+
+	It("prefers the verified public link token", func() {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/remote.php/dav/public-files/sometoken/file.txt", http.NoBody)
+		req.RemoteAddr = "192.0.2.1:1234"
+		req = withCredentials(req, config.RateLimitKeyPublicLink, "sometoken")
+		Expect(rateLimitClient(req, "")).To(Equal("public_link:sometoken"))
+		Expect(rateLimitClient(req, config.RateLimitKeyAppToken)).To(Equal("ip:192.0.2.1"))
+	})
+
+	It("uses the verified app token", func() {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/remote.php/dav/spaces", http.NoBody)
+		req = withCredentials(req, config.RateLimitKeyAppToken, "einstein:apptoken")
+		Expect(rateLimitClient(req, "")).To(Equal("app_token:einstein:apptoken"))
+	})
+
+	It("ignores credentials which were not verified", func() {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/remote.php/dav/public-files/sometoken/file.txt?public-token=othertoken", http.NoBody)
+		req.RemoteAddr = "192.0.2.1:1234"
+		req.SetBasicAuth("einstein", "apptoken")
+		Expect(rateLimitClient(req, "")).To(Equal("ip:192.0.2.1"))
+		Expect(rateLimitClient(req, config.RateLimitKeyPublicLink)).To(Equal("ip:192.0.2.1"))
+		Expect(rateLimitClient(req, config.RateLimitKeyAppToken)).To(Equal("ip:192.0.2.1"))
+	})
+
+	It("falls back to the IP address", func() {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/graph", http.NoBody)
+		req.RemoteAddr = "192.0.2.1:1234"
+		Expect(rateLimitClient(req, "")).To(Equal("ip:192.0.2.1"))
+		Expect(rateLimitClient(req, config.RateLimitKeyUser)).To(Equal("ip:192.0.2.1"))
+		Expect(rateLimitClient(req, config.RateLimitKeyPublicLink)).To(Equal("ip:192.0.2.1"))
+	})
+})
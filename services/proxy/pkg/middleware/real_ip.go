@@ -0,0 +1,116 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"net/netip"
+	"strings"
+	"sync"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+)
+
+const (
+	headerForwardedFor = "X-Forwarded-For"
+	headerRealIP       = "X-Real-IP"
+)
+
+// RealIP replaces the remote address of requests sent by a trusted proxy with
+// the address of the client the request was forwarded for. The addresses in
+// the X-Forwarded-For and X-Real-IP headers of other requests are ignored,
+// they are set by the client and could be anything.
+//
+// Before the trusted proxies were introduced, the headers of all requests
+// were used. A warning is logged for the first request carrying the headers
+// from an untrusted address, which is most likely a reverse proxy missing in
+// the trusted proxies.
+func RealIP(logger log.Logger, trustedProxies []netip.Prefix) func(next http.Handler) http.Handler {
+	var warnOnce sync.Once
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ip, ok, trusted := forwardedClientIP(r, trustedProxies)
+			switch {
+			case ok:
+				r.RemoteAddr = ip
+			case !trusted && hasForwardedHeaders(r):
+				warnOnce.Do(func() {
+					logger.Warn().
+						Str("remoteAddr", r.RemoteAddr).
+						Msg("ignoring the X-Forwarded-For and X-Real-IP headers of a request from an untrusted address, add the address of the reverse proxy to PROXY_TRUSTED_PROXIES")
+				})
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// ParseTrustedProxies parses the IP addresses and CIDR ranges of the trusted
+// proxies
+func ParseTrustedProxies(proxies []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(proxies))
+	for _, p := range proxies {
+		p = strings.TrimSpace(p)
+		if strings.Contains(p, "/") {
+			prefix, err := netip.ParsePrefix(p)
+			if err != nil {
+				return nil, fmt.Errorf("invalid trusted proxy '%s': %w", p, err)
+			}
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy '%s': %w", p, err)
+		}
+		addr = addr.Unmap()
+		prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return prefixes, nil
+}
+
+// forwardedClientIP returns the client address a trusted proxy forwarded the
+// request for. Every proxy appends the address it received the request from
+// to the X-Forwarded-For header, so the right most address which doesn't
+// belong to a trusted proxy is the client. The last return value tells if
+// the request was sent by a trusted proxy.
+func forwardedClientIP(r *http.Request, trustedProxies []netip.Prefix) (string, bool, bool) {
+	remote, err := netip.ParseAddrPort(r.RemoteAddr)
+	if err != nil || !isTrustedProxy(remote.Addr(), trustedProxies) {
+		return "", false, false
+	}
+
+	var client netip.Addr
+	forwarded := strings.Split(strings.Join(r.Header.Values(headerForwardedFor), ","), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		addr, err := netip.ParseAddr(strings.TrimSpace(forwarded[i]))
+		if err != nil {
+			break
+		}
+		client = addr.Unmap()
+		if !isTrustedProxy(client, trustedProxies) {
+			break
+		}
+	}
+	if client.IsValid() {
+		return client.String(), true, true
+	}
+
+	if addr, err := netip.ParseAddr(strings.TrimSpace(r.Header.Get(headerRealIP))); err == nil {
+		return addr.Unmap().String(), true, true
+	}
+	return "", false, true
+}
+
+func hasForwardedHeaders(r *http.Request) bool {
+	return r.Header.Get(headerForwardedFor) != "" || r.Header.Get(headerRealIP) != ""
+}
+
+func isTrustedProxy(addr netip.Addr, trustedProxies []netip.Prefix) bool {
+	addr = addr.Unmap()
+	for _, p := range trustedProxies {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/rs/zerolog"
+)
+
+var _ = Describe("Real IP", Label("RealIP"), func() {
+	DescribeTable("takes the client address only from trusted proxies",
+		func(remoteAddr string, headers map[string]string, expected string) {
+			trustedProxies, err := ParseTrustedProxies([]string{"10.0.0.0/8", "192.0.2.1"})
+			Expect(err).ToNot(HaveOccurred())
+
+			req := httptest.NewRequest(http.MethodGet, "http://example.com/", http.NoBody)
+			req.RemoteAddr = remoteAddr
+			for k, v := range headers {
+				req.Header.Set(k, v)
+			}
+
+			var got string
+			RealIP(log.NopLogger(), trustedProxies)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = clientIP(r)
+			})).ServeHTTP(httptest.NewRecorder(), req)
+			Expect(got).To(Equal(expected))
+		},
+		Entry("without headers", "192.0.2.1:1234", nil, "192.0.2.1"),
+		Entry("from an untrusted address", "203.0.113.7:1234", map[string]string{
+			"X-Forwarded-For": "198.51.100.1",
+			"X-Real-IP":       "198.51.100.2",
+		}, "203.0.113.7"),
+		Entry("forwarded by a trusted proxy", "192.0.2.1:1234", map[string]string{
+			"X-Forwarded-For": "198.51.100.1",
+		}, "198.51.100.1"),
+		Entry("with an address added by the client", "192.0.2.1:1234", map[string]string{
+			"X-Forwarded-For": "198.51.100.99, 198.51.100.1",
+		}, "198.51.100.1"),
+		Entry("forwarded by a chain of trusted proxies", "10.0.0.2:1234", map[string]string{
+			"X-Forwarded-For": "198.51.100.1, 10.1.1.1",
+		}, "198.51.100.1"),
+		Entry("with the real IP header of a trusted proxy", "10.0.0.2:1234", map[string]string{
+			"X-Real-IP": "198.51.100.1",
+		}, "198.51.100.1"),
+		Entry("with an invalid header", "10.0.0.2:1234", map[string]string{
+			"X-Forwarded-For": "unknown",
+		}, "10.0.0.2"),
+	)
+
+	It("warns once about forwarded headers from untrusted addresses", func() {
+		trustedProxies, err := ParseTrustedProxies([]string{"192.0.2.1"})
+		Expect(err).ToNot(HaveOccurred())
+
+		buf := &bytes.Buffer{}
+		handler := RealIP(log.Logger{Logger: zerolog.New(buf)}, trustedProxies)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+		send := func(remoteAddr string, forwarded bool) {
+			req := httptest.NewRequest(http.MethodGet, "http://example.com/", http.NoBody)
+			req.RemoteAddr = remoteAddr
+			if forwarded {
+				req.Header.Set("X-Forwarded-For", "198.51.100.1")
+			}
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+		}
+
+		send("203.0.113.7:1234", false)
+		send("192.0.2.1:1234", true)
+		Expect(buf.String()).To(BeEmpty())
+
+		send("203.0.113.7:1234", true)
+		send("203.0.113.8:1234", true)
+		Expect(strings.Count(buf.String(), "PROXY_TRUSTED_PROXIES")).To(Equal(1))
+	})
+
+	It("rejects invalid trusted proxies", func() {
+		_, err := ParseTrustedProxies([]string{"10.0.0.0/33"})
+		Expect(err).To(HaveOccurred())
+		_, err = ParseTrustedProxies([]string{"proxy.example.com"})
+		Expect(err).To(HaveOccurred())
+	})
+})
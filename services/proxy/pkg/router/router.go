@@ -91,6 +91,8 @@ type RoutingInfo struct {
 	unprotected      bool
 	remoteUserHeader string
 	skipXAccessToken bool
+	name             string
+	rateLimit        *config.RateLimit
 }
 
 // Rewrite returns the proxy rewrite hook.
@@ -114,6 +116,17 @@ func (r RoutingInfo) SkipXAccessToken() bool {
 	return r.skipXAccessToken
 }
 
+// Name returns the name of the route, made up of the policy, the method and
+// the endpoint of the route.
+func (r RoutingInfo) Name() string {
+	return r.name
+}
+
+// RateLimit returns the rate limit of the route, nil if the route isn't rate limited
+func (r RoutingInfo) RateLimit() *config.RateLimit {
+	return r.rateLimit
+}
+
 // Router handles the routing of HTTP requests according to the given policies.
 type Router struct {
 	logger          log.Logger
@@ -143,6 +156,8 @@ func (rt Router) addHost(policy string, target *url.URL, route config.Route) {
 		unprotected:      route.Unprotected,
 		remoteUserHeader: route.RemoteUserHeader,
 		skipXAccessToken: route.SkipXAccessToken,
+		name:             policy + ":" + route.Method + ":" + route.Endpoint,
+		rateLimit:        route.RateLimit,
 		rewrite: func(req *httputil.ProxyRequest) {
 			if route.Service != "" {
 				// select next node
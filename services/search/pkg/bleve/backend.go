@@ -2,11 +2,16 @@ package bleve
 
 import (
 	"context"
+	"errors"
 	"math"
+	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/index/scorch"
+	bleveSearch "github.com/blevesearch/bleve/v2/search"
 	"github.com/blevesearch/bleve/v2/search/query"
 	storageProvider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
 	"github.com/opencloud-eu/reva/v2/pkg/errtypes"
@@ -22,14 +27,31 @@ import (
 	searchQuery "github.com/opencloud-eu/opencloud/services/search/pkg/query"
 )
 
-const defaultBatchSize = 50
+const (
+	defaultBatchSize = 50
+	walkPageSize     = 1000
+)
+
+// _highlightFields are the fields for which highlighted fragments are returned
+var _highlightFields = []string{"Name", "Content"}
 
-var _ search.Engine = (*Backend)(nil) // ensure Backend implements Engine
+var (
+	_ search.Engine    = (*Backend)(nil) // ensure Backend implements Engine
+	_ search.Rebuilder = (*Backend)(nil) // ensure Backend implements Rebuilder
+	_ search.Compactor = (*Backend)(nil) // ensure Backend implements Compactor
+)
 
 type Backend struct {
 	index        bleve.Index
 	queryCreator searchQuery.Creator[query.Query]
 	log          log.Logger
+
+	// mu guards the index, the shadow and the retired indexes which change during a rebuild
+	mu sync.RWMutex
+	// shadow is the index which is being rebuilt, it receives all changes of the current index
+	shadow bleve.Index
+	// retired are the indexes which have been replaced by a rebuild, they are removed on close
+	retired []bleve.Index
 }
 
 func NewBackend(index bleve.Index, queryCreator searchQuery.Creator[query.Query], log log.Logger) *Backend {
@@ -89,7 +111,7 @@ func (b *Backend) Search(_ context.Context, sir *searchService.SearchIndexReques
 	}
 
 	bleveReq.Fields = []string{"*"}
-	res, err := b.index.Search(bleveReq)
+	res, err := b.currentIndex().Search(bleveReq)
 	if err != nil {
 		return nil, err
 	}
@@ -135,6 +157,7 @@ func (b *Backend) Search(_ context.Context, sir *searchService.SearchIndexReques
 				Deleted:    getFieldValue[bool](hit.Fields, "Deleted"),
 				Tags:       getFieldSliceValue[string](hit.Fields, "Tags"),
 				Highlights: getFragmentValue(hit.Fragments, "Content", 0),
+				Fragments:  getFragments(hit.Fragments, _highlightFields...),
 				Audio:      getAudioValue[searchMessage.Audio](hit.Fields),
 				Image:      getImageValue[searchMessage.Image](hit.Fields),
 				Location:   getLocationValue[searchMessage.GeoCoordinates](hit.Fields),
@@ -156,7 +179,116 @@ func (b *Backend) Search(_ context.Context, sir *searchService.SearchIndexReques
 }
 
 func (b *Backend) DocCount() (uint64, error) {
-	return b.index.DocCount()
+	return b.currentIndex().DocCount()
+}
+
+// SpaceStats returns the number of documents and the size of the files per space.
+func (b *Backend) SpaceStats() ([]search.SpaceStats, error) {
+	var stats []search.SpaceStats
+	positions := map[string]int{}
+	err := walkIndex(b.currentIndex(), bleve.NewMatchAllQuery(), []string{"RootID", "Type", "Size", "Deleted"}, func(hit *bleveSearch.DocumentMatch) error {
+		rootID := getFieldValue[string](hit.Fields, "RootID")
+		i, ok := positions[rootID]
+		if !ok {
+			i = len(stats)
+			positions[rootID] = i
+			stats = append(stats, search.SpaceStats{RootID: rootID})
+		}
+
+		stats[i].Documents++
+		switch {
+		case getFieldValue[bool](hit.Fields, "Deleted"):
+			stats[i].Deleted++
+		case uint64(getFieldValue[float64](hit.Fields, "Type")) == uint64(storageProvider.ResourceType_RESOURCE_TYPE_FILE):
+			stats[i].Size += uint64(getFieldValue[float64](hit.Fields, "Size"))
+		}
+
+		return nil
+	})
+
+	return stats, err
+}
+
+// WalkSpace calls fn for every resource of the space, including the deleted ones.
+func (b *Backend) WalkSpace(rootID string, fn func(r search.Resource) error) error {
+	return walkIndex(b.currentIndex(), &query.TermQuery{FieldVal: "RootID", Term: rootID}, []string{"*"}, func(hit *bleveSearch.DocumentMatch) error {
+		return fn(*matchToResource(hit))
+	})
+}
+
+// Rebuild fills a new index using build and replaces the current index with it afterward.
+// The new index uses the current mapping, changes which happen while it is built are applied to both indexes.
+func (b *Backend) Rebuild(build func(eng search.Engine) error) error {
+	indexMapping, err := NewMapping()
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	if b.shadow != nil {
+		b.mu.Unlock()
+		return errors.New("the index is already being rebuilt")
+	}
+	shadow, err := newRebuildIndex(b.index.Name(), indexMapping)
+	if err != nil {
+		b.mu.Unlock()
+		return err
+	}
+	b.shadow = shadow
+	b.mu.Unlock()
+
+	buildErr := build(NewBackend(shadow, b.queryCreator, b.log))
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.shadow = nil
+
+	if buildErr == nil {
+		buildErr = activateIndex(shadow.Name())
+	}
+	if buildErr != nil {
+		if err := removeIndex(shadow); err != nil {
+			b.log.Error().Err(err).Str("index", shadow.Name()).Msg("could not remove the rebuilt index")
+		}
+		return buildErr
+	}
+
+	// batches which were created before the swap still write to the retired index,
+	// so it is kept open until the backend is closed
+	b.retired = append(b.retired, b.index)
+	b.index = shadow
+
+	return nil
+}
+
+// Compact merges the segments of the index into a single one and frees the space of deleted documents.
+func (b *Backend) Compact() error {
+	internal, err := b.currentIndex().Advanced()
+	if err != nil {
+		return err
+	}
+
+	s, ok := internal.(*scorch.Scorch)
+	if !ok {
+		return errtypes.NotSupported("compacting is only supported for scorch indexes")
+	}
+
+	return s.ForceMerge(context.Background(), nil)
+}
+
+// Close closes the index and removes the indexes which have been replaced by a rebuild.
+func (b *Backend) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, index := range b.retired {
+		if err := removeIndex(index); err != nil {
+			b.log.Error().Err(err).Str("index", index.Name()).Msg("could not remove the retired index")
+		}
+	}
+	b.retired = nil
+
+	return b.index.Close()
 }
 
 func (b *Backend) Upsert(id string, r search.Resource) error {
@@ -225,5 +357,39 @@ func (b *Backend) Purge(id string, onlyDeleted bool) error {
 }
 
 func (b *Backend) NewBatch(size int) (search.BatchOperator, error) {
-	return NewBatch(b.index, size)
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	batch, err := NewBatch(b.index, size)
+	if err != nil {
+		return nil, err
+	}
+
+	if b.shadow != nil {
+		if batch.shadow, err = NewBatch(b.shadow, size); err != nil {
+			return nil, err
+		}
+	}
+
+	return batch, nil
+}
+
+func (b *Backend) currentIndex() bleve.Index {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return b.index
+}
+
+// removeIndex closes the index and deletes its files
+func removeIndex(index bleve.Index) error {
+	if err := index.Close(); err != nil && !errors.Is(err, bleve.ErrorIndexClosed) {
+		return err
+	}
+
+	if index.Name() == "" {
+		return nil
+	}
+
+	return os.RemoveAll(index.Name())
 }
@@ -3,6 +3,7 @@ package bleve_test
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 
 	bleveSearch "github.com/blevesearch/bleve/v2"
 	sprovider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
@@ -137,6 +138,51 @@ var _ = Describe("Bleve", func() {
 				assertDocCount(rootResource.ID, "Size:<1000", 0)
 				assertDocCount(rootResource.ID, "Size:>100000", 0)
 			})
+
+			It("finds files by size ranges", func() {
+				parentResource.Document.Size = 12 * 1024
+				err := eng.Upsert(parentResource.ID, parentResource)
+				Expect(err).ToNot(HaveOccurred())
+
+				assertDocCount(rootResource.ID, "size>10KB", 1)
+				assertDocCount(rootResource.ID, "size>=12KiB size<=12KiB", 1)
+				assertDocCount(rootResource.ID, "size>12KiB", 0)
+				assertDocCount(rootResource.ID, "size<1MB AND size>20KB", 0)
+			})
+
+			It("finds files by owner", func() {
+				parentResource.Owner = []string{"4c510ada-c86b-4815-8820-42cdf82c3d51", "einstein"}
+				err := eng.Upsert(parentResource.ID, parentResource)
+				Expect(err).ToNot(HaveOccurred())
+
+				assertDocCount(rootResource.ID, "owner:Einstein", 1)
+				assertDocCount(rootResource.ID, "owner:4c510ada-c86b-4815-8820-42cdf82c3d51", 1)
+				assertDocCount(rootResource.ID, "owner:marie", 0)
+			})
+
+			It("finds files below a path", func() {
+				err := eng.Upsert(parentResource.ID, parentResource)
+				Expect(err).ToNot(HaveOccurred())
+				err = eng.Upsert(childResource.ID, childResource)
+				Expect(err).ToNot(HaveOccurred())
+
+				assertDocCount(rootResource.ID, `path:"parent d!r"`, 2)
+				assertDocCount(rootResource.ID, `path:"parent d!r/child.pdf"`, 1)
+				assertDocCount(rootResource.ID, `path:"parent"`, 0)
+			})
+
+			It("finds files by words near each other", func() {
+				parentResource.Document.Content = "The quick brown fox jumps over the lazy dogs"
+				err := eng.Upsert(parentResource.ID, parentResource)
+				Expect(err).ToNot(HaveOccurred())
+
+				assertDocCount(rootResource.ID, "fox NEAR dog", 1)
+				assertDocCount(rootResource.ID, "dog NEAR(4) fox", 1)
+				assertDocCount(rootResource.ID, "dog NEAR(3) fox", 0)
+				assertDocCount(rootResource.ID, "quick NEAR(2) dog", 0)
+				assertDocCount(rootResource.ID, `content:"brown fox"`, 1)
+				assertDocCount(rootResource.ID, `content:"fox brown"`, 0)
+			})
 		})
 
 		Context("by filename", func() {
@@ -283,6 +329,29 @@ var _ = Describe("Bleve", func() {
 				Expect(res.Matches[0].Entity.Highlights).To(Equal("foo <mark>bar</mark> baz"))
 			})
 
+			It("returns the fragments of the matched fields", func() {
+				parentResource.Document.Name = "budget.pdf"
+				parentResource.Document.Content = "the budget for next year"
+				err := eng.Upsert(parentResource.ID, parentResource)
+				Expect(err).ToNot(HaveOccurred())
+
+				res, err := doSearch(rootResource.ID, "Content:budget", "")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res.TotalMatches).To(Equal(int32(1)))
+				Expect(res.Matches[0].Entity.Fragments).To(HaveLen(1))
+				Expect(res.Matches[0].Entity.Fragments[0].Field).To(Equal("Content"))
+				Expect(res.Matches[0].Entity.Fragments[0].Text).To(Equal("the <mark>budget</mark> for next year"))
+
+				res, err = doSearch(rootResource.ID, "Name:budget* OR Content:year", "")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res.TotalMatches).To(Equal(int32(1)))
+				Expect(res.Matches[0].Entity.Fragments).To(HaveLen(2))
+				Expect(res.Matches[0].Entity.Fragments[0].Field).To(Equal("Name"))
+				Expect(res.Matches[0].Entity.Fragments[0].Text).To(Equal("<mark>budget.pdf</mark>"))
+				Expect(res.Matches[0].Entity.Fragments[1].Field).To(Equal("Content"))
+				Expect(res.Matches[0].Entity.Fragments[1].Text).To(Equal("the budget for next <mark>year</mark>"))
+			})
+
 		})
 
 		Context("with a file in the root of the space and folder with a file. all of them have the same name", func() {
@@ -600,6 +669,114 @@ var _ = Describe("Bleve", func() {
 		})
 	})
 
+	Describe("SpaceStats", func() {
+		It("counts the documents and the size per space", func() {
+			childResource.Size = 10
+			childResource2.Size = 5
+			otherResource := search.Resource{ID: "1$7!7", RootID: "1$7!7", Path: ".", Type: uint64(sprovider.ResourceType_RESOURCE_TYPE_CONTAINER)}
+			for _, r := range []search.Resource{rootResource, parentResource, childResource, childResource2, otherResource} {
+				Expect(eng.Upsert(r.ID, r)).To(Succeed())
+			}
+			Expect(eng.Delete(childResource2.ID)).To(Succeed())
+
+			stats, err := eng.SpaceStats()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stats).To(ConsistOf(
+				search.SpaceStats{RootID: rootResource.ID, Documents: 4, Deleted: 1, Size: 10},
+				search.SpaceStats{RootID: otherResource.ID, Documents: 1},
+			))
+		})
+	})
+
+	Describe("WalkSpace", func() {
+		It("returns all resources of the space", func() {
+			for _, r := range []search.Resource{rootResource, parentResource, childResource} {
+				Expect(eng.Upsert(r.ID, r)).To(Succeed())
+			}
+			Expect(eng.Upsert("1$7!7", search.Resource{ID: "1$7!7", RootID: "1$7!7", Path: "."})).To(Succeed())
+			Expect(eng.Delete(childResource.ID)).To(Succeed())
+
+			var ids []string
+			Expect(eng.WalkSpace(rootResource.ID, func(r search.Resource) error {
+				ids = append(ids, r.ID)
+				if r.ID == childResource.ID {
+					Expect(r.Deleted).To(BeTrue())
+				}
+				return nil
+			})).To(Succeed())
+			Expect(ids).To(ConsistOf(rootResource.ID, parentResource.ID, childResource.ID))
+		})
+	})
+
+	Describe("Rebuild", func() {
+		It("replaces the index with the rebuilt one", func() {
+			Expect(eng.Upsert(parentResource.ID, parentResource)).To(Succeed())
+
+			err := eng.Rebuild(func(rebuilt search.Engine) error {
+				// changes which happen during the rebuild reach both indexes
+				Expect(eng.Upsert(childResource.ID, childResource)).To(Succeed())
+				return rebuilt.Upsert(childResource2.ID, childResource2)
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			assertDocCount(rootResource.ID, `"child.pdf"`, 1)
+			assertDocCount(rootResource.ID, `"child2.pdf"`, 1)
+			assertDocCount(rootResource.ID, `"parent d!r"`, 0)
+		})
+
+		It("opens the rebuilt index after a restart", func() {
+			root := GinkgoT().TempDir()
+			idx, err := bleve.NewIndex(root)
+			Expect(err).ToNot(HaveOccurred())
+			eng := bleve.NewBackend(idx, bleveQuery.DefaultCreator, log.Logger{})
+			Expect(eng.Upsert(parentResource.ID, parentResource)).To(Succeed())
+
+			Expect(eng.Rebuild(func(rebuilt search.Engine) error {
+				return rebuilt.Upsert(childResource.ID, childResource)
+			})).To(Succeed())
+			Expect(eng.Close()).To(Succeed())
+
+			idx, err = bleve.NewIndex(root)
+			Expect(err).ToNot(HaveOccurred())
+			defer idx.Close()
+			count, err := idx.DocCount()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(Equal(uint64(1)))
+			Expect(filepath.Join(root, "bleve")).ToNot(BeADirectory())
+		})
+
+		It("keeps the index if the rebuild fails", func() {
+			Expect(eng.Upsert(parentResource.ID, parentResource)).To(Succeed())
+
+			err := eng.Rebuild(func(rebuilt search.Engine) error {
+				return fmt.Errorf("failed")
+			})
+			Expect(err).To(HaveOccurred())
+
+			assertDocCount(rootResource.ID, `"parent d!r"`, 1)
+			Expect(eng.Upsert(childResource.ID, childResource)).To(Succeed())
+			assertDocCount(rootResource.ID, `"child.pdf"`, 1)
+		})
+	})
+
+	Describe("Compact", func() {
+		It("compacts the index", func() {
+			idx, err := bleve.NewIndex(GinkgoT().TempDir())
+			Expect(err).ToNot(HaveOccurred())
+			eng := bleve.NewBackend(idx, bleveQuery.DefaultCreator, log.Logger{})
+			defer func() { Expect(eng.Close()).To(Succeed()) }()
+
+			Expect(eng.Upsert(childResource.ID, childResource)).To(Succeed())
+			Expect(eng.Purge(childResource.ID, false)).To(Succeed())
+
+			Expect(eng.Compact()).To(Succeed())
+		})
+
+		It("is not supported by in memory indexes", func() {
+			Expect(eng.Compact()).To(HaveOccurred())
+		})
+	})
+
 	Describe("File type specific metadata", func() {
 
 		Context("with audio metadata", func() {
@@ -20,6 +20,9 @@ type Batch struct {
 	index bleve.Index
 	size  int
 	log   log.Logger
+
+	// shadow receives the same operations while the index is rebuilt
+	shadow *Batch
 }
 
 func NewBatch(index bleve.Index, size int) (*Batch, error) {
@@ -35,12 +38,20 @@ func NewBatch(index bleve.Index, size int) (*Batch, error) {
 }
 
 func (b *Batch) Upsert(id string, r search.Resource) error {
+	b.mirror(func(shadow *Batch) error {
+		return shadow.Upsert(id, r)
+	})
+
 	return b.withSizeLimit(func() error {
 		return b.batch.Index(id, r)
 	})
 }
 
 func (b *Batch) Move(id, parentID, location string) error {
+	b.mirror(func(shadow *Batch) error {
+		return shadow.Move(id, parentID, location)
+	})
+
 	return b.withSizeLimit(func() error {
 		rootResource, err := searchResourceByID(id, b.index)
 		if err != nil {
@@ -78,6 +89,10 @@ func (b *Batch) Move(id, parentID, location string) error {
 }
 
 func (b *Batch) Delete(id string) error {
+	b.mirror(func(shadow *Batch) error {
+		return shadow.Delete(id)
+	})
+
 	return b.withSizeLimit(func() error {
 		affectedResources, err := searchAndUpdateResourcesDeletionState(id, true, b.index)
 		if err != nil {
@@ -95,6 +110,10 @@ func (b *Batch) Delete(id string) error {
 }
 
 func (b *Batch) Restore(id string) error {
+	b.mirror(func(shadow *Batch) error {
+		return shadow.Restore(id)
+	})
+
 	return b.withSizeLimit(func() error {
 		affectedResources, err := searchAndUpdateResourcesDeletionState(id, false, b.index)
 		if err != nil {
@@ -112,6 +131,10 @@ func (b *Batch) Restore(id string) error {
 }
 
 func (b *Batch) Purge(id string, onlyDeleted bool) error {
+	b.mirror(func(shadow *Batch) error {
+		return shadow.Purge(id, onlyDeleted)
+	})
+
 	return b.withSizeLimit(func() error {
 		rootResource, err := searchResourceByID(id, b.index)
 		if err != nil {
@@ -149,6 +172,10 @@ func (b *Batch) Purge(id string, onlyDeleted bool) error {
 }
 
 func (b *Batch) Push() error {
+	b.mirror(func(shadow *Batch) error {
+		return shadow.Push()
+	})
+
 	if b.batch.Size() == 0 {
 		return nil
 	}
@@ -173,3 +200,13 @@ func (b *Batch) withSizeLimit(f func() error) error {
 
 	return nil
 }
+
+// mirror applies an operation to the shadow batch. Errors are ignored, the shadow index
+// does not contain all resources until the rebuild is done and the rebuild indexes them anyway.
+func (b *Batch) mirror(f func(shadow *Batch) error) {
+	if b.shadow == nil {
+		return
+	}
+
+	_ = f(b.shadow)
+}
@@ -75,6 +75,18 @@ func getFragmentValue(m bleveSearch.FieldFragmentMap, key string, idx int) strin
 	return val[idx]
 }
 
+// getFragments returns the highlighted fragments of the given fields in the order of the fields
+func getFragments(m bleveSearch.FieldFragmentMap, fields ...string) []*searchMessage.Fragment {
+	var fragments []*searchMessage.Fragment
+	for _, field := range fields {
+		for _, text := range m[field] {
+			fragments = append(fragments, &searchMessage.Fragment{Field: field, Text: text})
+		}
+	}
+
+	return fragments
+}
+
 func getAudioValue[T any](fields map[string]interface{}) *T {
 	if !strings.HasPrefix(getFieldValue[string](fields, "MimeType"), "audio/") {
 		return nil
@@ -185,6 +197,8 @@ func matchToResource(match *bleveSearch.DocumentMatch) *search.Resource {
 		ParentID: getFieldValue[string](match.Fields, "ParentID"),
 		Type:     uint64(getFieldValue[float64](match.Fields, "Type")),
 		Deleted:  getFieldValue[bool](match.Fields, "Deleted"),
+		Owner:    getFieldSliceValue[string](match.Fields, "Owner"),
+		Indexed:  getFieldValue[string](match.Fields, "Indexed"),
 		Document: content.Document{
 			Name:     getFieldValue[string](match.Fields, "Name"),
 			Title:    getFieldValue[string](match.Fields, "Title"),
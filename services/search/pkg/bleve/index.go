@@ -2,8 +2,12 @@ package bleve
 
 import (
 	"errors"
+	"fmt"
 	"math"
+	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/blevesearch/bleve/v2"
 	"github.com/blevesearch/bleve/v2/analysis/analyzer/custom"
@@ -13,13 +17,22 @@ import (
 	"github.com/blevesearch/bleve/v2/analysis/tokenizer/single"
 	"github.com/blevesearch/bleve/v2/analysis/tokenizer/unicode"
 	"github.com/blevesearch/bleve/v2/mapping"
+	bleveSearch "github.com/blevesearch/bleve/v2/search"
+	"github.com/blevesearch/bleve/v2/search/query"
 	storageProvider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
 
 	"github.com/opencloud-eu/opencloud/services/search/pkg/search"
 )
 
+const (
+	defaultIndexName = "bleve"
+	// currentIndexFile holds the name of the index directory which replaced the default one in a rebuild
+	currentIndexFile = "bleve.current"
+)
+
 func NewIndex(root string) (bleve.Index, error) {
-	destination := filepath.Join(root, "bleve")
+	name := currentIndexName(root)
+	destination := filepath.Join(root, name)
 	index, err := bleve.Open(destination)
 	if errors.Is(bleve.ErrorIndexPathDoesNotExist, err) {
 		indexMapping, err := NewMapping()
@@ -33,8 +46,66 @@ func NewIndex(root string) (bleve.Index, error) {
 
 		return index, nil
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	removeStaleIndexes(root, name)
+
+	return index, nil
+}
+
+// currentIndexName returns the name of the index directory which is in use
+func currentIndexName(root string) string {
+	b, err := os.ReadFile(filepath.Join(root, currentIndexFile))
+	if err != nil {
+		return defaultIndexName
+	}
+
+	if name := strings.TrimSpace(string(b)); name != "" {
+		return name
+	}
+
+	return defaultIndexName
+}
+
+// removeStaleIndexes removes the indexes of rebuilds which were interrupted or not cleaned up
+func removeStaleIndexes(root, current string) {
+	stale, _ := filepath.Glob(filepath.Join(root, defaultIndexName+"-*"))
+	if current != defaultIndexName {
+		stale = append(stale, filepath.Join(root, defaultIndexName))
+	}
 
-	return index, err
+	for _, p := range stale {
+		if filepath.Base(p) != current {
+			_ = os.RemoveAll(p)
+		}
+	}
+}
+
+// newRebuildIndex creates an empty index next to the index at the given path
+func newRebuildIndex(current string, indexMapping mapping.IndexMapping) (bleve.Index, error) {
+	if current == "" {
+		return bleve.NewMemOnly(indexMapping)
+	}
+
+	destination := filepath.Join(filepath.Dir(current), fmt.Sprintf("%s-%d", defaultIndexName, time.Now().UnixNano()))
+	return bleve.New(destination, indexMapping)
+}
+
+// activateIndex makes the index at the given path the one which is opened by NewIndex
+func activateIndex(destination string) error {
+	if destination == "" {
+		return nil
+	}
+
+	root := filepath.Dir(destination)
+	tmp := filepath.Join(root, currentIndexFile+".tmp")
+	if err := os.WriteFile(tmp, []byte(filepath.Base(destination)), 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, filepath.Join(root, currentIndexFile))
 }
 
 func NewMapping() (mapping.IndexMapping, error) {
@@ -52,6 +123,7 @@ func NewMapping() (mapping.IndexMapping, error) {
 	docMapping := bleve.NewDocumentMapping()
 	docMapping.AddFieldMappingsAt("Name", nameMapping)
 	docMapping.AddFieldMappingsAt("Tags", lowercaseMapping)
+	docMapping.AddFieldMappingsAt("Owner", lowercaseMapping)
 	docMapping.AddFieldMappingsAt("Content", fulltextFieldMapping)
 
 	indexMapping := bleve.NewIndexMapping()
@@ -101,6 +173,31 @@ func searchResourceByID(id string, index bleve.Index) (*search.Resource, error)
 	return matchToResource(res.Hits[0]), nil
 }
 
+// walkIndex calls fn for every document matching the query, the documents are fetched in pages
+func walkIndex(index bleve.Index, q query.Query, fields []string, fn func(hit *bleveSearch.DocumentMatch) error) error {
+	req := bleve.NewSearchRequestOptions(q, walkPageSize, 0, false)
+	req.Fields = fields
+	req.SortBy([]string{"_id"})
+
+	for {
+		res, err := index.Search(req)
+		if err != nil {
+			return err
+		}
+
+		for _, hit := range res.Hits {
+			if err := fn(hit); err != nil {
+				return err
+			}
+		}
+
+		if len(res.Hits) < walkPageSize {
+			return nil
+		}
+		req.SearchAfter = []string{res.Hits[len(res.Hits)-1].ID}
+	}
+}
+
 func searchResourcesByPath(rootId, lookupPath string, index bleve.Index) ([]*search.Resource, error) {
 	q := bleve.NewConjunctionQuery(
 		bleve.NewQueryStringQuery("RootID:"+rootId),
@@ -4,8 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"strconv"
 	"time"
 
+	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli/v2"
 	"go-micro.dev/v4/client"
 
@@ -35,6 +38,12 @@ func Index(cfg *config.Config) *cli.Command {
 				Usage: "index all spaces instead. This or --space is required.",
 			},
 		},
+		Subcommands: []*cli.Command{
+			verifyIndex(cfg),
+			rebuildIndex(cfg),
+			compactIndex(cfg),
+			indexStats(cfg),
+		},
 		Before: func(_ *cli.Context) error {
 			return configlog.ReturnFatal(parser.ParseConfig(cfg))
 		},
@@ -43,20 +52,11 @@ func Index(cfg *config.Config) *cli.Command {
 				return errors.New("either --space or --all-spaces is required")
 			}
 
-			traceProvider, err := tracing.GetTraceProvider(ctx.Context, cfg.Commons.TracesExporter, cfg.Service.Name)
-			if err != nil {
-				return err
-			}
-			grpcClient, err := grpc.NewClient(
-				append(grpc.GetClientOptions(cfg.GRPCClientTLS),
-					grpc.WithTraceProvider(traceProvider),
-				)...,
-			)
+			c, err := searchClient(ctx, cfg)
 			if err != nil {
 				return err
 			}
 
-			c := searchsvc.NewSearchProviderService("eu.opencloud.api.search", grpcClient)
 			_, err = c.IndexSpace(context.Background(), &searchsvc.IndexSpaceRequest{
 				SpaceId: ctx.String("space"),
 			}, func(opts *client.CallOptions) { opts.RequestTimeout = 10 * time.Minute })
@@ -68,3 +68,146 @@ func Index(cfg *config.Config) *cli.Command {
 		},
 	}
 }
+
+// verifyIndex compares the index with the storage
+func verifyIndex(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:  "verify",
+		Usage: "compare the index with the storage and report documents which are missing, stale or orphaned",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "space",
+				Aliases: []string{"s"},
+				Usage:   "the id of the space to verify, all spaces are verified if omitted",
+			},
+			&cli.BoolFlag{
+				Name:  "repair",
+				Usage: "reindex missing and stale documents and purge the orphaned ones",
+			},
+		},
+		Action: func(ctx *cli.Context) error {
+			c, err := searchClient(ctx, cfg)
+			if err != nil {
+				return err
+			}
+
+			res, err := c.VerifyIndex(context.Background(), &searchsvc.VerifyIndexRequest{
+				SpaceId: ctx.String("space"),
+				Repair:  ctx.Bool("repair"),
+			}, func(opts *client.CallOptions) { opts.RequestTimeout = 60 * time.Minute })
+			if err != nil {
+				fmt.Println("failed to verify the index: " + err.Error())
+				return err
+			}
+
+			tbl := tablewriter.NewWriter(os.Stdout)
+			tbl.Header([]string{"Space", "Missing", "Stale", "Orphaned", "Repaired"})
+			for _, space := range res.GetSpaces() {
+				_ = tbl.Append([]string{
+					space.GetSpaceId(),
+					strconv.FormatUint(space.GetMissing(), 10),
+					strconv.FormatUint(space.GetStale(), 10),
+					strconv.FormatUint(space.GetOrphaned(), 10),
+					strconv.FormatBool(space.GetRepaired()),
+				})
+			}
+			return tbl.Render()
+		},
+	}
+}
+
+// rebuildIndex rebuilds the index into a new one
+func rebuildIndex(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:  "rebuild",
+		Usage: "rebuild the index of all spaces into a new index which replaces the current one when it is done",
+		Action: func(ctx *cli.Context) error {
+			c, err := searchClient(ctx, cfg)
+			if err != nil {
+				return err
+			}
+
+			res, err := c.RebuildIndex(context.Background(), &searchsvc.RebuildIndexRequest{},
+				func(opts *client.CallOptions) { opts.RequestTimeout = 24 * time.Hour })
+			if err != nil {
+				fmt.Println("failed to rebuild the index: " + err.Error())
+				return err
+			}
+
+			fmt.Printf("rebuilt the index with %d documents\n", res.GetDocuments())
+			return nil
+		},
+	}
+}
+
+// compactIndex compacts the index
+func compactIndex(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:  "compact",
+		Usage: "compact the index to free the space of deleted documents, only supported by bleve",
+		Action: func(ctx *cli.Context) error {
+			c, err := searchClient(ctx, cfg)
+			if err != nil {
+				return err
+			}
+
+			if _, err := c.CompactIndex(context.Background(), &searchsvc.CompactIndexRequest{},
+				func(opts *client.CallOptions) { opts.RequestTimeout = 60 * time.Minute }); err != nil {
+				fmt.Println("failed to compact the index: " + err.Error())
+				return err
+			}
+
+			return nil
+		},
+	}
+}
+
+// indexStats prints the number of documents and the size of the files per space
+func indexStats(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:  "stats",
+		Usage: "print the number of documents and the size of the indexed files per space",
+		Action: func(ctx *cli.Context) error {
+			c, err := searchClient(ctx, cfg)
+			if err != nil {
+				return err
+			}
+
+			res, err := c.IndexStats(context.Background(), &searchsvc.IndexStatsRequest{},
+				func(opts *client.CallOptions) { opts.RequestTimeout = 10 * time.Minute })
+			if err != nil {
+				fmt.Println("failed to get the index stats: " + err.Error())
+				return err
+			}
+
+			tbl := tablewriter.NewWriter(os.Stdout)
+			tbl.Header([]string{"Space", "Documents", "Deleted", "Size"})
+			for _, space := range res.GetSpaces() {
+				_ = tbl.Append([]string{
+					space.GetSpaceId(),
+					strconv.FormatUint(space.GetDocuments(), 10),
+					strconv.FormatUint(space.GetDeleted(), 10),
+					strconv.FormatUint(space.GetSize(), 10),
+				})
+			}
+			return tbl.Render()
+		},
+	}
+}
+
+func searchClient(ctx *cli.Context, cfg *config.Config) (searchsvc.SearchProviderService, error) {
+	traceProvider, err := tracing.GetTraceProvider(ctx.Context, cfg.Commons.TracesExporter, cfg.Service.Name)
+	if err != nil {
+		return nil, err
+	}
+	grpcClient, err := grpc.NewClient(
+		append(grpc.GetClientOptions(cfg.GRPCClientTLS),
+			grpc.WithTraceProvider(traceProvider),
+		)...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return searchsvc.NewSearchProviderService("eu.opencloud.api.search", grpcClient), nil
+}
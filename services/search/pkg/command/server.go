@@ -77,13 +77,15 @@ func Server(cfg *config.Config) *cli.Command {
 					return err
 				}
 
+				// the backend replaces the index when it is rebuilt, so it takes care of closing it
+				bleveBackend := bleve.NewBackend(idx, bleveQuery.DefaultCreator, logger)
 				defer func() {
-					if err = idx.Close(); err != nil {
+					if err = bleveBackend.Close(); err != nil {
 						logger.Error().Err(err).Msg("could not close bleve index")
 					}
 				}()
 
-				eng = bleve.NewBackend(idx, bleveQuery.DefaultCreator, logger)
+				eng = bleveBackend
 			case "open-search":
 				clientConfig := opensearchgo.Config{
 					Addresses:             cfg.Engine.OpenSearch.Client.Addresses,
@@ -148,6 +150,10 @@ func Server(cfg *config.Config) *cli.Command {
 				if extractor, err = content.NewTikaExtractor(selector, logger, cfg); err != nil {
 					return err
 				}
+			case "native":
+				if extractor, err = content.NewNativeExtractor(selector, logger, cfg); err != nil {
+					return err
+				}
 			default:
 				return fmt.Errorf("unknown search extractor: %s", cfg.Extractor.Type)
 			}
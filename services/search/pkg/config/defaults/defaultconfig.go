@@ -52,6 +52,10 @@ func DefaultConfig() *config.Config {
 				TikaURL:        "http://127.0.0.1:9998",
 				CleanStopWords: true,
 			},
+			Native: config.ExtractorNative{
+				MaxPages:       500,
+				MaxContentSize: 4 * 1024 * 1024,
+			},
 		},
 		Events: config.Events{
 			Endpoint:         "127.0.0.1:9233",
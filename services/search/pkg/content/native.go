@@ -0,0 +1,167 @@
+package content
+
+import (
+	"context"
+	"io"
+	"path"
+	"strings"
+	"unicode/utf8"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/config"
+)
+
+// nativeParser extracts the title and the text of a document,
+// maxPages limits the number of pages, slides or sheets which are read.
+type nativeParser func(data []byte, maxPages int) (title string, text string, err error)
+
+// Native is used to extract content from a resource,
+// it understands PDF, OOXML, ODF and RTF documents without any external service.
+type Native struct {
+	*Basic
+	Retriever
+	ContentExtractionSizeLimit uint64
+	MaxPages                   int
+	MaxContentSize             uint64
+}
+
+// NewNativeExtractor creates a new Native instance.
+func NewNativeExtractor(gatewaySelector pool.Selectable[gateway.GatewayAPIClient], logger log.Logger, cfg *config.Config) (*Native, error) {
+	basic, err := NewBasicExtractor(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Native{
+		Basic:                      basic,
+		Retriever:                  newCS3Retriever(gatewaySelector, logger, cfg.Extractor.CS3AllowInsecure),
+		ContentExtractionSizeLimit: cfg.ContentExtractionSizeLimit,
+		MaxPages:                   cfg.Extractor.Native.MaxPages,
+		MaxContentSize:             cfg.Extractor.Native.MaxContentSize,
+	}, nil
+}
+
+// Extract loads a resource from its underlying storage, parses it and processes the result into a Document.
+// Documents which can not be parsed are indexed without content.
+func (n Native) Extract(ctx context.Context, ri *provider.ResourceInfo) (Document, error) {
+	doc, err := n.Basic.Extract(ctx, ri)
+	if err != nil {
+		return doc, err
+	}
+
+	if ri.Size == 0 {
+		return doc, nil
+	}
+
+	if ri.Size > n.ContentExtractionSizeLimit {
+		n.logger.Info().Interface("ResourceID", ri.Id).Str("Name", ri.Name).Msg("file exceeds content extraction size limit. skipping.")
+		return doc, nil
+	}
+
+	if ri.Type != provider.ResourceType_RESOURCE_TYPE_FILE {
+		return doc, nil
+	}
+
+	parse := nativeParserFor(ri.MimeType, ri.Name)
+	if parse == nil {
+		return doc, nil
+	}
+
+	data, err := n.Retrieve(ctx, ri.Id)
+	if err != nil {
+		return doc, err
+	}
+	defer data.Close()
+
+	buf, err := io.ReadAll(io.LimitReader(data, int64(n.ContentExtractionSizeLimit)))
+	if err != nil {
+		return doc, err
+	}
+
+	title, text, err := parse(buf, n.MaxPages)
+	if err != nil {
+		n.logger.Debug().Err(err).Interface("ResourceID", ri.Id).Str("Name", ri.Name).Msg("could not parse the file content")
+		return doc, nil
+	}
+
+	doc.Title = strings.TrimSpace(title)
+	doc.Content = truncateText(normalizeText(text), n.MaxContentSize)
+
+	return doc, nil
+}
+
+// nativeParserFor returns the parser for the given mime type, the file extension is used as fallback
+func nativeParserFor(mimeType, name string) nativeParser {
+	switch mimeType {
+	case "application/pdf":
+		return parsePDF
+	case "application/rtf", "text/rtf":
+		return parseRTF
+	case "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+		"application/vnd.openxmlformats-officedocument.wordprocessingml.template",
+		"application/vnd.ms-word.document.macroenabled.12":
+		return parseDOCX
+	case "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+		"application/vnd.openxmlformats-officedocument.spreadsheetml.template",
+		"application/vnd.ms-excel.sheet.macroenabled.12":
+		return parseXLSX
+	case "application/vnd.openxmlformats-officedocument.presentationml.presentation",
+		"application/vnd.openxmlformats-officedocument.presentationml.template",
+		"application/vnd.ms-powerpoint.presentation.macroenabled.12":
+		return parsePPTX
+	}
+
+	if strings.HasPrefix(mimeType, "application/vnd.oasis.opendocument.") {
+		return parseODF
+	}
+
+	switch strings.ToLower(path.Ext(name)) {
+	case ".pdf":
+		return parsePDF
+	case ".rtf":
+		return parseRTF
+	case ".docx", ".docm", ".dotx":
+		return parseDOCX
+	case ".xlsx", ".xlsm", ".xltx":
+		return parseXLSX
+	case ".pptx", ".pptm", ".potx":
+		return parsePPTX
+	case ".odt", ".ott", ".ods", ".ots", ".odp", ".otp", ".odg":
+		return parseODF
+	}
+
+	return nil
+}
+
+// normalizeText collapses runs of blanks and empty lines
+func normalizeText(text string) string {
+	lines := strings.Split(text, "\n")
+	out := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if line = strings.Join(strings.Fields(line), " "); line != "" {
+			out = append(out, line)
+		}
+	}
+	return strings.Join(out, "\n")
+}
+
+// truncateText cuts the text to the given number of bytes without splitting runes, 0 disables the limit
+func truncateText(text string, limit uint64) string {
+	if limit == 0 || uint64(len(text)) <= limit {
+		return text
+	}
+
+	text = text[:limit]
+	for len(text) > 0 {
+		r, size := utf8.DecodeLastRuneInString(text)
+		if r != utf8.RuneError || size > 1 {
+			break
+		}
+		text = text[:len(text)-1]
+	}
+	return text
+}
@@ -0,0 +1,371 @@
+package content
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxXMLPartSize limits the uncompressed size of a single xml part of an office document
+const maxXMLPartSize = 64 * 1024 * 1024
+
+var (
+	errPartNotFound = errors.New("part not found")
+
+	ooxmlSlidePart = regexp.MustCompile(`^ppt/slides/slide(\d+)\.xml$`)
+	ooxmlSheetPart = regexp.MustCompile(`^xl/worksheets/sheet(\d+)\.xml$`)
+)
+
+// xmlTextRules describe how the text of a xml part is collected
+type xmlTextRules struct {
+	// text holds the local names of the elements whose character data is text
+	text map[string]bool
+	// breaks holds the local names of the elements which end a line
+	breaks map[string]bool
+	// spaces maps the local names of empty elements to the text they stand for
+	spaces map[string]string
+	// pages holds the local name of the elements counted as page, slide or sheet
+	pages string
+}
+
+var (
+	docxRules = xmlTextRules{
+		text:   map[string]bool{"t": true},
+		breaks: map[string]bool{"p": true, "tr": true},
+		spaces: map[string]string{"tab": "\t", "br": "\n", "cr": "\n"},
+	}
+	pptxRules = xmlTextRules{
+		text:   map[string]bool{"t": true},
+		breaks: map[string]bool{"p": true},
+		spaces: map[string]string{"br": "\n"},
+	}
+	odfRules = xmlTextRules{
+		// elements like span or a are nested in paragraphs and headings
+		text:   map[string]bool{"p": true, "h": true},
+		breaks: map[string]bool{"p": true, "h": true, "table-row": true},
+		spaces: map[string]string{"s": " ", "tab": "\t", "line-break": "\n"},
+	}
+)
+
+// parseDOCX extracts the text of word processing documents
+func parseDOCX(data []byte, _ int) (string, string, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return "", "", err
+	}
+
+	part, err := readZipPart(zr, "word/document.xml")
+	if err != nil {
+		return "", "", err
+	}
+
+	text, err := xmlText(part, docxRules, 0)
+	if err != nil {
+		return "", "", err
+	}
+
+	return ooxmlTitle(zr), text, nil
+}
+
+// parsePPTX extracts the text of the slides of presentations
+func parsePPTX(data []byte, maxPages int) (string, string, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return "", "", err
+	}
+
+	var sb strings.Builder
+	for _, name := range numberedParts(zr, ooxmlSlidePart, maxPages) {
+		part, err := readZipPart(zr, name)
+		if err != nil {
+			return "", "", err
+		}
+
+		text, err := xmlText(part, pptxRules, 0)
+		if err != nil {
+			return "", "", err
+		}
+		sb.WriteString(text)
+		sb.WriteString("\n")
+	}
+
+	return ooxmlTitle(zr), sb.String(), nil
+}
+
+// parseXLSX extracts the cell values of the sheets of workbooks
+func parseXLSX(data []byte, maxPages int) (string, string, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return "", "", err
+	}
+
+	var sharedStrings []string
+	switch part, err := readZipPart(zr, "xl/sharedStrings.xml"); {
+	case errors.Is(err, errPartNotFound):
+	case err != nil:
+		return "", "", err
+	default:
+		if sharedStrings, err = xlsxSharedStrings(part); err != nil {
+			return "", "", err
+		}
+	}
+
+	var sb strings.Builder
+	for _, name := range numberedParts(zr, ooxmlSheetPart, maxPages) {
+		part, err := readZipPart(zr, name)
+		if err != nil {
+			return "", "", err
+		}
+
+		if err := xlsxSheetText(part, sharedStrings, &sb); err != nil {
+			return "", "", err
+		}
+	}
+
+	return ooxmlTitle(zr), sb.String(), nil
+}
+
+// xlsxSharedStrings returns the shared strings of a workbook, rich text runs of a string are joined
+func xlsxSharedStrings(part []byte) ([]string, error) {
+	d := xml.NewDecoder(bytes.NewReader(part))
+	var sharedStrings []string
+	var sb strings.Builder
+	var inText bool
+	for {
+		tok, err := d.Token()
+		switch {
+		case err == io.EOF:
+			return sharedStrings, nil
+		case err != nil:
+			return nil, err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "si":
+				sb.Reset()
+			case "t":
+				inText = true
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "si":
+				sharedStrings = append(sharedStrings, sb.String())
+			case "t":
+				inText = false
+			}
+		case xml.CharData:
+			if inText {
+				sb.Write(t)
+			}
+		}
+	}
+}
+
+// xlsxSheetText writes the cell values of a sheet, one row per line
+func xlsxSheetText(part []byte, sharedStrings []string, sb *strings.Builder) error {
+	d := xml.NewDecoder(bytes.NewReader(part))
+	var cellType string
+	var inValue, inText bool
+	for {
+		tok, err := d.Token()
+		switch {
+		case err == io.EOF:
+			return nil
+		case err != nil:
+			return err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "c":
+				cellType = ""
+				for _, attr := range t.Attr {
+					if attr.Name.Local == "t" {
+						cellType = attr.Value
+					}
+				}
+			case "v":
+				inValue = true
+			case "t":
+				inText = true
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "v":
+				inValue = false
+			case "t":
+				inText = false
+			case "c":
+				sb.WriteString("\t")
+			case "row":
+				sb.WriteString("\n")
+			}
+		case xml.CharData:
+			switch {
+			case inValue && cellType == "s":
+				i, err := strconv.Atoi(strings.TrimSpace(string(t)))
+				if err == nil && i >= 0 && i < len(sharedStrings) {
+					sb.WriteString(sharedStrings[i])
+				}
+			case inValue, inText:
+				sb.Write(t)
+			}
+		}
+	}
+}
+
+// ooxmlTitle returns the title of the core properties
+func ooxmlTitle(zr *zip.Reader) string {
+	part, err := readZipPart(zr, "docProps/core.xml")
+	if err != nil {
+		return ""
+	}
+
+	text, err := xmlText(part, xmlTextRules{text: map[string]bool{"title": true}}, 0)
+	if err != nil {
+		return ""
+	}
+	return text
+}
+
+// parseODF extracts the text of open document text documents, spreadsheets and presentations
+func parseODF(data []byte, maxPages int) (string, string, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return "", "", err
+	}
+
+	part, err := readZipPart(zr, "content.xml")
+	if err != nil {
+		return "", "", err
+	}
+
+	rules := odfRules
+	switch {
+	case bytes.Contains(part, []byte("<office:presentation")), bytes.Contains(part, []byte("<office:drawing")):
+		rules.pages = "page"
+	case bytes.Contains(part, []byte("<office:spreadsheet")):
+		rules.pages = "table"
+	}
+
+	text, err := xmlText(part, rules, maxPages)
+	if err != nil {
+		return "", "", err
+	}
+
+	var title string
+	if meta, err := readZipPart(zr, "meta.xml"); err == nil {
+		title, _ = xmlText(meta, xmlTextRules{text: map[string]bool{"title": true}}, 0)
+	}
+
+	return title, text, nil
+}
+
+// xmlText collects the text of a xml document, it stops after maxPages elements
+// named rules.pages if a limit is given.
+func xmlText(part []byte, rules xmlTextRules, maxPages int) (string, error) {
+	d := xml.NewDecoder(bytes.NewReader(part))
+	var sb strings.Builder
+	var depth, pages int
+	for {
+		tok, err := d.Token()
+		switch {
+		case err == io.EOF:
+			return sb.String(), nil
+		case err != nil:
+			return "", err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if rules.pages != "" && t.Name.Local == rules.pages {
+				if maxPages > 0 && pages >= maxPages {
+					return sb.String(), nil
+				}
+				pages++
+			}
+			if rules.text[t.Name.Local] {
+				depth++
+			}
+			if s, ok := rules.spaces[t.Name.Local]; ok {
+				sb.WriteString(s)
+			}
+		case xml.EndElement:
+			if rules.text[t.Name.Local] && depth > 0 {
+				depth--
+			}
+			if rules.breaks[t.Name.Local] {
+				sb.WriteString("\n")
+			}
+		case xml.CharData:
+			if depth > 0 {
+				sb.Write(t)
+			}
+		}
+	}
+}
+
+// numberedParts returns the names of the parts matching the pattern ordered by their number
+func numberedParts(zr *zip.Reader, pattern *regexp.Regexp, limit int) []string {
+	type numbered struct {
+		name string
+		n    int
+	}
+
+	var parts []numbered
+	for _, f := range zr.File {
+		m := pattern.FindStringSubmatch(f.Name)
+		if m == nil {
+			continue
+		}
+		n, _ := strconv.Atoi(m[1])
+		parts = append(parts, numbered{name: f.Name, n: n})
+	}
+	sort.Slice(parts, func(i, j int) bool { return parts[i].n < parts[j].n })
+
+	if limit > 0 && len(parts) > limit {
+		parts = parts[:limit]
+	}
+
+	names := make([]string, 0, len(parts))
+	for _, p := range parts {
+		names = append(names, p.name)
+	}
+	return names
+}
+
+// readZipPart reads a part of an office document
+func readZipPart(zr *zip.Reader, name string) ([]byte, error) {
+	for _, f := range zr.File {
+		if f.Name != name {
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+
+		data, err := io.ReadAll(io.LimitReader(rc, maxXMLPartSize+1))
+		if err != nil {
+			return nil, err
+		}
+		if len(data) > maxXMLPartSize {
+			return nil, fmt.Errorf("part %s exceeds %d bytes", name, maxXMLPartSize)
+		}
+		return data, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", errPartNotFound, name)
+}
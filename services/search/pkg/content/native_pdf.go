@@ -0,0 +1,1033 @@
+package content
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"io"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+const (
+	// maxPDFStreamSize limits the decoded size of a single pdf stream
+	maxPDFStreamSize = 64 * 1024 * 1024
+	// maxPDFFormDepth limits the nesting of form xobjects
+	maxPDFFormDepth = 4
+)
+
+var (
+	pdfObjectHeader = regexp.MustCompile(`(\d+)\s+(\d+)\s+obj\b`)
+	pdfReference    = regexp.MustCompile(`^(\d+)\s+(\d+)\s+R\b`)
+	pdfReferences   = regexp.MustCompile(`(\d+)\s+\d+\s+R\b`)
+	pdfCMapChar     = regexp.MustCompile(`<([0-9A-Fa-f]+)>\s*<([0-9A-Fa-f]*)>`)
+	pdfCMapRange    = regexp.MustCompile(`<([0-9A-Fa-f]+)>\s*<([0-9A-Fa-f]+)>\s*(<[0-9A-Fa-f]*>|\[[^\]]*\])`)
+	pdfHexString    = regexp.MustCompile(`<([0-9A-Fa-f\s]*)>`)
+
+	errPDFEncrypted = errors.New("encrypted pdf files are not supported")
+)
+
+// pdfObject is an indirect object of a pdf file
+type pdfObject struct {
+	// body holds the object without its stream, usually a dictionary
+	body      []byte
+	stream    []byte
+	hasStream bool
+}
+
+// pdfFont knows how to turn the character codes of a font into text
+type pdfFont struct {
+	toUnicode map[uint32]string
+	codeWidth int
+	encoding  *charmap.Charmap
+	composite bool
+	// widths holds the glyph widths in thousandths of text space units
+	widths       map[uint32]float64
+	defaultWidth float64
+}
+
+// pdfFile is a parsed pdf file
+type pdfFile struct {
+	objects  map[int]*pdfObject
+	trailers []map[string][]byte
+	fonts    map[int]*pdfFont
+}
+
+// parsePDF extracts the text and the title of pdf files
+func parsePDF(data []byte, maxPages int) (string, string, error) {
+	if !bytes.Contains(data[:min(len(data), 1024)], []byte("%PDF-")) {
+		return "", "", errors.New("missing pdf header")
+	}
+
+	f := newPDFFile(data)
+	if v := f.trailerValue("Encrypt"); v != nil {
+		return "", "", errPDFEncrypted
+	}
+
+	var sb strings.Builder
+	for _, page := range f.pages(maxPages) {
+		f.pageText(page, &sb)
+		sb.WriteString("\n")
+	}
+
+	return f.title(), sb.String(), nil
+}
+
+func newPDFFile(data []byte) *pdfFile {
+	f := &pdfFile{
+		objects: make(map[int]*pdfObject),
+		fonts:   make(map[int]*pdfFont),
+	}
+
+	pos := 0
+	for pos < len(data) {
+		loc := pdfObjectHeader.FindSubmatchIndex(data[pos:])
+		if loc == nil {
+			break
+		}
+		num, _ := strconv.Atoi(string(data[pos+loc[2] : pos+loc[3]]))
+		start := pos + loc[1]
+
+		body := data[start:]
+		endobj := bytes.Index(body, []byte("endobj"))
+		if endobj >= 0 {
+			body = body[:endobj]
+		}
+
+		obj := &pdfObject{body: body}
+		if si := bytes.Index(body, []byte("stream")); si >= 0 && (si == 0 || body[si-1] != 'd') {
+			obj.body, obj.hasStream = body[:si], true
+			obj.stream, pos = pdfStreamData(data, start+si+len("stream"), pdfDict(obj.body)["Length"])
+			if e := bytes.Index(data[pos:], []byte("endobj")); e >= 0 {
+				pos += e
+			}
+		} else if endobj >= 0 {
+			pos = start + endobj
+		} else {
+			pos = len(data)
+		}
+		// later definitions are incremental updates and replace the earlier ones
+		f.objects[num] = obj
+	}
+
+	for _, obj := range f.objects {
+		if !obj.hasStream {
+			continue
+		}
+		switch d := pdfDict(obj.body); pdfName(d["Type"]) {
+		case "ObjStm":
+			f.addObjectStream(obj, d)
+		case "XRef":
+			f.trailers = append(f.trailers, d)
+		}
+	}
+
+	for rest := data; ; {
+		i := bytes.LastIndex(rest, []byte("trailer"))
+		if i < 0 {
+			break
+		}
+		f.trailers = append(f.trailers, pdfDict(rest[i:]))
+		rest = rest[:i]
+	}
+
+	return f
+}
+
+// pdfStreamData returns the data of a stream starting at pos and the position after it
+func pdfStreamData(data []byte, pos int, length []byte) ([]byte, int) {
+	if pos < len(data) && data[pos] == '\r' {
+		pos++
+	}
+	if pos < len(data) && data[pos] == '\n' {
+		pos++
+	}
+
+	if n, err := strconv.Atoi(string(bytes.TrimSpace(length))); err == nil && n >= 0 && pos+n <= len(data) {
+		if bytes.HasPrefix(bytes.TrimLeft(data[pos+n:], "\r\n \t"), []byte("endstream")) {
+			return data[pos : pos+n], pos + n
+		}
+	}
+
+	end := bytes.Index(data[pos:], []byte("endstream"))
+	if end < 0 {
+		return data[pos:], len(data)
+	}
+	return bytes.TrimRight(data[pos:pos+end], "\r\n"), pos + end
+}
+
+// addObjectStream adds the objects which are compressed in an object stream
+func (f *pdfFile) addObjectStream(obj *pdfObject, d map[string][]byte) {
+	data := f.decodeStream(obj)
+	first, err := strconv.Atoi(string(bytes.TrimSpace(d["First"])))
+	if err != nil || first > len(data) {
+		return
+	}
+
+	header := strings.Fields(string(data[:first]))
+	for i := 0; i+1 < len(header); i += 2 {
+		num, err1 := strconv.Atoi(header[i])
+		offset, err2 := strconv.Atoi(header[i+1])
+		if err1 != nil || err2 != nil || first+offset > len(data) {
+			continue
+		}
+
+		end := len(data)
+		if i+3 < len(header) {
+			if next, err := strconv.Atoi(header[i+3]); err == nil && first+next <= len(data) && next >= offset {
+				end = first + next
+			}
+		}
+
+		if _, ok := f.objects[num]; !ok {
+			f.objects[num] = &pdfObject{body: data[first+offset : end]}
+		}
+	}
+}
+
+// decodeStream returns the decoded stream data, streams with unsupported filters are returned empty
+func (f *pdfFile) decodeStream(obj *pdfObject) []byte {
+	d := pdfDict(obj.body)
+	filters := pdfNames(f.resolve(d["Filter"]))
+
+	data := obj.stream
+	for _, filter := range filters {
+		switch filter {
+		case "FlateDecode", "Fl":
+			r, err := zlib.NewReader(bytes.NewReader(data))
+			if err != nil {
+				return nil
+			}
+			// broken streams are common, keep what could be decoded
+			data, _ = io.ReadAll(io.LimitReader(r, maxPDFStreamSize))
+		default:
+			return nil
+		}
+	}
+	return data
+}
+
+// resolve follows references to indirect objects
+func (f *pdfFile) resolve(v []byte) []byte {
+	for i := 0; i < 8; i++ {
+		m := pdfReference.FindSubmatch(bytes.TrimSpace(v))
+		if m == nil {
+			return v
+		}
+		num, _ := strconv.Atoi(string(m[1]))
+		obj, ok := f.objects[num]
+		if !ok {
+			return nil
+		}
+		v = obj.body
+	}
+	return v
+}
+
+// object returns the object a reference points to
+func (f *pdfFile) object(v []byte) (int, *pdfObject) {
+	m := pdfReference.FindSubmatch(bytes.TrimSpace(v))
+	if m == nil {
+		return 0, nil
+	}
+	num, _ := strconv.Atoi(string(m[1]))
+	return num, f.objects[num]
+}
+
+func (f *pdfFile) trailerValue(key string) []byte {
+	for _, t := range f.trailers {
+		if v, ok := t[key]; ok {
+			return v
+		}
+	}
+	return nil
+}
+
+// title returns the title of the document information dictionary
+func (f *pdfFile) title() string {
+	info := pdfDict(f.resolve(f.trailerValue("Info")))
+	return pdfTextString(pdfString(f.resolve(info["Title"])))
+}
+
+// pages returns the page objects in document order
+func (f *pdfFile) pages(maxPages int) []*pdfObject {
+	var pages []*pdfObject
+	visited := map[int]bool{}
+	full := func() bool { return maxPages > 0 && len(pages) >= maxPages }
+
+	var walk func(ref []byte)
+	walk = func(ref []byte) {
+		num, obj := f.object(ref)
+		if obj == nil || visited[num] || full() {
+			return
+		}
+		visited[num] = true
+
+		d := pdfDict(obj.body)
+		if pdfName(d["Type"]) == "Page" {
+			pages = append(pages, obj)
+			return
+		}
+		for _, kid := range pdfReferences.FindAll(f.resolve(d["Kids"]), -1) {
+			walk(kid)
+		}
+	}
+
+	catalog := pdfDict(f.resolve(f.trailerValue("Root")))
+	walk(catalog["Pages"])
+	if len(pages) > 0 {
+		return pages
+	}
+
+	// the page tree is broken, fall back to the order of the objects
+	nums := make([]int, 0, len(f.objects))
+	for num, obj := range f.objects {
+		if pdfName(pdfDict(obj.body)["Type"]) == "Page" {
+			nums = append(nums, num)
+		}
+	}
+	sort.Ints(nums)
+	for _, num := range nums {
+		if full() {
+			break
+		}
+		pages = append(pages, f.objects[num])
+	}
+	return pages
+}
+
+// pageText writes the text of the content streams of a page
+func (f *pdfFile) pageText(page *pdfObject, sb *strings.Builder) {
+	d := pdfDict(page.body)
+	resources := f.inheritedResources(d)
+
+	var content []byte
+	contents := bytes.TrimSpace(d["Contents"])
+	if _, obj := f.object(contents); obj != nil && !obj.hasStream {
+		// an indirect array of content streams
+		contents = obj.body
+	}
+	for _, ref := range pdfReferences.FindAll(contents, -1) {
+		if _, obj := f.object(ref); obj != nil && obj.hasStream {
+			content = append(content, f.decodeStream(obj)...)
+			content = append(content, '\n')
+		}
+	}
+
+	f.contentText(content, resources, sb, 0)
+}
+
+// inheritedResources returns the resources of a page, which may be inherited from the page tree
+func (f *pdfFile) inheritedResources(d map[string][]byte) map[string][]byte {
+	for i := 0; i < 32 && d != nil; i++ {
+		if r, ok := d["Resources"]; ok {
+			return pdfDict(f.resolve(r))
+		}
+		parent, ok := d["Parent"]
+		if !ok {
+			break
+		}
+		d = pdfDict(f.resolve(parent))
+	}
+	return nil
+}
+
+// font returns the font with the given resource name
+func (f *pdfFile) font(resources map[string][]byte, name string) *pdfFont {
+	ref := pdfDict(f.resolve(resources["Font"]))[name]
+	num, obj := f.object(ref)
+	if obj == nil {
+		return nil
+	}
+	if font, ok := f.fonts[num]; ok {
+		return font
+	}
+
+	d := pdfDict(obj.body)
+	font := &pdfFont{codeWidth: 1, encoding: charmap.Windows1252, widths: map[uint32]float64{}, defaultWidth: 500}
+
+	switch enc := d["Encoding"]; pdfName(f.resolve(enc)) {
+	case "MacRomanEncoding":
+		font.encoding = charmap.Macintosh
+	case "":
+		if base := pdfDict(f.resolve(enc))["BaseEncoding"]; pdfName(base) == "MacRomanEncoding" {
+			font.encoding = charmap.Macintosh
+		}
+	}
+
+	if pdfName(d["Subtype"]) == "Type0" {
+		font.composite, font.codeWidth, font.defaultWidth = true, 2, 1000
+		descendant := pdfDict(f.resolve(pdfReferences.Find(f.resolve(d["DescendantFonts"]))))
+		if dw, err := strconv.ParseFloat(string(bytes.TrimSpace(f.resolve(descendant["DW"]))), 64); err == nil {
+			font.defaultWidth = dw
+		}
+		f.cidWidths(f.resolve(descendant["W"]), font.widths)
+	} else if first, err := strconv.Atoi(string(bytes.TrimSpace(f.resolve(d["FirstChar"])))); err == nil {
+		for i, w := range pdfNumbers(f.resolve(d["Widths"])) {
+			font.widths[uint32(first+i)] = w
+		}
+	}
+
+	if _, cmap := f.object(d["ToUnicode"]); cmap != nil && cmap.hasStream {
+		font.toUnicode, font.codeWidth = parseCMap(f.decodeStream(cmap), font.codeWidth)
+	}
+
+	f.fonts[num] = font
+	return font
+}
+
+// cidWidths reads the glyph widths of a cid font, which are given as
+// "first [w1 w2 ...]" or "first last w" entries
+func (f *pdfFile) cidWidths(w []byte, widths map[uint32]float64) {
+	w = bytes.TrimSpace(w)
+	if len(w) < 2 || w[0] != '[' {
+		return
+	}
+
+	var numbers []float64
+	for i := 1; i < len(w); {
+		switch c := w[i]; {
+		case isPDFWhitespace(c):
+			i++
+		case c == '[':
+			end := pdfValueEnd(w, i)
+			if len(numbers) > 0 {
+				first := numbers[len(numbers)-1]
+				for j, width := range pdfNumbers(w[i:end]) {
+					widths[uint32(first)+uint32(j)] = width
+				}
+			}
+			numbers = numbers[:0]
+			i = end
+		case c == ']':
+			return
+		default:
+			end := pdfValueEnd(w, i)
+			if n, err := strconv.ParseFloat(string(w[i:end]), 64); err == nil {
+				numbers = append(numbers, n)
+			}
+			if len(numbers) == 3 {
+				if numbers[1]-numbers[0] <= 0xFFFF {
+					for code := uint32(numbers[0]); code <= uint32(numbers[1]); code++ {
+						widths[code] = numbers[2]
+					}
+				}
+				numbers = numbers[:0]
+			}
+			i = end
+		}
+	}
+}
+
+// pdfOperand is an operand of a content stream operator
+type pdfOperand struct {
+	str   []byte
+	isStr bool
+	name  string
+	num   float64
+	isNum bool
+	array []pdfOperand
+}
+
+// pdfTextState tracks the position of the text to find the gaps between words and the line breaks
+type pdfTextState struct {
+	sb       *strings.Builder
+	font     *pdfFont
+	fontSize float64
+	// scaleX and scaleY are the scaling of the text matrix
+	scaleX, scaleY float64
+	// lineX and lineY are the start of the current line, x is the current position
+	lineX, lineY, x float64
+	// endX is the end of the last text
+	endX float64
+	// lastY is the line of the last text, written tells if there was any text yet
+	lastY   float64
+	written bool
+}
+
+func (s *pdfTextState) setMatrix(a, d, e, f float64) {
+	s.scaleX, s.scaleY = math.Abs(a), math.Abs(d)
+	if s.scaleX == 0 {
+		s.scaleX = 1
+	}
+	if s.scaleY == 0 {
+		s.scaleY = 1
+	}
+	s.lineX, s.lineY, s.x = e, f, e
+}
+
+func (s *pdfTextState) moveLine(tx, ty float64) {
+	s.lineX += tx * s.scaleX
+	s.lineY += ty * s.scaleY
+	s.x = s.lineX
+}
+
+func (s *pdfTextState) nextLine() {
+	s.moveLine(0, -s.fontSize*1.2)
+}
+
+// show writes the text of a string and separates it from the previous text if needed
+func (s *pdfTextState) show(str []byte) {
+	text, advance := s.font.decode(str)
+
+	size := math.Abs(s.fontSize) * s.scaleY
+	switch {
+	case !s.written:
+	case math.Abs(s.lineY-s.lastY) > size*0.5:
+		s.sb.WriteString("\n")
+	case s.x-s.endX > size*0.2:
+		s.sb.WriteString(" ")
+	}
+
+	s.sb.WriteString(text)
+	s.written, s.lastY = true, s.lineY
+	s.x += advance * math.Abs(s.fontSize) * s.scaleX
+	s.endX = s.x
+}
+
+// contentText interprets the text operators of a content stream
+func (f *pdfFile) contentText(content []byte, resources map[string][]byte, sb *strings.Builder, depth int) {
+	var operands []pdfOperand
+	var arrays [][]pdfOperand
+	state := &pdfTextState{sb: sb, scaleX: 1, scaleY: 1}
+
+	push := func(o pdfOperand) {
+		if len(arrays) > 0 {
+			arrays[len(arrays)-1] = append(arrays[len(arrays)-1], o)
+			return
+		}
+		operands = append(operands, o)
+	}
+	num := func(i int) float64 {
+		if i < 0 || i >= len(operands) {
+			return 0
+		}
+		return operands[i].num
+	}
+
+	for i := 0; i < len(content); {
+		c := content[i]
+		switch {
+		case isPDFWhitespace(c):
+			i++
+		case c == '%':
+			for i < len(content) && content[i] != '\n' && content[i] != '\r' {
+				i++
+			}
+		case c == '(':
+			s, end := pdfLiteralString(content, i)
+			push(pdfOperand{str: s, isStr: true})
+			i = end
+		case c == '<' && i+1 < len(content) && content[i+1] == '<':
+			i = pdfValueEnd(content, i)
+			push(pdfOperand{})
+		case c == '<':
+			end := bytes.IndexByte(content[i:], '>')
+			if end < 0 {
+				return
+			}
+			push(pdfOperand{str: pdfHexBytes(content[i+1 : i+end]), isStr: true})
+			i += end + 1
+		case c == '[':
+			arrays = append(arrays, nil)
+			i++
+		case c == ']':
+			i++
+			if len(arrays) == 0 {
+				break
+			}
+			arr := arrays[len(arrays)-1]
+			arrays = arrays[:len(arrays)-1]
+			push(pdfOperand{array: arr})
+		case c == '/':
+			end := pdfValueEnd(content, i)
+			push(pdfOperand{name: string(content[i+1 : end])})
+			i = end
+		default:
+			end := i + 1
+			for end < len(content) && !isPDFWhitespace(content[end]) && !isPDFDelimiter(content[end]) {
+				end++
+			}
+			token := string(content[i:end])
+			i = end
+
+			if n, err := strconv.ParseFloat(token, 64); err == nil {
+				push(pdfOperand{num: n, isNum: true})
+				continue
+			}
+
+			last := len(operands) - 1
+			switch token {
+			case "BT":
+				state.setMatrix(1, 1, 0, 0)
+			case "Tf":
+				if last >= 1 {
+					state.font = f.font(resources, operands[last-1].name)
+					state.fontSize = num(last)
+				}
+			case "Tm":
+				if last >= 5 {
+					state.setMatrix(num(last-5), num(last-2), num(last-1), num(last))
+				}
+			case "Td", "TD":
+				if last >= 1 {
+					state.moveLine(num(last-1), num(last))
+				}
+			case "T*":
+				state.nextLine()
+			case "Tj":
+				if last >= 0 {
+					state.show(operands[last].str)
+				}
+			case "'", "\"":
+				state.nextLine()
+				if last >= 0 {
+					state.show(operands[last].str)
+				}
+			case "TJ":
+				if last < 0 {
+					break
+				}
+				for _, o := range operands[last].array {
+					switch {
+					case o.isStr:
+						state.show(o.str)
+					case o.isNum:
+						// positive numbers move the next glyph to the left
+						state.x -= o.num / 1000 * state.fontSize * state.scaleX
+					}
+				}
+			case "Do":
+				if last >= 0 && depth < maxPDFFormDepth {
+					f.formText(resources, operands[last].name, sb, depth+1)
+				}
+			case "BI":
+				// skip inline images
+				id := bytes.Index(content[i:], []byte("ID"))
+				if id < 0 {
+					return
+				}
+				ei := bytes.Index(content[i+id:], []byte("EI"))
+				if ei < 0 {
+					return
+				}
+				i += id + ei + 2
+			}
+			operands = operands[:0]
+		}
+	}
+}
+
+// formText writes the text of a form xobject
+func (f *pdfFile) formText(resources map[string][]byte, name string, sb *strings.Builder, depth int) {
+	ref := pdfDict(f.resolve(resources["XObject"]))[name]
+	_, obj := f.object(ref)
+	if obj == nil || !obj.hasStream {
+		return
+	}
+
+	d := pdfDict(obj.body)
+	if pdfName(d["Subtype"]) != "Form" {
+		return
+	}
+
+	formResources := resources
+	if r, ok := d["Resources"]; ok {
+		formResources = pdfDict(f.resolve(r))
+	}
+	f.contentText(f.decodeStream(obj), formResources, sb, depth)
+}
+
+// decode turns the character codes of a string into text and returns it with the advance of the glyphs in text space
+func (font *pdfFont) decode(s []byte) (string, float64) {
+	if font == nil {
+		font = &pdfFont{codeWidth: 1, encoding: charmap.Windows1252, defaultWidth: 500}
+	}
+
+	var sb strings.Builder
+	var advance float64
+	w := max(font.codeWidth, 1)
+	for i := 0; i+w <= len(s); i += w {
+		var code uint32
+		for _, b := range s[i : i+w] {
+			code = code<<8 | uint32(b)
+		}
+
+		if width, ok := font.widths[code]; ok {
+			advance += width / 1000
+		} else {
+			advance += font.defaultWidth / 1000
+		}
+
+		switch t, ok := font.toUnicode[code]; {
+		case ok:
+			sb.WriteString(t)
+		case font.composite:
+			// the codes are glyph ids which can not be mapped without a cmap
+		case w == 1:
+			sb.WriteRune(font.encoding.DecodeByte(s[i]))
+		}
+	}
+
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || r == utf8.RuneError {
+			return -1
+		}
+		return r
+	}, sb.String()), advance
+}
+
+// parseCMap reads the unicode mappings of a ToUnicode cmap and returns them with the width of the codes
+func parseCMap(data []byte, codeWidth int) (map[uint32]string, int) {
+	m := make(map[uint32]string)
+
+	if section := pdfSection(data, "begincodespacerange", "endcodespacerange"); section != nil {
+		if hex := pdfHexString.FindSubmatch(section); hex != nil {
+			codeWidth = max(len(bytes.TrimSpace(hex[1]))/2, 1)
+		}
+	}
+
+	for rest := data; ; {
+		section := pdfSection(rest, "beginbfchar", "endbfchar")
+		if section == nil {
+			break
+		}
+		for _, match := range pdfCMapChar.FindAllSubmatch(section, -1) {
+			m[pdfHexCode(match[1])] = pdfUTF16(pdfHexBytes(match[2]))
+		}
+		rest = rest[bytes.Index(rest, []byte("endbfchar"))+len("endbfchar"):]
+	}
+
+	for rest := data; ; {
+		section := pdfSection(rest, "beginbfrange", "endbfrange")
+		if section == nil {
+			break
+		}
+		for _, match := range pdfCMapRange.FindAllSubmatch(section, -1) {
+			lo, hi := pdfHexCode(match[1]), pdfHexCode(match[2])
+			if hi < lo || hi-lo > 0xFFFF {
+				continue
+			}
+
+			if match[3][0] == '[' {
+				for i, hex := range pdfHexString.FindAllSubmatch(match[3], -1) {
+					if lo+uint32(i) > hi {
+						break
+					}
+					m[lo+uint32(i)] = pdfUTF16(pdfHexBytes(hex[1]))
+				}
+				continue
+			}
+
+			dst := pdfHexBytes(match[3][1 : len(match[3])-1])
+			if len(dst) < 2 {
+				continue
+			}
+			for code := lo; code <= hi; code++ {
+				units := make([]byte, len(dst))
+				copy(units, dst)
+				last := uint32(units[len(units)-2])<<8 | uint32(units[len(units)-1])
+				last += code - lo
+				units[len(units)-2], units[len(units)-1] = byte(last>>8), byte(last)
+				m[code] = pdfUTF16(units)
+			}
+		}
+		rest = rest[bytes.Index(rest, []byte("endbfrange"))+len("endbfrange"):]
+	}
+
+	return m, codeWidth
+}
+
+// pdfSection returns the data between the first begin and end keyword
+func pdfSection(data []byte, begin, end string) []byte {
+	b := bytes.Index(data, []byte(begin))
+	if b < 0 {
+		return nil
+	}
+	e := bytes.Index(data[b:], []byte(end))
+	if e < 0 {
+		return nil
+	}
+	return data[b+len(begin) : b+e]
+}
+
+// pdfDict returns the top level entries of the first dictionary in b
+func pdfDict(b []byte) map[string][]byte {
+	start := bytes.Index(b, []byte("<<"))
+	if start < 0 {
+		return nil
+	}
+
+	d := make(map[string][]byte)
+	for i := start + 2; i < len(b); {
+		switch c := b[i]; {
+		case isPDFWhitespace(c):
+			i++
+		case c == '>':
+			return d
+		case c == '/':
+			keyEnd := pdfValueEnd(b, i)
+			key := string(b[i+1 : keyEnd])
+			i = keyEnd
+			for i < len(b) && isPDFWhitespace(b[i]) {
+				i++
+			}
+			if i >= len(b) {
+				return d
+			}
+			end := pdfValueEnd(b, i)
+			d[key] = b[i:end]
+			i = end
+		default:
+			// not a valid key, skip the value
+			i = pdfValueEnd(b, i)
+		}
+	}
+	return d
+}
+
+// pdfValueEnd returns the end of the value starting at i
+func pdfValueEnd(b []byte, i int) int {
+	if i >= len(b) {
+		return len(b)
+	}
+
+	switch c := b[i]; {
+	case c == '<' && i+1 < len(b) && b[i+1] == '<':
+		depth := 0
+		for j := i; j < len(b); {
+			switch {
+			case b[j] == '(':
+				_, j = pdfLiteralString(b, j)
+			case b[j] == '<' && j+1 < len(b) && b[j+1] == '<':
+				depth++
+				j += 2
+			case b[j] == '>' && j+1 < len(b) && b[j+1] == '>':
+				depth--
+				j += 2
+				if depth == 0 {
+					return j
+				}
+			default:
+				j++
+			}
+		}
+		return len(b)
+	case c == '<':
+		if end := bytes.IndexByte(b[i:], '>'); end >= 0 {
+			return i + end + 1
+		}
+		return len(b)
+	case c == '(':
+		_, end := pdfLiteralString(b, i)
+		return end
+	case c == '[':
+		depth := 0
+		for j := i; j < len(b); {
+			switch b[j] {
+			case '(':
+				_, j = pdfLiteralString(b, j)
+				continue
+			case '[':
+				depth++
+			case ']':
+				depth--
+				if depth == 0 {
+					return j + 1
+				}
+			}
+			j++
+		}
+		return len(b)
+	case c == '/':
+		j := i + 1
+		for j < len(b) && !isPDFWhitespace(b[j]) && !isPDFDelimiter(b[j]) {
+			j++
+		}
+		return j
+	case isPDFDelimiter(c):
+		return i + 1
+	default:
+		j := i
+		for j < len(b) && !isPDFWhitespace(b[j]) && !isPDFDelimiter(b[j]) {
+			j++
+		}
+		// references consist of the object number, the generation and R
+		if m := pdfReference.FindIndex(b[i:]); m != nil {
+			return i + m[1]
+		}
+		return j
+	}
+}
+
+// pdfLiteralString decodes the literal string starting at i and returns it with the position after it
+func pdfLiteralString(b []byte, i int) ([]byte, int) {
+	var out []byte
+	depth := 0
+	for j := i; j < len(b); j++ {
+		c := b[j]
+		switch c {
+		case '(':
+			depth++
+			if depth == 1 {
+				continue
+			}
+		case ')':
+			depth--
+			if depth == 0 {
+				return out, j + 1
+			}
+		case '\\':
+			j++
+			if j >= len(b) {
+				return out, j
+			}
+			switch e := b[j]; e {
+			case 'n':
+				out = append(out, '\n')
+			case 'r':
+				out = append(out, '\r')
+			case 't':
+				out = append(out, '\t')
+			case 'b':
+				out = append(out, '\b')
+			case 'f':
+				out = append(out, '\f')
+			case '\r':
+				if j+1 < len(b) && b[j+1] == '\n' {
+					j++
+				}
+			case '\n':
+			case '0', '1', '2', '3', '4', '5', '6', '7':
+				v, k := 0, j
+				for ; k < len(b) && k < j+3 && b[k] >= '0' && b[k] <= '7'; k++ {
+					v = v*8 + int(b[k]-'0')
+				}
+				out = append(out, byte(v))
+				j = k - 1
+			default:
+				out = append(out, e)
+			}
+			continue
+		}
+		out = append(out, c)
+	}
+	return out, len(b)
+}
+
+// pdfString returns the bytes of a literal or hex string value
+func pdfString(v []byte) []byte {
+	v = bytes.TrimSpace(v)
+	switch {
+	case len(v) > 0 && v[0] == '(':
+		s, _ := pdfLiteralString(v, 0)
+		return s
+	case len(v) > 1 && v[0] == '<' && v[1] != '<':
+		return pdfHexBytes(bytes.Trim(v, "<>"))
+	}
+	return nil
+}
+
+// pdfTextString decodes strings outside of content streams, which are either utf-16 or PDFDocEncoding
+func pdfTextString(s []byte) string {
+	switch {
+	case bytes.HasPrefix(s, []byte{0xFE, 0xFF}):
+		return pdfUTF16(s[2:])
+	case bytes.HasPrefix(s, []byte{0xEF, 0xBB, 0xBF}):
+		return string(s[3:])
+	}
+
+	var sb strings.Builder
+	for _, b := range s {
+		sb.WriteRune(charmap.Windows1252.DecodeByte(b))
+	}
+	return sb.String()
+}
+
+func pdfUTF16(b []byte) string {
+	units := make([]uint16, 0, len(b)/2)
+	for i := 0; i+1 < len(b); i += 2 {
+		units = append(units, uint16(b[i])<<8|uint16(b[i+1]))
+	}
+	return string(utf16.Decode(units))
+}
+
+func pdfHexBytes(hex []byte) []byte {
+	digits := make([]byte, 0, len(hex))
+	for _, c := range hex {
+		if !isPDFWhitespace(c) {
+			digits = append(digits, c)
+		}
+	}
+	if len(digits)%2 == 1 {
+		digits = append(digits, '0')
+	}
+
+	out := make([]byte, 0, len(digits)/2)
+	for i := 0; i+1 < len(digits); i += 2 {
+		v, err := strconv.ParseUint(string(digits[i:i+2]), 16, 8)
+		if err != nil {
+			return out
+		}
+		out = append(out, byte(v))
+	}
+	return out
+}
+
+func pdfHexCode(hex []byte) uint32 {
+	var code uint32
+	for _, b := range pdfHexBytes(hex) {
+		code = code<<8 | uint32(b)
+	}
+	return code
+}
+
+// pdfName returns a name value without its leading slash
+func pdfName(v []byte) string {
+	v = bytes.TrimSpace(v)
+	if len(v) == 0 || v[0] != '/' {
+		return ""
+	}
+	return string(v[1:])
+}
+
+// pdfNumbers returns the numbers of an array
+func pdfNumbers(v []byte) []float64 {
+	var numbers []float64
+	for _, field := range bytes.FieldsFunc(v, func(r rune) bool { return r == '[' || r == ']' || r == ' ' || r == '\n' || r == '\r' || r == '\t' }) {
+		if n, err := strconv.ParseFloat(string(field), 64); err == nil {
+			numbers = append(numbers, n)
+		}
+	}
+	return numbers
+}
+
+// pdfNames returns the names of a name or an array of names
+func pdfNames(v []byte) []string {
+	var names []string
+	for _, field := range bytes.FieldsFunc(v, func(r rune) bool {
+		return r == '[' || r == ']' || r == '/' || r == ' ' || r == '\n' || r == '\r' || r == '\t'
+	}) {
+		names = append(names, string(field))
+	}
+	return names
+}
+
+func isPDFWhitespace(c byte) bool {
+	return c == ' ' || c == '\n' || c == '\r' || c == '\t' || c == '\f' || c == 0
+}
+
+func isPDFDelimiter(c byte) bool {
+	switch c {
+	case '(', ')', '<', '>', '[', ']', '{', '}', '/', '%':
+		return true
+	}
+	return false
+}
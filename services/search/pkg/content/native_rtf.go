@@ -0,0 +1,195 @@
+package content
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+// rtfSkippedDestinations are groups which do not hold any document text
+var rtfSkippedDestinations = map[string]bool{
+	"fonttbl": true, "colortbl": true, "stylesheet": true, "listtable": true, "listoverridetable": true,
+	"revtbl": true, "rsidtbl": true, "generator": true, "pict": true, "object": true, "themedata": true,
+	"colorschememapping": true, "datastore": true, "latentstyles": true, "xmlnstbl": true, "filetbl": true,
+	"header": true, "headerl": true, "headerr": true, "headerf": true, "footer": true, "footerl": true,
+	"footerr": true, "footerf": true, "info": true, "fldinst": true, "mmathPict": true,
+}
+
+// rtfState is the state of a rtf group
+type rtfState struct {
+	skip bool
+	// title is set inside of the info title group
+	title bool
+	// uc is the number of fallback characters following an unicode character
+	uc int
+}
+
+// parseRTF extracts the text and the title of rich text documents
+func parseRTF(data []byte, _ int) (string, string, error) {
+	if !bytes.HasPrefix(bytes.TrimSpace(data), []byte(`{\rtf`)) {
+		return "", "", errors.New("missing rtf header")
+	}
+
+	decoder := charmap.Windows1252.NewDecoder()
+	var text, title strings.Builder
+	state := rtfState{uc: 1}
+	var stack []rtfState
+	// skipChars counts the fallback characters of an unicode character which are still to be skipped
+	skipChars := 0
+	// pendingSurrogate holds the first half of an utf-16 surrogate pair
+	var pendingSurrogate rune
+
+	write := func(s string) {
+		switch {
+		case state.skip && !state.title:
+		case state.title:
+			title.WriteString(s)
+		default:
+			text.WriteString(s)
+		}
+	}
+
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		switch c {
+		case '{':
+			stack = append(stack, state)
+			skipChars = 0
+		case '}':
+			if len(stack) == 0 {
+				return title.String(), text.String(), nil
+			}
+			state, stack = stack[len(stack)-1], stack[:len(stack)-1]
+			skipChars = 0
+		case '\r', '\n':
+		case '\\':
+			if i+1 >= len(data) {
+				break
+			}
+
+			next := data[i+1]
+			switch {
+			case next == '\\' || next == '{' || next == '}':
+				i++
+				if skipChars > 0 {
+					skipChars--
+					break
+				}
+				write(string(next))
+			case next == '\'':
+				// hex encoded character of the ansi code page
+				i++
+				if i+2 >= len(data) {
+					break
+				}
+				v, err := strconv.ParseUint(string(data[i+1:i+3]), 16, 8)
+				i += 2
+				if err != nil {
+					break
+				}
+				if skipChars > 0 {
+					skipChars--
+					break
+				}
+				if s, err := decoder.Bytes([]byte{byte(v)}); err == nil {
+					write(string(s))
+				}
+			case next == '*':
+				// ignorable destination, skipped unless it is known
+				i++
+				state.skip = true
+			case next == '~':
+				i++
+				write(" ")
+			case next == '-' || next == '_':
+				i++
+			case isRTFLetter(next):
+				j := i + 1
+				for j < len(data) && isRTFLetter(data[j]) {
+					j++
+				}
+				word := string(data[i+1 : j])
+
+				k := j
+				if k < len(data) && data[k] == '-' {
+					k++
+				}
+				for k < len(data) && data[k] >= '0' && data[k] <= '9' {
+					k++
+				}
+				param, hasParam := 0, k > j
+				if hasParam {
+					param, _ = strconv.Atoi(string(data[j:k]))
+				}
+				if k < len(data) && data[k] == ' ' {
+					k++
+				}
+				i = k - 1
+
+				switch {
+				case rtfSkippedDestinations[word]:
+					state.skip = true
+				case word == "title":
+					state.title = true
+				case word == "uc" && hasParam:
+					state.uc = param
+				case word == "u" && hasParam:
+					r := rune(param)
+					if r < 0 {
+						r += 65536
+					}
+					skipChars = state.uc
+					switch {
+					case utf16.IsSurrogate(r) && pendingSurrogate == 0:
+						pendingSurrogate = r
+					case pendingSurrogate != 0:
+						write(string(utf16.DecodeRune(pendingSurrogate, r)))
+						pendingSurrogate = 0
+					default:
+						write(string(r))
+					}
+				case word == "par" || word == "line" || word == "row" || word == "sect" || word == "page":
+					write("\n")
+				case word == "tab" || word == "cell":
+					write("\t")
+				case word == "emdash":
+					write("—")
+				case word == "endash":
+					write("–")
+				case word == "bullet":
+					write("•")
+				case word == "lquote":
+					write("‘")
+				case word == "rquote":
+					write("’")
+				case word == "ldblquote":
+					write("“")
+				case word == "rdblquote":
+					write("”")
+				}
+			default:
+				i++
+			}
+		default:
+			if skipChars > 0 {
+				skipChars--
+				break
+			}
+			if c < 0x80 {
+				write(string(c))
+			} else if s, err := decoder.Bytes([]byte{c}); err == nil {
+				write(string(s))
+			}
+		}
+	}
+
+	return title.String(), text.String(), nil
+}
+
+func isRTFLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
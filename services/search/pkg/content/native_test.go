@@ -0,0 +1,189 @@
+package content_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/zlib"
+	"context"
+	"fmt"
+	"io"
+
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	conf "github.com/opencloud-eu/opencloud/services/search/pkg/config/defaults"
+	"github.com/opencloud-eu/opencloud/services/search/pkg/content"
+	contentMocks "github.com/opencloud-eu/opencloud/services/search/pkg/content/mocks"
+)
+
+var _ = Describe("Native", func() {
+	var (
+		native *content.Native
+		data   []byte
+	)
+
+	zipFile := func(parts map[string]string) []byte {
+		buf := &bytes.Buffer{}
+		zw := zip.NewWriter(buf)
+		for name, body := range parts {
+			w, err := zw.Create(name)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = w.Write([]byte(body))
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(zw.Close()).To(Succeed())
+		return buf.Bytes()
+	}
+
+	pdfFile := func() []byte {
+		compressed := &bytes.Buffer{}
+		zw := zlib.NewWriter(compressed)
+		_, _ = zw.Write([]byte("BT /F2 12 Tf 72 700 Td <00010002> Tj ET"))
+		_ = zw.Close()
+
+		cmap := "/CIDInit /ProcSet findresource begin\n1 begincodespacerange\n<0000> <FFFF>\nendcodespacerange\n" +
+			"1 beginbfchar\n<0001> <004F>\nendbfchar\n1 beginbfrange\n<0002> <0002> <004B>\nendbfrange\nend\n"
+		page1 := "BT /F1 12 Tf 72 720 Td (Hello \\(native\\)) Tj 0 -14 Td [(Wor) -20 (ld) -400 (again)] TJ ET"
+
+		objects := []string{
+			"<< /Type /Catalog /Pages 2 0 R >>",
+			"<< /Type /Pages /Kids [3 0 R 4 0 R] /Count 2 /Resources << /Font << /F1 5 0 R /F2 6 0 R >> >> >>",
+			"<< /Type /Page /Parent 2 0 R /Contents 7 0 R >>",
+			"<< /Type /Page /Parent 2 0 R /Contents [8 0 R] >>",
+			"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+			"<< /Type /Font /Subtype /Type0 /BaseFont /Subset /Encoding /Identity-H /ToUnicode 9 0 R >>",
+			fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(page1), page1),
+			fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", compressed.Len(), compressed.String()),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(cmap), cmap),
+			"<< /Title <FEFF0054006900740065006C> >>",
+		}
+
+		buf := &bytes.Buffer{}
+		buf.WriteString("%PDF-1.7\n")
+		for i, obj := range objects {
+			fmt.Fprintf(buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+		}
+		fmt.Fprintf(buf, "trailer\n<< /Size %d /Root 1 0 R /Info 10 0 R >>\n%%%%EOF\n", len(objects)+1)
+		return buf.Bytes()
+	}
+
+	extract := func(mimeType, name string) content.Document {
+		doc, err := native.Extract(context.TODO(), &provider.ResourceInfo{
+			Type:     provider.ResourceType_RESOURCE_TYPE_FILE,
+			Size:     uint64(len(data)),
+			MimeType: mimeType,
+			Name:     name,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return doc
+	}
+
+	BeforeEach(func() {
+		var err error
+		native, err = content.NewNativeExtractor(nil, log.NewLogger(), conf.DefaultConfig())
+		Expect(err).ToNot(HaveOccurred())
+
+		retriever := &contentMocks.Retriever{}
+		retriever.On("Retrieve", mock.Anything, mock.Anything).Return(func(context.Context, *provider.ResourceId) (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(data)), nil
+		})
+		native.Retriever = retriever
+	})
+
+	It("skips non file resources", func() {
+		data = []byte("{\\rtf1 text}")
+		doc, err := native.Extract(context.TODO(), &provider.ResourceInfo{Size: 1, MimeType: "application/rtf"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(doc.Content).To(Equal(""))
+	})
+
+	It("skips unknown file types", func() {
+		data = []byte("plain text")
+		Expect(extract("text/plain", "file.txt").Content).To(Equal(""))
+	})
+
+	It("ignores files which can not be parsed", func() {
+		data = []byte("not a zip file")
+		doc := extract("application/vnd.openxmlformats-officedocument.wordprocessingml.document", "file.docx")
+		Expect(doc.Name).To(Equal("file.docx"))
+		Expect(doc.Content).To(Equal(""))
+	})
+
+	It("extracts pdf files", func() {
+		data = pdfFile()
+		doc := extract("application/pdf", "file.pdf")
+		Expect(doc.Title).To(Equal("Titel"))
+		Expect(doc.Content).To(Equal("Hello (native)\nWorld again\nOK"))
+	})
+
+	It("limits the number of pdf pages", func() {
+		native.MaxPages = 1
+		data = pdfFile()
+		Expect(extract("application/pdf", "file.pdf").Content).To(Equal("Hello (native)\nWorld again"))
+	})
+
+	It("extracts docx files", func() {
+		data = zipFile(map[string]string{
+			"word/document.xml": `<w:document xmlns:w="w"><w:body>` +
+				`<w:p><w:r><w:t>Hello</w:t></w:r><w:r><w:tab/><w:t xml:space="preserve">Wor</w:t><w:t>ld</w:t></w:r></w:p>` +
+				`<w:p><w:r><w:t>second paragraph</w:t></w:r></w:p></w:body></w:document>`,
+			"docProps/core.xml": `<cp:coreProperties xmlns:cp="cp" xmlns:dc="dc"><dc:title>Docx Title</dc:title></cp:coreProperties>`,
+		})
+		doc := extract("", "file.docx")
+		Expect(doc.Title).To(Equal("Docx Title"))
+		Expect(doc.Content).To(Equal("Hello World\nsecond paragraph"))
+	})
+
+	It("extracts xlsx files", func() {
+		data = zipFile(map[string]string{
+			"xl/sharedStrings.xml": `<sst><si><t>Name</t></si><si><r><t>Ein</t></r><r><t>stein</t></r></si></sst>`,
+			"xl/worksheets/sheet1.xml": `<worksheet><sheetData>` +
+				`<row><c t="s"><v>0</v></c><c><v>42</v></c></row>` +
+				`<row><c t="s"><v>1</v></c><c t="inlineStr"><is><t>inline</t></is></c></row></sheetData></worksheet>`,
+			"xl/worksheets/sheet2.xml": `<worksheet><sheetData><row><c><v>7</v></c></row></sheetData></worksheet>`,
+		})
+		native.MaxPages = 1
+		Expect(extract("application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", "file.xlsx").Content).To(Equal("Name 42\nEinstein inline"))
+	})
+
+	It("extracts pptx slides in order", func() {
+		data = zipFile(map[string]string{
+			"ppt/slides/slide10.xml": `<p:sld xmlns:p="p" xmlns:a="a"><a:p><a:r><a:t>tenth</a:t></a:r></a:p></p:sld>`,
+			"ppt/slides/slide2.xml":  `<p:sld xmlns:p="p" xmlns:a="a"><a:p><a:r><a:t>second</a:t></a:r></a:p></p:sld>`,
+			"ppt/slides/slide1.xml":  `<p:sld xmlns:p="p" xmlns:a="a"><a:p><a:r><a:t>first</a:t></a:r></a:p></p:sld>`,
+		})
+		Expect(extract("application/vnd.openxmlformats-officedocument.presentationml.presentation", "file.pptx").Content).To(Equal("first\nsecond\ntenth"))
+	})
+
+	It("extracts odf files", func() {
+		data = zipFile(map[string]string{
+			"content.xml": `<office:document-content xmlns:office="office" xmlns:text="text" xmlns:draw="draw">` +
+				`<office:body><office:presentation>` +
+				`<draw:page><text:p>first<text:s/><text:span>slide</text:span></text:p></draw:page>` +
+				`<draw:page><text:p>second slide</text:p></draw:page>` +
+				`</office:presentation></office:body></office:document-content>`,
+			"meta.xml": `<office:document-meta xmlns:office="office" xmlns:dc="dc"><office:meta><dc:title>Slides</dc:title></office:meta></office:document-meta>`,
+		})
+		native.MaxPages = 1
+		doc := extract("application/vnd.oasis.opendocument.presentation", "file.odp")
+		Expect(doc.Title).To(Equal("Slides"))
+		Expect(doc.Content).To(Equal("first slide"))
+	})
+
+	It("extracts rtf files", func() {
+		data = []byte(`{\rtf1\ansi\ansicpg1252{\fonttbl{\f0 Arial;}}{\info{\title RTF Title}{\author someone}}` +
+			`{\*\generator Writer}\f0 Caf\'e9 \b bold\b0\par {\uc1\u8364?} and \{braces\}\line last}`)
+		doc := extract("application/rtf", "file.rtf")
+		Expect(doc.Title).To(Equal("RTF Title"))
+		Expect(doc.Content).To(Equal("Café bold\n€ and {braces}\nlast"))
+	})
+
+	It("truncates the content", func() {
+		native.MaxContentSize = 4
+		data = []byte(`{\rtf1 Caf\'e9 au lait}`)
+		Expect(extract("", "file.rtf").Content).To(Equal("Caf"))
+	})
+})
@@ -2,8 +2,11 @@ package opensearch
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	storageProvider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
@@ -20,15 +23,28 @@ import (
 	"github.com/opencloud-eu/opencloud/services/search/pkg/search"
 )
 
-const defaultBatchSize = 50
+const (
+	defaultBatchSize = 50
+	walkPageSize     = 1000
+)
 
 var (
 	ErrUnhealthyCluster = fmt.Errorf("cluster is not healthy")
 )
 
+var (
+	_ search.Engine    = (*Backend)(nil) // ensure Backend implements Engine
+	_ search.Rebuilder = (*Backend)(nil) // ensure Backend implements Rebuilder
+)
+
 type Backend struct {
 	index  string
 	client *opensearchgoAPI.Client
+
+	// mu guards the shadow index
+	mu sync.RWMutex
+	// shadow is the index which is being rebuilt, it receives all changes of the current index
+	shadow string
 }
 
 func NewBackend(index string, client *opensearchgoAPI.Client) (*Backend, error) {
@@ -113,6 +129,7 @@ func (b *Backend) Search(ctx context.Context, sir *searchService.SearchIndexRequ
 				PreTags:  []string{"<mark>"},
 				PostTags: []string{"</mark>"},
 				Fields: map[string]osu.BodyParamHighlight{
+					"Name":    {},
 					"Content": {},
 				},
 			},
@@ -174,6 +191,198 @@ func (b *Backend) DocCount() (uint64, error) {
 	return uint64(resp.Count), nil
 }
 
+// SpaceStats returns the number of documents and the size of the files per space.
+func (b *Backend) SpaceStats() ([]search.SpaceStats, error) {
+	var stats []search.SpaceStats
+	var after map[string]any
+	for {
+		composite := map[string]any{
+			"size":    walkPageSize,
+			"sources": []any{map[string]any{"RootID": map[string]any{"terms": map[string]any{"field": "RootID"}}}},
+		}
+		if after != nil {
+			composite["after"] = after
+		}
+
+		req, err := osu.BuildSearchReq(&opensearchgoAPI.SearchReq{
+			Indices: []string{b.index},
+			Params:  opensearchgoAPI.SearchParams{Size: conversions.ToPointer(0)},
+		},
+			nil,
+			osu.SearchBodyParams{
+				Aggregations: map[string]any{
+					"spaces": map[string]any{
+						"composite": composite,
+						"aggs": map[string]any{
+							"deleted": map[string]any{
+								"filter": osu.NewTermQuery[bool]("Deleted").Value(true),
+							},
+							"files": map[string]any{
+								"filter": osu.NewBoolQuery().Filter(
+									osu.NewTermQuery[bool]("Deleted").Value(false),
+									osu.NewTermQuery[uint64]("Type").Value(uint64(storageProvider.ResourceType_RESOURCE_TYPE_FILE)),
+								),
+								"aggs": map[string]any{
+									"size": map[string]any{"sum": map[string]any{"field": "Size"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build search request: %w", err)
+		}
+
+		resp, err := b.client.Search(context.TODO(), req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to aggregate space stats: %w", err)
+		}
+
+		var aggregations struct {
+			Spaces struct {
+				AfterKey map[string]any `json:"after_key"`
+				Buckets  []struct {
+					Key      map[string]string `json:"key"`
+					DocCount uint64            `json:"doc_count"`
+					Deleted  struct {
+						DocCount uint64 `json:"doc_count"`
+					} `json:"deleted"`
+					Files struct {
+						Size struct {
+							Value float64 `json:"value"`
+						} `json:"size"`
+					} `json:"files"`
+				} `json:"buckets"`
+			} `json:"spaces"`
+		}
+		if err := json.Unmarshal(resp.Aggregations, &aggregations); err != nil {
+			return nil, fmt.Errorf("failed to decode space stats: %w", err)
+		}
+
+		for _, bucket := range aggregations.Spaces.Buckets {
+			stats = append(stats, search.SpaceStats{
+				RootID:    bucket.Key["RootID"],
+				Documents: bucket.DocCount,
+				Deleted:   bucket.Deleted.DocCount,
+				Size:      uint64(bucket.Files.Size.Value),
+			})
+		}
+
+		if len(aggregations.Spaces.Buckets) < walkPageSize || aggregations.Spaces.AfterKey == nil {
+			return stats, nil
+		}
+		after = aggregations.Spaces.AfterKey
+	}
+}
+
+// WalkSpace calls fn for every resource of the space, including the deleted ones.
+func (b *Backend) WalkSpace(rootID string, fn func(r search.Resource) error) error {
+	var searchAfter []any
+	for {
+		req, err := osu.BuildSearchReq(&opensearchgoAPI.SearchReq{
+			Indices: []string{b.index},
+			Params:  opensearchgoAPI.SearchParams{Size: conversions.ToPointer(walkPageSize)},
+		},
+			osu.NewTermQuery[string]("RootID").Value(rootID),
+			osu.SearchBodyParams{
+				Sort:        []any{map[string]any{"ID": "asc"}},
+				SearchAfter: searchAfter,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to build search request: %w", err)
+		}
+
+		resp, err := b.client.Search(context.TODO(), req)
+		if err != nil {
+			return fmt.Errorf("failed to search: %w", err)
+		}
+
+		for _, hit := range resp.Hits.Hits {
+			resource, err := conversions.To[search.Resource](hit.Source)
+			if err != nil {
+				return fmt.Errorf("failed to convert hit source: %w", err)
+			}
+
+			if err := fn(resource); err != nil {
+				return err
+			}
+		}
+
+		if len(resp.Hits.Hits) < walkPageSize {
+			return nil
+		}
+		searchAfter = resp.Hits.Hits[len(resp.Hits.Hits)-1].Sort
+	}
+}
+
+// Rebuild fills a new index using build and points the index alias to it afterward.
+// If the backend uses a plain index, it is replaced by an alias with the same name.
+func (b *Backend) Rebuild(build func(eng search.Engine) error) error {
+	b.mu.Lock()
+	if b.shadow != "" {
+		b.mu.Unlock()
+		return errors.New("the index is already being rebuilt")
+	}
+	shadow := fmt.Sprintf("%s-%d", b.index, time.Now().Unix())
+	if err := IndexManagerLatest.Apply(context.TODO(), shadow, b.client); err != nil {
+		b.mu.Unlock()
+		return fmt.Errorf("failed to create index %s: %w", shadow, err)
+	}
+	b.shadow = shadow
+	b.mu.Unlock()
+
+	buildErr := build(&Backend{index: shadow, client: b.client})
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.shadow = ""
+
+	if buildErr == nil {
+		buildErr = b.swapIndex(shadow)
+	}
+	if buildErr != nil {
+		if _, err := b.client.Indices.Delete(context.TODO(), opensearchgoAPI.IndicesDeleteReq{Indices: []string{shadow}}); err != nil {
+			return errors.Join(buildErr, fmt.Errorf("failed to delete index %s: %w", shadow, err))
+		}
+		return buildErr
+	}
+
+	return nil
+}
+
+// swapIndex atomically points the alias to the given index and removes the indexes it pointed to before
+func (b *Backend) swapIndex(index string) error {
+	resp, err := b.client.Indices.Get(context.TODO(), opensearchgoAPI.IndicesGetReq{Indices: []string{b.index}})
+	if err != nil {
+		return fmt.Errorf("failed to get index %s: %w", b.index, err)
+	}
+
+	actions := []any{
+		map[string]any{"add": map[string]any{"index": index, "alias": b.index}},
+	}
+	for name := range resp.Indices {
+		actions = append(actions, map[string]any{"remove_index": map[string]any{"index": name}})
+	}
+
+	body, err := json.Marshal(map[string]any{"actions": actions})
+	if err != nil {
+		return err
+	}
+
+	aliasResp, err := b.client.Aliases(context.TODO(), opensearchgoAPI.AliasesReq{Body: strings.NewReader(string(body))})
+	switch {
+	case err != nil:
+		return fmt.Errorf("failed to update alias %s: %w", b.index, err)
+	case !aliasResp.Acknowledged:
+		return fmt.Errorf("failed to update alias %s: not acknowledged", b.index)
+	}
+
+	return nil
+}
+
 func (b *Backend) Upsert(id string, r search.Resource) error {
 	batch, err := b.NewBatch(defaultBatchSize)
 	if err != nil {
@@ -240,5 +449,19 @@ func (b *Backend) Purge(id string, onlyDeleted bool) error {
 }
 
 func (b *Backend) NewBatch(size int) (search.BatchOperator, error) {
-	return NewBatch(b.client, b.index, size)
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	batch, err := NewBatch(b.client, b.index, size)
+	if err != nil {
+		return nil, err
+	}
+
+	if b.shadow != "" {
+		if batch.shadow, err = NewBatch(b.client, b.shadow, size); err != nil {
+			return nil, err
+		}
+	}
+
+	return batch, nil
 }
@@ -27,6 +27,9 @@ type Batch struct {
 	log        log.Logger
 	operations []any
 	mu         sync.Mutex
+
+	// shadow receives the same operations while the index is rebuilt
+	shadow *Batch
 }
 
 func NewBatch(client *opensearchgoAPI.Client, index string, size int) (*Batch, error) {
@@ -42,6 +45,10 @@ func NewBatch(client *opensearchgoAPI.Client, index string, size int) (*Batch, e
 }
 
 func (b *Batch) Upsert(id string, r search.Resource) error {
+	b.mirror(func(shadow *Batch) error {
+		return shadow.Upsert(id, r)
+	})
+
 	return b.withSizeLimit(func() error {
 		body, err := conversions.To[map[string]any](r)
 		if err != nil {
@@ -64,6 +71,10 @@ func (b *Batch) Upsert(id string, r search.Resource) error {
 }
 
 func (b *Batch) Move(id, parentID, location string) error {
+	b.mirror(func(shadow *Batch) error {
+		return shadow.Move(id, parentID, location)
+	})
+
 	return b.withSizeLimit(func() error {
 		op := func() error {
 			return updateSelfAndDescendants(context.Background(), b.client, b.index, id, func(rootResource search.Resource) *osu.BodyParamScript {
@@ -93,6 +104,10 @@ func (b *Batch) Move(id, parentID, location string) error {
 }
 
 func (b *Batch) Delete(id string) error {
+	b.mirror(func(shadow *Batch) error {
+		return shadow.Delete(id)
+	})
+
 	return b.withSizeLimit(func() error {
 		op := func() error {
 			return updateSelfAndDescendants(context.Background(), b.client, b.index, id, func(_ search.Resource) *osu.BodyParamScript {
@@ -115,6 +130,10 @@ func (b *Batch) Delete(id string) error {
 }
 
 func (b *Batch) Restore(id string) error {
+	b.mirror(func(shadow *Batch) error {
+		return shadow.Restore(id)
+	})
+
 	return b.withSizeLimit(func() error {
 		op := func() error {
 			return updateSelfAndDescendants(context.Background(), b.client, b.index, id, func(_ search.Resource) *osu.BodyParamScript {
@@ -137,6 +156,10 @@ func (b *Batch) Restore(id string) error {
 }
 
 func (b *Batch) Purge(id string, onlyDeleted bool) error {
+	b.mirror(func(shadow *Batch) error {
+		return shadow.Purge(id, onlyDeleted)
+	})
+
 	return b.withSizeLimit(func() error {
 		resource, err := searchResourceByID(context.Background(), b.client, b.index, id)
 		if err != nil {
@@ -182,6 +205,10 @@ func (b *Batch) Purge(id string, onlyDeleted bool) error {
 }
 
 func (b *Batch) Push() error {
+	b.mirror(func(shadow *Batch) error {
+		return shadow.Push()
+	})
+
 	b.mu.Lock()
 	defer b.mu.Unlock()
 	defer func() { // cleanup
@@ -246,3 +273,13 @@ func (b *Batch) withSizeLimit(f func() error) error {
 
 	return nil
 }
+
+// mirror applies an operation to the shadow batch. Errors are ignored, the shadow index
+// does not contain all resources until the rebuild is done and the rebuild indexes them anyway.
+func (b *Batch) mirror(f func(shadow *Batch) error) {
+	if b.shadow == nil {
+		return
+	}
+
+	_ = f(b.shadow)
+}
@@ -74,6 +74,12 @@ func (m IndexManager) Apply(ctx context.Context, name string, client *opensearch
 		}
 
 		remoteIndex, ok := resp.Indices[name]
+		if !ok && len(resp.Indices) == 1 {
+			// the name is an alias, the response contains the index it points to
+			for _, index := range resp.Indices {
+				remoteIndex, ok = index, true
+			}
+		}
 		if !ok {
 			return fmt.Errorf("index %s not found in response", name)
 		}
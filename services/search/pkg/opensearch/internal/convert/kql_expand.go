@@ -53,6 +53,14 @@ func (e kqlExpander) expand(nodes []ast.Node, defaultKey string) ([]ast.Node, er
 			cnode.Key = e.remapKey(cnode.Key, defaultKey)
 		case *ast.BooleanNode:
 			cnode.Key = e.remapKey(cnode.Key, defaultKey)
+		case *ast.NumericNode:
+			cnode.Key = e.remapKey(cnode.Key, defaultKey)
+		case *ast.ProximityNode:
+			if cnode.Key == "" && defaultKey == "" {
+				cnode.Key = "Content" // proximity searches without a key look into the content
+			} else {
+				cnode.Key = e.remapKey(cnode.Key, defaultKey)
+			}
 		}
 
 		if unfoldedNodes != nil {
@@ -85,6 +93,7 @@ func (_ kqlExpander) remapKey(current string, defaultKey string) string {
 		"tags":      "Tags",
 		"content":   "Content",
 		"hidden":    "Hidden",
+		"owner":     "Owner",
 	}[current]
 	if !ok {
 		return current // Return the original key if not found
@@ -142,6 +142,7 @@ func TestExpandKQLAST(t *testing.T) {
 			"tags":      "Tags",
 			"content":   "Content",
 			"hidden":    "Hidden",
+			"owner":     "Owner",
 			"any":       "any", // Example of an unknown key that should remain unchanged
 		} {
 			tests = append(tests, opensearchtest.TableTest[[]ast.Node, []ast.Node]{
@@ -241,6 +242,50 @@ func TestExpandKQLAST(t *testing.T) {
 		}
 	})
 
+	t.Run("remaps the keys of numeric and proximity nodes", func(t *testing.T) {
+		tests := []opensearchtest.TableTest[[]ast.Node, []ast.Node]{
+			{
+				Name: "size>1",
+				Got: []ast.Node{
+					&ast.NumericNode{Key: "size", Value: 1},
+				},
+				Want: []ast.Node{
+					&ast.NumericNode{Key: "Size", Value: 1},
+				},
+			},
+			{
+				Name: "cat NEAR dog",
+				Got: []ast.Node{
+					&ast.ProximityNode{Left: "cat", Right: "dog"},
+				},
+				Want: []ast.Node{
+					&ast.ProximityNode{Key: "Content", Left: "cat", Right: "dog"},
+				},
+			},
+			{
+				Name: "name:(cat NEAR dog)",
+				Got: []ast.Node{
+					&ast.GroupNode{Key: "name", Nodes: []ast.Node{
+						&ast.ProximityNode{Left: "cat", Right: "dog"},
+					}},
+				},
+				Want: []ast.Node{
+					&ast.GroupNode{Key: "Name", Nodes: []ast.Node{
+						&ast.ProximityNode{Key: "Name", Left: "cat", Right: "dog"},
+					}},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.Name, func(t *testing.T) {
+				result, err := convert.ExpandKQL(test.Got)
+				require.NoError(t, err)
+				require.Equal(t, test.Want, result)
+			})
+		}
+	})
+
 	t.Run("lowercases some values", func(t *testing.T) {
 		tests := []opensearchtest.TableTest[[]ast.Node, []ast.Node]{
 			{
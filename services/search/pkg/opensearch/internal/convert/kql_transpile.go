@@ -3,9 +3,14 @@ package convert
 import (
 	"errors"
 	"fmt"
+	"math"
+	"path"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/opencloud-eu/reva/v2/pkg/utils"
+
 	"github.com/opencloud-eu/opencloud/pkg/ast"
 	"github.com/opencloud-eu/opencloud/pkg/kql"
 	"github.com/opencloud-eu/opencloud/services/search/pkg/opensearch/internal/osu"
@@ -99,6 +104,31 @@ func (t kqlOpensearchTranspiler) toBuilder(node ast.Node) (osu.Builder, error) {
 	case *ast.BooleanNode:
 		return osu.NewTermQuery[bool](node.Key).Value(node.Value), nil
 	case *ast.StringNode:
+		switch node.Key {
+		case "Size":
+			// keep supporting the range syntax, e.g. size:>1000
+			operator, value := "=", node.Value
+			for _, o := range []string{">=", "<=", ">", "<"} {
+				if strings.HasPrefix(value, o) {
+					operator, value = o, strings.TrimPrefix(value, o)
+					break
+				}
+			}
+
+			size, err := kql.ParseNumber(value)
+			if err != nil {
+				return nil, err
+			}
+
+			return t.numericRange(node.Key, operator, size)
+		case "Path":
+			// the path is indexed with a path_hierarchy analyzer, every ancestor of a resource is a term
+			p := strings.ToLower(utils.MakeRelativePath(path.Clean("/" + node.Value)))
+			return osu.NewTermQuery[string](node.Key).Value(p), nil
+		case "Owner":
+			return osu.NewMatchPhraseQuery(node.Key).Query(node.Value), nil
+		}
+
 		isWildcard := strings.Contains(node.Value, "*")
 		if isWildcard {
 			return osu.NewWildcardQuery(node.Key).Value(node.Value), nil
@@ -134,6 +164,16 @@ func (t kqlOpensearchTranspiler) toBuilder(node ast.Node) (osu.Builder, error) {
 		}
 
 		return nil, fmt.Errorf("unsupported operator %s for date time node: %w", node.Operator.Value, ErrUnsupportedNodeType)
+	case *ast.NumericNode:
+		if node.Operator == nil {
+			return builder, fmt.Errorf("numeric node without operator: %w", ErrUnsupportedNodeType)
+		}
+
+		return t.numericRange(node.Key, node.Operator.Value, node.Value)
+	case *ast.ProximityNode:
+		return osu.NewMatchPhraseQuery(node.Key).
+			Params(&osu.MatchPhraseQueryParams{Slop: node.Distance}).
+			Query(node.Left + " " + node.Right), nil
 	case *ast.GroupNode:
 		group, err := t.transpile(node.Nodes)
 		if err != nil {
@@ -145,3 +185,28 @@ func (t kqlOpensearchTranspiler) toBuilder(node ast.Node) (osu.Builder, error) {
 
 	return nil, fmt.Errorf("%w: %T", ErrUnsupportedNodeType, node)
 }
+
+// numericRange builds a range query for integer fields,
+// the bounds are rounded to integers and sent as strings to keep zero values.
+func (t kqlOpensearchTranspiler) numericRange(key, operator string, v float64) (osu.Builder, error) {
+	format := func(f float64) string {
+		return strconv.FormatFloat(f, 'f', 0, 64)
+	}
+
+	query := osu.NewRangeQuery[string](key)
+
+	switch operator {
+	case ">":
+		return query.Gt(format(math.Floor(v))), nil
+	case ">=":
+		return query.Gte(format(math.Ceil(v))), nil
+	case "<":
+		return query.Lt(format(math.Ceil(v))), nil
+	case "<=":
+		return query.Lte(format(math.Floor(v))), nil
+	case "=", ":":
+		return query.Gte(format(math.Ceil(v))).Lte(format(math.Floor(v))), nil
+	}
+
+	return nil, fmt.Errorf("unsupported operator %s for numeric node: %w", operator, ErrUnsupportedNodeType)
+}
@@ -60,6 +60,69 @@ func TestTranspileKQLToOpenSearch(t *testing.T) {
 			},
 			Want: osu.NewWildcardQuery("Name").Value("open*"),
 		},
+		{
+			Name: "range query - numeric node",
+			Got: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.NumericNode{Key: "Size", Operator: &ast.OperatorNode{Value: ">"}, Value: 1.5},
+				},
+			},
+			Want: osu.NewRangeQuery[string]("Size").Gt("1"),
+		},
+		{
+			Name: "range query - numeric node - zero",
+			Got: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.NumericNode{Key: "Size", Operator: &ast.OperatorNode{Value: ">="}, Value: 0},
+				},
+			},
+			Want: osu.NewRangeQuery[string]("Size").Gte("0"),
+		},
+		{
+			Name: "range query - size string node",
+			Got: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.StringNode{Key: "Size", Value: "1kib"},
+				},
+			},
+			Want: osu.NewRangeQuery[string]("Size").Gte("1024").Lte("1024"),
+		},
+		{
+			Name: "range query - size string node - bleve syntax",
+			Got: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.StringNode{Key: "Size", Value: "<=1000"},
+				},
+			},
+			Want: osu.NewRangeQuery[string]("Size").Lte("1000"),
+		},
+		{
+			Name: "term query - path string node",
+			Got: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.StringNode{Key: "Path", Value: "Projects/Some Folder/"},
+				},
+			},
+			Want: osu.NewTermQuery[string]("Path").Value("./projects/some folder"),
+		},
+		{
+			Name: "match-phrase query - owner string node",
+			Got: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.StringNode{Key: "Owner", Value: "einstein"},
+				},
+			},
+			Want: osu.NewMatchPhraseQuery("Owner").Query("einstein"),
+		},
+		{
+			Name: "match-phrase query - proximity node",
+			Got: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.ProximityNode{Key: "Content", Left: "black cat", Right: "dog", Distance: 3},
+				},
+			},
+			Want: osu.NewMatchPhraseQuery("Content").Params(&osu.MatchPhraseQueryParams{Slop: 3}).Query("black cat dog"),
+		},
 		{
 			Name: "bool query",
 			Got: &ast.Ast{
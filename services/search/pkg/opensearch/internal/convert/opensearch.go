@@ -68,6 +68,16 @@ func OpenSearchHitToMatch(hit opensearchgoAPI.SearchHit) (*searchMessage.Match,
 
 				return strings.Join(contentHighlights[:], "; ")
 			}(),
+			Fragments: func() []*searchMessage.Fragment {
+				var fragments []*searchMessage.Fragment
+				for _, field := range []string{"Name", "Content"} {
+					for _, text := range hit.Highlight[field] {
+						fragments = append(fragments, &searchMessage.Fragment{Field: field, Text: text})
+					}
+				}
+
+				return fragments
+			}(),
 			Audio: func() *searchMessage.Audio {
 				if !strings.HasPrefix(resource.MimeType, "audio/") {
 					return nil
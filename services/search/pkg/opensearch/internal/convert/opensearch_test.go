@@ -36,3 +36,24 @@ func TestOpenSearchHitToMatch(t *testing.T) {
 		assert.JSONEq(t, opensearchtest.JSONMustMarshal(t, audio), opensearchtest.JSONMustMarshal(t, match.Entity.Audio))
 	})
 }
+
+func TestOpenSearchHitToMatchFragments(t *testing.T) {
+	hit := opensearchgoAPI.SearchHit{
+		Source: json.RawMessage(opensearchtest.JSONMustMarshal(t, opensearchtest.Testdata.Resources.File)),
+		Highlight: map[string][]string{
+			"Content": {"the <mark>budget</mark> for", "next <mark>budget</mark>"},
+			"Name":    {"<mark>budget</mark>.pdf"},
+			"Tags":    {"<mark>budget</mark>"},
+		},
+	}
+	match, err := convert.OpenSearchHitToMatch(hit)
+	assert.NoError(t, err)
+	assert.Equal(t, "the <mark>budget</mark> for; next <mark>budget</mark>", match.Entity.Highlights)
+	assert.Len(t, match.Entity.Fragments, 3)
+	assert.Equal(t, "Name", match.Entity.Fragments[0].Field)
+	assert.Equal(t, "<mark>budget</mark>.pdf", match.Entity.Fragments[0].Text)
+	assert.Equal(t, "Content", match.Entity.Fragments[1].Field)
+	assert.Equal(t, "the <mark>budget</mark> for", match.Entity.Fragments[1].Text)
+	assert.Equal(t, "Content", match.Entity.Fragments[2].Field)
+	assert.Equal(t, "next <mark>budget</mark>", match.Entity.Fragments[2].Text)
+}
@@ -64,7 +64,11 @@ func BuildSearchReq(req *opensearchgoAPI.SearchReq, q Builder, p ...SearchBodyPa
 }
 
 type SearchBodyParams struct {
-	Highlight *BodyParamHighlight `json:"highlight,omitempty"`
+	Highlight    *BodyParamHighlight `json:"highlight,omitempty"`
+	Source       []string            `json:"_source,omitempty"`
+	Sort         []any               `json:"sort,omitempty"`
+	SearchAfter  []any               `json:"search_after,omitempty"`
+	Aggregations map[string]any      `json:"aggs,omitempty"`
 }
 
 //----------------------------------------------------------------------------//
@@ -74,6 +74,40 @@ func TestBuildSearchReq(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "paging",
+			Got: func() io.Reader {
+				req, _ := osu.BuildSearchReq(
+					&opensearchgoAPI.SearchReq{},
+					osu.NewTermQuery[string]("RootID").Value("1$2!2"),
+					osu.SearchBodyParams{
+						Source:      []string{"ID", "Path"},
+						Sort:        []any{map[string]any{"ID": "asc"}},
+						SearchAfter: []any{"1$2!3"},
+						Aggregations: map[string]any{
+							"spaces": map[string]any{"terms": map[string]any{"field": "RootID"}},
+						},
+					},
+				)
+
+				return req.Body
+			}(),
+			Want: map[string]any{
+				"query": map[string]any{
+					"term": map[string]any{
+						"RootID": map[string]any{
+							"value": "1$2!2",
+						},
+					},
+				},
+				"_source":      []string{"ID", "Path"},
+				"sort":         []any{map[string]any{"ID": "asc"}},
+				"search_after": []any{"1$2!3"},
+				"aggs": map[string]any{
+					"spaces": map[string]any{"terms": map[string]any{"field": "RootID"}},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
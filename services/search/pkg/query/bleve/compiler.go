@@ -2,12 +2,18 @@ package bleve
 
 import (
 	"fmt"
+	"path"
 	"strings"
 
 	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis"
+	"github.com/blevesearch/bleve/v2/analysis/token/lowercase"
+	"github.com/blevesearch/bleve/v2/analysis/token/porter"
+	"github.com/blevesearch/bleve/v2/analysis/tokenizer/unicode"
 	bleveQuery "github.com/blevesearch/bleve/v2/search/query"
 	"github.com/opencloud-eu/opencloud/pkg/ast"
 	"github.com/opencloud-eu/opencloud/pkg/kql"
+	"github.com/opencloud-eu/reva/v2/pkg/utils"
 )
 
 var _fields = map[string]string{
@@ -23,8 +29,21 @@ var _fields = map[string]string{
 	"tags":      "Tags",
 	"content":   "Content",
 	"hidden":    "Hidden",
+	"owner":     "Owner",
 }
 
+// _fulltextAnalyzer analyzes the terms of proximity queries like the Content field is analyzed in the index
+var _fulltextAnalyzer = &analysis.DefaultAnalyzer{
+	Tokenizer: unicode.NewUnicodeTokenizer(),
+	TokenFilters: []analysis.TokenFilter{
+		lowercase.NewLowerCaseFilter(),
+		porter.NewPorterStemmer(),
+	},
+}
+
+// _maxProximityDistance limits the number of phrases a proximity query is expanded to
+const _maxProximityDistance = 20
+
 // The following quoted string enumerates the characters which may be escaped: "+-=&|><!(){}[]^\"~*?:\\/ "
 // based on bleve docs https://blevesearch.com/docs/Query-String-Query/
 // Wildcards * and ? are excluded
@@ -86,22 +105,45 @@ func walk(offset int, nodes []ast.Node) (bleveQuery.Query, int, error) {
 		case *ast.StringNode:
 			k := getField(n.Key)
 			v := n.Value
-			if k != "ID" && k != "Size" {
+			if k != "ID" && k != "Size" && k != "Path" {
 				v = bleveEscaper.Replace(n.Value)
 			}
 
-			if k != "Hidden" {
+			if k != "Hidden" && k != "Path" {
 				v = strings.ToLower(v)
 			}
 
 			var q bleveQuery.Query
 			var group bool
-			switch k {
-			case "MimeType":
+			switch {
+			case k == "MimeType":
 				q, group = mimeType(k, v)
 				if prev == nil {
 					isGroup = group
 				}
+			case k == "Size":
+				// keep supporting the bleve range syntax, e.g. size:>1000
+				operator := "="
+				for _, o := range []string{">=", "<=", ">", "<"} {
+					if strings.HasPrefix(v, o) {
+						operator, v = o, strings.TrimPrefix(v, o)
+						break
+					}
+				}
+				size, err := kql.ParseNumber(v)
+				if err != nil {
+					return nil, 0, err
+				}
+				q = numericRange(k, operator, size)
+			case k == "Path":
+				q = pathScope(k, v)
+				if prev == nil {
+					isGroup = true
+				}
+			case k == "Content" && strings.Contains(strings.TrimSpace(n.Value), " "):
+				phrase := bleveQuery.NewMatchPhraseQuery(strings.ToLower(n.Value))
+				phrase.SetField(k)
+				q = phrase
 			default:
 				q = bleveQuery.NewQueryStringQuery(k + ":" + v)
 			}
@@ -146,6 +188,36 @@ func walk(offset int, nodes []ast.Node) (bleveQuery.Query, int, error) {
 			} else {
 				next = q
 			}
+		case *ast.NumericNode:
+			if n.Operator == nil {
+				continue
+			}
+			q := numericRange(getField(n.Key), n.Operator.Value, n.Value)
+			if q == nil {
+				continue
+			}
+
+			if prev == nil {
+				prev = q
+			} else {
+				next = q
+			}
+		case *ast.ProximityNode:
+			k := "Content"
+			if n.Key != "" {
+				k = getField(n.Key)
+			}
+			q := proximity(k, n.Left, n.Right, n.Distance)
+			if q == nil {
+				return nil, 0, fmt.Errorf("can not compile the proximity query")
+			}
+
+			if prev == nil {
+				prev = q
+				isGroup = true
+			} else {
+				next = q
+			}
 		case *ast.BooleanNode:
 			q := bleveQuery.NewQueryStringQuery(getField(n.Key) + fmt.Sprintf(":%v", n.Value))
 			if prev == nil {
@@ -284,6 +356,84 @@ func normalizeGroupingProperty(group *ast.GroupNode) *ast.GroupNode {
 	return group
 }
 
+// numericRange returns a query comparing a numeric field with the given value
+func numericRange(k, operator string, v float64) bleveQuery.Query {
+	var minimum, maximum *float64
+	var minInclusive, maxInclusive *bool
+	inclusive, exclusive := true, false
+
+	switch operator {
+	case ">":
+		minimum, minInclusive = &v, &exclusive
+	case ">=":
+		minimum, minInclusive = &v, &inclusive
+	case "<":
+		maximum, maxInclusive = &v, &exclusive
+	case "<=":
+		maximum, maxInclusive = &v, &inclusive
+	case "=", ":":
+		minimum, minInclusive = &v, &inclusive
+		maximum, maxInclusive = &v, &inclusive
+	default:
+		return nil
+	}
+
+	q := bleveQuery.NewNumericRangeInclusiveQuery(minimum, maximum, minInclusive, maxInclusive)
+	q.SetField(k)
+	return q
+}
+
+// pathScope returns a query matching the resource at the given path and everything below it
+func pathScope(k, v string) bleveQuery.Query {
+	p := utils.MakeRelativePath(path.Clean("/" + v))
+	if p == "." {
+		return bleveQuery.NewMatchAllQuery()
+	}
+
+	term := bleveQuery.NewTermQuery(p)
+	term.SetField(k)
+	prefix := bleveQuery.NewPrefixQuery(p + "/")
+	prefix.SetField(k)
+	return bleveQuery.NewDisjunctionQuery([]bleveQuery.Query{term, prefix})
+}
+
+// proximity returns a query matching the left and right terms within the given distance in any order.
+// Bleve phrases do not support a slop, so the terms are matched as phrases with up to distance
+// placeholders between them.
+func proximity(k, left, right string, distance int) bleveQuery.Query {
+	lt, rt := analyze(left), analyze(right)
+	if len(lt) == 0 || len(rt) == 0 {
+		return nil
+	}
+	if distance < 0 {
+		distance = 0
+	}
+	if distance > _maxProximityDistance {
+		distance = _maxProximityDistance
+	}
+
+	phrases := make([]bleveQuery.Query, 0, 2*(distance+1))
+	for gap := 0; gap <= distance; gap++ {
+		placeholders := make([]string, gap)
+		for _, terms := range [][]string{
+			append(append(append([]string{}, lt...), placeholders...), rt...),
+			append(append(append([]string{}, rt...), placeholders...), lt...),
+		} {
+			phrases = append(phrases, bleveQuery.NewPhraseQuery(terms, k))
+		}
+	}
+	return bleveQuery.NewDisjunctionQuery(phrases)
+}
+
+// analyze returns the terms of a text like they are stored in the full text index
+func analyze(text string) []string {
+	var terms []string
+	for _, token := range _fulltextAnalyzer.Analyze([]byte(text)) {
+		terms = append(terms, string(token.Term))
+	}
+	return terms
+}
+
 func mimeType(k, v string) (bleveQuery.Query, bool) {
 	switch v {
 	case "file":
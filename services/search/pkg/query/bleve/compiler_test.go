@@ -6,6 +6,7 @@ import (
 
 	"github.com/blevesearch/bleve/v2/search/query"
 	"github.com/opencloud-eu/opencloud/pkg/ast"
+	"github.com/opencloud-eu/opencloud/pkg/kql"
 	tAssert "github.com/stretchr/testify/assert"
 )
 
@@ -525,6 +526,106 @@ func Test_compile(t *testing.T) {
 			}),
 			wantErr: false,
 		},
+		{
+			name: `size>=1KiB size<2MB`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.NumericNode{Key: "size", Operator: &ast.OperatorNode{Value: ">="}, Value: 1024},
+					&ast.OperatorNode{Value: kql.BoolAND},
+					&ast.NumericNode{Key: "size", Operator: &ast.OperatorNode{Value: "<"}, Value: 2e6},
+				},
+			},
+			want: query.NewConjunctionQuery([]query.Query{
+				numericRange("Size", ">=", 1024),
+				numericRange("Size", "<", 2e6),
+			}),
+			wantErr: false,
+		},
+		{
+			name: `size:10`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.StringNode{Key: "size", Value: "10"},
+				},
+			},
+			want: query.NewConjunctionQuery([]query.Query{
+				numericRange("Size", "=", 10),
+			}),
+			wantErr: false,
+		},
+		{
+			name: `size:ten`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.StringNode{Key: "size", Value: "ten"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: `path:"Projects/Some Folder/" AND tag:book`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.StringNode{Key: "path", Value: "Projects/Some Folder/"},
+					&ast.OperatorNode{Value: kql.BoolAND},
+					&ast.StringNode{Key: "tag", Value: "book"},
+				},
+			},
+			want: query.NewConjunctionQuery([]query.Query{
+				func() query.Query {
+					term := query.NewTermQuery("./Projects/Some Folder")
+					term.SetField("Path")
+					prefix := query.NewPrefixQuery("./Projects/Some Folder/")
+					prefix.SetField("Path")
+					return query.NewDisjunctionQuery([]query.Query{term, prefix})
+				}(),
+				query.NewQueryStringQuery(`Tags:book`),
+			}),
+			wantErr: false,
+		},
+		{
+			name: `owner:Einstein`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.StringNode{Key: "owner", Value: "Einstein"},
+				},
+			},
+			want: query.NewConjunctionQuery([]query.Query{
+				query.NewQueryStringQuery(`Owner:einstein`),
+			}),
+			wantErr: false,
+		},
+		{
+			name: `content:"Black Cats"`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.StringNode{Key: "content", Value: "Black Cats"},
+				},
+			},
+			want: query.NewConjunctionQuery([]query.Query{
+				func() query.Query {
+					q := query.NewMatchPhraseQuery("black cats")
+					q.SetField("Content")
+					return q
+				}(),
+			}),
+			wantErr: false,
+		},
+		{
+			name: `cats NEAR(1) dogs`,
+			args: &ast.Ast{
+				Nodes: []ast.Node{
+					&ast.ProximityNode{Left: "cats", Right: "dogs", Distance: 1},
+				},
+			},
+			want: query.NewDisjunctionQuery([]query.Query{
+				query.NewPhraseQuery([]string{"cat", "dog"}, "Content"),
+				query.NewPhraseQuery([]string{"dog", "cat"}, "Content"),
+				query.NewPhraseQuery([]string{"cat", "", "dog"}, "Content"),
+				query.NewPhraseQuery([]string{"dog", "", "cat"}, "Content"),
+			}),
+			wantErr: false,
+		},
 	}
 
 	assert := tAssert.New(t)
@@ -0,0 +1,346 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/opencloud-eu/reva/v2/pkg/errtypes"
+	"github.com/opencloud-eu/reva/v2/pkg/storage/utils/walker"
+	"github.com/opencloud-eu/reva/v2/pkg/storagespace"
+	"github.com/opencloud-eu/reva/v2/pkg/utils"
+
+	searchsvc "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/search/v0"
+)
+
+// VerifyIndex compares the index with the storage and reports the drift per space.
+// Resources which are missing in the index or stale are reindexed and orphans are purged if repair is set.
+// Without a space id all spaces are verified, including the spaces which only exist in the index anymore.
+func (s *Service) VerifyIndex(spaceID string, repair bool) ([]*searchsvc.SpaceDrift, error) {
+	ownerCtx, err := getAuthContext(s.serviceAccountID, s.gatewaySelector, s.serviceAccountSecret, s.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	spaces, err := s.listSpaces(ownerCtx, spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	drifts := make([]*searchsvc.SpaceDrift, 0, len(spaces))
+	known := make(map[string]bool, len(spaces))
+	for _, space := range spaces {
+		rootID, err := storagespace.ParseID(space.GetId().GetOpaqueId())
+		if err != nil {
+			return nil, err
+		}
+		rootID.OpaqueId = rootID.SpaceId
+		known[storagespace.FormatResourceID(&rootID)] = true
+
+		drift, err := s.verifySpace(ownerCtx, &rootID, repair)
+		if err != nil {
+			return nil, err
+		}
+		drifts = append(drifts, drift)
+	}
+
+	if spaceID != "" {
+		return drifts, nil
+	}
+
+	// the documents of spaces which have been deleted are orphans as well
+	stats, err := s.engine.SpaceStats()
+	if err != nil {
+		return nil, err
+	}
+	for _, stat := range stats {
+		if known[stat.RootID] {
+			continue
+		}
+
+		exists, err := s.spaceExists(ownerCtx, stat.RootID)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			continue
+		}
+
+		drift := &searchsvc.SpaceDrift{SpaceId: stat.RootID, Orphaned: stat.Documents}
+		if repair {
+			if err := s.engine.Purge(stat.RootID, false); err != nil {
+				s.logger.Error().Err(err).Str("space", stat.RootID).Msg("failed to purge the documents of a deleted space")
+			} else {
+				drift.Repaired = true
+			}
+		}
+		drifts = append(drifts, drift)
+	}
+
+	if repair {
+		logDocCount(s.engine, s.logger)
+	}
+
+	return drifts, nil
+}
+
+// verifySpace compares the index with the storage of a single space. Resources which were changed or indexed
+// after the verification started are not reported as orphans, they were created while the space was walked.
+func (s *Service) verifySpace(ctx context.Context, rootID *provider.ResourceId, repair bool) (*searchsvc.SpaceDrift, error) {
+	started := time.Now()
+
+	type storageResource struct {
+		ref   *provider.Reference
+		info  *provider.ResourceInfo
+		found bool
+	}
+
+	resources := map[string]*storageResource{}
+	w := walker.NewWalker(s.gatewaySelector)
+	err := w.Walk(ctx, rootID, func(wd string, info *provider.ResourceInfo, err error) error {
+		if err != nil {
+			s.logger.Error().Err(err).Msg("error walking the tree")
+			return err
+		}
+
+		if info == nil {
+			return nil
+		}
+
+		resources[storagespace.FormatResourceID(info.GetId())] = &storageResource{
+			ref: &provider.Reference{
+				Path:       utils.MakeRelativePath(filepath.Join(wd, info.GetPath())),
+				ResourceId: rootID,
+			},
+			info: info,
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	drift := &searchsvc.SpaceDrift{SpaceId: storagespace.FormatResourceID(rootID)}
+	var stale []*provider.Reference
+	orphans := map[string]string{}
+	err = s.engine.WalkSpace(drift.SpaceId, func(r Resource) error {
+		res, ok := resources[r.ID]
+		switch {
+		case !ok && !r.Deleted && !changedSince(r, started):
+			orphans[r.ID] = r.ParentID
+		case !ok && !r.Deleted:
+			// created after the walk passed its parent
+		case !ok:
+			// deleted resources are in the trash, they stay in the index until they are purged
+		case r.Deleted || isStale(r, res.info):
+			res.found = true
+			stale = append(stale, res.ref)
+		default:
+			res.found = true
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var missing []*provider.Reference
+	for _, res := range resources {
+		if !res.found {
+			missing = append(missing, res.ref)
+		}
+	}
+
+	drift.Missing = uint64(len(missing))
+	drift.Stale = uint64(len(stale))
+	drift.Orphaned = uint64(len(orphans))
+
+	if !repair || drift.Missing+drift.Stale+drift.Orphaned == 0 {
+		return drift, nil
+	}
+
+	batch, err := s.engine.NewBatch(s.batchSize)
+	if err != nil {
+		return nil, err
+	}
+	for _, ref := range append(missing, stale...) {
+		s.doUpsertItem(ref, batch)
+	}
+	if err := batch.Push(); err != nil {
+		return nil, err
+	}
+
+	for id, parentID := range orphans {
+		if _, ok := orphans[parentID]; ok {
+			// purged together with the parent
+			continue
+		}
+
+		if err := s.engine.Purge(id, false); err != nil {
+			return nil, fmt.Errorf("failed to purge the orphaned resource %s: %w", id, err)
+		}
+	}
+
+	drift.Repaired = true
+	return drift, nil
+}
+
+// RebuildIndex rebuilds the index of all spaces into a new index which replaces the current one when it is done.
+// Returns the number of documents in the new index.
+func (s *Service) RebuildIndex() (uint64, error) {
+	rebuilder, ok := s.engine.(Rebuilder)
+	if !ok {
+		return 0, errtypes.NotSupported("the search engine does not support rebuilding the index")
+	}
+
+	ownerCtx, err := getAuthContext(s.serviceAccountID, s.gatewaySelector, s.serviceAccountSecret, s.logger)
+	if err != nil {
+		return 0, err
+	}
+
+	spaces, err := s.listSpaces(ownerCtx, "")
+	if err != nil {
+		return 0, err
+	}
+
+	var count uint64
+	err = rebuilder.Rebuild(func(eng Engine) error {
+		for _, space := range spaces {
+			if err := s.indexSpace(space.GetId(), eng); err != nil {
+				return err
+			}
+		}
+
+		count, err = eng.DocCount()
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	s.logger.Info().Uint64("count", count).Msg("rebuilt the index")
+	return count, nil
+}
+
+// CompactIndex compacts the index to free the space of deleted documents.
+func (s *Service) CompactIndex() error {
+	compactor, ok := s.engine.(Compactor)
+	if !ok {
+		return errtypes.NotSupported("the search engine does not support compacting the index")
+	}
+
+	return compactor.Compact()
+}
+
+// IndexStats returns the number of documents and the size of the files per space.
+func (s *Service) IndexStats() ([]*searchsvc.SpaceStats, error) {
+	stats, err := s.engine.SpaceStats()
+	if err != nil {
+		return nil, err
+	}
+
+	spaceStats := make([]*searchsvc.SpaceStats, 0, len(stats))
+	for _, stat := range stats {
+		spaceStats = append(spaceStats, &searchsvc.SpaceStats{
+			SpaceId:   stat.RootID,
+			Documents: stat.Documents,
+			Deleted:   stat.Deleted,
+			Size:      stat.Size,
+		})
+	}
+
+	return spaceStats, nil
+}
+
+// listSpaces returns the personal and project spaces, or only the given one
+func (s *Service) listSpaces(ctx context.Context, spaceID string) ([]*provider.StorageSpace, error) {
+	gatewayClient, err := s.gatewaySelector.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	req := &provider.ListStorageSpacesRequest{}
+	if spaceID != "" {
+		req.Filters = []*provider.ListStorageSpacesRequest_Filter{{
+			Type: provider.ListStorageSpacesRequest_Filter_TYPE_ID,
+			Term: &provider.ListStorageSpacesRequest_Filter_Id{Id: &provider.StorageSpaceId{OpaqueId: spaceID}},
+		}}
+	}
+
+	res, err := gatewayClient.ListStorageSpaces(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if res.GetStatus().GetCode() != rpc.Code_CODE_OK {
+		return nil, fmt.Errorf("could not list spaces: %s", res.GetStatus().GetMessage())
+	}
+
+	spaces := make([]*provider.StorageSpace, 0, len(res.GetStorageSpaces()))
+	for _, space := range res.GetStorageSpaces() {
+		if typ := space.GetSpaceType(); typ != _spaceTypePersonal && typ != _spaceTypeProject {
+			continue
+		}
+		spaces = append(spaces, space)
+	}
+
+	if spaceID != "" && len(spaces) == 0 {
+		return nil, errtypes.NotFound(spaceID)
+	}
+
+	return spaces, nil
+}
+
+// spaceExists checks if a space which is not part of the space list still exists, e.g. because it is disabled
+func (s *Service) spaceExists(ctx context.Context, rootID string) (bool, error) {
+	gatewayClient, err := s.gatewaySelector.Next()
+	if err != nil {
+		return false, err
+	}
+
+	res, err := gatewayClient.ListStorageSpaces(ctx, &provider.ListStorageSpacesRequest{
+		Opaque: utils.AppendPlainToOpaque(nil, "unrestricted", "T"),
+		Filters: []*provider.ListStorageSpacesRequest_Filter{{
+			Type: provider.ListStorageSpacesRequest_Filter_TYPE_ID,
+			Term: &provider.ListStorageSpacesRequest_Filter_Id{Id: &provider.StorageSpaceId{OpaqueId: rootID}},
+		}},
+	})
+	switch {
+	case err != nil:
+		return false, err
+	case res.GetStatus().GetCode() == rpc.Code_CODE_NOT_FOUND:
+		return false, nil
+	case res.GetStatus().GetCode() != rpc.Code_CODE_OK:
+		return false, fmt.Errorf("could not list spaces: %s", res.GetStatus().GetMessage())
+	}
+
+	return len(res.GetStorageSpaces()) > 0, nil
+}
+
+// changedSince checks if the indexed resource was modified or indexed after the given time
+func changedSince(r Resource, t time.Time) bool {
+	for _, ts := range []string{r.Mtime, r.Indexed} {
+		if parsed, err := time.Parse(time.RFC3339Nano, ts); err == nil && parsed.After(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// isStale checks if the indexed file is older than the one in the storage. Folders are not compared,
+// their mtime changes with every change of their content.
+func isStale(r Resource, info *provider.ResourceInfo) bool {
+	if info.GetType() != provider.ResourceType_RESOURCE_TYPE_FILE || info.GetMtime() == nil {
+		return false
+	}
+
+	mtime, err := time.Parse(time.RFC3339Nano, r.Mtime)
+	if err != nil {
+		return true
+	}
+
+	return !mtime.Equal(utils.TSToTime(info.GetMtime()))
+}
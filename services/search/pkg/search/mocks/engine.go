@@ -444,6 +444,61 @@ func (_c *Engine_Search_Call) RunAndReturn(run func(ctx context.Context, req *v0
 	return _c
 }
 
+// SpaceStats provides a mock function for the type Engine
+func (_mock *Engine) SpaceStats() ([]search.SpaceStats, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SpaceStats")
+	}
+
+	var r0 []search.SpaceStats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]search.SpaceStats, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []search.SpaceStats); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]search.SpaceStats)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Engine_SpaceStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SpaceStats'
+type Engine_SpaceStats_Call struct {
+	*mock.Call
+}
+
+// SpaceStats is a helper method to define mock.On call
+func (_e *Engine_Expecter) SpaceStats() *Engine_SpaceStats_Call {
+	return &Engine_SpaceStats_Call{Call: _e.mock.On("SpaceStats")}
+}
+
+func (_c *Engine_SpaceStats_Call) Run(run func()) *Engine_SpaceStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Engine_SpaceStats_Call) Return(spaceStatss []search.SpaceStats, err error) *Engine_SpaceStats_Call {
+	_c.Call.Return(spaceStatss, err)
+	return _c
+}
+
+func (_c *Engine_SpaceStats_Call) RunAndReturn(run func() ([]search.SpaceStats, error)) *Engine_SpaceStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Upsert provides a mock function for the type Engine
 func (_mock *Engine) Upsert(id string, r search.Resource) error {
 	ret := _mock.Called(id, r)
@@ -500,3 +555,60 @@ func (_c *Engine_Upsert_Call) RunAndReturn(run func(id string, r search.Resource
 	_c.Call.Return(run)
 	return _c
 }
+
+// WalkSpace provides a mock function for the type Engine
+func (_mock *Engine) WalkSpace(rootID string, fn func(r search.Resource) error) error {
+	ret := _mock.Called(rootID, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WalkSpace")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, func(r search.Resource) error) error); ok {
+		r0 = returnFunc(rootID, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Engine_WalkSpace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WalkSpace'
+type Engine_WalkSpace_Call struct {
+	*mock.Call
+}
+
+// WalkSpace is a helper method to define mock.On call
+//   - rootID string
+//   - fn func(r search.Resource) error
+func (_e *Engine_Expecter) WalkSpace(rootID interface{}, fn interface{}) *Engine_WalkSpace_Call {
+	return &Engine_WalkSpace_Call{Call: _e.mock.On("WalkSpace", rootID, fn)}
+}
+
+func (_c *Engine_WalkSpace_Call) Run(run func(rootID string, fn func(r search.Resource) error)) *Engine_WalkSpace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 func(r search.Resource) error
+		if args[1] != nil {
+			arg1 = args[1].(func(r search.Resource) error)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Engine_WalkSpace_Call) Return(err error) *Engine_WalkSpace_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Engine_WalkSpace_Call) RunAndReturn(run func(rootID string, fn func(r search.Resource) error) error) *Engine_WalkSpace_Call {
+	_c.Call.Return(run)
+	return _c
+}
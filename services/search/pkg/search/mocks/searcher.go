@@ -39,6 +39,50 @@ func (_m *Searcher) EXPECT() *Searcher_Expecter {
 	return &Searcher_Expecter{mock: &_m.Mock}
 }
 
+// CompactIndex provides a mock function for the type Searcher
+func (_mock *Searcher) CompactIndex() error {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompactIndex")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Searcher_CompactIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompactIndex'
+type Searcher_CompactIndex_Call struct {
+	*mock.Call
+}
+
+// CompactIndex is a helper method to define mock.On call
+func (_e *Searcher_Expecter) CompactIndex() *Searcher_CompactIndex_Call {
+	return &Searcher_CompactIndex_Call{Call: _e.mock.On("CompactIndex")}
+}
+
+func (_c *Searcher_CompactIndex_Call) Run(run func()) *Searcher_CompactIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Searcher_CompactIndex_Call) Return(err error) *Searcher_CompactIndex_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Searcher_CompactIndex_Call) RunAndReturn(run func() error) *Searcher_CompactIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IndexSpace provides a mock function for the type Searcher
 func (_mock *Searcher) IndexSpace(rID *providerv1beta1.StorageSpaceId) error {
 	ret := _mock.Called(rID)
@@ -90,6 +134,61 @@ func (_c *Searcher_IndexSpace_Call) RunAndReturn(run func(rID *providerv1beta1.S
 	return _c
 }
 
+// IndexStats provides a mock function for the type Searcher
+func (_mock *Searcher) IndexStats() ([]*v0.SpaceStats, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IndexStats")
+	}
+
+	var r0 []*v0.SpaceStats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]*v0.SpaceStats, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []*v0.SpaceStats); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*v0.SpaceStats)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Searcher_IndexStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IndexStats'
+type Searcher_IndexStats_Call struct {
+	*mock.Call
+}
+
+// IndexStats is a helper method to define mock.On call
+func (_e *Searcher_Expecter) IndexStats() *Searcher_IndexStats_Call {
+	return &Searcher_IndexStats_Call{Call: _e.mock.On("IndexStats")}
+}
+
+func (_c *Searcher_IndexStats_Call) Run(run func()) *Searcher_IndexStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Searcher_IndexStats_Call) Return(spaceStatss []*v0.SpaceStats, err error) *Searcher_IndexStats_Call {
+	_c.Call.Return(spaceStatss, err)
+	return _c
+}
+
+func (_c *Searcher_IndexStats_Call) RunAndReturn(run func() ([]*v0.SpaceStats, error)) *Searcher_IndexStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MoveItem provides a mock function for the type Searcher
 func (_mock *Searcher) MoveItem(ref *providerv1beta1.Reference) {
 	_mock.Called(ref)
@@ -221,6 +320,59 @@ func (_c *Searcher_PurgeItem_Call) RunAndReturn(run func(rID *providerv1beta1.Re
 	return _c
 }
 
+// RebuildIndex provides a mock function for the type Searcher
+func (_mock *Searcher) RebuildIndex() (uint64, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RebuildIndex")
+	}
+
+	var r0 uint64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (uint64, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() uint64); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Searcher_RebuildIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RebuildIndex'
+type Searcher_RebuildIndex_Call struct {
+	*mock.Call
+}
+
+// RebuildIndex is a helper method to define mock.On call
+func (_e *Searcher_Expecter) RebuildIndex() *Searcher_RebuildIndex_Call {
+	return &Searcher_RebuildIndex_Call{Call: _e.mock.On("RebuildIndex")}
+}
+
+func (_c *Searcher_RebuildIndex_Call) Run(run func()) *Searcher_RebuildIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Searcher_RebuildIndex_Call) Return(v uint64, err error) *Searcher_RebuildIndex_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *Searcher_RebuildIndex_Call) RunAndReturn(run func() (uint64, error)) *Searcher_RebuildIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RestoreItem provides a mock function for the type Searcher
 func (_mock *Searcher) RestoreItem(ref *providerv1beta1.Reference) {
 	_mock.Called(ref)
@@ -408,3 +560,71 @@ func (_c *Searcher_UpsertItem_Call) RunAndReturn(run func(ref *providerv1beta1.R
 	_c.Run(run)
 	return _c
 }
+
+// VerifyIndex provides a mock function for the type Searcher
+func (_mock *Searcher) VerifyIndex(spaceID string, repair bool) ([]*v0.SpaceDrift, error) {
+	ret := _mock.Called(spaceID, repair)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyIndex")
+	}
+
+	var r0 []*v0.SpaceDrift
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, bool) ([]*v0.SpaceDrift, error)); ok {
+		return returnFunc(spaceID, repair)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, bool) []*v0.SpaceDrift); ok {
+		r0 = returnFunc(spaceID, repair)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*v0.SpaceDrift)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, bool) error); ok {
+		r1 = returnFunc(spaceID, repair)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Searcher_VerifyIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyIndex'
+type Searcher_VerifyIndex_Call struct {
+	*mock.Call
+}
+
+// VerifyIndex is a helper method to define mock.On call
+//   - spaceID string
+//   - repair bool
+func (_e *Searcher_Expecter) VerifyIndex(spaceID interface{}, repair interface{}) *Searcher_VerifyIndex_Call {
+	return &Searcher_VerifyIndex_Call{Call: _e.mock.On("VerifyIndex", spaceID, repair)}
+}
+
+func (_c *Searcher_VerifyIndex_Call) Run(run func(spaceID string, repair bool)) *Searcher_VerifyIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 bool
+		if args[1] != nil {
+			arg1 = args[1].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Searcher_VerifyIndex_Call) Return(spaceDrifts []*v0.SpaceDrift, err error) *Searcher_VerifyIndex_Call {
+	_c.Call.Return(spaceDrifts, err)
+	return _c
+}
+
+func (_c *Searcher_VerifyIndex_Call) RunAndReturn(run func(spaceID string, repair bool) ([]*v0.SpaceDrift, error)) *Searcher_VerifyIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -27,6 +27,8 @@ var scopeRegex = regexp.MustCompile(`scope:\s*([^" "\n\r]*)`)
 type Engine interface {
 	Search(ctx context.Context, req *searchService.SearchIndexRequest) (*searchService.SearchIndexResponse, error)
 	DocCount() (uint64, error)
+	SpaceStats() ([]SpaceStats, error)
+	WalkSpace(rootID string, fn func(r Resource) error) error
 
 	Upsert(id string, r Resource) error
 	Move(id string, parentid string, target string) error
@@ -37,6 +39,18 @@ type Engine interface {
 	NewBatch(batchSize int) (BatchOperator, error)
 }
 
+// Rebuilder is implemented by engines which can rebuild the index without downtime.
+// The build function fills the new index, all changes which are made in the meantime are
+// written to both indexes. The new index replaces the current one once build returns without an error.
+type Rebuilder interface {
+	Rebuild(build func(eng Engine) error) error
+}
+
+// Compactor is implemented by engines which can compact their index.
+type Compactor interface {
+	Compact() error
+}
+
 type BatchOperator interface {
 	Upsert(id string, r Resource) error
 	Move(rootID, parentID, location string) error
@@ -58,6 +72,20 @@ type Resource struct {
 	Type     uint64
 	Deleted  bool
 	Hidden   bool
+	// Owner holds the id and the username of the owner, both lowercased
+	Owner []string
+	// Indexed is the time the resource was indexed last, formatted as RFC3339
+	Indexed string
+}
+
+// SpaceStats holds the number of documents of a space in the index.
+type SpaceStats struct {
+	RootID    string
+	Documents uint64
+	// Deleted is the number of documents which are marked as deleted
+	Deleted uint64
+	// Size is the size of all files which are not deleted
+	Size uint64
 }
 
 // ResolveReference makes sure the path is relative to the space root
@@ -10,10 +10,12 @@ import (
 	"time"
 
 	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	user "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
 	rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
 	rpcv1beta1 "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
 	collaborationv1beta1 "github.com/cs3org/go-cs3apis/cs3/sharing/collaboration/v1beta1"
 	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"github.com/jellydator/ttlcache/v3"
 	libregraph "github.com/opencloud-eu/libre-graph-api-go"
 	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
 	"github.com/opencloud-eu/reva/v2/pkg/errtypes"
@@ -49,6 +51,11 @@ type Searcher interface {
 	IndexSpace(rID *provider.StorageSpaceId) error
 	PurgeDeleted(spaceID *provider.StorageSpaceId) error
 
+	VerifyIndex(spaceID string, repair bool) ([]*searchsvc.SpaceDrift, error)
+	RebuildIndex() (uint64, error)
+	CompactIndex() error
+	IndexStats() ([]*searchsvc.SpaceStats, error)
+
 	TrashItem(rID *provider.ResourceId)
 	PurgeItem(rID *provider.Reference)
 	UpsertItem(ref *provider.Reference)
@@ -69,8 +76,18 @@ type Service struct {
 	serviceAccountSecret string
 
 	batchSize int
+
+	// ownerNames caches the usernames of resource owners by user id
+	ownerNames *ttlcache.Cache[string, string]
 }
 
+const (
+	// ownerNamesTTL is the time the usernames of the owners are cached, renamed users are picked up afterwards
+	ownerNamesTTL = time.Hour
+	// ownerNamesCapacity is the maximum number of cached usernames
+	ownerNamesCapacity = 10000
+)
+
 var errSkipSpace error
 
 // NewService creates a new Provider instance.
@@ -86,6 +103,12 @@ func NewService(gatewaySelector pool.Selectable[gateway.GatewayAPIClient], eng E
 		serviceAccountSecret: cfg.ServiceAccount.ServiceAccountSecret,
 
 		batchSize: cfg.BatchSize,
+
+		ownerNames: ttlcache.New(
+			ttlcache.WithTTL[string, string](ownerNamesTTL),
+			ttlcache.WithCapacity[string, string](ownerNamesCapacity),
+			ttlcache.WithDisableTouchOnHit[string, string](),
+		),
 	}
 
 	return s
@@ -435,6 +458,11 @@ func (s *Service) searchIndex(ctx context.Context, req *searchsvc.SearchRequest,
 
 // IndexSpace (re)indexes all resources of a given space.
 func (s *Service) IndexSpace(spaceID *provider.StorageSpaceId) error {
+	return s.indexSpace(spaceID, s.engine)
+}
+
+// indexSpace (re)indexes all resources of a given space into the given engine.
+func (s *Service) indexSpace(spaceID *provider.StorageSpaceId, eng Engine) error {
 	ownerCtx, err := getAuthContext(s.serviceAccountID, s.gatewaySelector, s.serviceAccountSecret, s.logger)
 	if err != nil {
 		return err
@@ -466,7 +494,7 @@ func (s *Service) IndexSpace(spaceID *provider.StorageSpaceId) error {
 	}()
 
 	w := walker.NewWalker(s.gatewaySelector)
-	batch, err := s.engine.NewBatch(s.batchSize)
+	batch, err := eng.NewBatch(s.batchSize)
 	if err != nil {
 		return err
 	}
@@ -474,7 +502,7 @@ func (s *Service) IndexSpace(spaceID *provider.StorageSpaceId) error {
 		if err := batch.Push(); err != nil {
 			s.logger.Error().Err(err).Msg("failed to end batch")
 		}
-		logDocCount(s.engine, s.logger)
+		logDocCount(eng, s.logger)
 	}()
 	err = w.Walk(ownerCtx, &rootID, func(wd string, info *provider.ResourceInfo, err error) error {
 		if err != nil {
@@ -492,7 +520,7 @@ func (s *Service) IndexSpace(spaceID *provider.StorageSpaceId) error {
 		}
 		s.logger.Debug().Str("path", ref.Path).Msg("Walking tree")
 
-		searchRes, err := s.engine.Search(ownerCtx, &searchsvc.SearchIndexRequest{
+		searchRes, err := eng.Search(ownerCtx, &searchsvc.SearchIndexRequest{
 			Query: "id:" + storagespace.FormatResourceID(info.Id) + ` mtime>=` + utils.TSToTime(info.Mtime).Format(time.RFC3339Nano),
 		})
 
@@ -594,6 +622,7 @@ func (s *Service) doUpsertItem(ref *provider.Reference, batch BatchOperator) {
 		Path:     utils.MakeRelativePath(path),
 		Type:     uint64(stat.Info.Type),
 		Document: doc,
+		Indexed:  time.Now().UTC().Format(time.RFC3339Nano),
 	}
 	r.Hidden = strings.HasPrefix(r.Path, ".")
 
@@ -601,6 +630,10 @@ func (s *Service) doUpsertItem(ref *provider.Reference, batch BatchOperator) {
 		r.ParentID = storagespace.FormatResourceID(parentID)
 	}
 
+	if owner := stat.GetInfo().GetOwner(); owner != nil {
+		r.Owner = s.ownerTerms(ctx, owner)
+	}
+
 	if batch != nil {
 		err = batch.Upsert(r.ID, r)
 	} else {
@@ -642,6 +675,38 @@ func (s *Service) doUpsertItem(ref *provider.Reference, batch BatchOperator) {
 	}
 }
 
+// ownerTerms returns the lowercased id and username of the owner, which are matched by owner: queries.
+// Failed lookups are not cached, the username is looked up again for the next resource of the owner.
+func (s *Service) ownerTerms(ctx context.Context, owner *user.UserId) []string {
+	terms := []string{strings.ToLower(owner.GetOpaqueId())}
+
+	var name string
+	if item := s.ownerNames.Get(owner.GetOpaqueId()); item != nil {
+		name = item.Value()
+	} else {
+		gatewayClient, err := s.gatewaySelector.Next()
+		if err == nil {
+			var res *user.GetUserResponse
+			res, err = gatewayClient.GetUser(ctx, &user.GetUserRequest{UserId: owner, SkipFetchingUserGroups: true})
+			if err == nil && res.GetStatus().GetCode() != rpc.Code_CODE_OK {
+				err = fmt.Errorf("could not get user: %s", res.GetStatus().GetMessage())
+			}
+			if err == nil {
+				name = res.GetUser().GetUsername()
+				s.ownerNames.Set(owner.GetOpaqueId(), name, ttlcache.DefaultTTL)
+			}
+		}
+		if err != nil {
+			s.logger.Debug().Err(err).Str("owner", owner.GetOpaqueId()).Msg("could not get the username of the owner")
+		}
+	}
+
+	if n := strings.ToLower(name); n != "" && n != terms[0] {
+		terms = append(terms, n)
+	}
+	return terms
+}
+
 func addAudioMetadata(metadata map[string]string, audio *libregraph.Audio) {
 	if audio == nil {
 		return
@@ -2,6 +2,8 @@ package search_test
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
 	userv1beta1 "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
@@ -138,6 +140,161 @@ var _ = Describe("Searchprovider", func() {
 		})
 	})
 
+	Describe("UpsertItem", func() {
+		It("indexes the username of the owner and retries failed lookups", func() {
+			owned := &sprovider.ResourceInfo{
+				Id:       ri.Id,
+				ParentId: ri.ParentId,
+				Path:     ri.Path,
+				Mtime:    ri.Mtime,
+				Owner:    &userv1beta1.UserId{OpaqueId: "OwnerID"},
+			}
+			gatewayClient.On("GetUserByClaim", mock.Anything, mock.Anything).Return(&userv1beta1.GetUserByClaimResponse{
+				Status: status.NewOK(context.Background()),
+				User:   user,
+			}, nil)
+			gatewayClient.On("Stat", mock.Anything, mock.Anything).Return(&sprovider.StatResponse{
+				Status: status.NewOK(context.Background()),
+				Info:   owned,
+			}, nil)
+			gatewayClient.On("GetUser", mock.Anything, mock.Anything).Return(&userv1beta1.GetUserResponse{
+				Status: status.NewInternal(context.Background(), "unavailable"),
+			}, nil).Once()
+			gatewayClient.On("GetUser", mock.Anything, mock.Anything).Return(&userv1beta1.GetUserResponse{
+				Status: status.NewOK(context.Background()),
+				User:   &userv1beta1.User{Id: owned.Owner, Username: "Einstein"},
+			}, nil)
+			extractor.On("Extract", mock.Anything, mock.Anything, mock.Anything).Return(content.Document{}, nil)
+
+			var owners [][]string
+			indexClient.On("Upsert", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+				owners = append(owners, args.Get(1).(search.Resource).Owner)
+			})
+
+			ref := &sprovider.Reference{ResourceId: ri.Id}
+			for i := 0; i < 3; i++ {
+				s.UpsertItem(ref)
+			}
+
+			Expect(owners).To(Equal([][]string{
+				{"ownerid"},
+				{"ownerid", "einstein"},
+				{"ownerid", "einstein"},
+			}))
+			// the username is cached after the first successful lookup
+			gatewayClient.AssertNumberOfCalls(GinkgoT(), "GetUser", 2)
+		})
+	})
+
+	Describe("VerifyIndex", func() {
+		It("reports missing and orphaned resources", func() {
+			gatewayClient.On("ListStorageSpaces", mock.Anything, mock.Anything).Return(&sprovider.ListStorageSpacesResponse{
+				Status:        status.NewOK(context.Background()),
+				StorageSpaces: []*sprovider.StorageSpace{personalSpace},
+			}, nil)
+			gatewayClient.On("Stat", mock.Anything, mock.Anything).Return(&sprovider.StatResponse{
+				Status: status.NewOK(context.Background()),
+				Info:   ri,
+			}, nil)
+			indexClient.On("WalkSpace", "storageid$personalspace!personalspace", mock.Anything).Return(func(_ string, fn func(r search.Resource) error) error {
+				for _, r := range []search.Resource{
+					{ID: "storageid$personalspace!orphan"},
+					{ID: "storageid$personalspace!trashed", Deleted: true},
+				} {
+					if err := fn(r); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+
+			drifts, err := s.VerifyIndex(personalSpace.GetId().GetOpaqueId(), false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(drifts).To(HaveLen(1))
+			Expect(drifts[0].GetSpaceId()).To(Equal("storageid$personalspace!personalspace"))
+			Expect(drifts[0].GetMissing()).To(Equal(uint64(1)))
+			Expect(drifts[0].GetStale()).To(Equal(uint64(0)))
+			Expect(drifts[0].GetOrphaned()).To(Equal(uint64(1)))
+			Expect(drifts[0].GetRepaired()).To(BeFalse())
+		})
+
+		It("does not report resources changed or indexed after the verification started as orphans", func() {
+			gatewayClient.On("ListStorageSpaces", mock.Anything, mock.Anything).Return(&sprovider.ListStorageSpacesResponse{
+				Status:        status.NewOK(context.Background()),
+				StorageSpaces: []*sprovider.StorageSpace{personalSpace},
+			}, nil)
+			gatewayClient.On("Stat", mock.Anything, mock.Anything).Return(&sprovider.StatResponse{
+				Status: status.NewOK(context.Background()),
+				Info:   ri,
+			}, nil)
+			later := time.Now().Add(time.Minute).Format(time.RFC3339Nano)
+			indexClient.On("WalkSpace", "storageid$personalspace!personalspace", mock.Anything).Return(func(_ string, fn func(r search.Resource) error) error {
+				for _, r := range []search.Resource{
+					{ID: "storageid$personalspace!orphan", Document: content.Document{Mtime: "2020-01-01T00:00:00Z"}, Indexed: "2020-01-01T00:00:00Z"},
+					{ID: "storageid$personalspace!uploaded", Document: content.Document{Mtime: later}},
+					{ID: "storageid$personalspace!copied", Document: content.Document{Mtime: "2020-01-01T00:00:00Z"}, Indexed: later},
+				} {
+					if err := fn(r); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+
+			drifts, err := s.VerifyIndex(personalSpace.GetId().GetOpaqueId(), false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(drifts).To(HaveLen(1))
+			Expect(drifts[0].GetOrphaned()).To(Equal(uint64(1)))
+		})
+
+		It("returns the error when an orphan can't be purged", func() {
+			gatewayClient.On("ListStorageSpaces", mock.Anything, mock.Anything).Return(&sprovider.ListStorageSpacesResponse{
+				Status:        status.NewOK(context.Background()),
+				StorageSpaces: []*sprovider.StorageSpace{personalSpace},
+			}, nil)
+			gatewayClient.On("Stat", mock.Anything, mock.Anything).Return(&sprovider.StatResponse{
+				Status: status.NewOK(context.Background()),
+				Info:   ri,
+			}, nil)
+			gatewayClient.On("GetUserByClaim", mock.Anything, mock.Anything).Return(&userv1beta1.GetUserByClaimResponse{
+				Status: status.NewOK(context.Background()),
+				User:   user,
+			}, nil)
+			extractor.On("Extract", mock.Anything, mock.Anything, mock.Anything).Return(content.Document{}, nil)
+			batch := &engineMocks.BatchOperator{}
+			batch.On("Upsert", mock.Anything, mock.Anything).Return(nil)
+			batch.On("Push").Return(nil)
+			indexClient.On("NewBatch", mock.Anything).Return(batch, nil)
+			indexClient.On("WalkSpace", "storageid$personalspace!personalspace", mock.Anything).Return(func(_ string, fn func(r search.Resource) error) error {
+				return fn(search.Resource{ID: "storageid$personalspace!orphan"})
+			})
+			indexClient.On("Purge", "storageid$personalspace!orphan", false).Return(errors.New("purge failed"))
+
+			_, err := s.VerifyIndex(personalSpace.GetId().GetOpaqueId(), true)
+			Expect(err).To(MatchError(ContainSubstring("purge failed")))
+		})
+	})
+
+	Describe("IndexStats", func() {
+		It("returns the stats of the engine", func() {
+			indexClient.On("SpaceStats").Return([]search.SpaceStats{{RootID: "1$2!2", Documents: 3, Deleted: 1, Size: 42}}, nil)
+
+			stats, err := s.IndexStats()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stats).To(HaveLen(1))
+			Expect(stats[0].GetSpaceId()).To(Equal("1$2!2"))
+			Expect(stats[0].GetDocuments()).To(Equal(uint64(3)))
+			Expect(stats[0].GetDeleted()).To(Equal(uint64(1)))
+			Expect(stats[0].GetSize()).To(Equal(uint64(42)))
+		})
+	})
+
+	Describe("CompactIndex", func() {
+		It("fails for engines which can not be compacted", func() {
+			Expect(s.CompactIndex()).To(MatchError(ContainSubstring("not support")))
+		})
+	})
+
 	Describe("Search", func() {
 		It("fails when an empty query is given", func() {
 			res, err := s.Search(ctx, &searchsvc.SearchRequest{
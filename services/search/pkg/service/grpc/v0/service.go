@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"time"
 
 	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
@@ -153,6 +154,59 @@ func (s Service) IndexSpace(_ context.Context, in *searchsvc.IndexSpaceRequest,
 	return nil
 }
 
+// VerifyIndex compares the index with the storage and repairs the drift if requested.
+func (s Service) VerifyIndex(_ context.Context, in *searchsvc.VerifyIndexRequest, out *searchsvc.VerifyIndexResponse) error {
+	spaces, err := s.searcher.VerifyIndex(in.GetSpaceId(), in.GetRepair())
+	if err != nil {
+		return s.maintenanceError(err)
+	}
+
+	out.Spaces = spaces
+	return nil
+}
+
+// RebuildIndex rebuilds the index of all spaces without downtime.
+func (s Service) RebuildIndex(_ context.Context, _ *searchsvc.RebuildIndexRequest, out *searchsvc.RebuildIndexResponse) error {
+	documents, err := s.searcher.RebuildIndex()
+	if err != nil {
+		return s.maintenanceError(err)
+	}
+
+	out.Documents = documents
+	return nil
+}
+
+// CompactIndex compacts the index.
+func (s Service) CompactIndex(_ context.Context, _ *searchsvc.CompactIndexRequest, _ *searchsvc.CompactIndexResponse) error {
+	if err := s.searcher.CompactIndex(); err != nil {
+		return s.maintenanceError(err)
+	}
+
+	return nil
+}
+
+// IndexStats returns the number of documents and the size of the files per space.
+func (s Service) IndexStats(_ context.Context, _ *searchsvc.IndexStatsRequest, out *searchsvc.IndexStatsResponse) error {
+	spaces, err := s.searcher.IndexStats()
+	if err != nil {
+		return s.maintenanceError(err)
+	}
+
+	out.Spaces = spaces
+	return nil
+}
+
+func (s Service) maintenanceError(err error) error {
+	switch err.(type) {
+	case errtypes.NotSupported:
+		return merrors.New(s.id, err.Error(), http.StatusNotImplemented)
+	case errtypes.NotFound:
+		return merrors.NotFound(s.id, "%s", err.Error())
+	default:
+		return merrors.InternalServerError(s.id, "%s", err.Error())
+	}
+}
+
 // FromCache pulls a search result from cache
 func (s Service) FromCache(key string) (*searchsvc.SearchResponse, bool) {
 	v, err := s.cache.Get(key)
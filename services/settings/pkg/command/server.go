@@ -6,6 +6,7 @@ import (
 	"os/signal"
 
 	"github.com/opencloud-eu/opencloud/pkg/config/configlog"
+	"github.com/opencloud-eu/opencloud/pkg/generators"
 	"github.com/opencloud-eu/opencloud/pkg/runner"
 	ogrpc "github.com/opencloud-eu/opencloud/pkg/service/grpc"
 	"github.com/opencloud-eu/opencloud/pkg/tracing"
@@ -18,6 +19,8 @@ import (
 	"github.com/opencloud-eu/opencloud/services/settings/pkg/server/grpc"
 	"github.com/opencloud-eu/opencloud/services/settings/pkg/server/http"
 	svc "github.com/opencloud-eu/opencloud/services/settings/pkg/service/v0"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
+	"github.com/opencloud-eu/reva/v2/pkg/events/stream"
 	"github.com/urfave/cli/v2"
 )
 
@@ -53,7 +56,16 @@ func Server(cfg *config.Config) *cli.Command {
 			mtrcs := metrics.New()
 			mtrcs.BuildInfo.WithLabelValues(version.GetString()).Set(1)
 
-			handle := svc.NewDefaultLanguageService(cfg, svc.NewService(cfg, logger))
+			var publisher events.Stream
+			if cfg.Events.Endpoint != "" {
+				connName := generators.GenerateConnectionName(cfg.Service.Name, generators.NTypeBus)
+				publisher, err = stream.NatsFromConfig(connName, false, stream.NatsConfig(cfg.Events))
+				if err != nil {
+					return fmt.Errorf("could not initialize events publisher: %w", err)
+				}
+			}
+
+			handle := svc.NewDefaultLanguageService(cfg, svc.NewService(cfg, logger, publisher))
 
 			gr := runner.NewGroup()
 
@@ -47,6 +47,11 @@ func DefaultConfig() *config.Config {
 			Addr:      "127.0.0.1:9191",
 			Namespace: "eu.opencloud.api",
 		},
+		Events: config.Events{
+			Endpoint:  "127.0.0.1:9233",
+			Cluster:   "opencloud-cluster",
+			EnableTLS: false,
+		},
 		SetupDefaultAssignments: false,
 		Metadata: config.Metadata{
 			GatewayAddress: "eu.opencloud.api.storage-system",
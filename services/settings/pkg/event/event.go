@@ -0,0 +1,89 @@
+package event
+
+import (
+	"encoding/json"
+
+	user "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	types "github.com/cs3org/go-cs3apis/cs3/types/v1beta1"
+)
+
+// RoleAssigned is emitted when a role is assigned to a user. A user has exactly one role,
+// the assignment replaces the previous role.
+type RoleAssigned struct {
+	Executant      *user.UserId
+	UserID         string
+	RoleID         string
+	RoleName       string
+	PreviousRoleID string
+	Timestamp      *types.Timestamp
+}
+
+// Unmarshal to fulfill umarshaller interface
+func (RoleAssigned) Unmarshal(v []byte) (interface{}, error) {
+	e := RoleAssigned{}
+	err := json.Unmarshal(v, &e)
+	return e, err
+}
+
+// RoleUnassigned is emitted when a role assignment is removed
+type RoleUnassigned struct {
+	Executant    *user.UserId
+	AssignmentID string
+	UserID       string
+	RoleID       string
+	Timestamp    *types.Timestamp
+}
+
+// Unmarshal to fulfill umarshaller interface
+func (RoleUnassigned) Unmarshal(v []byte) (interface{}, error) {
+	e := RoleUnassigned{}
+	err := json.Unmarshal(v, &e)
+	return e, err
+}
+
+// RoleCreated is emitted when a custom role is created
+type RoleCreated struct {
+	Executant   *user.UserId
+	RoleID      string
+	RoleName    string
+	Permissions []string
+	Timestamp   *types.Timestamp
+}
+
+// Unmarshal to fulfill umarshaller interface
+func (RoleCreated) Unmarshal(v []byte) (interface{}, error) {
+	e := RoleCreated{}
+	err := json.Unmarshal(v, &e)
+	return e, err
+}
+
+// RoleUpdated is emitted when the permissions or the names of a custom role are changed
+type RoleUpdated struct {
+	Executant   *user.UserId
+	RoleID      string
+	RoleName    string
+	Permissions []string
+	Timestamp   *types.Timestamp
+}
+
+// Unmarshal to fulfill umarshaller interface
+func (RoleUpdated) Unmarshal(v []byte) (interface{}, error) {
+	e := RoleUpdated{}
+	err := json.Unmarshal(v, &e)
+	return e, err
+}
+
+// RoleDeleted is emitted when a custom role is deleted
+type RoleDeleted struct {
+	Executant *user.UserId
+	RoleID    string
+	RoleName  string
+	Timestamp *types.Timestamp
+}
+
+// Unmarshal to fulfill umarshaller interface
+func (RoleDeleted) Unmarshal(v []byte) (interface{}, error) {
+	e := RoleDeleted{}
+	err := json.Unmarshal(v, &e)
+	return e, err
+}
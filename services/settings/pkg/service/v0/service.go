@@ -7,8 +7,10 @@ import (
 	"fmt"
 	"strings"
 
+	userpb "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
 	cs3permissions "github.com/cs3org/go-cs3apis/cs3/permissions/v1beta1"
 	rpcv1beta1 "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	"github.com/google/uuid"
 	"github.com/leonelquinteros/gotext"
 	"github.com/opencloud-eu/opencloud/pkg/l10n"
 	"github.com/opencloud-eu/opencloud/pkg/log"
@@ -17,11 +19,14 @@ import (
 	settingsmsg "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/settings/v0"
 	settingssvc "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/settings/v0"
 	"github.com/opencloud-eu/opencloud/services/settings/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/settings/pkg/event"
 	"github.com/opencloud-eu/opencloud/services/settings/pkg/settings"
 	"github.com/opencloud-eu/opencloud/services/settings/pkg/store/defaults"
 	metastore "github.com/opencloud-eu/opencloud/services/settings/pkg/store/metadata"
 	ctxpkg "github.com/opencloud-eu/reva/v2/pkg/ctx"
+	"github.com/opencloud-eu/reva/v2/pkg/events"
 	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/status"
+	"github.com/opencloud-eu/reva/v2/pkg/utils"
 	merrors "go-micro.dev/v4/errors"
 	"go-micro.dev/v4/metadata"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -34,18 +39,20 @@ var _domain = "settings"
 
 // Service represents a service.
 type Service struct {
-	id      string
-	config  *config.Config
-	logger  log.Logger
-	manager settings.Manager
+	id        string
+	config    *config.Config
+	logger    log.Logger
+	manager   settings.Manager
+	publisher events.Publisher
 }
 
-// NewService returns a service implementation for Service.
-func NewService(cfg *config.Config, logger log.Logger) settings.ServiceHandler {
+// NewService returns a service implementation for Service. The publisher is optional.
+func NewService(cfg *config.Config, logger log.Logger, publisher events.Publisher) settings.ServiceHandler {
 	service := Service{
-		id:     "opencloud-settings",
-		config: cfg,
-		logger: logger,
+		id:        "opencloud-settings",
+		config:    cfg,
+		logger:    logger,
+		publisher: publisher,
 	}
 
 	service.manager = metastore.New(cfg)
@@ -415,11 +422,32 @@ func (g Service) AssignRoleToUser(ctx context.Context, req *settingssvc.AssignRo
 		return merrors.Forbidden(g.id, "user has no role management permission")
 	}
 
+	var previousRoleID string
+	if g.publisher != nil {
+		if previous, err := g.manager.ListRoleAssignments(req.GetAccountUuid()); err == nil && len(previous) > 0 {
+			previousRoleID = previous[0].GetRoleId()
+		}
+	}
+
 	r, err := g.manager.WriteRoleAssignment(req.GetAccountUuid(), req.GetRoleId())
 	if err != nil {
 		return merrors.BadRequest(g.id, "%s", err)
 	}
 	res.Assignment = r
+
+	if g.publisher != nil {
+		ev := event.RoleAssigned{
+			Executant:      &userpb.UserId{OpaqueId: ownAccountUUID},
+			UserID:         req.GetAccountUuid(),
+			RoleID:         req.GetRoleId(),
+			PreviousRoleID: previousRoleID,
+			Timestamp:      utils.TSNow(),
+		}
+		if bundle, err := g.manager.ReadBundle(req.GetRoleId()); err == nil {
+			ev.RoleName = bundle.GetName()
+		}
+		g.publishEvent(ctx, ev)
+	}
 	return nil
 }
 
@@ -452,9 +480,150 @@ func (g Service) RemoveRoleFromUser(ctx context.Context, req *settingssvc.Remove
 		}
 	}
 
+	// look up the assignment before it is gone to tell whose role was removed
+	ev := event.RoleUnassigned{
+		Executant:    &userpb.UserId{OpaqueId: ownAccountUUID},
+		AssignmentID: req.GetId(),
+	}
+	if g.publisher != nil {
+		if a, err := g.manager.ReadRoleAssignment(req.GetId()); err == nil {
+			ev.UserID = a.GetAccountUuid()
+			ev.RoleID = a.GetRoleId()
+		} else {
+			g.logger.Debug().Err(err).Str("assignmentid", req.GetId()).Msg("could not read the role assignment")
+		}
+	}
+
 	if err := g.manager.RemoveRoleAssignment(req.GetId()); err != nil {
 		return merrors.BadRequest(g.id, "%s", err)
 	}
+
+	ev.Timestamp = utils.TSNow()
+	g.publishEvent(ctx, ev)
+	return nil
+}
+
+// SaveRole implements the RoleServiceHandler interface. It creates or updates a custom role, the
+// permissions of the role are taken from the permission catalog.
+func (g Service) SaveRole(ctx context.Context, req *settingssvc.SaveRoleRequest, res *settingssvc.SaveRoleResponse) error {
+	if !g.canManageRoles(ctx) {
+		return merrors.Forbidden(g.id, "user has no role management permission")
+	}
+	if validationError := validateSaveRole(req); validationError != nil {
+		return merrors.BadRequest(g.id, "%s", validationError)
+	}
+
+	role := req.GetRole()
+	if g.isBuiltInRole(role.GetId()) {
+		return merrors.Forbidden(g.id, "built-in roles can't be changed")
+	}
+
+	created := role.GetId() == ""
+	if created {
+		role.Id = uuid.NewString()
+	} else {
+		existing, err := g.manager.ReadBundle(role.GetId())
+		switch {
+		case errors.Is(err, settings.ErrNotFound):
+			created = true
+		case err != nil:
+			return merrors.InternalServerError(g.id, "%s", err)
+		case existing.GetType() != settingsmsg.Bundle_TYPE_ROLE:
+			return merrors.BadRequest(g.id, "bundle '%s' is not a role", role.GetId())
+		}
+	}
+
+	permissions, err := rolePermissions(role.GetSettings())
+	if err != nil {
+		return merrors.BadRequest(g.id, "%s", err)
+	}
+
+	bundle, err := g.manager.WriteBundle(&settingsmsg.Bundle{
+		Id:          role.GetId(),
+		Name:        role.GetName(),
+		Type:        settingsmsg.Bundle_TYPE_ROLE,
+		Extension:   "opencloud-roles",
+		DisplayName: role.GetDisplayName(),
+		Settings:    permissions,
+		Resource: &settingsmsg.Resource{
+			Type: settingsmsg.Resource_TYPE_SYSTEM,
+		},
+	})
+	if err != nil {
+		return merrors.BadRequest(g.id, "%s", err)
+	}
+	res.Role = bundle
+
+	ownAccountUUID, _ := metadata.Get(ctx, middleware.AccountID)
+	permissionNames := make([]string, 0, len(permissions))
+	for _, p := range permissions {
+		permissionNames = append(permissionNames, p.GetName())
+	}
+	if created {
+		g.publishEvent(ctx, event.RoleCreated{
+			Executant:   &userpb.UserId{OpaqueId: ownAccountUUID},
+			RoleID:      bundle.GetId(),
+			RoleName:    bundle.GetName(),
+			Permissions: permissionNames,
+			Timestamp:   utils.TSNow(),
+		})
+	} else {
+		g.publishEvent(ctx, event.RoleUpdated{
+			Executant:   &userpb.UserId{OpaqueId: ownAccountUUID},
+			RoleID:      bundle.GetId(),
+			RoleName:    bundle.GetName(),
+			Permissions: permissionNames,
+			Timestamp:   utils.TSNow(),
+		})
+	}
+	return nil
+}
+
+// DeleteRole implements the RoleServiceHandler interface. Only custom roles which are not assigned
+// to any user can be deleted.
+func (g Service) DeleteRole(ctx context.Context, req *settingssvc.DeleteRoleRequest, _ *emptypb.Empty) error {
+	if !g.canManageRoles(ctx) {
+		return merrors.Forbidden(g.id, "user has no role management permission")
+	}
+	if validationError := validateDeleteRole(req); validationError != nil {
+		return merrors.BadRequest(g.id, "%s", validationError)
+	}
+	if g.isBuiltInRole(req.GetRoleId()) {
+		return merrors.Forbidden(g.id, "built-in roles can't be deleted")
+	}
+
+	bundle, err := g.manager.ReadBundle(req.GetRoleId())
+	switch {
+	case errors.Is(err, settings.ErrNotFound):
+		return merrors.NotFound(g.id, "%s", err)
+	case err != nil:
+		return merrors.InternalServerError(g.id, "%s", err)
+	case bundle.GetType() != settingsmsg.Bundle_TYPE_ROLE:
+		return merrors.BadRequest(g.id, "bundle '%s' is not a role", req.GetRoleId())
+	}
+
+	assignments, err := g.manager.ListRoleAssignmentsByRole(req.GetRoleId())
+	if err != nil {
+		return merrors.InternalServerError(g.id, "%s", err)
+	}
+	if len(assignments) > 0 {
+		return merrors.Conflict(g.id, "role '%s' is still assigned to %d users", req.GetRoleId(), len(assignments))
+	}
+
+	if err := g.manager.DeleteBundle(req.GetRoleId()); err != nil {
+		if errors.Is(err, settings.ErrNotFound) {
+			return merrors.NotFound(g.id, "%s", err)
+		}
+		return merrors.InternalServerError(g.id, "%s", err)
+	}
+
+	ownAccountUUID, _ := metadata.Get(ctx, middleware.AccountID)
+	g.publishEvent(ctx, event.RoleDeleted{
+		Executant: &userpb.UserId{OpaqueId: ownAccountUUID},
+		RoleID:    bundle.GetId(),
+		RoleName:  bundle.GetName(),
+		Timestamp: utils.TSNow(),
+	})
 	return nil
 }
 
@@ -536,6 +705,39 @@ func (g Service) GetPermissionByID(ctx context.Context, req *settingssvc.GetPerm
 	return nil
 }
 
+// ListAvailablePermissions implements the PermissionServiceHandler interface
+func (g Service) ListAvailablePermissions(ctx context.Context, _ *settingssvc.ListAvailablePermissionsRequest, res *settingssvc.ListAvailablePermissionsResponse) error {
+	if !g.canManageRoles(ctx) {
+		return merrors.Forbidden(g.id, "user has no role management permission")
+	}
+	res.Permissions = defaults.Permissions()
+	return nil
+}
+
+// rolePermissions resolves the requested settings to the permissions of the catalog. The constraint
+// of a requested setting replaces the default constraint of the permission.
+func rolePermissions(requested []*settingsmsg.Setting) ([]*settingsmsg.Setting, error) {
+	catalog := make(map[string]*settingsmsg.Setting)
+	for _, p := range defaults.Permissions() {
+		catalog[p.GetId()] = p
+	}
+
+	permissions := make([]*settingsmsg.Setting, 0, len(requested))
+	for _, r := range requested {
+		p, ok := catalog[r.GetId()]
+		if !ok {
+			return nil, fmt.Errorf("unknown permission '%s'", r.GetId())
+		}
+		if c := r.GetPermissionValue().GetConstraint(); c != settingsmsg.Permission_CONSTRAINT_UNKNOWN {
+			p.GetPermissionValue().Constraint = c
+		}
+		permissions = append(permissions, p)
+		// the same permission must only be added once
+		delete(catalog, r.GetId())
+	}
+	return permissions, nil
+}
+
 // cleanUpResource makes sure that the account uuid of the authenticated user is injected if needed.
 func cleanUpResource(ctx context.Context, resource *settingsmsg.Resource) {
 	if resource != nil && resource.GetType() == settingsmsg.Resource_TYPE_USER {
@@ -659,6 +861,29 @@ func (g Service) isCurrentUser(ctx context.Context, accountID string) bool {
 	return accountID == ownAccountID
 }
 
+// publishEvent publishes the event if an events publisher is configured
+func (g Service) publishEvent(ctx context.Context, ev interface{}) {
+	if g.publisher == nil {
+		return
+	}
+	if err := events.Publish(ctx, g.publisher, ev); err != nil {
+		g.logger.Error().Err(err).Msg("could not publish event")
+	}
+}
+
+// isBuiltInRole checks if the role is one of the configured roles, they can't be changed with the api
+func (g Service) isBuiltInRole(roleID string) bool {
+	if roleID == defaults.BundleUUIDServiceAccount {
+		return true
+	}
+	for _, b := range g.config.Bundles {
+		if b.GetType() == settingsmsg.Bundle_TYPE_ROLE && b.GetId() == roleID {
+			return true
+		}
+	}
+	return false
+}
+
 func (g Service) canManageRoles(ctx context.Context) bool {
 	return g.hasStaticPermission(ctx, RoleManagementPermissionID)
 }
@@ -708,7 +933,10 @@ func translateBundle(bundle *settingsmsg.Bundle, t *gotext.Locale) *settingsmsg.
 			defaults.SettingUUIDProfileEventSpaceUnshared,
 			defaults.SettingUUIDProfileEventSpaceMembershipExpired,
 			defaults.SettingUUIDProfileEventSpaceDisabled,
-			defaults.SettingUUIDProfileEventSpaceDeleted:
+			defaults.SettingUUIDProfileEventSpaceDeleted,
+			defaults.SettingUUIDProfileNotificationChannels,
+			defaults.SettingUUIDProfileNotificationMatrixRoom,
+			defaults.SettingUUIDProfileNotificationPushTarget:
 			// translate event names ('Share Received', 'Share Removed', ...)
 			set.DisplayName = t.Get(set.GetDisplayName(), []interface{}{}...)
 			// translate event descriptions ('Notify me when I receive a share', ...)
@@ -8,8 +8,11 @@ import (
 	"github.com/opencloud-eu/opencloud/pkg/middleware"
 	settingsmsg "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/settings/v0"
 	v0 "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/settings/v0"
+	"github.com/opencloud-eu/opencloud/services/settings/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/settings/pkg/event"
 	"github.com/opencloud-eu/opencloud/services/settings/pkg/settings/mocks"
 	"github.com/opencloud-eu/opencloud/services/settings/pkg/store/defaults"
+	eventsmocks "github.com/opencloud-eu/reva/v2/pkg/events/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	merrors "go-micro.dev/v4/errors"
@@ -140,6 +143,81 @@ func TestEditOwnRoleAssignment(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestAssignRoleToUserPublishesEvent(t *testing.T) {
+	manager := &mocks.Manager{}
+	publisher := &eventsmocks.Stream{}
+	svc := Service{
+		manager:   manager,
+		publisher: publisher,
+	}
+	a := []*settingsmsg.UserRoleAssignment{
+		{
+			Id:          "00000000-0000-0000-0000-000000000001",
+			AccountUuid: "00000000-0000-0000-0000-000000000000",
+			RoleId:      defaults.BundleUUIDRoleUser,
+		},
+	}
+	editRolePermission := &settingsmsg.Permission{
+		Operation:  settingsmsg.Permission_OPERATION_READWRITE,
+		Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
+	}
+	manager.On("ListRoleAssignments", "61445573-4dbe-4d56-88dc-88ab47aceba7").Return(a, nil)
+	manager.On("ListRoleAssignments", "00000000-0000-0000-0000-000000000000").Return(a, nil)
+	manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(editRolePermission, nil)
+	manager.On("WriteRoleAssignment", mock.Anything, mock.Anything).Return(nil, nil)
+	manager.On("ReadBundle", defaults.BundleUUIDRoleAdmin).Return(&settingsmsg.Bundle{Name: "admin"}, nil)
+	publisher.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	req := v0.AssignRoleToUserRequest{
+		AccountUuid: "00000000-0000-0000-0000-000000000000",
+		RoleId:      defaults.BundleUUIDRoleAdmin,
+	}
+	res := v0.AssignRoleToUserResponse{}
+	assert.Nil(t, svc.AssignRoleToUser(ctxWithUUID, &req, &res))
+
+	publisher.AssertCalled(t, "Publish", mock.Anything, mock.MatchedBy(func(ev event.RoleAssigned) bool {
+		return ev.Executant.GetOpaqueId() == "61445573-4dbe-4d56-88dc-88ab47aceba7" &&
+			ev.UserID == "00000000-0000-0000-0000-000000000000" &&
+			ev.RoleID == defaults.BundleUUIDRoleAdmin &&
+			ev.RoleName == "admin" &&
+			ev.PreviousRoleID == defaults.BundleUUIDRoleUser
+	}), mock.Anything)
+}
+
+func TestRemoveRoleFromUserPublishesEvent(t *testing.T) {
+	manager := &mocks.Manager{}
+	publisher := &eventsmocks.Stream{}
+	svc := Service{
+		manager:   manager,
+		publisher: publisher,
+	}
+	editRolePermission := &settingsmsg.Permission{
+		Operation:  settingsmsg.Permission_OPERATION_READWRITE,
+		Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
+	}
+	manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(editRolePermission, nil)
+	manager.On("ListRoleAssignments", mock.Anything).Return(nil, nil)
+	manager.On("ReadRoleAssignment", "00000000-0000-0000-0000-000000000002").Return(&settingsmsg.UserRoleAssignment{
+		Id:          "00000000-0000-0000-0000-000000000002",
+		AccountUuid: "00000000-0000-0000-0000-000000000000",
+		RoleId:      defaults.BundleUUIDRoleAdmin,
+	}, nil)
+	manager.On("RemoveRoleAssignment", "00000000-0000-0000-0000-000000000002").Return(nil)
+	publisher.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	req := v0.RemoveRoleFromUserRequest{
+		Id: "00000000-0000-0000-0000-000000000002",
+	}
+	assert.Nil(t, svc.RemoveRoleFromUser(ctxWithUUID, &req, nil))
+
+	publisher.AssertCalled(t, "Publish", mock.Anything, mock.MatchedBy(func(ev event.RoleUnassigned) bool {
+		return ev.Executant.GetOpaqueId() == "61445573-4dbe-4d56-88dc-88ab47aceba7" &&
+			ev.AssignmentID == "00000000-0000-0000-0000-000000000002" &&
+			ev.UserID == "00000000-0000-0000-0000-000000000000" &&
+			ev.RoleID == defaults.BundleUUIDRoleAdmin
+	}), mock.Anything)
+}
+
 func TestRemoveOwnRoleAssignment(t *testing.T) {
 	manager := &mocks.Manager{}
 	a := []*settingsmsg.UserRoleAssignment{
@@ -459,3 +537,150 @@ func TestListRoleAssignmentsFilteredByRole(t *testing.T) {
 		})
 	}
 }
+
+func TestSaveRole(t *testing.T) {
+	editRolePermission := &settingsmsg.Permission{
+		Operation:  settingsmsg.Permission_OPERATION_READWRITE,
+		Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
+	}
+	helpdesk := func() *settingsmsg.Bundle {
+		return &settingsmsg.Bundle{
+			Name:        "helpdesk",
+			DisplayName: "Helpdesk",
+			Settings: []*settingsmsg.Setting{
+				{Id: ResetUserPasswordPermissionID},
+				{Id: ListAllSpacesPermissionID},
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		role   func() *settingsmsg.Bundle
+		status int32
+	}{
+		"create helpdesk role": {
+			role: helpdesk,
+		},
+		"built-in role": {
+			role: func() *settingsmsg.Bundle {
+				b := helpdesk()
+				b.Id = defaults.BundleUUIDRoleAdmin
+				return b
+			},
+			status: http.StatusForbidden,
+		},
+		"unknown permission": {
+			role: func() *settingsmsg.Bundle {
+				b := helpdesk()
+				b.Settings = append(b.Settings, &settingsmsg.Setting{Id: "00000000-0000-0000-0000-000000000000"})
+				return b
+			},
+			status: http.StatusBadRequest,
+		},
+		"missing name": {
+			role: func() *settingsmsg.Bundle {
+				b := helpdesk()
+				b.Name = ""
+				return b
+			},
+			status: http.StatusBadRequest,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			manager := &mocks.Manager{}
+			publisher := &eventsmocks.Stream{}
+			svc := Service{
+				config:    &config.Config{Bundles: defaults.GenerateBundlesDefaultRoles()},
+				manager:   manager,
+				publisher: publisher,
+			}
+			manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(editRolePermission, nil)
+			manager.On("ListRoleAssignments", mock.Anything).Return(nil, nil)
+			manager.On("WriteBundle", mock.Anything).Return(func(b *settingsmsg.Bundle) *settingsmsg.Bundle { return b }, nil)
+			publisher.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+			res := v0.SaveRoleResponse{}
+			err := svc.SaveRole(ctxWithUUID, &v0.SaveRoleRequest{Role: test.role()}, &res)
+			if test.status != 0 {
+				merr, ok := merrors.As(err)
+				assert.True(t, ok)
+				assert.Equal(t, test.status, merr.Code)
+				manager.AssertNotCalled(t, "WriteBundle", mock.Anything)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.NotEmpty(t, res.GetRole().GetId())
+			assert.Equal(t, settingsmsg.Bundle_TYPE_ROLE, res.GetRole().GetType())
+			assert.Len(t, res.GetRole().GetSettings(), 2)
+			assert.Equal(t, "Accounts.ResetPassword", res.GetRole().GetSettings()[0].GetName())
+			assert.Equal(t, settingsmsg.Permission_CONSTRAINT_ALL, res.GetRole().GetSettings()[0].GetPermissionValue().GetConstraint())
+			publisher.AssertCalled(t, "Publish", mock.Anything, mock.MatchedBy(func(ev event.RoleCreated) bool {
+				return ev.RoleName == "helpdesk" && len(ev.Permissions) == 2
+			}), mock.Anything)
+		})
+	}
+}
+
+func TestDeleteRole(t *testing.T) {
+	const roleID = "7b3a3c0e-4b62-4a4d-8f8b-3d5b0b0e2f7a"
+	editRolePermission := &settingsmsg.Permission{
+		Operation:  settingsmsg.Permission_OPERATION_READWRITE,
+		Constraint: settingsmsg.Permission_CONSTRAINT_ALL,
+	}
+
+	tests := map[string]struct {
+		roleID      string
+		assignments []*settingsmsg.UserRoleAssignment
+		status      int32
+	}{
+		"delete custom role": {
+			roleID: roleID,
+		},
+		"built-in role": {
+			roleID: defaults.BundleUUIDRoleUser,
+			status: http.StatusForbidden,
+		},
+		"role is still assigned": {
+			roleID: roleID,
+			assignments: []*settingsmsg.UserRoleAssignment{
+				{Id: "00000000-0000-0000-0000-000000000001", AccountUuid: "aceb15b8-7486-479f-ae32-c91118e07a39", RoleId: roleID},
+			},
+			status: http.StatusConflict,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			manager := &mocks.Manager{}
+			publisher := &eventsmocks.Stream{}
+			svc := Service{
+				config:    &config.Config{Bundles: defaults.GenerateBundlesDefaultRoles()},
+				manager:   manager,
+				publisher: publisher,
+			}
+			manager.On("ReadPermissionByID", mock.Anything, mock.Anything).Return(editRolePermission, nil)
+			manager.On("ListRoleAssignments", mock.Anything).Return(nil, nil)
+			manager.On("ReadBundle", roleID).Return(&settingsmsg.Bundle{Id: roleID, Name: "helpdesk", Type: settingsmsg.Bundle_TYPE_ROLE}, nil)
+			manager.On("ListRoleAssignmentsByRole", roleID).Return(test.assignments, nil)
+			manager.On("DeleteBundle", roleID).Return(nil)
+			publisher.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+			err := svc.DeleteRole(ctxWithUUID, &v0.DeleteRoleRequest{RoleId: test.roleID}, nil)
+			if test.status != 0 {
+				merr, ok := merrors.As(err)
+				assert.True(t, ok)
+				assert.Equal(t, test.status, merr.Code)
+				manager.AssertNotCalled(t, "DeleteBundle", mock.Anything)
+				return
+			}
+
+			assert.Nil(t, err)
+			publisher.AssertCalled(t, "Publish", mock.Anything, mock.MatchedBy(func(ev event.RoleDeleted) bool {
+				return ev.RoleID == roleID && ev.RoleName == "helpdesk"
+			}), mock.Anything)
+		})
+	}
+}
@@ -45,6 +45,10 @@ const (
 	AccountManagementPermissionID string = "8e587774-d929-4215-910b-a317b1e80f73"
 	// AccountManagementPermissionName is the hardcoded setting name for the account management permission
 	AccountManagementPermissionName string = "account-management"
+	// ResetUserPasswordPermissionID is the hardcoded setting UUID for the reset user password permission
+	ResetUserPasswordPermissionID string = "1f36a3a1-72cf-4d22-a052-decaa4b1393f"
+	// ResetUserPasswordPermissionName is the hardcoded setting name for the reset user password permission
+	ResetUserPasswordPermissionName string = "reset-user-password"
 	// GroupManagementPermissionID is the hardcoded setting UUID for the group management permission
 	GroupManagementPermissionID string = "522adfbe-5908-45b4-b135-41979de73245"
 	// GroupManagementPermissionName is the hardcoded setting name for the group management permission
@@ -192,6 +192,26 @@ func validateRemoveRoleFromUser(req *settingssvc.RemoveRoleFromUserRequest) erro
 	)
 }
 
+func validateSaveRole(req *settingssvc.SaveRoleRequest) error {
+	if req.Role == nil {
+		return errors.New("role must not be blank")
+	}
+	return validation.ValidateStruct(
+		req.Role,
+		validation.Field(&req.Role.Id, validation.When(req.Role.Id != "", is.UUID)),
+		validation.Field(&req.Role.Name, requireAlphanumeric...),
+		validation.Field(&req.Role.DisplayName, validation.Required),
+		validation.Field(&req.Role.Settings, validation.Required),
+	)
+}
+
+func validateDeleteRole(req *settingssvc.DeleteRoleRequest) error {
+	return validation.ValidateStruct(
+		req,
+		validation.Field(&req.RoleId, validation.Required, is.UUID),
+	)
+}
+
 func validateListPermissionsByResource(req *settingssvc.ListPermissionsByResourceRequest) error {
 	return validateResource(req.Resource)
 }
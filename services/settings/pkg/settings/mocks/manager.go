@@ -104,6 +104,57 @@ func (_c *Manager_AddSettingToBundle_Call) RunAndReturn(run func(bundleID string
 	return _c
 }
 
+// DeleteBundle provides a mock function for the type Manager
+func (_mock *Manager) DeleteBundle(bundleID string) error {
+	ret := _mock.Called(bundleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBundle")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(bundleID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Manager_DeleteBundle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBundle'
+type Manager_DeleteBundle_Call struct {
+	*mock.Call
+}
+
+// DeleteBundle is a helper method to define mock.On call
+//   - bundleID string
+func (_e *Manager_Expecter) DeleteBundle(bundleID interface{}) *Manager_DeleteBundle_Call {
+	return &Manager_DeleteBundle_Call{Call: _e.mock.On("DeleteBundle", bundleID)}
+}
+
+func (_c *Manager_DeleteBundle_Call) Run(run func(bundleID string)) *Manager_DeleteBundle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *Manager_DeleteBundle_Call) Return(err error) *Manager_DeleteBundle_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Manager_DeleteBundle_Call) RunAndReturn(run func(bundleID string) error) *Manager_DeleteBundle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListBundles provides a mock function for the type Manager
 func (_mock *Manager) ListBundles(bundleType v0.Bundle_Type, bundleIDs []string) ([]*v0.Bundle, error) {
 	ret := _mock.Called(bundleType, bundleIDs)
@@ -630,6 +681,68 @@ func (_c *Manager_ReadPermissionByName_Call) RunAndReturn(run func(name string,
 	return _c
 }
 
+// ReadRoleAssignment provides a mock function for the type Manager
+func (_mock *Manager) ReadRoleAssignment(assignmentID string) (*v0.UserRoleAssignment, error) {
+	ret := _mock.Called(assignmentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadRoleAssignment")
+	}
+
+	var r0 *v0.UserRoleAssignment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*v0.UserRoleAssignment, error)); ok {
+		return returnFunc(assignmentID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *v0.UserRoleAssignment); ok {
+		r0 = returnFunc(assignmentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v0.UserRoleAssignment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(assignmentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Manager_ReadRoleAssignment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadRoleAssignment'
+type Manager_ReadRoleAssignment_Call struct {
+	*mock.Call
+}
+
+// ReadRoleAssignment is a helper method to define mock.On call
+//   - assignmentID string
+func (_e *Manager_Expecter) ReadRoleAssignment(assignmentID interface{}) *Manager_ReadRoleAssignment_Call {
+	return &Manager_ReadRoleAssignment_Call{Call: _e.mock.On("ReadRoleAssignment", assignmentID)}
+}
+
+func (_c *Manager_ReadRoleAssignment_Call) Run(run func(assignmentID string)) *Manager_ReadRoleAssignment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *Manager_ReadRoleAssignment_Call) Return(userRoleAssignment *v0.UserRoleAssignment, err error) *Manager_ReadRoleAssignment_Call {
+	_c.Call.Return(userRoleAssignment, err)
+	return _c
+}
+
+func (_c *Manager_ReadRoleAssignment_Call) RunAndReturn(run func(assignmentID string) (*v0.UserRoleAssignment, error)) *Manager_ReadRoleAssignment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReadSetting provides a mock function for the type Manager
 func (_mock *Manager) ReadSetting(settingID string) (*v0.Setting, error) {
 	ret := _mock.Called(settingID)
@@ -43,6 +43,7 @@ type BundleManager interface {
 	ListBundles(bundleType settingsmsg.Bundle_Type, bundleIDs []string) ([]*settingsmsg.Bundle, error)
 	ReadBundle(bundleID string) (*settingsmsg.Bundle, error)
 	WriteBundle(bundle *settingsmsg.Bundle) (*settingsmsg.Bundle, error)
+	DeleteBundle(bundleID string) error
 	ReadSetting(settingID string) (*settingsmsg.Setting, error)
 	AddSettingToBundle(bundleID string, setting *settingsmsg.Setting) (*settingsmsg.Setting, error)
 	RemoveSettingFromBundle(bundleID, settingID string) error
@@ -61,6 +62,7 @@ type RoleAssignmentManager interface {
 	ListRoleAssignments(accountUUID string) ([]*settingsmsg.UserRoleAssignment, error)
 	ListRoleAssignmentsByRole(roleID string) ([]*settingsmsg.UserRoleAssignment, error)
 	WriteRoleAssignment(accountUUID, roleID string) (*settingsmsg.UserRoleAssignment, error)
+	ReadRoleAssignment(assignmentID string) (*settingsmsg.UserRoleAssignment, error)
 	RemoveRoleAssignment(assignmentID string) error
 }
 
@@ -45,6 +45,12 @@ const (
 	SettingUUIDProfileEventSpaceDeleted = "094ceca9-5a00-40ba-bb1a-bbc7bccd39ee"
 	// SettingUUIDProfileEventPostprocessingStepFinished is the hardcoded setting UUID for the send in mail setting
 	SettingUUIDProfileEventPostprocessingStepFinished = "fe0a3011-d886-49c8-b797-33d02fa426ef"
+	// SettingUUIDProfileNotificationChannels is the hardcoded setting UUID for the notification channels setting
+	SettingUUIDProfileNotificationChannels = "650a7ea2-4b33-45b7-9a38-8514cc37230a"
+	// SettingUUIDProfileNotificationMatrixRoom is the hardcoded setting UUID for the matrix room setting
+	SettingUUIDProfileNotificationMatrixRoom = "3b9a1c64-6f0d-4b8e-9d5e-2a7c4f1e8b20"
+	// SettingUUIDProfileNotificationPushTarget is the hardcoded setting UUID for the push topic or token setting
+	SettingUUIDProfileNotificationPushTarget = "c5e0d7f2-8a41-4e6b-b3f9-61d2a0e4c7a8"
 )
 
 // GenerateBundlesDefaultRoles bootstraps the default roles.
@@ -121,6 +127,9 @@ func generateBundleAdminRole() *settingsmsg.Bundle {
 			DeleteReadOnlyPublicLinkPasswordPermission(All),
 			DisableEmailNotificationsPermission(Own),
 			ProfileEmailSendingIntervalPermission(Own),
+			ProfileNotificationChannelsPermission(Own),
+			ProfileNotificationMatrixRoomPermission(Own),
+			ProfileNotificationPushTargetPermission(Own),
 			ProfileEventShareCreatedPermission(Own),
 			ProfileEventShareRemovedPermission(Own),
 			ProfileEventShareExpiredPermission(Own),
@@ -135,6 +144,7 @@ func generateBundleAdminRole() *settingsmsg.Bundle {
 			ListFavoritesPermission(Own),
 			ListSpacesPermission(All),
 			ManageSpacePropertiesPermission(All),
+			ResetUserPasswordPermission(All),
 			RoleManagementPermission(All),
 			SetPersonalSpaceQuotaPermission(All),
 			SetProjectSpaceQuotaPermission(All),
@@ -165,6 +175,9 @@ func generateBundleSpaceAdminRole() *settingsmsg.Bundle {
 			DeleteReadOnlyPublicLinkPasswordPermission(All),
 			DisableEmailNotificationsPermission(Own),
 			ProfileEmailSendingIntervalPermission(Own),
+			ProfileNotificationChannelsPermission(Own),
+			ProfileNotificationMatrixRoomPermission(Own),
+			ProfileNotificationPushTargetPermission(Own),
 			ProfileEventShareCreatedPermission(Own),
 			ProfileEventShareRemovedPermission(Own),
 			ProfileEventShareExpiredPermission(Own),
@@ -203,6 +216,9 @@ func generateBundleUserRole() *settingsmsg.Bundle {
 			CreateSpacesPermission(Own),
 			DisableEmailNotificationsPermission(Own),
 			ProfileEmailSendingIntervalPermission(Own),
+			ProfileNotificationChannelsPermission(Own),
+			ProfileNotificationMatrixRoomPermission(Own),
+			ProfileNotificationPushTargetPermission(Own),
 			ProfileEventShareCreatedPermission(Own),
 			ProfileEventShareRemovedPermission(Own),
 			ProfileEventShareExpiredPermission(Own),
@@ -234,6 +250,9 @@ func generateBundleUserLightRole() *settingsmsg.Bundle {
 			AutoAcceptSharesPermission(Own),
 			DisableEmailNotificationsPermission(Own),
 			ProfileEmailSendingIntervalPermission(Own),
+			ProfileNotificationChannelsPermission(Own),
+			ProfileNotificationMatrixRoomPermission(Own),
+			ProfileNotificationPushTargetPermission(Own),
 			LanguageManagementPermission(Own),
 		},
 	}
@@ -290,6 +309,45 @@ func generateBundleProfileRequest() *settingsmsg.Bundle {
 				},
 				Value: &sendEmailOptions,
 			},
+			{
+				Id:          SettingUUIDProfileNotificationChannels,
+				Name:        "notification-channels-options",
+				DisplayName: TemplateNotificationChannels,
+				Description: TemplateNotificationChannelsDescription,
+				Resource: &settingsmsg.Resource{
+					Type: settingsmsg.Resource_TYPE_USER,
+				},
+				Value: &settingsmsg.Setting_MultiChoiceCollectionValue{
+					MultiChoiceCollectionValue: &settingsmsg.MultiChoiceCollection{
+						Options: []*settingsmsg.MultiChoiceCollectionOption{
+							&optionMailTrue,
+							&optionWebhookFalse,
+							&optionMatrixFalse,
+							&optionPushFalse,
+						},
+					},
+				},
+			},
+			{
+				Id:          SettingUUIDProfileNotificationMatrixRoom,
+				Name:        "notification-matrix-room",
+				DisplayName: TemplateNotificationMatrixRoom,
+				Description: TemplateNotificationMatrixRoomDescription,
+				Resource: &settingsmsg.Resource{
+					Type: settingsmsg.Resource_TYPE_USER,
+				},
+				Value: &settingsmsg.Setting_StringValue{StringValue: &settingsmsg.String{Placeholder: "!room:example.org"}},
+			},
+			{
+				Id:          SettingUUIDProfileNotificationPushTarget,
+				Name:        "notification-push-target",
+				DisplayName: TemplateNotificationPushTarget,
+				Description: TemplateNotificationPushTargetDescription,
+				Resource: &settingsmsg.Resource{
+					Type: settingsmsg.Resource_TYPE_USER,
+				},
+				Value: &settingsmsg.Setting_StringValue{StringValue: &settingsmsg.String{}},
+			},
 			{
 				Id:          SettingUUIDProfileEventShareCreated,
 				Name:        "event-share-created-options",
@@ -524,6 +582,42 @@ var optionMailFalseDisabled = settingsmsg.MultiChoiceCollectionOption{
 	},
 }
 
+var optionWebhookFalse = settingsmsg.MultiChoiceCollectionOption{
+	Key:          "webhook",
+	DisplayValue: "Webhook",
+	Value: &settingsmsg.MultiChoiceCollectionOptionValue{
+		Option: &settingsmsg.MultiChoiceCollectionOptionValue_BoolValue{
+			BoolValue: &settingsmsg.Bool{
+				Default: false,
+			},
+		},
+	},
+}
+
+var optionMatrixFalse = settingsmsg.MultiChoiceCollectionOption{
+	Key:          "matrix",
+	DisplayValue: "Matrix",
+	Value: &settingsmsg.MultiChoiceCollectionOptionValue{
+		Option: &settingsmsg.MultiChoiceCollectionOptionValue_BoolValue{
+			BoolValue: &settingsmsg.Bool{
+				Default: false,
+			},
+		},
+	},
+}
+
+var optionPushFalse = settingsmsg.MultiChoiceCollectionOption{
+	Key:          "push",
+	DisplayValue: "Push",
+	Value: &settingsmsg.MultiChoiceCollectionOptionValue{
+		Option: &settingsmsg.MultiChoiceCollectionOptionValue_BoolValue{
+			BoolValue: &settingsmsg.Bool{
+				Default: false,
+			},
+		},
+	},
+}
+
 // TODO: languageSetting needed?
 var languageSetting = settingsmsg.Setting_SingleChoiceValue{
 	SingleChoiceValue: &settingsmsg.SingleChoiceList{
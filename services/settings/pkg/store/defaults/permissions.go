@@ -219,6 +219,63 @@ func ProfileEmailSendingIntervalPermission(c settingsmsg.Permission_Constraint)
 	}
 }
 
+// ProfileNotificationChannelsPermission is the permission to choose the notification channels
+func ProfileNotificationChannelsPermission(c settingsmsg.Permission_Constraint) *settingsmsg.Setting {
+	return &settingsmsg.Setting{
+		Id:          "0cc330ac-30f7-4e12-8562-ffbf92932d81",
+		Name:        "NotificationChannels.ReadWrite",
+		DisplayName: "Notification Channels",
+		Resource: &settingsmsg.Resource{
+			Type: settingsmsg.Resource_TYPE_SETTING,
+			Id:   SettingUUIDProfileNotificationChannels,
+		},
+		Value: &settingsmsg.Setting_PermissionValue{
+			PermissionValue: &settingsmsg.Permission{
+				Operation:  settingsmsg.Permission_OPERATION_READWRITE,
+				Constraint: c,
+			},
+		},
+	}
+}
+
+// ProfileNotificationMatrixRoomPermission is the permission to set the matrix room for notifications
+func ProfileNotificationMatrixRoomPermission(c settingsmsg.Permission_Constraint) *settingsmsg.Setting {
+	return &settingsmsg.Setting{
+		Id:          "9e4f2b71-05c3-4d8a-a6e2-7b1c3d9f0e54",
+		Name:        "NotificationMatrixRoom.ReadWrite",
+		DisplayName: "Notification Matrix Room",
+		Resource: &settingsmsg.Resource{
+			Type: settingsmsg.Resource_TYPE_SETTING,
+			Id:   SettingUUIDProfileNotificationMatrixRoom,
+		},
+		Value: &settingsmsg.Setting_PermissionValue{
+			PermissionValue: &settingsmsg.Permission{
+				Operation:  settingsmsg.Permission_OPERATION_READWRITE,
+				Constraint: c,
+			},
+		},
+	}
+}
+
+// ProfileNotificationPushTargetPermission is the permission to set the push topic or token for notifications
+func ProfileNotificationPushTargetPermission(c settingsmsg.Permission_Constraint) *settingsmsg.Setting {
+	return &settingsmsg.Setting{
+		Id:          "2d7a8c03-b9e1-4f56-8a0d-c4e3f5b6a917",
+		Name:        "NotificationPushTarget.ReadWrite",
+		DisplayName: "Notification Push Target",
+		Resource: &settingsmsg.Resource{
+			Type: settingsmsg.Resource_TYPE_SETTING,
+			Id:   SettingUUIDProfileNotificationPushTarget,
+		},
+		Value: &settingsmsg.Setting_PermissionValue{
+			PermissionValue: &settingsmsg.Permission{
+				Operation:  settingsmsg.Permission_OPERATION_READWRITE,
+				Constraint: c,
+			},
+		},
+	}
+}
+
 // ProfileEventShareCreatedPermission is
 func ProfileEventShareCreatedPermission(c settingsmsg.Permission_Constraint) *settingsmsg.Setting {
 	return &settingsmsg.Setting{
@@ -486,6 +543,26 @@ func ManageSpacePropertiesPermission(c settingsmsg.Permission_Constraint) *setti
 	}
 }
 
+// ResetUserPasswordPermission is the permission to reset the password of other users
+func ResetUserPasswordPermission(c settingsmsg.Permission_Constraint) *settingsmsg.Setting {
+	return &settingsmsg.Setting{
+		Id:          "1f36a3a1-72cf-4d22-a052-decaa4b1393f",
+		Name:        "Accounts.ResetPassword",
+		DisplayName: "Reset Passwords",
+		Description: "This permission allows resetting the password of other users without giving access to the rest of the account management.",
+		Resource: &settingsmsg.Resource{
+			Type: settingsmsg.Resource_TYPE_USER,
+			Id:   "all",
+		},
+		Value: &settingsmsg.Setting_PermissionValue{
+			PermissionValue: &settingsmsg.Permission{
+				Operation:  settingsmsg.Permission_OPERATION_READWRITE,
+				Constraint: c,
+			},
+		},
+	}
+}
+
 // RoleManagementPermission is the permission to manage roles
 func RoleManagementPermission(c settingsmsg.Permission_Constraint) *settingsmsg.Setting {
 	return &settingsmsg.Setting{
@@ -640,3 +717,47 @@ func WebOfficeManagementPermssion(c settingsmsg.Permission_Constraint) *settings
 		},
 	}
 }
+
+// Permissions returns the catalog of the permissions which can be added to a role. The constraint of a
+// permission is the one used by the built-in roles, roles can choose another one.
+func Permissions() []*settingsmsg.Setting {
+	return []*settingsmsg.Setting{
+		AccountManagementPermission(All),
+		AutoAcceptSharesPermission(Own),
+		ChangeLogoPermission(All),
+		CreatePublicLinkPermission(All),
+		CreateSharePermission(All),
+		CreateSpacesPermission(All),
+		DeletePersonalSpacesPermission(All),
+		DeleteProjectSpacesPermission(All),
+		DeleteReadOnlyPublicLinkPasswordPermission(All),
+		DisableEmailNotificationsPermission(Own),
+		ProfileEmailSendingIntervalPermission(Own),
+		ProfileNotificationChannelsPermission(Own),
+		ProfileNotificationMatrixRoomPermission(Own),
+		ProfileNotificationPushTargetPermission(Own),
+		ProfileEventShareCreatedPermission(Own),
+		ProfileEventShareRemovedPermission(Own),
+		ProfileEventShareExpiredPermission(Own),
+		ProfileEventSpaceSharedPermission(Own),
+		ProfileEventSpaceUnsharedPermission(Own),
+		ProfileEventSpaceMembershipExpiredPermission(Own),
+		ProfileEventSpaceDisabledPermission(Own),
+		ProfileEventSpaceDeletedPermission(Own),
+		ProfileEventPostprocessingStepFinishedPermission(Own),
+		GroupManagementPermission(All),
+		LanguageManagementPermission(Own),
+		ListFavoritesPermission(Own),
+		ListSpacesPermission(All),
+		ManageSpacePropertiesPermission(All),
+		ResetUserPasswordPermission(All),
+		RoleManagementPermission(All),
+		SelfManagementPermission(Own),
+		SetPersonalSpaceQuotaPermission(All),
+		SetProjectSpaceQuotaPermission(All),
+		SettingsManagementPermission(All),
+		SpaceAbilityPermission(All),
+		WebOfficeManagementPermssion(All),
+		WriteFavoritesPermission(Own),
+	}
+}
@@ -44,6 +44,18 @@ var (
 	TemplateEmailSendingInterval = l10n.Template("Email sending interval")
 	// description of the notification option 'Email Interval'
 	TemplateEmailSendingIntervalDescription = l10n.Template("Selected value:")
+	// name of the notification option 'Notification Channels'
+	TemplateNotificationChannels = l10n.Template("Notification channels")
+	// description of the notification option 'Notification Channels'
+	TemplateNotificationChannelsDescription = l10n.Template("Choose how you want to be notified")
+	// name of the notification option 'Matrix Room'
+	TemplateNotificationMatrixRoom = l10n.Template("Matrix room")
+	// description of the notification option 'Matrix Room'
+	TemplateNotificationMatrixRoomDescription = l10n.Template("ID of the Matrix room notifications are posted to. Invite the notification account to the room.")
+	// name of the notification option 'Push Target'
+	TemplateNotificationPushTarget = l10n.Template("Push topic or token")
+	// description of the notification option 'Push Target'
+	TemplateNotificationPushTargetDescription = l10n.Template("The ntfy topic or the Gotify application token push notifications are sent to")
 	// translation for the 'instant' email interval option
 	TemplateIntervalInstant = l10n.Template("Instant")
 	// translation for the 'daily' email interval option
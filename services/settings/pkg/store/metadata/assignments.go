@@ -147,6 +147,50 @@ func (s *Store) WriteRoleAssignment(accountUUID, roleID string) (*settingsmsg.Us
 	return ass, s.mdc.SimpleUpload(ctx, assignmentPath(accountUUID, ass.Id), b)
 }
 
+// ReadRoleAssignment returns the role assignment with the given id.
+func (s *Store) ReadRoleAssignment(assignmentID string) (*settingsmsg.UserRoleAssignment, error) {
+	s.Init()
+	ctx := context.TODO()
+	accounts, err := s.mdc.ReadDir(ctx, accountsFolderLocation)
+	switch err.(type) {
+	case nil:
+		// continue
+	case errtypes.NotFound:
+		return nil, fmt.Errorf("assignmentID '%s' %w", assignmentID, settings.ErrNotFound)
+	default:
+		return nil, err
+	}
+
+	for _, accID := range accounts {
+		assIDs, err := s.mdc.ReadDir(ctx, accountPath(accID))
+		if err != nil {
+			continue
+		}
+
+		for _, assID := range assIDs {
+			if assID != assignmentID {
+				continue
+			}
+			b, err := s.mdc.SimpleDownload(ctx, assignmentPath(accID, assID))
+			switch err.(type) {
+			case nil:
+				// continue
+			case errtypes.NotFound:
+				return nil, fmt.Errorf("assignmentID '%s' %w", assignmentID, settings.ErrNotFound)
+			default:
+				return nil, err
+			}
+
+			a := &settingsmsg.UserRoleAssignment{}
+			if err := json.Unmarshal(b, a); err != nil {
+				return nil, err
+			}
+			return a, nil
+		}
+	}
+	return nil, fmt.Errorf("assignmentID '%s' %w", assignmentID, settings.ErrNotFound)
+}
+
 // RemoveRoleAssignment deletes the given role assignment from the existing assignments of the respective account.
 func (s *Store) RemoveRoleAssignment(assignmentID string) error {
 	s.Init()
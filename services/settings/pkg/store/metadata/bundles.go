@@ -132,6 +132,20 @@ func (s *Store) WriteBundle(record *settingsmsg.Bundle) (*settingsmsg.Bundle, er
 	return record, s.mdc.SimpleUpload(ctx, bundlePath(record.Id), b)
 }
 
+// DeleteBundle deletes the bundle with the given id from the metadata service
+func (s *Store) DeleteBundle(bundleID string) error {
+	s.Init()
+	ctx := context.TODO()
+
+	if _, err := s.mdc.SimpleDownload(ctx, bundlePath(bundleID)); err != nil {
+		if _, ok := err.(errtypes.NotFound); ok {
+			return fmt.Errorf("bundleID '%s' %w", bundleID, settings.ErrNotFound)
+		}
+		return err
+	}
+	return s.mdc.Delete(ctx, bundlePath(bundleID))
+}
+
 // AddSettingToBundle adds the given setting to the bundle with the given bundleID.
 func (s *Store) AddSettingToBundle(bundleID string, setting *settingsmsg.Setting) (*settingsmsg.Setting, error) {
 	s.Init()
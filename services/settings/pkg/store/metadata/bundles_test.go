@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	settingsmsg "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/settings/v0"
+	"github.com/opencloud-eu/opencloud/services/settings/pkg/settings"
 	"github.com/stretchr/testify/require"
 )
 
@@ -178,6 +179,18 @@ func TestBundles(t *testing.T) {
 	require.Equal(t, "test-desc-1", setting.Description) // could be tested better ;)
 }
 
+func TestDeleteBundle(t *testing.T) {
+	s := initStore()
+	_, err := s.WriteBundle(bundleScenarios[2].bundle)
+	require.NoError(t, err)
+
+	require.NoError(t, s.DeleteBundle(bundle3))
+	_, err = s.ReadBundle(bundle3)
+	require.ErrorIs(t, err, settings.ErrNotFound)
+
+	require.ErrorIs(t, s.DeleteBundle(bundle3), settings.ErrNotFound)
+}
+
 func TestAppendSetting(t *testing.T) {
 	s := initStore()
 	setupRoles(s)
@@ -0,0 +1,153 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	ctxpkg "github.com/opencloud-eu/reva/v2/pkg/ctx"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
+	"github.com/opencloud-eu/reva/v2/pkg/storage/utils/walker"
+	"github.com/opencloud-eu/reva/v2/pkg/storagespace"
+	"github.com/opencloud-eu/reva/v2/pkg/utils"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/opencloud-eu/opencloud/pkg/config/configlog"
+	"github.com/opencloud-eu/opencloud/pkg/registry"
+	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/config/parser"
+	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/logging"
+	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/thumbnail/storage"
+)
+
+// Cleanup is the entrypoint for the cleanup command.
+func Cleanup(cfg *config.Config) *cli.Command {
+	return &cli.Command{
+		Name:  "cleanup",
+		Usage: "remove the thumbnails of files which have been purged",
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  "dry-run",
+				Usage: "only report the thumbnails which would be removed",
+			},
+		},
+		Before: func(c *cli.Context) error {
+			if err := configlog.ReturnFatal(parser.ParseConfig(cfg)); err != nil {
+				return err
+			}
+			return configlog.ReturnFatal(parser.ValidateServiceAccount(cfg))
+		},
+		Action: func(c *cli.Context) error {
+			logger := logging.Configure(cfg.Service.Name, cfg.Log)
+
+			store, err := storage.New(cfg.Thumbnail, logger)
+			if err != nil {
+				return cli.Exit(err.Error(), 1)
+			}
+
+			tm, err := pool.StringToTLSMode(cfg.GRPCClientTLS.Mode)
+			if err != nil {
+				return err
+			}
+			gatewaySelector, err := pool.GatewaySelector(cfg.Thumbnail.RevaGateway,
+				pool.WithTLSCACert(cfg.GRPCClientTLS.CACert),
+				pool.WithTLSMode(tm),
+				pool.WithRegistry(registry.GetRegistry()),
+			)
+			if err != nil {
+				return err
+			}
+
+			// thumbnails written from now on belong to files which are not part of the collected checksums
+			start := time.Now()
+			checksums, err := collectChecksums(c.Context, gatewaySelector, cfg.ServiceAccount)
+			if err != nil {
+				return cli.Exit(fmt.Sprintf("could not collect the checksums of the files: %s", err), 1)
+			}
+
+			summary, err := storage.Cleanup(store, func(checksum string) bool {
+				_, ok := checksums[checksum]
+				return ok
+			}, start, c.Bool("dry-run"))
+			if err != nil {
+				return cli.Exit(err.Error(), 1)
+			}
+
+			fmt.Printf("removed: %d, freed: %d bytes\n", summary.Removed, summary.Freed)
+			return nil
+		},
+	}
+}
+
+// collectChecksums returns the checksums of all files in the personal and project spaces
+func collectChecksums(ctx context.Context, gatewaySelector pool.Selectable[gateway.GatewayAPIClient], sa config.ServiceAccount) (map[string]struct{}, error) {
+	authCtx, err := serviceUserContext(ctx, gatewaySelector, sa)
+	if err != nil {
+		return nil, err
+	}
+
+	gwc, err := gatewaySelector.Next()
+	if err != nil {
+		return nil, err
+	}
+	res, err := gwc.ListStorageSpaces(authCtx, &provider.ListStorageSpacesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if res.GetStatus().GetCode() != rpc.Code_CODE_OK {
+		return nil, fmt.Errorf("could not list spaces: %s", res.GetStatus().GetMessage())
+	}
+
+	checksums := map[string]struct{}{}
+	for _, space := range res.GetStorageSpaces() {
+		if typ := space.GetSpaceType(); typ != "personal" && typ != "project" {
+			continue
+		}
+
+		rootID, err := storagespace.ParseID(space.GetId().GetOpaqueId())
+		if err != nil {
+			return nil, err
+		}
+		rootID.OpaqueId = rootID.SpaceId
+
+		// get a fresh token for every space, walking big spaces takes a while
+		authCtx, err := serviceUserContext(ctx, gatewaySelector, sa)
+		if err != nil {
+			return nil, err
+		}
+
+		// a failed walk must not be skipped, the thumbnails of the space would be removed
+		err = walker.NewWalker(gatewaySelector).Walk(authCtx, &rootID, func(_ string, info *provider.ResourceInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if sum := info.GetChecksum().GetSum(); sum != "" {
+				checksums[sum] = struct{}{}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return checksums, nil
+}
+
+func serviceUserContext(ctx context.Context, gatewaySelector pool.Selectable[gateway.GatewayAPIClient], sa config.ServiceAccount) (context.Context, error) {
+	gwc, err := gatewaySelector.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := utils.GetServiceUserToken(ctx, gwc, sa.ServiceAccountID, sa.ServiceAccountSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata.AppendToOutgoingContext(ctxpkg.ContextSetToken(ctx, token), ctxpkg.TokenHeader, token), nil
+}
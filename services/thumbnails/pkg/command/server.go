@@ -17,6 +17,8 @@ import (
 	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/server/debug"
 	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/server/grpc"
 	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/server/http"
+	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/thumbnail/storage"
+	"github.com/opencloud-eu/reva/v2/pkg/bytesize"
 	"github.com/urfave/cli/v2"
 )
 
@@ -53,6 +55,30 @@ func Server(cfg *config.Config) *cli.Command {
 
 			gr := runner.NewGroup()
 
+			// the grpc and the http server share the storage, the eviction needs to see the reads of both
+			var store storage.Storage
+			{
+				s, err := storage.New(cfg.Thumbnail, logger)
+				if err != nil {
+					return err
+				}
+				store = s
+
+				if cfg.Thumbnail.Eviction.MaxSize != "" {
+					maxSize, err := bytesize.Parse(cfg.Thumbnail.Eviction.MaxSize)
+					if err != nil {
+						return err
+					}
+					evicting := storage.NewEvictingStorage(s, maxSize.Bytes(), cfg.Thumbnail.Eviction.Interval, logger)
+					gr.Add(runner.New(cfg.Service.Name+".eviction", func() error {
+						return evicting.Run()
+					}, func() {
+						evicting.Close()
+					}))
+					store = evicting
+				}
+			}
+
 			service := grpc.NewService(
 				grpc.Logger(logger),
 				grpc.Context(ctx),
@@ -63,6 +89,7 @@ func Server(cfg *config.Config) *cli.Command {
 				grpc.Metrics(m),
 				grpc.TraceProvider(traceProvider),
 				grpc.MaxConcurrentRequests(cfg.GRPC.MaxConcurrentRequests),
+				grpc.Storage(store),
 			)
 			gr.Add(runner.NewGoMicroGrpcServerRunner(cfg.Service.Name+".grpc", service))
 
@@ -84,6 +111,7 @@ func Server(cfg *config.Config) *cli.Command {
 				http.Metrics(m),
 				http.Namespace(cfg.HTTP.Namespace),
 				http.TraceProvider(traceProvider),
+				http.Storage(store),
 			)
 			if err != nil {
 				logger.Info().
@@ -3,6 +3,7 @@ package config
 
 import (
 	"context"
+	"time"
 
 	"github.com/opencloud-eu/opencloud/pkg/shared"
 	"go-micro.dev/v4/client"
@@ -25,6 +26,8 @@ type Config struct {
 
 	Thumbnail Thumbnail `yaml:"thumbnail"`
 
+	ServiceAccount ServiceAccount `yaml:"service_account"`
+
 	Context context.Context `yaml:"-"`
 }
 
@@ -33,10 +36,50 @@ type FileSystemStorage struct {
 	RootDirectory string `yaml:"root_directory" env:"THUMBNAILS_FILESYSTEMSTORAGE_ROOT" desc:"The directory where the filesystem storage will store the thumbnails. If not defined, the root directory derives from $OC_BASE_DATA_PATH/thumbnails." introductionVersion:"1.0.0"`
 }
 
+// S3Storage defines the available S3 storage configuration.
+type S3Storage struct {
+	Endpoint  string `yaml:"endpoint" env:"THUMBNAILS_S3STORAGE_ENDPOINT" desc:"Endpoint of the S3 compatible object storage, e.g. 'https://s3.example.com'." introductionVersion:"%%NEXT%%"`
+	Region    string `yaml:"region" env:"THUMBNAILS_S3STORAGE_REGION" desc:"Region of the S3 bucket." introductionVersion:"%%NEXT%%"`
+	AccessKey string `yaml:"access_key" env:"THUMBNAILS_S3STORAGE_ACCESS_KEY" desc:"Access key for the S3 bucket." introductionVersion:"%%NEXT%%"`
+	SecretKey string `yaml:"secret_key" env:"THUMBNAILS_S3STORAGE_SECRET_KEY" desc:"Secret key for the S3 bucket." introductionVersion:"%%NEXT%%"`
+	Bucket    string `yaml:"bucket" env:"THUMBNAILS_S3STORAGE_BUCKET" desc:"Name of the S3 bucket. The bucket must exist." introductionVersion:"%%NEXT%%"`
+	Insecure  bool   `yaml:"insecure" env:"OC_INSECURE;THUMBNAILS_S3STORAGE_INSECURE" desc:"Ignore untrusted SSL certificates when connecting to the S3 endpoint." introductionVersion:"%%NEXT%%"`
+}
+
+// Eviction defines the available configuration for removing thumbnails when the storage grows too big.
+type Eviction struct {
+	MaxSize  string        `yaml:"max_size" env:"THUMBNAILS_EVICTION_MAX_SIZE" desc:"The maximum size of all stored thumbnails. The least recently used thumbnails are removed when it is exceeded. Usable common abbreviations: [KB, KiB, MB, MiB, GB, GiB, TB, TiB, PB, PiB, EB, EiB], example: 2GB. Thumbnails are never removed if not set." introductionVersion:"%%NEXT%%"`
+	Interval time.Duration `yaml:"interval" env:"THUMBNAILS_EVICTION_INTERVAL" desc:"The interval in which the size of the stored thumbnails is checked. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+}
+
+// DocumentConverter defines the available configuration for the thumbnails of PDF and office documents.
+type DocumentConverter struct {
+	URL      string        `yaml:"url" env:"THUMBNAILS_DOCUMENT_CONVERTER_URL" desc:"The URL of an endpoint which is compatible with the convert-to API of Collabora Online, e.g. 'https://collabora.example.com/cool/convert-to'. It is used to render the first page of office documents, and of PDF files if the service is built without libvips. No thumbnails are generated for documents if not set. The webdav and graph services need the same setting to advertise the previews." introductionVersion:"%%NEXT%%"`
+	Insecure bool          `yaml:"insecure" env:"OC_INSECURE;THUMBNAILS_DOCUMENT_CONVERTER_INSECURE" desc:"Ignore untrusted SSL certificates when connecting to the document converter." introductionVersion:"%%NEXT%%"`
+	Timeout  time.Duration `yaml:"timeout" env:"THUMBNAILS_DOCUMENT_CONVERTER_TIMEOUT" desc:"The time to wait for the document converter. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+}
+
+// FFmpeg defines the available configuration for the thumbnails of videos.
+type FFmpeg struct {
+	Path    string        `yaml:"path" env:"THUMBNAILS_FFMPEG_PATH" desc:"The path to the ffmpeg binary which is used to extract a frame of videos, e.g. '/usr/bin/ffmpeg'. No thumbnails are generated for videos if not set. The webdav and graph services need the same setting to advertise the previews." introductionVersion:"%%NEXT%%"`
+	Timeout time.Duration `yaml:"timeout" env:"THUMBNAILS_FFMPEG_TIMEOUT" desc:"The time to wait for ffmpeg to extract a frame. See the Environment Variable Types description for more details." introductionVersion:"%%NEXT%%"`
+}
+
+// ServiceAccount is the configuration for the used service account
+type ServiceAccount struct {
+	ServiceAccountID     string `yaml:"service_account_id" env:"OC_SERVICE_ACCOUNT_ID;THUMBNAILS_SERVICE_ACCOUNT_ID" desc:"The ID of the service account the service should use to clean up thumbnails. See the 'auth-service' service description for more details." introductionVersion:"%%NEXT%%"`
+	ServiceAccountSecret string `yaml:"service_account_secret" env:"OC_SERVICE_ACCOUNT_SECRET;THUMBNAILS_SERVICE_ACCOUNT_SECRET" desc:"The service account secret." introductionVersion:"%%NEXT%%"`
+}
+
 // Thumbnail defines the available thumbnail related configuration.
 type Thumbnail struct {
 	Resolutions           []string          `yaml:"resolutions" env:"THUMBNAILS_RESOLUTIONS" desc:"The supported list of target resolutions in the format WidthxHeight like 32x32. You can define any resolution as required. See the Environment Variable Types description for more details." introductionVersion:"1.0.0"`
+	Storage               string            `yaml:"storage" env:"THUMBNAILS_STORAGE" desc:"The storage for the thumbnails. Supported values are 'filesystem' and 's3'. Use 's3' to share the thumbnails between multiple instances of the service." introductionVersion:"%%NEXT%%"`
 	FileSystemStorage     FileSystemStorage `yaml:"filesystem_storage"`
+	S3Storage             S3Storage         `yaml:"s3_storage"`
+	Eviction              Eviction          `yaml:"eviction"`
+	DocumentConverter     DocumentConverter `yaml:"document_converter"`
+	FFmpeg                FFmpeg            `yaml:"ffmpeg"`
 	WebdavAllowInsecure   bool              `yaml:"webdav_allow_insecure" env:"OC_INSECURE;THUMBNAILS_WEBDAVSOURCE_INSECURE" desc:"Ignore untrusted SSL certificates when connecting to the webdav source." introductionVersion:"1.0.0"`
 	CS3AllowInsecure      bool              `yaml:"cs3_allow_insecure" env:"OC_INSECURE;THUMBNAILS_CS3SOURCE_INSECURE" desc:"Ignore untrusted SSL certificates when connecting to the CS3 source." introductionVersion:"1.0.0"`
 	RevaGateway           string            `yaml:"reva_gateway" env:"OC_REVA_GATEWAY" desc:"CS3 gateway used to look up user metadata" introductionVersion:"1.0.0"`
@@ -2,12 +2,15 @@ package parser
 
 import (
 	"errors"
+	"fmt"
 
 	occfg "github.com/opencloud-eu/opencloud/pkg/config"
 	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/config"
 	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/config/defaults"
 
 	"github.com/opencloud-eu/opencloud/pkg/config/envdecode"
+	"github.com/opencloud-eu/opencloud/pkg/shared"
+	"github.com/opencloud-eu/reva/v2/pkg/bytesize"
 )
 
 // ParseConfig loads configuration from known paths.
@@ -34,6 +37,36 @@ func ParseConfig(cfg *config.Config) error {
 }
 
 // Validate can validate the configuration
-func Validate(_ *config.Config) error {
+func Validate(cfg *config.Config) error {
+	switch cfg.Thumbnail.Storage {
+	case "filesystem":
+	case "s3":
+		if cfg.Thumbnail.S3Storage.Endpoint == "" || cfg.Thumbnail.S3Storage.Bucket == "" {
+			return fmt.Errorf("the s3 storage for %s needs an endpoint and a bucket", cfg.Service.Name)
+		}
+	default:
+		return fmt.Errorf("unknown storage '%s' for %s", cfg.Thumbnail.Storage, cfg.Service.Name)
+	}
+
+	if cfg.Thumbnail.Eviction.MaxSize != "" {
+		if _, err := bytesize.Parse(cfg.Thumbnail.Eviction.MaxSize); err != nil {
+			return fmt.Errorf("invalid eviction max size '%s': %w", cfg.Thumbnail.Eviction.MaxSize, err)
+		}
+		if cfg.Thumbnail.Eviction.Interval <= 0 {
+			return fmt.Errorf("the eviction interval for %s must be greater than zero", cfg.Service.Name)
+		}
+	}
+
+	return nil
+}
+
+// ValidateServiceAccount makes sure the service account needed to clean up thumbnails is configured
+func ValidateServiceAccount(cfg *config.Config) error {
+	if cfg.ServiceAccount.ServiceAccountID == "" {
+		return shared.MissingServiceAccountID(cfg.Service.Name)
+	}
+	if cfg.ServiceAccount.ServiceAccountSecret == "" {
+		return shared.MissingServiceAccountSecret(cfg.Service.Name)
+	}
 	return nil
 }
@@ -13,6 +13,8 @@ var (
 	ErrNoGeneratorForType = errors.New("thumbnails: no generator for this type found")
 	// ErrNoImageFromAudioFile defines an error when an image cannot be extracted from an audio file
 	ErrNoImageFromAudioFile = errors.New("thumbnails: could not extract image from audio file")
+	// ErrNoImageFromVideoFile defines an error when no frame can be extracted from a video file
+	ErrNoImageFromVideoFile = errors.New("thumbnails: could not extract image from video file")
 	// ErrNoConverterForExtractedImageFromGgsFile defines an error when the extracted image from an ggs file could not be converted
 	ErrNoConverterForExtractedImageFromGgsFile = errors.New("thumbnails: could not find converter for image extracted from ggs file")
 	// ErrNoConverterForExtractedImageFromAudioFile defines an error when the extracted image from an audio file could not be converted
@@ -321,7 +321,17 @@ func ForType(mimeType string, opts map[string]interface{}) FileConverter {
 		fallthrough
 	case "audio/ogg":
 		return AudioDecoder{}
-	default:
-		return ImageDecoder{}
 	}
+
+	if ext, ok := DocumentMimeTypes[mimeType]; ok {
+		if converter, ok := opts["documentConverter"].(DocumentConverter); ok {
+			return converter.WithExtension(ext)
+		}
+	}
+	if _, ok := VideoMimeTypes[mimeType]; ok {
+		if decoder, ok := opts["videoDecoder"].(VideoDecoder); ok {
+			return decoder
+		}
+	}
+	return ImageDecoder{}
 }
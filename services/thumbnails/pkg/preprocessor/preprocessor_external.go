@@ -0,0 +1,184 @@
+package preprocessor
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	thumbnailerErrors "github.com/opencloud-eu/opencloud/services/thumbnails/pkg/errors"
+)
+
+var (
+	// DocumentMimeTypes contains the mimetypes of the office documents which are rendered by the document converter
+	// and the file extensions the converter uses to detect the format. PDF files are rendered by libvips if it is
+	// enabled and by the document converter otherwise.
+	DocumentMimeTypes = map[string]string{
+		"application/msword":            "doc",
+		"application/vnd.ms-excel":      "xls",
+		"application/vnd.ms-powerpoint": "ppt",
+
+		"application/vnd.openxmlformats-officedocument.wordprocessingml.document":   "docx",
+		"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet":         "xlsx",
+		"application/vnd.openxmlformats-officedocument.presentationml.presentation": "pptx",
+
+		"application/vnd.oasis.opendocument.text":         "odt",
+		"application/vnd.oasis.opendocument.spreadsheet":  "ods",
+		"application/vnd.oasis.opendocument.presentation": "odp",
+		"application/vnd.oasis.opendocument.graphics":     "odg",
+	}
+
+	// VideoMimeTypes contains the mimetypes of the videos whose poster frame is extracted with ffmpeg.
+	VideoMimeTypes = map[string]struct{}{
+		"video/mp4":        {},
+		"video/mpeg":       {},
+		"video/ogg":        {},
+		"video/quicktime":  {},
+		"video/webm":       {},
+		"video/x-matroska": {},
+		"video/x-msvideo":  {},
+		"video/3gpp":       {},
+	}
+)
+
+// DocumentConverter renders the first page of a document with an endpoint which is compatible
+// with the convert-to API of Collabora Online.
+type DocumentConverter struct {
+	url       string
+	extension string
+	client    *http.Client
+}
+
+// NewDocumentConverter creates a new DocumentConverter, url is the address of the convert-to endpoint,
+// e.g. https://collabora.example.com/cool/convert-to
+func NewDocumentConverter(url string, insecure bool, timeout time.Duration) DocumentConverter {
+	return DocumentConverter{
+		url: strings.TrimSuffix(url, "/"),
+		client: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{
+					MinVersion:         tls.VersionTLS12,
+					InsecureSkipVerify: insecure, //nolint:gosec
+				},
+			},
+		},
+	}
+}
+
+// WithExtension returns a copy of the converter which uploads the documents with the given file extension,
+// the converter uses it to detect the format.
+func (c DocumentConverter) WithExtension(ext string) DocumentConverter {
+	c.extension = ext
+	return c
+}
+
+// Convert uploads the document to the converter and decodes the returned image of the first page
+func (c DocumentConverter) Convert(r io.Reader) (interface{}, error) {
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	go func() {
+		part, err := mw.CreateFormFile("data", "document."+c.extension)
+		if err == nil {
+			_, err = io.Copy(part, r)
+		}
+		if err == nil {
+			err = mw.Close()
+		}
+		_ = pw.CloseWithError(err)
+	}()
+
+	req, err := http.NewRequest(http.MethodPost, c.url+"/png", pr)
+	if err != nil {
+		_ = pr.Close()
+		return nil, err
+	}
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert the document")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not convert the document, the converter returned the status code %d", resp.StatusCode)
+	}
+
+	return ForType("image/png", nil).Convert(resp.Body)
+}
+
+// VideoDecoder extracts a poster frame of a video with ffmpeg
+type VideoDecoder struct {
+	ffmpeg      string
+	timeout     time.Duration
+	maxWidth    int
+	maxHeight   int
+	maxFileSize uint64
+}
+
+// NewVideoDecoder creates a new VideoDecoder. The poster frames are scaled down to the maximum dimensions,
+// videos larger than maxFileSize are rejected.
+func NewVideoDecoder(ffmpeg string, timeout time.Duration, maxWidth, maxHeight int, maxFileSize uint64) VideoDecoder {
+	return VideoDecoder{
+		ffmpeg:      ffmpeg,
+		timeout:     timeout,
+		maxWidth:    maxWidth,
+		maxHeight:   maxHeight,
+		maxFileSize: maxFileSize,
+	}
+}
+
+// Convert stores the video in a temporary file, ffmpeg needs to seek in most containers,
+// and lets ffmpeg pick a representative frame from the beginning of the video.
+func (v VideoDecoder) Convert(r io.Reader) (interface{}, error) {
+	f, err := os.CreateTemp("", "thumbnail-video")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(f.Name())
+
+	// never store more than the maximum file size, the size reported by the source might be wrong
+	n, err := io.Copy(f, io.LimitReader(r, int64(v.maxFileSize)+1))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not store the video")
+	}
+	if uint64(n) > v.maxFileSize {
+		return nil, thumbnailerErrors.ErrImageTooLarge
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), v.timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, v.ffmpeg,
+		"-hide_banner", "-loglevel", "error",
+		"-i", f.Name(),
+		"-vf", fmt.Sprintf("thumbnail,scale=w='min(iw,%d)':h='min(ih,%d)':force_original_aspect_ratio=decrease", v.maxWidth, v.maxHeight),
+		"-frames:v", "1",
+		"-f", "image2pipe", "-c:v", "png",
+		"-",
+	)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Wrapf(err, "could not extract a frame from the video: %s", strings.TrimSpace(stderr.String()))
+	}
+	if stdout.Len() == 0 {
+		return nil, thumbnailerErrors.ErrNoImageFromVideoFile
+	}
+
+	return ForType("image/png", nil).Convert(&stdout)
+}
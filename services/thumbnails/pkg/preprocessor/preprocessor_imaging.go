@@ -9,6 +9,11 @@ import (
 	"github.com/pkg/errors"
 )
 
+func init() {
+	// without libvips the first page of PDF files is rendered by the document converter
+	DocumentMimeTypes["application/pdf"] = "pdf"
+}
+
 // ImageDecoder is a converter for the image file
 type ImageDecoder struct{}
 
@@ -3,14 +3,22 @@ package preprocessor
 import (
 	"bytes"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
 	"testing"
+	"time"
 
 	"golang.org/x/image/font"
 	"golang.org/x/image/font/opentype"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+
+	thumbnailerErrors "github.com/opencloud-eu/opencloud/services/thumbnails/pkg/errors"
 )
 
 func TestImageDecoder(t *testing.T) {
@@ -182,5 +190,141 @@ var _ = Describe("ImageDecoder", func() {
 			decoder := ForType("unknown", nil)
 			Expect(decoder).To(BeAssignableToTypeOf(ImageDecoder{}))
 		})
+
+		It("should return the DocumentConverter for document types", func() {
+			opts := map[string]interface{}{"documentConverter": NewDocumentConverter("http://localhost", false, time.Second)}
+			decoder := ForType("application/vnd.oasis.opendocument.text", opts)
+			Expect(decoder).To(BeAssignableToTypeOf(DocumentConverter{}))
+			Expect(decoder.(DocumentConverter).extension).To(Equal("odt"))
+		})
+
+		It("should use the DocumentConverter for pdf files only without libvips", func() {
+			opts := map[string]interface{}{"documentConverter": NewDocumentConverter("http://localhost", false, time.Second)}
+			decoder := ForType("application/pdf", opts)
+			if _, converted := DocumentMimeTypes["application/pdf"]; converted {
+				Expect(decoder).To(BeAssignableToTypeOf(DocumentConverter{}))
+				Expect(decoder.(DocumentConverter).extension).To(Equal("pdf"))
+			} else {
+				Expect(decoder).To(BeAssignableToTypeOf(ImageDecoder{}))
+			}
+		})
+
+		It("should return the VideoDecoder for video types", func() {
+			opts := map[string]interface{}{"videoDecoder": NewVideoDecoder("ffmpeg", time.Second, 100, 100, 1024)}
+			decoder := ForType("video/mp4", opts)
+			Expect(decoder).To(BeAssignableToTypeOf(VideoDecoder{}))
+		})
+	})
+
+	Describe("DocumentConverter", func() {
+		var (
+			png      []byte
+			filename string
+			srv      *httptest.Server
+		)
+		BeforeEach(func() {
+			var err error
+			png, err = os.ReadFile("test_assets/noise.png")
+			Expect(err).ToNot(HaveOccurred())
+
+			srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/cool/convert-to/png" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				f, header, err := r.FormFile("data")
+				if err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				defer f.Close()
+				content, _ := io.ReadAll(f)
+				if string(content) != "document content" {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				filename = header.Filename
+				_, _ = w.Write(png)
+			}))
+		})
+
+		AfterEach(func() {
+			srv.Close()
+		})
+
+		It("should convert a document", func() {
+			converter := NewDocumentConverter(srv.URL+"/cool/convert-to/", false, time.Second).WithExtension("docx")
+			img, err := converter.Convert(strings.NewReader("document content"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(img).ToNot(BeNil())
+			Expect(filename).To(Equal("document.docx"))
+		})
+
+		It("should return an error if the conversion fails", func() {
+			converter := NewDocumentConverter(srv.URL+"/unknown", false, time.Second).WithExtension("docx")
+			img, err := converter.Convert(strings.NewReader("document content"))
+			Expect(err).To(HaveOccurred())
+			Expect(img).To(BeNil())
+		})
+	})
+
+	Describe("VideoDecoder", func() {
+		var dir string
+		// fakeFFmpeg writes a script which acts like ffmpeg
+		fakeFFmpeg := func(script string) string {
+			p := filepath.Join(dir, "ffmpeg")
+			Expect(os.WriteFile(p, []byte("#!/bin/sh\n"+script+"\n"), 0700)).To(Succeed())
+			return p
+		}
+
+		BeforeEach(func() {
+			if runtime.GOOS == "windows" {
+				Skip("the fake ffmpeg is a shell script")
+			}
+			var err error
+			dir, err = os.MkdirTemp("", "ffmpeg")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		It("should extract a frame", func() {
+			png, err := filepath.Abs("test_assets/noise.png")
+			Expect(err).ToNot(HaveOccurred())
+			decoder := NewVideoDecoder(fakeFFmpeg("cat "+png), time.Second, 100, 100, 1024)
+			img, err := decoder.Convert(strings.NewReader("video content"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(img).ToNot(BeNil())
+		})
+
+		It("should pass the video and the maximum dimensions to ffmpeg", func() {
+			args := filepath.Join(dir, "args")
+			decoder := NewVideoDecoder(fakeFFmpeg(`for a in "$@"; do echo "$a"; done > `+args+`; cat "$5" >> `+args), time.Second, 640, 480, 1024)
+			_, err := decoder.Convert(strings.NewReader("video content"))
+			Expect(err).To(MatchError(thumbnailerErrors.ErrNoImageFromVideoFile))
+
+			content, err := os.ReadFile(args)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(content)).To(ContainSubstring("thumbnail,scale=w='min(iw,640)':h='min(ih,480)'"))
+			Expect(string(content)).To(HaveSuffix("video content"))
+		})
+
+		It("should reject videos which are too large", func() {
+			called := filepath.Join(dir, "called")
+			decoder := NewVideoDecoder(fakeFFmpeg("touch "+called), time.Second, 100, 100, 4)
+			img, err := decoder.Convert(strings.NewReader("video content"))
+			Expect(err).To(MatchError(thumbnailerErrors.ErrImageTooLarge))
+			Expect(img).To(BeNil())
+			Expect(called).ToNot(BeAnExistingFile())
+		})
+
+		It("should return an error if ffmpeg fails", func() {
+			decoder := NewVideoDecoder(fakeFFmpeg("echo 'invalid data' >&2; exit 1"), time.Second, 100, 100, 1024)
+			img, err := decoder.Convert(strings.NewReader("video content"))
+			Expect(err).To(MatchError(ContainSubstring("invalid data")))
+			Expect(img).To(BeNil())
+		})
 	})
 })
@@ -6,6 +6,7 @@ import (
 	"github.com/opencloud-eu/opencloud/pkg/log"
 	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/config"
 	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/metrics"
+	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/thumbnail/storage"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -23,6 +24,7 @@ type Options struct {
 	Namespace             string
 	TraceProvider         trace.TracerProvider
 	MaxConcurrentRequests int
+	Storage               storage.Storage
 }
 
 // newOptions initializes the available default options.
@@ -98,3 +100,10 @@ func MaxConcurrentRequests(val int) Option {
 		o.MaxConcurrentRequests = val
 	}
 }
+
+// Storage provides a function to set the thumbnail storage option.
+func Storage(val storage.Storage) Option {
+	return func(o *Options) {
+		o.Storage = val
+	}
+}
@@ -9,7 +9,6 @@ import (
 	svc "github.com/opencloud-eu/opencloud/services/thumbnails/pkg/service/grpc/v0"
 	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/service/grpc/v0/decorators"
 	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/thumbnail/imgsource"
-	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/thumbnail/storage"
 	"github.com/opencloud-eu/reva/v2/pkg/bytesize"
 	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
 )
@@ -69,12 +68,7 @@ func NewService(opts ...Option) grpc.Service {
 			svc.Config(options.Config),
 			svc.Logger(options.Logger),
 			svc.ThumbnailSource(imgsource.NewWebDavSource(tconf, b)),
-			svc.ThumbnailStorage(
-				storage.NewFileSystemStorage(
-					tconf.FileSystemStorage,
-					options.Logger,
-				),
-			),
+			svc.ThumbnailStorage(options.Storage),
 			svc.CS3Source(imgsource.NewCS3Source(tconf, gatewaySelector, b)),
 			svc.GatewaySelector(gatewaySelector),
 		)
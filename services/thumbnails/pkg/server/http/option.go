@@ -6,6 +6,7 @@ import (
 	"github.com/opencloud-eu/opencloud/pkg/log"
 	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/config"
 	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/metrics"
+	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/thumbnail/storage"
 	"github.com/urfave/cli/v2"
 	"go.opentelemetry.io/otel/trace"
 	"go.opentelemetry.io/otel/trace/noop"
@@ -23,6 +24,7 @@ type Options struct {
 	Metrics       *metrics.Metrics
 	Flags         []cli.Flag
 	TraceProvider trace.TracerProvider
+	Storage       storage.Storage
 }
 
 // newOptions initializes the available default options.
@@ -81,3 +83,10 @@ func TraceProvider(traceProvider trace.TracerProvider) Option {
 		}
 	}
 }
+
+// Storage provides a function to set the thumbnail storage option.
+func Storage(val storage.Storage) Option {
+	return func(o *Options) {
+		o.Storage = val
+	}
+}
@@ -12,6 +12,7 @@ import (
 	rpc "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
 	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/opencloud-eu/reva/v2/pkg/bytesize"
 	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
 	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
 	"github.com/opencloud-eu/reva/v2/pkg/storagespace"
@@ -22,6 +23,7 @@ import (
 
 	"github.com/opencloud-eu/opencloud/pkg/log"
 	thumbnailssvc "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/thumbnails/v0"
+	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/config"
 	terrors "github.com/opencloud-eu/opencloud/services/thumbnails/pkg/errors"
 	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/preprocessor"
 	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/service/grpc/v0/decorators"
@@ -38,6 +40,11 @@ func NewService(opts ...Option) decorators.DecoratedService {
 	if err != nil {
 		logger.Fatal().Err(err).Msg("resolutions not configured correctly")
 	}
+	maxInputFileSize, err := bytesize.Parse(options.Config.Thumbnail.MaxInputImageFileSize)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("max input image file size not configured correctly")
+	}
+	preprocessorOpts := newPreprocessorOpts(options.Config.Thumbnail, maxInputFileSize.Bytes())
 	svc := Thumbnail{
 		serviceID: options.Config.GRPC.Namespace + "." + options.Config.Service.Name,
 		manager: thumbnail.NewSimpleManager(
@@ -47,15 +54,14 @@ func NewService(opts ...Option) decorators.DecoratedService {
 			options.Config.Thumbnail.MaxInputWidth,
 			options.Config.Thumbnail.MaxInputHeight,
 		),
-		webdavSource: options.ImageSource,
-		cs3Source:    options.CS3Source,
-		logger:       logger,
-		selector:     options.GatewaySelector,
-		preprocessorOpts: PreprocessorOpts{
-			TxtFontFileMap: options.Config.Thumbnail.FontMapFile,
-		},
-		dataEndpoint:   options.Config.Thumbnail.DataEndpoint,
-		transferSecret: options.Config.Thumbnail.TransferSecret,
+		webdavSource:     options.ImageSource,
+		cs3Source:        options.CS3Source,
+		logger:           logger,
+		selector:         options.GatewaySelector,
+		preprocessorOpts: preprocessorOpts,
+		previews:         thumbnail.NewPreviews(options.Config.Thumbnail.DocumentConverter.URL, options.Config.Thumbnail.FFmpeg.Path),
+		dataEndpoint:     options.Config.Thumbnail.DataEndpoint,
+		transferSecret:   options.Config.Thumbnail.TransferSecret,
 	}
 
 	return svc
@@ -72,11 +78,44 @@ type Thumbnail struct {
 	logger           log.Logger
 	selector         pool.Selectable[gateway.GatewayAPIClient]
 	preprocessorOpts PreprocessorOpts
+	previews         thumbnail.Previews
 }
 
 // PreprocessorOpts holds the options for the preprocessor
 type PreprocessorOpts struct {
 	TxtFontFileMap string
+	// DocumentConverter and VideoDecoder are nil if they are not configured
+	DocumentConverter *preprocessor.DocumentConverter
+	VideoDecoder      *preprocessor.VideoDecoder
+}
+
+func newPreprocessorOpts(cfg config.Thumbnail, maxInputFileSize uint64) PreprocessorOpts {
+	opts := PreprocessorOpts{
+		TxtFontFileMap: cfg.FontMapFile,
+	}
+	if cfg.DocumentConverter.URL != "" {
+		c := preprocessor.NewDocumentConverter(cfg.DocumentConverter.URL, cfg.DocumentConverter.Insecure, cfg.DocumentConverter.Timeout)
+		opts.DocumentConverter = &c
+	}
+	if cfg.FFmpeg.Path != "" {
+		d := preprocessor.NewVideoDecoder(cfg.FFmpeg.Path, cfg.FFmpeg.Timeout, cfg.MaxInputWidth, cfg.MaxInputHeight, maxInputFileSize)
+		opts.VideoDecoder = &d
+	}
+	return opts
+}
+
+// asMap returns the options as expected by preprocessor.ForType
+func (o PreprocessorOpts) asMap() map[string]interface{} {
+	m := map[string]interface{}{
+		"fontFileMap": o.TxtFontFileMap,
+	}
+	if o.DocumentConverter != nil {
+		m["documentConverter"] = *o.DocumentConverter
+	}
+	if o.VideoDecoder != nil {
+		m["videoDecoder"] = *o.VideoDecoder
+	}
+	return m
 }
 
 // GetThumbnail retrieves a thumbnail for an image
@@ -164,10 +203,7 @@ func (g Thumbnail) handleCS3Source(ctx context.Context, req *thumbnailssvc.GetTh
 	}
 
 	defer r.Close()
-	ppOpts := map[string]interface{}{
-		"fontFileMap": g.preprocessorOpts.TxtFontFileMap,
-	}
-	pp := preprocessor.ForType(sRes.GetInfo().GetMimeType(), ppOpts)
+	pp := preprocessor.ForType(sRes.GetInfo().GetMimeType(), g.preprocessorOpts.asMap())
 	img, err := pp.Convert(r)
 	if img == nil || err != nil {
 		return "", merrors.NotFound(g.serviceID, "could not get image")
@@ -256,10 +292,7 @@ func (g Thumbnail) handleWebdavSource(ctx context.Context, req *thumbnailssvc.Ge
 		return "", merrors.InternalServerError(g.serviceID, "could not get image from source: %s", err.Error())
 	}
 	defer r.Close()
-	ppOpts := map[string]interface{}{
-		"fontFileMap": g.preprocessorOpts.TxtFontFileMap,
-	}
-	pp := preprocessor.ForType(sRes.GetInfo().GetMimeType(), ppOpts)
+	pp := preprocessor.ForType(sRes.GetInfo().GetMimeType(), g.preprocessorOpts.asMap())
 	img, err := pp.Convert(r)
 	if img == nil || err != nil {
 		return "", merrors.NotFound(g.serviceID, "could not get image")
@@ -319,7 +352,7 @@ func (g Thumbnail) stat(path, auth string) (*provider.StatResponse, error) {
 		g.logger.Error().Msg("resource info is missing checksum")
 		return nil, merrors.NotFound(g.serviceID, "resource info is missing a checksum")
 	}
-	if !thumbnail.IsMimeTypeSupported(rsp.GetInfo().GetMimeType()) {
+	if !g.previews.IsMimeTypeSupported(rsp.GetInfo().GetMimeType()) {
 		return nil, merrors.NotFound(g.serviceID, "Unsupported file type")
 	}
 	return rsp, nil
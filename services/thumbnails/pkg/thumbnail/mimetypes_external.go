@@ -0,0 +1,39 @@
+package thumbnail
+
+import (
+	"mime"
+
+	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/preprocessor"
+)
+
+// Previews tells if the mimetypes which depend on external tools can be thumbnailed. The thumbnails
+// service only renders office documents and videos if the document converter or ffmpeg are configured.
+// Services advertising previews derive it from the same configuration, they don't share a process
+// with the thumbnails service in every deployment.
+type Previews struct {
+	Documents bool
+	Videos    bool
+}
+
+// NewPreviews returns the Previews for the configured document converter url and ffmpeg path
+func NewPreviews(documentConverterURL, ffmpegPath string) Previews {
+	return Previews{Documents: documentConverterURL != "", Videos: ffmpegPath != ""}
+}
+
+// IsMimeTypeSupported checks if a thumbnail can be generated for the mimetype
+func (p Previews) IsMimeTypeSupported(m string) bool {
+	if IsMimeTypeSupported(m) {
+		return true
+	}
+	mimeType, _, err := mime.ParseMediaType(m)
+	if err != nil {
+		return false
+	}
+	if _, ok := preprocessor.DocumentMimeTypes[mimeType]; ok {
+		return p.Documents
+	}
+	if _, ok := preprocessor.VideoMimeTypes[mimeType]; ok {
+		return p.Videos
+	}
+	return false
+}
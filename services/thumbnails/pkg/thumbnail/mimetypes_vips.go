@@ -19,5 +19,6 @@ var (
 		"application/vnd.geogebra.slides":   {},
 		"application/vnd.geogebra.pinboard": {},
 		"image/webp":                        {},
+		"application/pdf":                   {}, // the first page is rendered by libvips
 	}
 )
@@ -0,0 +1,44 @@
+package storage
+
+import (
+	"time"
+)
+
+// CleanupSummary contains the result of a cleanup
+type CleanupSummary struct {
+	Removed int
+	Freed   uint64
+}
+
+// Cleanup removes the thumbnails of files which don't exist anymore. exists reports if there is a file with the given checksum.
+// Thumbnails written after the given time are kept, their files could have been uploaded after the checksums were collected.
+// With dryRun the summary only reports what would have been removed.
+func Cleanup(s Evictable, exists func(checksum string) bool, writtenBefore time.Time, dryRun bool) (CleanupSummary, error) {
+	var (
+		summary CleanupSummary
+		orphans []Entry
+	)
+	err := s.List(func(e Entry) error {
+		checksum := checksumFromKey(e.Key)
+		if checksum == "" || e.LastAccess.After(writtenBefore) || exists(checksum) {
+			return nil
+		}
+		orphans = append(orphans, e)
+		return nil
+	})
+	if err != nil {
+		return summary, err
+	}
+
+	for _, e := range orphans {
+		if !dryRun {
+			if err := s.Delete(e.Key); err != nil {
+				return summary, err
+			}
+		}
+		summary.Removed++
+		summary.Freed += uint64(e.Size)
+	}
+
+	return summary, nil
+}
@@ -0,0 +1,156 @@
+package storage
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+)
+
+// NewEvictingStorage creates a new instance of EvictingStorage
+func NewEvictingStorage(s Evictable, maxSize uint64, interval time.Duration, logger log.Logger) *EvictingStorage {
+	return &EvictingStorage{
+		Evictable: s,
+		maxSize:   maxSize,
+		interval:  interval,
+		logger:    logger,
+		accessed:  map[string]time.Time{},
+		stopCh:    make(chan struct{}),
+	}
+}
+
+// EvictingStorage wraps a storage and removes the least recently used thumbnails
+// when the size of all thumbnails exceeds the maximum size.
+//
+// The storages only know when a thumbnail was written, reads are tracked in memory.
+// After a restart or for reads served by other instances the time of the write is used.
+type EvictingStorage struct {
+	Evictable
+
+	maxSize  uint64
+	interval time.Duration
+	logger   log.Logger
+
+	mu       sync.Mutex
+	accessed map[string]time.Time
+
+	stopOnce sync.Once
+	stopCh   chan struct{}
+}
+
+// Get returns the thumbnail for the given key and records the access
+func (s *EvictingStorage) Get(key string) ([]byte, error) {
+	img, err := s.Evictable.Get(key)
+	if err == nil {
+		s.touch(key)
+	}
+	return img, err
+}
+
+// Put stores the thumbnail for the given key and records the access
+func (s *EvictingStorage) Put(key string, img []byte) error {
+	if err := s.Evictable.Put(key, img); err != nil {
+		return err
+	}
+	s.touch(key)
+	return nil
+}
+
+// Run evicts thumbnails in the configured interval until Close is called
+func (s *EvictingStorage) Run() error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stopCh:
+			return nil
+		case <-ticker.C:
+			evicted, freed, err := s.Evict()
+			if err != nil {
+				s.logger.Error().Err(err).Msg("could not evict thumbnails")
+				continue
+			}
+			if evicted > 0 {
+				s.logger.Info().Int("count", evicted).Uint64("freed", freed).Msg("evicted thumbnails")
+			}
+		}
+	}
+}
+
+// Close stops Run
+func (s *EvictingStorage) Close() {
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+}
+
+// Evict removes the least recently used thumbnails until their size is below the maximum size.
+// It returns the number of removed thumbnails and their size.
+func (s *EvictingStorage) Evict() (int, uint64, error) {
+	var (
+		entries []Entry
+		total   uint64
+	)
+	err := s.List(func(e Entry) error {
+		entries = append(entries, e)
+		total += uint64(e.Size)
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	s.mu.Lock()
+	accessed := make(map[string]time.Time, len(entries))
+	for i, e := range entries {
+		if t, ok := s.accessed[e.Key]; ok {
+			accessed[e.Key] = t
+			if t.After(e.LastAccess) {
+				entries[i].LastAccess = t
+			}
+		}
+	}
+	// forget about thumbnails which have been removed by others
+	s.accessed = accessed
+	s.mu.Unlock()
+
+	if total <= s.maxSize {
+		return 0, 0, nil
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].LastAccess.Before(entries[j].LastAccess)
+	})
+
+	var (
+		evicted int
+		freed   uint64
+	)
+	for _, e := range entries {
+		if total-freed <= s.maxSize {
+			break
+		}
+		if err := s.Delete(e.Key); err != nil {
+			return evicted, freed, err
+		}
+		s.forget(e.Key)
+		evicted++
+		freed += uint64(e.Size)
+	}
+
+	return evicted, freed, nil
+}
+
+func (s *EvictingStorage) touch(key string) {
+	s.mu.Lock()
+	s.accessed[key] = time.Now()
+	s.mu.Unlock()
+}
+
+func (s *EvictingStorage) forget(key string) {
+	s.mu.Lock()
+	delete(s.accessed, key)
+	s.mu.Unlock()
+}
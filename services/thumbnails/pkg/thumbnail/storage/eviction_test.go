@@ -0,0 +1,91 @@
+package storage_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/thumbnail/storage"
+)
+
+func TestEvictingStorage_Evict(t *testing.T) {
+	root := t.TempDir()
+	fs := storage.NewFileSystemStorage(config.FileSystemStorage{RootDirectory: root}, log.NopLogger())
+	s := storage.NewEvictingStorage(fs, 25, time.Hour, log.NopLogger())
+
+	keys := []string{
+		filepath.Join("aa", "aa", "aaaa", "32x32.png"),
+		filepath.Join("bb", "bb", "bbbb", "32x32.png"),
+		filepath.Join("cc", "cc", "cccc", "32x32.png"),
+	}
+	for i, key := range keys {
+		require.NoError(t, s.Put(key, make([]byte, 10)))
+		// written an hour apart, the first one is the oldest
+		mtime := time.Now().Add(time.Duration(i-len(keys)) * time.Hour)
+		require.NoError(t, os.Chtimes(filepath.Join(root, "files", key), mtime, mtime))
+	}
+
+	// like after a restart, a new instance only knows the modification times
+	evicted, freed, err := storage.NewEvictingStorage(fs, 25, time.Hour, log.NopLogger()).Evict()
+	require.NoError(t, err)
+	assert.Equal(t, 1, evicted)
+	assert.Equal(t, uint64(10), freed)
+	assert.False(t, fs.Stat(keys[0]))
+	assert.True(t, fs.Stat(keys[1]))
+
+	// the read makes the second thumbnail the most recently used one
+	require.NoError(t, s.Put(keys[0], make([]byte, 10)))
+	_, err = s.Get(keys[1])
+	require.NoError(t, err)
+	require.NoError(t, os.Chtimes(filepath.Join(root, "files", keys[0]), time.Now(), time.Now()))
+
+	evicted, freed, err = s.Evict()
+	require.NoError(t, err)
+	assert.Equal(t, 1, evicted)
+	assert.Equal(t, uint64(10), freed)
+	assert.True(t, fs.Stat(keys[0]))
+	assert.True(t, fs.Stat(keys[1]))
+	assert.False(t, fs.Stat(keys[2]))
+
+	evicted, _, err = s.Evict()
+	require.NoError(t, err)
+	assert.Zero(t, evicted)
+}
+
+func TestCleanup(t *testing.T) {
+	root := t.TempDir()
+	fs := storage.NewFileSystemStorage(config.FileSystemStorage{RootDirectory: root}, log.NopLogger())
+
+	purged := filepath.Join("aa", "aa", "aaaa", "32x32.png")
+	existing := filepath.Join("bb", "bb", "bbbb", "32x32.png")
+	recent := filepath.Join("cc", "cc", "cccc", "32x32.png")
+	for _, key := range []string{purged, existing, recent} {
+		require.NoError(t, fs.Put(key, make([]byte, 10)))
+	}
+	start := time.Now().Add(-time.Minute)
+	old := start.Add(-time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(root, "files", purged), old, old))
+	require.NoError(t, os.Chtimes(filepath.Join(root, "files", existing), old, old))
+
+	exists := func(checksum string) bool {
+		return checksum == "bbbbbbbb"
+	}
+
+	summary, err := storage.Cleanup(fs, exists, start, true)
+	require.NoError(t, err)
+	assert.Equal(t, storage.CleanupSummary{Removed: 1, Freed: 10}, summary)
+	assert.True(t, fs.Stat(purged))
+
+	summary, err = storage.Cleanup(fs, exists, start, false)
+	require.NoError(t, err)
+	assert.Equal(t, storage.CleanupSummary{Removed: 1, Freed: 10}, summary)
+	assert.False(t, fs.Stat(purged))
+	assert.True(t, fs.Stat(existing))
+	assert.True(t, fs.Stat(recent))
+}
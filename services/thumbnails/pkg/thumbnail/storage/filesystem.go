@@ -4,7 +4,6 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
-	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -94,6 +93,56 @@ func (s FileSystem) Put(key string, img []byte) error {
 	return nil
 }
 
+// List calls fn for every thumbnail in the file system. The modification time of the files is used as access time.
+func (s FileSystem) List(fn func(e Entry) error) error {
+	files := filepath.Join(s.root, filesDir)
+	err := filepath.WalkDir(files, func(p string, d fs.DirEntry, err error) error {
+		switch {
+		case err != nil:
+			return err
+		case d.IsDir() || strings.HasPrefix(d.Name(), "tmpthumb"):
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				// removed in the meantime
+				return nil
+			}
+			return err
+		}
+
+		key, err := filepath.Rel(files, p)
+		if err != nil {
+			return err
+		}
+
+		return fn(Entry{Key: key, Size: info.Size(), LastAccess: info.ModTime()})
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// Delete removes the thumbnail for the given key and the directories which became empty
+func (s FileSystem) Delete(key string) error {
+	files := filepath.Join(s.root, filesDir)
+	img := filepath.Join(files, key)
+	if err := os.Remove(img); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return errors.Wrapf(err, "could not delete thumbnail \"%s\"", key)
+	}
+
+	// removing a directory fails as long as it is not empty
+	for dir := filepath.Dir(img); dir != files && strings.HasPrefix(dir, files); dir = filepath.Dir(dir) {
+		if err := os.Remove(dir); err != nil {
+			break
+		}
+	}
+	return nil
+}
+
 // BuildKey generate the unique key for a thumbnail.
 // The key is structure as follows:
 //
@@ -103,16 +152,5 @@ func (s FileSystem) Put(key string, img []byte) error {
 //
 // The key also represents the path to the thumbnail in the filesystem under the configured root directory.
 func (s FileSystem) BuildKey(r Request) string {
-	checksum := r.Checksum
-	filetype := r.Types[0]
-
-	parts := []string{strconv.Itoa(r.Resolution.Dx()), "x", strconv.Itoa(r.Resolution.Dy())}
-
-	if r.Characteristic != "" {
-		parts = append(parts, "-", r.Characteristic)
-	}
-
-	parts = append(parts, ".", filetype)
-
-	return filepath.Join(checksum[:2], checksum[2:4], checksum[4:], strings.Join(parts, ""))
+	return filepath.Join(keyElements(r)...)
 }
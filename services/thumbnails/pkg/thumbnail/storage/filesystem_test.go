@@ -2,10 +2,14 @@ package storage_test
 
 import (
 	"image"
+	"path/filepath"
 	"testing"
 
 	tAssert "github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/config"
 	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/thumbnail/storage"
 )
 
@@ -63,3 +67,30 @@ func TestFileSystem_BuildKey(t *testing.T) {
 	}
 
 }
+
+func TestFileSystem_ListDelete(t *testing.T) {
+	root := t.TempDir()
+	s := storage.NewFileSystemStorage(config.FileSystemStorage{RootDirectory: root}, log.NopLogger())
+
+	var entries []storage.Entry
+	list := func(e storage.Entry) error {
+		entries = append(entries, e)
+		return nil
+	}
+	require.NoError(t, s.List(list))
+	tAssert.Empty(t, entries)
+
+	key := filepath.Join("12", "0E", "A8A25E5D487BF68B5F7096440019", "2x2.png")
+	require.NoError(t, s.Put(key, []byte("image")))
+
+	require.NoError(t, s.List(list))
+	require.Len(t, entries, 1)
+	tAssert.Equal(t, key, entries[0].Key)
+	tAssert.Equal(t, int64(5), entries[0].Size)
+
+	require.NoError(t, s.Delete(key))
+	tAssert.False(t, s.Stat(key))
+	// the empty directories are removed as well
+	tAssert.NoDirExists(t, filepath.Join(root, "files", "12"))
+	tAssert.DirExists(t, filepath.Join(root, "files"))
+}
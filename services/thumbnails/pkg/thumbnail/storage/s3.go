@@ -0,0 +1,134 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/pkg/errors"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/config"
+)
+
+// NewS3Storage creates a new instance of S3
+func NewS3Storage(cfg config.S3Storage, logger log.Logger) (S3, error) {
+	u, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return S3{}, errors.Wrapf(err, "invalid s3 endpoint \"%s\"", cfg.Endpoint)
+	}
+	secure := u.Scheme == "https"
+
+	transport, err := minio.DefaultTransport(secure)
+	if err != nil {
+		return S3{}, errors.Wrap(err, "could not create the s3 transport")
+	}
+	if secure && cfg.Insecure {
+		transport.TLSClientConfig.InsecureSkipVerify = true //nolint:gosec
+	}
+
+	client, err := minio.New(u.Host, &minio.Options{
+		Region:    cfg.Region,
+		Creds:     credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, ""),
+		Secure:    secure,
+		Transport: transport,
+	})
+	if err != nil {
+		return S3{}, errors.Wrap(err, "could not create the s3 client")
+	}
+
+	return S3{
+		client: client,
+		bucket: cfg.Bucket,
+		logger: logger,
+	}, nil
+}
+
+// S3 represents a storage for the thumbnails using an S3 compatible object storage.
+// It can be shared by multiple instances of the thumbnails service.
+type S3 struct {
+	client *minio.Client
+	bucket string
+	logger log.Logger
+}
+
+// Stat returns if an object for the given key exists in the bucket
+func (s S3) Stat(key string) bool {
+	if _, err := s.client.StatObject(context.Background(), s.bucket, key, minio.StatObjectOptions{}); err != nil {
+		if !isNotExist(err) {
+			s.logger.Debug().Err(err).Str("key", key).Msg("could not stat thumbnail")
+		}
+		return false
+	}
+	return true
+}
+
+// Get returns the object content for the given key
+func (s S3) Get(key string) ([]byte, error) {
+	obj, err := s.client.GetObject(context.Background(), s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer obj.Close()
+
+	content, err := io.ReadAll(obj)
+	if err != nil {
+		if isNotExist(err) {
+			return nil, fs.ErrNotExist
+		}
+		s.logger.Debug().Err(err).Str("key", key).Msg("could not load thumbnail from store")
+		return nil, err
+	}
+	return content, nil
+}
+
+// Put stores image data in the bucket for the given key
+func (s S3) Put(key string, img []byte) error {
+	_, err := s.client.PutObject(context.Background(), s.bucket, key, bytes.NewReader(img), int64(len(img)), minio.PutObjectOptions{
+		ContentType: mime.TypeByExtension(path.Ext(key)),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "could not upload thumbnail \"%s\"", key)
+	}
+	return nil
+}
+
+// List calls fn for every thumbnail in the bucket. The modification time of the objects is used as access time.
+func (s S3) List(fn func(e Entry) error) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for obj := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Recursive: true}) {
+		if obj.Err != nil {
+			return errors.Wrap(obj.Err, "could not list thumbnails")
+		}
+		if err := fn(Entry{Key: obj.Key, Size: obj.Size, LastAccess: obj.LastModified}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Delete removes the object for the given key
+func (s S3) Delete(key string) error {
+	if err := s.client.RemoveObject(context.Background(), s.bucket, key, minio.RemoveObjectOptions{}); err != nil {
+		return errors.Wrapf(err, "could not delete thumbnail \"%s\"", key)
+	}
+	return nil
+}
+
+// BuildKey generate the unique key for a thumbnail, see FileSystem.BuildKey.
+func (s S3) BuildKey(r Request) string {
+	return path.Join(keyElements(r)...)
+}
+
+func isNotExist(err error) bool {
+	return minio.ToErrorResponse(err).StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,184 @@
+package storage_test
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/config"
+	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/thumbnail/storage"
+)
+
+// fakeS3 is a minimal S3 compatible server which supports the requests of the S3 storage
+type fakeS3 struct {
+	bucket  string
+	mu      sync.Mutex
+	objects map[string][]byte
+	mtimes  map[string]time.Time
+}
+
+type listBucketResult struct {
+	XMLName     xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListBucketResult"`
+	Name        string
+	KeyCount    int
+	MaxKeys     int
+	IsTruncated bool
+	Contents    []listObject
+}
+
+type listObject struct {
+	Key          string
+	LastModified string
+	ETag         string
+	Size         int
+}
+
+func newFakeS3(t *testing.T, bucket string) (*fakeS3, *httptest.Server) {
+	f := &fakeS3{bucket: bucket, objects: map[string][]byte{}, mtimes: map[string]time.Time{}}
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+	return f, srv
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if bucket != f.bucket {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	switch {
+	case key == "" && r.Method == http.MethodGet:
+		keys := make([]string, 0, len(f.objects))
+		for k := range f.objects {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		res := listBucketResult{Name: f.bucket, KeyCount: len(keys), MaxKeys: 1000}
+		for _, k := range keys {
+			res.Contents = append(res.Contents, listObject{
+				Key:          k,
+				LastModified: f.mtimes[k].UTC().Format("2006-01-02T15:04:05.000Z"),
+				ETag:         `"etag"`,
+				Size:         len(f.objects[k]),
+			})
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		_ = xml.NewEncoder(w).Encode(res)
+	case r.Method == http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		if strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+			body = decodeAWSChunked(body)
+		}
+		f.objects[key] = body
+		f.mtimes[key] = time.Now()
+		w.Header().Set("ETag", `"etag"`)
+	case r.Method == http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodHead || r.Method == http.MethodGet:
+		obj, ok := f.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			if r.Method == http.MethodGet {
+				_, _ = io.WriteString(w, `<Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`)
+			}
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(obj)))
+		w.Header().Set("Last-Modified", f.mtimes[key].UTC().Format(http.TimeFormat))
+		w.Header().Set("ETag", `"etag"`)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(obj)
+		}
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// decodeAWSChunked returns the payload of a body which was sent with the aws-chunked content encoding
+func decodeAWSChunked(body []byte) []byte {
+	var payload []byte
+	for len(body) > 0 {
+		header, rest, ok := strings.Cut(string(body), "\r\n")
+		if !ok {
+			break
+		}
+		size, _, _ := strings.Cut(header, ";")
+		n, err := strconv.ParseInt(size, 16, 64)
+		if err != nil || n == 0 || int(n) > len(rest) {
+			break
+		}
+		payload = append(payload, rest[:n]...)
+		body = []byte(strings.TrimPrefix(rest[n:], "\r\n"))
+	}
+	return payload
+}
+
+func newS3Storage(t *testing.T, endpoint string) storage.S3 {
+	s, err := storage.NewS3Storage(config.S3Storage{
+		Endpoint:  endpoint,
+		Region:    "us-east-1",
+		AccessKey: "access",
+		SecretKey: "secret",
+		Bucket:    "thumbnails",
+	}, log.NopLogger())
+	require.NoError(t, err)
+	return s
+}
+
+func TestS3_PutGetStat(t *testing.T) {
+	fake, srv := newFakeS3(t, "thumbnails")
+	s := newS3Storage(t, srv.URL)
+
+	key := "12/0E/A8A25E5D487BF68B5F7096440019/2x2.png"
+	assert.False(t, s.Stat(key))
+	_, err := s.Get(key)
+	assert.Error(t, err)
+
+	require.NoError(t, s.Put(key, []byte("image")))
+	assert.Equal(t, []byte("image"), fake.objects[key])
+	assert.True(t, s.Stat(key))
+
+	img, err := s.Get(key)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("image"), img)
+}
+
+func TestS3_ListDelete(t *testing.T) {
+	_, srv := newFakeS3(t, "thumbnails")
+	s := newS3Storage(t, srv.URL)
+
+	require.NoError(t, s.Put("12/0E/A8A25E5D487BF68B5F7096440019/2x2.png", []byte("a")))
+	require.NoError(t, s.Put("12/0E/A8A25E5D487BF68B5F7096440019/4x4.png", []byte("bb")))
+
+	var entries []storage.Entry
+	require.NoError(t, s.List(func(e storage.Entry) error {
+		entries = append(entries, e)
+		return nil
+	}))
+	require.Len(t, entries, 2)
+	assert.Equal(t, "12/0E/A8A25E5D487BF68B5F7096440019/2x2.png", entries[0].Key)
+	assert.Equal(t, int64(1), entries[0].Size)
+	assert.WithinDuration(t, time.Now(), entries[0].LastAccess, time.Minute)
+	assert.Equal(t, int64(2), entries[1].Size)
+
+	require.NoError(t, s.Delete("12/0E/A8A25E5D487BF68B5F7096440019/2x2.png"))
+	assert.False(t, s.Stat("12/0E/A8A25E5D487BF68B5F7096440019/2x2.png"))
+	assert.True(t, s.Stat("12/0E/A8A25E5D487BF68B5F7096440019/4x4.png"))
+}
@@ -1,7 +1,16 @@
 package storage
 
 import (
+	"fmt"
 	"image"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/config"
 )
 
 // Request combines different attributes needed for storage operations.
@@ -30,3 +39,60 @@ type Storage interface {
 	Put(key string, img []byte) error
 	BuildKey(r Request) string
 }
+
+// Entry describes a stored thumbnail.
+type Entry struct {
+	Key  string
+	Size int64
+	// LastAccess is the time the thumbnail was last used as far as the storage knows,
+	// usually the time it was written.
+	LastAccess time.Time
+}
+
+// Evictable is a Storage whose thumbnails can be listed and removed.
+type Evictable interface {
+	Storage
+	// List calls fn for every stored thumbnail, listing stops when fn returns an error.
+	List(fn func(e Entry) error) error
+	Delete(key string) error
+}
+
+// New creates the storage configured for the thumbnails
+func New(cfg config.Thumbnail, logger log.Logger) (Evictable, error) {
+	switch cfg.Storage {
+	case "", "filesystem":
+		return NewFileSystemStorage(cfg.FileSystemStorage, logger), nil
+	case "s3":
+		return NewS3Storage(cfg.S3Storage, logger)
+	default:
+		return nil, fmt.Errorf("unknown thumbnail storage '%s'", cfg.Storage)
+	}
+}
+
+// keyElements returns the elements of the key for a thumbnail.
+// The key is structure as follows:
+//
+// <first two letters of checksum>/<next two letters of checksum>/<rest of checksum>/<width>x<height>.<filetype>
+func keyElements(r Request) []string {
+	checksum := r.Checksum
+	filetype := r.Types[0]
+
+	parts := []string{strconv.Itoa(r.Resolution.Dx()), "x", strconv.Itoa(r.Resolution.Dy())}
+
+	if r.Characteristic != "" {
+		parts = append(parts, "-", r.Characteristic)
+	}
+
+	parts = append(parts, ".", filetype)
+
+	return []string{checksum[:2], checksum[2:4], checksum[4:], strings.Join(parts, "")}
+}
+
+// checksumFromKey returns the checksum of the source file of a thumbnail, it is the inverse of keyElements
+func checksumFromKey(key string) string {
+	elements := strings.Split(path.Clean(filepath.ToSlash(key)), "/")
+	if len(elements) != 4 {
+		return ""
+	}
+	return elements[0] + elements[1] + elements[2]
+}
@@ -101,7 +101,8 @@ func mapToStorageRequest(r Request) storage.Request {
 	}
 }
 
-// IsMimeTypeSupported validate if the mime type is supported
+// IsMimeTypeSupported validate if the mime type is supported. Office documents and videos
+// are only supported if the tools to render them are configured, see Previews.
 func IsMimeTypeSupported(m string) bool {
 	mimeType, _, err := mime.ParseMediaType(m)
 	if err != nil {
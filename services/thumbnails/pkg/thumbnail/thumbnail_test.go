@@ -196,3 +196,18 @@ func TestPreviewGenerationTooBigImage(t *testing.T) {
 		})
 	}
 }
+
+func TestPreviews(t *testing.T) {
+	const docx = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+
+	none := NewPreviews("", "")
+	assert.True(t, none.IsMimeTypeSupported("image/png"))
+	assert.False(t, none.IsMimeTypeSupported(docx))
+	assert.False(t, none.IsMimeTypeSupported("video/mp4"))
+
+	all := NewPreviews("https://collabora.example.com/cool/convert-to", "/usr/bin/ffmpeg")
+	assert.True(t, all.IsMimeTypeSupported(docx))
+	assert.True(t, all.IsMimeTypeSupported("video/mp4; codecs=avc1"))
+	assert.True(t, all.IsMimeTypeSupported("application/pdf"), "pdf files are rendered by libvips or the document converter")
+	assert.False(t, all.IsMimeTypeSupported("application/x-unknown"))
+}
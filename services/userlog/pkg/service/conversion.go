@@ -15,6 +15,7 @@ import (
 	collaboration "github.com/cs3org/go-cs3apis/cs3/sharing/collaboration/v1beta1"
 	storageprovider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
 	"github.com/opencloud-eu/opencloud/pkg/l10n"
+	avevent "github.com/opencloud-eu/opencloud/services/antivirus/pkg/event"
 	"github.com/opencloud-eu/reva/v2/pkg/events"
 	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
 	"github.com/opencloud-eu/reva/v2/pkg/storagespace"
@@ -95,6 +96,8 @@ func (c *Converter) ConvertEvent(eventid string, event interface{}) (OC10Notific
 		default:
 			return OC10Notification{}, fmt.Errorf("unknown postprocessing step: %s", ev.FinishedStep)
 		}
+	case avevent.InfectedFileFound:
+		return c.virusMessage(eventid, VirusFoundAtRest, nil, ev.ResourceID, ev.Filename, ev.Description, ev.Scandate)
 
 	// space related
 	case events.SpaceDisabled:
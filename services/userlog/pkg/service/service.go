@@ -13,6 +13,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/opencloud-eu/reva/v2/pkg/events"
 	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
+	"github.com/opencloud-eu/reva/v2/pkg/storagespace"
 	"github.com/opencloud-eu/reva/v2/pkg/utils"
 	"go-micro.dev/v4/store"
 	"go.opentelemetry.io/otel/trace"
@@ -23,6 +24,7 @@ import (
 	ehmsg "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/eventhistory/v0"
 	ehsvc "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/eventhistory/v0"
 	settingssvc "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/settings/v0"
+	avevent "github.com/opencloud-eu/opencloud/services/antivirus/pkg/event"
 	"github.com/opencloud-eu/opencloud/services/userlog/pkg/config"
 )
 
@@ -155,6 +157,10 @@ func (ul *UserlogService) processEvent(event events.Event) {
 		default:
 			return
 		}
+	case avevent.InfectedFileFound:
+		// inform the managers of the space, for personal spaces this is the owner
+		rid := e.ResourceID
+		users, err = utils.GetSpaceMembers(ctx, storagespace.FormatStorageID(rid.GetStorageId(), rid.GetSpaceId()), gwc, utils.ManagerRole)
 
 	// space related // TODO: how to find spaceadmins?
 	case events.SpaceDisabled:
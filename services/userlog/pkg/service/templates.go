@@ -9,6 +9,11 @@ var (
 		Message: l10n.Template("Virus found in {resource}. Upload not possible. Virus: {virus}"),
 	}
 
+	VirusFoundAtRest = NotificationTemplate{
+		Subject: l10n.Template("Virus found"),
+		Message: l10n.Template("Virus found in {resource} during a rescan. Virus: {virus}"),
+	}
+
 	PoliciesEnforced = NotificationTemplate{
 		Subject: l10n.Template("Policies enforced"),
 		Message: l10n.Template("File {resource} was deleted because it violates the policies"),
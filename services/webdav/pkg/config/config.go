@@ -21,9 +21,18 @@ type Config struct {
 
 	HTTP HTTP `yaml:"http"`
 
+	Store Store `yaml:"store"`
+
 	DisablePreviews    bool            `yaml:"disablePreviews" env:"OC_DISABLE_PREVIEWS;WEBDAV_DISABLE_PREVIEWS" desc:"Set this option to 'true' to disable rendering of thumbnails triggered via webdav access. Note that when disabled, all access to preview related webdav paths will return a 404." introductionVersion:"1.0.0"`
 	OpenCloudPublicURL string          `yaml:"opencloud_public_url" env:"OC_URL;OC_PUBLIC_URL" desc:"URL, where OpenCloud is reachable for users." introductionVersion:"1.0.0"`
 	WebdavNamespace    string          `yaml:"webdav_namespace" env:"WEBDAV_WEBDAV_NAMESPACE" desc:"CS3 path layout to use when forwarding /webdav requests" introductionVersion:"1.0.0"`
 	RevaGateway        string          `yaml:"reva_gateway" env:"OC_REVA_GATEWAY" desc:"CS3 gateway used to look up user metadata" introductionVersion:"1.0.0"`
+	Thumbnails         Thumbnails      `yaml:"thumbnails"`
 	Context            context.Context `yaml:"-"`
 }
+
+// Thumbnails tells which additional file types the thumbnails service renders previews for.
+type Thumbnails struct {
+	DocumentConverterURL string `yaml:"document_converter_url" env:"THUMBNAILS_DOCUMENT_CONVERTER_URL" desc:"The URL of the document converter used by the thumbnails service. If set, office documents are reported as having a preview. Use the same value as for the thumbnails service." introductionVersion:"%%NEXT%%"`
+	FFmpegPath           string `yaml:"ffmpeg_path" env:"THUMBNAILS_FFMPEG_PATH" desc:"The path to the ffmpeg binary used by the thumbnails service. If set, videos are reported as having a preview. Use the same value as for the thumbnails service." introductionVersion:"%%NEXT%%"`
+}
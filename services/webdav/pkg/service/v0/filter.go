@@ -0,0 +1,212 @@
+package svc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	rpcv1beta1 "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	"go-micro.dev/v4/metadata"
+	grpcmetadata "google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/opencloud-eu/reva/v2/pkg/conversions"
+	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
+	"github.com/opencloud-eu/reva/v2/pkg/permission"
+	"github.com/opencloud-eu/reva/v2/pkg/storagespace"
+	"github.com/opencloud-eu/reva/v2/pkg/tags"
+	"github.com/opencloud-eu/reva/v2/pkg/utils"
+
+	searchmsg "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/search/v0"
+	searchsvc "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/search/v0"
+)
+
+// tagsKey is the arbitrary metadata key of the tags
+const tagsKey = "tags"
+
+// filterFiles answers filter-files reports. Resources with system tags are found with the search service,
+// favorites are looked up in the favorites store the ocdav service records them in.
+func (g Webdav) filterFiles(w http.ResponseWriter, r *http.Request, ff *reportFilterFiles) {
+	logger := g.log.SubloggerWithRequestID(r.Context())
+
+	systemTags := make([]string, 0, len(ff.Rules.SystemTags))
+	for _, tag := range ff.Rules.SystemTags {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			systemTags = append(systemTags, tag)
+		}
+	}
+	if !ff.Rules.Favorite && len(systemTags) == 0 {
+		renderError(w, r, errBadRequest("missing filter-rules"))
+		logger.Debug().Msg("filter-files report without rules")
+		return
+	}
+
+	t := r.Header.Get(revactx.TokenHeader)
+	ctx := revactx.ContextSetToken(r.Context(), t)
+	ctx = metadata.Set(ctx, revactx.TokenHeader, t)
+	ctx = grpcmetadata.AppendToOutgoingContext(ctx, revactx.TokenHeader, t)
+
+	var rsp *searchsvc.SearchResponse
+	if len(systemTags) > 0 {
+		var ok bool
+		rsp, ok = g.search(ctx, &searchsvc.SearchRequest{
+			Query:    tagQuery(systemTags),
+			PageSize: -1,
+			Ref:      g.spaceReference(r),
+		}, w, r)
+		if !ok {
+			return
+		}
+		if !ff.Rules.Favorite {
+			g.sendSearchResponse(rsp, w, r)
+			return
+		}
+	}
+
+	gatewayClient, err := g.gatewaySelector.Next()
+	if err != nil {
+		logger.Error().Err(err).Msg("could not get reva gatewayClient")
+		renderError(w, r, errInternalError("could not get reva gatewayClient"))
+		return
+	}
+
+	userRes, err := gatewayClient.WhoAmI(ctx, &gateway.WhoAmIRequest{Token: t})
+	if err != nil || userRes.GetStatus().GetCode() != rpcv1beta1.Code_CODE_OK {
+		logger.Error().Err(err).Interface("status", userRes.GetStatus()).Msg("could not get user")
+		renderError(w, r, errInternalError("could not get user"))
+		return
+	}
+	ctx = revactx.ContextSetUser(ctx, userRes.GetUser())
+
+	ok, err := utils.CheckPermission(ctx, permission.ListFavorites, gatewayClient)
+	if err != nil {
+		logger.Error().Err(err).Msg("error checking permission")
+		renderError(w, r, errInternalError("error checking permission"))
+		return
+	}
+	if !ok {
+		logger.Debug().Str("user", userRes.GetUser().GetId().GetOpaqueId()).Msg("user not allowed to list favorites")
+		renderError(w, r, errPermissionDenied("not allowed to list favorites"))
+		return
+	}
+
+	favorites, err := g.favorites.ListFavorites(ctx, userRes.GetUser().GetId())
+	if err != nil {
+		logger.Error().Err(err).Msg("could not list favorites")
+		renderError(w, r, errInternalError("could not list favorites"))
+		return
+	}
+
+	var matches []*searchmsg.Match
+	if rsp != nil {
+		matches = favoriteMatches(rsp.GetMatches(), favorites)
+	} else {
+		matches, err = g.listFavorites(ctx, gatewayClient, favorites)
+	}
+	if err != nil {
+		logger.Error().Err(err).Msg("could not list favorites")
+		renderError(w, r, errInternalError("could not list favorites"))
+		return
+	}
+
+	g.sendSearchResponse(&searchsvc.SearchResponse{Matches: matches, TotalMatches: int32(len(matches))}, w, r)
+}
+
+// tagQuery returns the KQL query matching resources with all given tags
+func tagQuery(systemTags []string) string {
+	terms := make([]string, 0, len(systemTags))
+	for _, tag := range systemTags {
+		terms = append(terms, fmt.Sprintf("tag:%q", tag))
+	}
+	return strings.Join(terms, " AND ")
+}
+
+// favoriteMatches returns the search matches marked as favorite by the current user
+func favoriteMatches(matches []*searchmsg.Match, favorites []*provider.ResourceId) []*searchmsg.Match {
+	ids := make(map[string]struct{}, len(favorites))
+	for _, id := range favorites {
+		ids[storagespace.FormatResourceID(id)] = struct{}{}
+	}
+
+	filtered := make([]*searchmsg.Match, 0, len(matches))
+	for _, match := range matches {
+		id := match.GetEntity().GetId()
+		if _, ok := ids[storagespace.FormatResourceID(&provider.ResourceId{
+			StorageId: id.GetStorageId(),
+			SpaceId:   id.GetSpaceId(),
+			OpaqueId:  id.GetOpaqueId(),
+		})]; ok {
+			filtered = append(filtered, match)
+		}
+	}
+	return filtered
+}
+
+// listFavorites returns the resources marked as favorite by the current user
+func (g Webdav) listFavorites(ctx context.Context, gatewayClient gateway.GatewayAPIClient, favorites []*provider.ResourceId) ([]*searchmsg.Match, error) {
+	matches := make([]*searchmsg.Match, 0, len(favorites))
+	for _, id := range favorites {
+		res, err := gatewayClient.Stat(ctx, &provider.StatRequest{
+			Ref:                   &provider.Reference{ResourceId: id},
+			ArbitraryMetadataKeys: []string{tagsKey},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if res.GetStatus().GetCode() != rpcv1beta1.Code_CODE_OK {
+			// the resource was removed or is not accessible anymore
+			g.log.Debug().Str("id", storagespace.FormatResourceID(id)).Interface("status", res.GetStatus()).Msg("could not stat favorite")
+			continue
+		}
+
+		pathRes, err := gatewayClient.GetPath(ctx, &provider.GetPathRequest{ResourceId: id})
+		if err != nil {
+			return nil, err
+		}
+		if pathRes.GetStatus().GetCode() != rpcv1beta1.Code_CODE_OK {
+			g.log.Debug().Str("id", storagespace.FormatResourceID(id)).Interface("status", pathRes.GetStatus()).Msg("could not get the path of favorite")
+			continue
+		}
+
+		info := res.GetInfo()
+		isDir := info.GetType() == provider.ResourceType_RESOURCE_TYPE_CONTAINER
+		permissions := conversions.RoleFromResourcePermissions(info.GetPermissionSet(), false).WebDAVPermissions(isDir, false, false, false)
+		matches = append(matches, &searchmsg.Match{
+			Entity: &searchmsg.Entity{
+				Ref: &searchmsg.Reference{
+					ResourceId: &searchmsg.ResourceID{
+						StorageId: id.GetStorageId(),
+						SpaceId:   id.GetSpaceId(),
+						OpaqueId:  id.GetSpaceId(),
+					},
+					Path: utils.MakeRelativePath(pathRes.GetPath()),
+				},
+				Id:               resourceID(info.GetId()),
+				ParentId:         resourceID(info.GetParentId()),
+				Name:             info.GetName(),
+				Etag:             info.GetEtag(),
+				Size:             info.GetSize(),
+				LastModifiedTime: timestamppb.New(utils.TSToTime(info.GetMtime())),
+				MimeType:         info.GetMimeType(),
+				Permissions:      permissions,
+				Type:             uint64(info.GetType()),
+				Tags:             tags.New(info.GetArbitraryMetadata().GetMetadata()[tagsKey]).AsSlice(),
+			},
+		})
+	}
+	return matches, nil
+}
+
+func resourceID(id *provider.ResourceId) *searchmsg.ResourceID {
+	if id == nil {
+		return nil
+	}
+	return &searchmsg.ResourceID{
+		StorageId: id.GetStorageId(),
+		SpaceId:   id.GetSpaceId(),
+		OpaqueId:  id.GetOpaqueId(),
+	}
+}
@@ -0,0 +1,193 @@
+package svc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	gateway "github.com/cs3org/go-cs3apis/cs3/gateway/v1beta1"
+	userv1beta1 "github.com/cs3org/go-cs3apis/cs3/identity/user/v1beta1"
+	permissions "github.com/cs3org/go-cs3apis/cs3/permissions/v1beta1"
+	rpcv1beta1 "github.com/cs3org/go-cs3apis/cs3/rpc/v1beta1"
+	provider "github.com/cs3org/go-cs3apis/cs3/storage/provider/v1beta1"
+	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
+	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
+	cs3mocks "github.com/opencloud-eu/reva/v2/tests/cs3mocks/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	microstore "go-micro.dev/v4/store"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/opencloud-eu/opencloud/pkg/favorite"
+	"github.com/opencloud-eu/opencloud/pkg/log"
+	searchmsg "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/search/v0"
+	searchsvc "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/search/v0"
+	searchmocks "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/search/v0/mocks"
+	"github.com/opencloud-eu/opencloud/services/webdav/pkg/config"
+)
+
+var _einstein = &userv1beta1.User{Id: &userv1beta1.UserId{OpaqueId: "einstein"}}
+
+func fileID(id string) *provider.ResourceId {
+	return &provider.ResourceId{StorageId: "storage", SpaceId: "space", OpaqueId: id}
+}
+
+func searchMatch(id string) *searchmsg.Match {
+	return &searchmsg.Match{
+		Entity: &searchmsg.Entity{
+			Ref:              &searchmsg.Reference{ResourceId: &searchmsg.ResourceID{StorageId: "storage", SpaceId: "space", OpaqueId: "space"}, Path: "./" + id + ".txt"},
+			Id:               &searchmsg.ResourceID{StorageId: "storage", SpaceId: "space", OpaqueId: id},
+			Name:             id + ".txt",
+			LastModifiedTime: timestamppb.Now(),
+		},
+	}
+}
+
+// newFilterWebdav returns a webdav service where einstein marked the files "fav" and "removed" as favorite,
+// the file "removed" doesn't exist anymore
+func newFilterWebdav(t *testing.T, allowed bool) (Webdav, *cs3mocks.GatewayAPIClient, *searchmocks.SearchProviderService) {
+	gwc := &cs3mocks.GatewayAPIClient{}
+	gwc.On("WhoAmI", mock.Anything, mock.Anything).Return(&gateway.WhoAmIResponse{
+		Status: &rpcv1beta1.Status{Code: rpcv1beta1.Code_CODE_OK},
+		User:   _einstein,
+	}, nil)
+	permissionCode := rpcv1beta1.Code_CODE_OK
+	if !allowed {
+		permissionCode = rpcv1beta1.Code_CODE_PERMISSION_DENIED
+	}
+	gwc.On("CheckPermission", mock.Anything, mock.Anything).Return(&permissions.CheckPermissionResponse{
+		Status: &rpcv1beta1.Status{Code: permissionCode},
+	}, nil)
+	gwc.On("Stat", mock.Anything, mock.MatchedBy(func(req *provider.StatRequest) bool {
+		return req.GetRef().GetResourceId().GetOpaqueId() == "fav"
+	})).Return(&provider.StatResponse{
+		Status: &rpcv1beta1.Status{Code: rpcv1beta1.Code_CODE_OK},
+		Info: &provider.ResourceInfo{
+			Id:       fileID("fav"),
+			ParentId: fileID("space"),
+			Type:     provider.ResourceType_RESOURCE_TYPE_FILE,
+			Name:     "fav.txt",
+			MimeType: "text/plain",
+			PermissionSet: &provider.ResourcePermissions{
+				Stat:                 true,
+				InitiateFileDownload: true,
+			},
+			ArbitraryMetadata: &provider.ArbitraryMetadata{
+				Metadata: map[string]string{"tags": "important"},
+			},
+		},
+	}, nil)
+	gwc.On("Stat", mock.Anything, mock.Anything).Return(&provider.StatResponse{
+		Status: &rpcv1beta1.Status{Code: rpcv1beta1.Code_CODE_NOT_FOUND},
+	}, nil)
+	gwc.On("GetPath", mock.Anything, mock.Anything).Return(&provider.GetPathResponse{
+		Status: &rpcv1beta1.Status{Code: rpcv1beta1.Code_CODE_OK},
+		Path:   "/folder/fav.txt",
+	}, nil)
+
+	pool.RemoveSelector("GatewaySelector" + "eu.opencloud.api.gateway")
+	gatewaySelector := pool.GetSelector[gateway.GatewayAPIClient](
+		"GatewaySelector",
+		"eu.opencloud.api.gateway",
+		func(cc grpc.ClientConnInterface) gateway.GatewayAPIClient {
+			return gwc
+		},
+	)
+
+	favorites := favorite.NewManager(microstore.NewMemoryStore())
+	for _, id := range []string{"fav", "removed"} {
+		require.NoError(t, favorites.SetFavorite(context.Background(), _einstein.GetId(), &provider.ResourceInfo{Id: fileID(id)}))
+	}
+
+	searchClient := searchmocks.NewSearchProviderService(t)
+
+	return Webdav{
+		config:          &config.Config{OpenCloudPublicURL: "https://localhost:9200"},
+		log:             log.NopLogger(),
+		searchClient:    searchClient,
+		gatewaySelector: gatewaySelector,
+		favorites:       favorites,
+	}, gwc, searchClient
+}
+
+func filterFilesReport(t *testing.T, g Webdav, rules string) *httptest.ResponseRecorder {
+	body := `<?xml version="1.0"?>
+<oc:filter-files xmlns:d="DAV:" xmlns:oc="http://owncloud.org/ns">
+  <d:prop><oc:fileid/></d:prop>
+  <oc:filter-rules>` + rules + `</oc:filter-rules>
+</oc:filter-files>`
+	req := httptest.NewRequest("REPORT", "/remote.php/dav/files/einstein", strings.NewReader(body))
+	req.Header.Set(revactx.TokenHeader, "token")
+	rr := httptest.NewRecorder()
+	g.Search(rr, req)
+	return rr
+}
+
+func TestFilterFilesFavorites(t *testing.T) {
+	g, gwc, _ := newFilterWebdav(t, true)
+
+	rr := filterFilesReport(t, g, `<oc:favorite>1</oc:favorite>`)
+	require.Equal(t, http.StatusMultiStatus, rr.Code)
+
+	assert.Contains(t, rr.Body.String(), "/remote.php/dav/spaces/storage$space/folder/fav.txt")
+	assert.Contains(t, rr.Body.String(), "<oc:fileid>storage$space!fav</oc:fileid>")
+	assert.NotContains(t, rr.Body.String(), "removed")
+	assert.Equal(t, "rows 0-0/1", rr.Header().Get("Content-Range"))
+
+	// only the favorites are looked up, the spaces are not walked
+	gwc.AssertNumberOfCalls(t, "Stat", 2)
+	gwc.AssertNotCalled(t, "ListStorageSpaces", mock.Anything, mock.Anything)
+	gwc.AssertNotCalled(t, "ListContainer", mock.Anything, mock.Anything)
+}
+
+func TestFilterFilesFavoritesWithTags(t *testing.T) {
+	g, gwc, searchClient := newFilterWebdav(t, true)
+	searchClient.On("Search", mock.Anything, mock.MatchedBy(func(req *searchsvc.SearchRequest) bool {
+		return req.GetQuery() == `tag:"important"`
+	})).Return(&searchsvc.SearchResponse{
+		Matches:      []*searchmsg.Match{searchMatch("fav"), searchMatch("other")},
+		TotalMatches: 2,
+	}, nil)
+
+	rr := filterFilesReport(t, g, `<oc:favorite>1</oc:favorite><oc:systemtag>important</oc:systemtag>`)
+	require.Equal(t, http.StatusMultiStatus, rr.Code)
+
+	assert.Contains(t, rr.Body.String(), "<oc:fileid>storage$space!fav</oc:fileid>")
+	assert.NotContains(t, rr.Body.String(), "other")
+
+	// the search matches are filtered without looking them up
+	gwc.AssertNotCalled(t, "Stat", mock.Anything, mock.Anything)
+}
+
+func TestFilterFilesTags(t *testing.T) {
+	g, gwc, searchClient := newFilterWebdav(t, true)
+	searchClient.On("Search", mock.Anything, mock.Anything).Return(&searchsvc.SearchResponse{
+		Matches:      []*searchmsg.Match{searchMatch("fav"), searchMatch("other")},
+		TotalMatches: 2,
+	}, nil)
+
+	rr := filterFilesReport(t, g, `<oc:systemtag>important</oc:systemtag>`)
+	require.Equal(t, http.StatusMultiStatus, rr.Code)
+
+	assert.Contains(t, rr.Body.String(), "<oc:fileid>storage$space!fav</oc:fileid>")
+	assert.Contains(t, rr.Body.String(), "<oc:fileid>storage$space!other</oc:fileid>")
+	gwc.AssertNotCalled(t, "WhoAmI", mock.Anything, mock.Anything)
+}
+
+func TestFilterFilesFavoritesNotAllowed(t *testing.T) {
+	g, _, _ := newFilterWebdav(t, false)
+
+	rr := filterFilesReport(t, g, `<oc:favorite>1</oc:favorite>`)
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+}
+
+func TestFilterFilesWithoutRules(t *testing.T) {
+	g, _, _ := newFilterWebdav(t, true)
+
+	rr := filterFilesReport(t, g, `<oc:favorite>0</oc:favorite>`)
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
@@ -31,7 +31,7 @@ import (
 
 const (
 	elementNameSearchFiles = "search-files"
-	// TODO elementNameFilterFiles = "filter-files"
+	elementNameFilterFiles = "filter-files"
 )
 
 // Search is the endpoint for retrieving search results for REPORT requests
@@ -44,8 +44,13 @@ func (g Webdav) Search(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if rep.FilterFiles != nil {
+		g.filterFiles(w, r, rep.FilterFiles)
+		return
+	}
+
 	if rep.SearchFiles == nil {
-		renderError(w, r, errBadRequest("missing search-files tag"))
+		renderError(w, r, errBadRequest("missing search-files or filter-files tag"))
 		logger.Debug().Err(err).Msg("error reading report")
 		return
 	}
@@ -57,25 +62,41 @@ func (g Webdav) Search(w http.ResponseWriter, r *http.Request) {
 	req := &searchsvc.SearchRequest{
 		Query:    rep.SearchFiles.Search.Pattern,
 		PageSize: int32(rep.SearchFiles.Search.Limit),
+		Ref:      g.spaceReference(r),
 	}
 
-	// Limit search to the according space when searching /dav/spaces/
-	if strings.HasPrefix(r.URL.Path, "/dav/spaces") {
-		space := strings.TrimPrefix(r.URL.Path, "/dav/spaces/")
-		rid, err := storagespace.ParseID(space)
-		if err != nil {
-			logger.Debug().Err(err).Msg("error parsing the space id for filtering")
-		} else {
-			req.Ref = &searchmsg.Reference{
-				ResourceId: &searchmsg.ResourceID{
-					StorageId: rid.StorageId,
-					SpaceId:   rid.SpaceId,
-					OpaqueId:  rid.SpaceId,
-				},
-			}
-		}
+	rsp, ok := g.search(ctx, req, w, r)
+	if !ok {
+		return
 	}
 
+	g.sendSearchResponse(rsp, w, r)
+}
+
+// spaceReference returns the reference to limit a search to the according space when searching /dav/spaces/
+func (g Webdav) spaceReference(r *http.Request) *searchmsg.Reference {
+	if !strings.HasPrefix(r.URL.Path, "/dav/spaces") {
+		return nil
+	}
+
+	space := strings.TrimPrefix(r.URL.Path, "/dav/spaces/")
+	rid, err := storagespace.ParseID(space)
+	if err != nil {
+		logger := g.log.SubloggerWithRequestID(r.Context())
+		logger.Debug().Err(err).Msg("error parsing the space id for filtering")
+		return nil
+	}
+	return &searchmsg.Reference{
+		ResourceId: &searchmsg.ResourceID{
+			StorageId: rid.StorageId,
+			SpaceId:   rid.SpaceId,
+			OpaqueId:  rid.SpaceId,
+		},
+	}
+}
+
+// search queries the search service and renders the error response if the search failed
+func (g Webdav) search(ctx context.Context, req *searchsvc.SearchRequest, w http.ResponseWriter, r *http.Request) (*searchsvc.SearchResponse, bool) {
 	rsp, err := g.searchClient.Search(ctx, req)
 	if err != nil {
 		e := merrors.Parse(err.Error())
@@ -85,16 +106,16 @@ func (g Webdav) Search(w http.ResponseWriter, r *http.Request) {
 		default:
 			renderError(w, r, errInternalError(err.Error()))
 		}
+		logger := g.log.SubloggerWithRequestID(r.Context())
 		logger.Error().Err(err).Msg("could not get search results")
-		return
+		return nil, false
 	}
-
-	g.sendSearchResponse(rsp, w, r)
+	return rsp, true
 }
 
 func (g Webdav) sendSearchResponse(rsp *searchsvc.SearchResponse, w http.ResponseWriter, r *http.Request) {
 	logger := g.log.SubloggerWithRequestID(r.Context())
-	responsesXML, err := multistatusResponse(r.Context(), g.config.OpenCloudPublicURL, rsp.Matches)
+	responsesXML, err := multistatusResponse(r.Context(), g.config.OpenCloudPublicURL, g.previews, rsp.Matches)
 	if err != nil {
 		logger.Error().Err(err).Msg("error formatting propfind")
 		w.WriteHeader(http.StatusInternalServerError)
@@ -112,10 +133,10 @@ func (g Webdav) sendSearchResponse(rsp *searchsvc.SearchResponse, w http.Respons
 }
 
 // multistatusResponse converts a list of matches into a multistatus response string
-func multistatusResponse(ctx context.Context, publicURL string, matches []*searchmsg.Match) ([]byte, error) {
+func multistatusResponse(ctx context.Context, publicURL string, previews thumbnail.Previews, matches []*searchmsg.Match) ([]byte, error) {
 	responses := make([]*propfind.ResponseXML, 0, len(matches))
 	for i := range matches {
-		res, err := matchToPropResponse(ctx, publicURL, matches[i])
+		res, err := matchToPropResponse(ctx, publicURL, previews, matches[i])
 		if err != nil {
 			return nil, err
 		}
@@ -131,7 +152,30 @@ func multistatusResponse(ctx context.Context, publicURL string, matches []*searc
 	return msg, nil
 }
 
-func matchToPropResponse(ctx context.Context, publicURL string, match *searchmsg.Match) (*propfind.ResponseXML, error) {
+// highlightFragmentsProps returns the highlighted fragments of the matched fields, one property per field, e.g.
+// <oc:name-highlights><oc:fragment>..</oc:fragment></oc:name-highlights>
+func highlightFragmentsProps(entity *searchmsg.Entity) []prop.PropertyXML {
+	var fields []string
+	fragments := map[string]*strings.Builder{}
+	for _, fragment := range entity.GetFragments() {
+		field := strings.ToLower(fragment.GetField())
+		b, ok := fragments[field]
+		if !ok {
+			b = &strings.Builder{}
+			fragments[field] = b
+			fields = append(fields, field)
+		}
+		b.WriteString("<oc:fragment>" + prop.Escape(fragment.GetText()) + "</oc:fragment>")
+	}
+
+	props := make([]prop.PropertyXML, 0, len(fields))
+	for _, field := range fields {
+		props = append(props, prop.Raw("oc:"+field+"-highlights", fragments[field].String()))
+	}
+	return props
+}
+
+func matchToPropResponse(ctx context.Context, publicURL string, previews thumbnail.Previews, match *searchmsg.Match) (*propfind.ResponseXML, error) {
 	// unfortunately, search uses own versions of ResourceId and Ref. So we need to assert them here
 	var (
 		ref string
@@ -198,9 +242,9 @@ func matchToPropResponse(ctx context.Context, publicURL string, match *searchmsg
 	propstatOK.Prop = append(propstatOK.Prop, prop.Escaped("d:getlastmodified", match.Entity.LastModifiedTime.AsTime().Format(constants.RFC1123)))
 	propstatOK.Prop = append(propstatOK.Prop, prop.Escaped("oc:permissions", match.Entity.Permissions))
 	propstatOK.Prop = append(propstatOK.Prop, prop.Escaped("oc:highlights", match.Entity.Highlights))
+	propstatOK.Prop = append(propstatOK.Prop, highlightFragmentsProps(match.Entity)...)
 	propstatOK.Prop = append(propstatOK.Prop, prop.Escaped("d:getcontenttype", match.Entity.MimeType))
-	_, isSupportedMimeType := thumbnail.SupportedMimeTypes[match.Entity.MimeType]
-	if isSupportedMimeType {
+	if previews.IsMimeTypeSupported(match.Entity.MimeType) {
 		propstatOK.Prop = append(propstatOK.Prop, prop.Escaped("oc:has-preview", "1"))
 	} else {
 		propstatOK.Prop = append(propstatOK.Prop, prop.Escaped("oc:has-preview", "0"))
@@ -241,9 +285,8 @@ func matchToPropResponse(ctx context.Context, publicURL string, match *searchmsg
 	return &response, nil
 }
 
-func hasPreview(md *provider.ResourceInfo, appendToOK func(p ...prop.PropertyXML)) {
-	_, match := thumbnail.SupportedMimeTypes[md.MimeType]
-	if match {
+func hasPreview(previews thumbnail.Previews, md *provider.ResourceInfo, appendToOK func(p ...prop.PropertyXML)) {
+	if previews.IsMimeTypeSupported(md.MimeType) {
 		appendToOK(prop.Escaped("oc:has-preview", "1"))
 	} else {
 		appendToOK(prop.Escaped("oc:has-preview", "0"))
@@ -252,7 +295,6 @@ func hasPreview(md *provider.ResourceInfo, appendToOK func(p ...prop.PropertyXML
 
 type report struct {
 	SearchFiles *reportSearchFiles
-	// FilterFiles TODO add this for tag based search
 	FilterFiles *reportFilterFiles `xml:"filter-files"`
 }
 type reportSearchFiles struct {
@@ -274,9 +316,11 @@ type reportFilterFiles struct {
 	Rules   reportFilterFilesRules `xml:"filter-rules"`
 }
 
+// reportFilterFilesRules are the rules of a filter-files report. All rules have to match, the system tags
+// are the names of the tags.
 type reportFilterFilesRules struct {
-	Favorite  bool `xml:"favorite"`
-	SystemTag int  `xml:"systemtag"`
+	Favorite   bool     `xml:"favorite"`
+	SystemTags []string `xml:"systemtag"`
 }
 
 // Props represents properties related to a resource
@@ -314,15 +358,13 @@ func readReport(r io.Reader) (rep *report, err error) {
 					return nil, err
 				}
 				rep.SearchFiles = &repSF
-				/*
-					} else if v.Name.Local == elementNameFilterFiles {
-						var repFF reportFilterFiles
-						err = decoder.DecodeElement(&repFF, &v)
-						if err != nil {
-							return nil, http.StatusBadRequest, err
-						}
-						rep.FilterFiles = &repFF
-				*/
+			} else if v.Name.Local == elementNameFilterFiles {
+				var repFF reportFilterFiles
+				err = decoder.DecodeElement(&repFF, &v)
+				if err != nil {
+					return nil, err
+				}
+				rep.FilterFiles = &repFF
 			}
 		}
 	}
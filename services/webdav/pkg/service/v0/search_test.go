@@ -0,0 +1,42 @@
+package svc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	searchmsg "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/search/v0"
+	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/thumbnail"
+)
+
+func TestMultistatusResponseHighlights(t *testing.T) {
+	match := searchMatch("budget")
+	match.Entity.Highlights = "the <mark>budget</mark> for next year"
+	match.Entity.Fragments = []*searchmsg.Fragment{
+		{Field: "Name", Text: "<mark>budget</mark>.txt"},
+		{Field: "Content", Text: "the <mark>budget</mark> for next year"},
+		{Field: "Content", Text: "the <mark>budget</mark> of last year"},
+	}
+
+	b, err := multistatusResponse(context.Background(), "https://localhost:9200", thumbnail.Previews{}, []*searchmsg.Match{match})
+	require.NoError(t, err)
+
+	body := string(b)
+	assert.Contains(t, body, "<oc:highlights>the &lt;mark&gt;budget&lt;/mark&gt; for next year</oc:highlights>")
+	assert.Contains(t, body, "<oc:name-highlights><oc:fragment>&lt;mark&gt;budget&lt;/mark&gt;.txt</oc:fragment></oc:name-highlights>")
+	assert.Contains(t, body, "<oc:content-highlights><oc:fragment>the &lt;mark&gt;budget&lt;/mark&gt; for next year</oc:fragment>"+
+		"<oc:fragment>the &lt;mark&gt;budget&lt;/mark&gt; of last year</oc:fragment></oc:content-highlights>")
+}
+
+func TestMultistatusResponseWithoutFragments(t *testing.T) {
+	match := searchMatch("budget")
+	match.Entity.Highlights = "the <mark>budget</mark>"
+
+	b, err := multistatusResponse(context.Background(), "https://localhost:9200", thumbnail.Previews{}, []*searchmsg.Match{match})
+	require.NoError(t, err)
+
+	assert.Contains(t, string(b), "<oc:highlights>the &lt;mark&gt;budget&lt;/mark&gt;</oc:highlights>")
+	assert.NotContains(t, string(b), "-highlights>")
+}
@@ -19,17 +19,22 @@ import (
 	"github.com/go-chi/render"
 	revactx "github.com/opencloud-eu/reva/v2/pkg/ctx"
 	"github.com/opencloud-eu/reva/v2/pkg/rgrpc/todo/pool"
+	"github.com/opencloud-eu/reva/v2/pkg/storage/favorite"
 	"github.com/opencloud-eu/reva/v2/pkg/storage/utils/templates"
+	"github.com/opencloud-eu/reva/v2/pkg/store"
 	"github.com/riandyrn/otelchi"
 	merrors "go-micro.dev/v4/errors"
+	microstore "go-micro.dev/v4/store"
 	grpcmetadata "google.golang.org/grpc/metadata"
 
+	ocfavorite "github.com/opencloud-eu/opencloud/pkg/favorite"
 	"github.com/opencloud-eu/opencloud/pkg/log"
 	"github.com/opencloud-eu/opencloud/pkg/registry"
 	"github.com/opencloud-eu/opencloud/pkg/tracing"
 	thumbnailsmsg "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/messages/thumbnails/v0"
 	searchsvc "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/search/v0"
 	thumbnailssvc "github.com/opencloud-eu/opencloud/protogen/gen/opencloud/services/thumbnails/v0"
+	"github.com/opencloud-eu/opencloud/services/thumbnails/pkg/thumbnail"
 	"github.com/opencloud-eu/opencloud/services/webdav/pkg/config"
 	"github.com/opencloud-eu/opencloud/services/webdav/pkg/constants"
 	"github.com/opencloud-eu/opencloud/services/webdav/pkg/dav/requests"
@@ -86,6 +91,14 @@ func NewService(opts ...Option) (Service, error) {
 		searchClient:     searchsvc.NewSearchProviderService("eu.opencloud.api.search", conf.GrpcClient),
 		thumbnailsClient: thumbnailssvc.NewThumbnailService("eu.opencloud.api.thumbnails", conf.GrpcClient),
 		gatewaySelector:  gatewaySelector,
+		favorites: ocfavorite.NewManager(store.Create(
+			store.Store(conf.Store.Store),
+			microstore.Nodes(conf.Store.Nodes...),
+			microstore.Database(conf.Store.Database),
+			microstore.Table(conf.Store.Table),
+			store.Authentication(conf.Store.AuthUsername, conf.Store.AuthPassword),
+		)),
+		previews: thumbnail.NewPreviews(conf.Thumbnails.DocumentConverterURL, conf.Thumbnails.FFmpegPath),
 	}
 
 	if svc.config.DisablePreviews {
@@ -155,6 +168,8 @@ type Webdav struct {
 	searchClient     searchsvc.SearchProviderService
 	thumbnailsClient thumbnailssvc.ThumbnailService
 	gatewaySelector  pool.Selectable[gatewayv1beta1.GatewayAPIClient]
+	favorites        favorite.Manager
+	previews         thumbnail.Previews
 }
 
 // ServeHTTP implements the Service interface.